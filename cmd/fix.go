@@ -14,3 +14,5 @@ package main
 //go:generate go run cmd/generate-enum/main.go ClusterNamespaceConditionType $PWD/proto/types/infrapb/v3
 //go:generate go run cmd/generate-enum/main.go ClusterTaskConditionType $PWD/proto/types/infrapb/v3
 //go:generate go run cmd/generate-enum/main.go ClusterShareMode $PWD/proto/types/infrapb/v3
+//go:generate go run cmd/generate-enum/main.go AgentUpgradeState $PWD/proto/types/infrapb/v3
+//go:generate go run cmd/generate-enum/main.go AgentUpgradeClusterState $PWD/proto/types/infrapb/v3
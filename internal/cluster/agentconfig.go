@@ -0,0 +1,84 @@
+package cluster
+
+import (
+	"fmt"
+
+	infrav3 "github.com/paralus/paralus/proto/types/infrapb/v3"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// formats of the configuration of a bootstrap agent
+const (
+	AgentConfigFormatYAML   = "yaml"
+	AgentConfigFormatSecret = "secret"
+)
+
+// agentConfigKey is the key of the configuration in the secret of a
+// bootstrap agent
+const agentConfigKey = "config.yaml"
+
+// BootstrapAgentConfig is the configuration a bootstrap agent needs to
+// register with the bootstrap endpoint and connect to the relays
+type BootstrapAgentConfig struct {
+	Name          string           `json:"name"`
+	Project       string           `json:"project,omitempty"`
+	Mode          string           `json:"mode"`
+	BootstrapAddr string           `json:"bootstrapAddr"`
+	TemplateToken string           `json:"templateToken"`
+	Token         string           `json:"token"`
+	CACert        string           `json:"caCert,omitempty"`
+	Relays        []string         `json:"relays"`
+	Proxy         *RelayAgentProxy `json:"proxy,omitempty"`
+}
+
+// NewBootstrapAgentProxy returns the proxy of the bootstrap agent of the
+// cluster, nil when the cluster does not dial out through a proxy
+func NewBootstrapAgentProxy(pc *infrav3.ProxyConfig) *RelayAgentProxy {
+	if !pc.GetEnabled() {
+		return nil
+	}
+	return &RelayAgentProxy{
+		Enabled:                true,
+		HttpProxy:              pc.HttpProxy,
+		HttpsProxy:             pc.HttpsProxy,
+		NoProxy:                pc.NoProxy,
+		ProxyAuth:              pc.ProxyAuth,
+		BootstrapCA:            pc.BootstrapCA,
+		AllowInsecureBootstrap: pc.AllowInsecureBootstrap,
+	}
+}
+
+// GetBootstrapAgentConfig renders the configuration of the bootstrap
+// agent in the format, as YAML or as a kubernetes secret manifest
+// holding the YAML
+func GetBootstrapAgentConfig(config *BootstrapAgentConfig, format string) ([]byte, error) {
+	if config.Relays == nil {
+		config.Relays = []string{}
+	}
+	b, err := yaml.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+
+	switch format {
+	case "", AgentConfigFormatYAML:
+		return b, nil
+	case AgentConfigFormatSecret:
+		secret := &corev1.Secret{
+			TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+			ObjectMeta: metav1.ObjectMeta{
+				Name: fmt.Sprintf("%s-bootstrap-config", config.Name),
+				Labels: map[string]string{
+					"app.kubernetes.io/managed-by": "paralus",
+				},
+			},
+			Type:       corev1.SecretTypeOpaque,
+			StringData: map[string]string{agentConfigKey: string(b)},
+		}
+		return yaml.Marshal(secret)
+	default:
+		return nil, fmt.Errorf("unknown agent config format %q", format)
+	}
+}
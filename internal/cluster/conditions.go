@@ -39,6 +39,7 @@ var (
 	NewClusterBootstrapAgent    ClusterConditionFunc = newClusterCondition(infrav3.ClusterConditionType_ClusterBootstrapAgent)
 	NewClusterDelete            ClusterConditionFunc = newClusterCondition(infrav3.ClusterConditionType_ClusterDelete)
 	NewClusterHealth            ClusterConditionFunc = newClusterCondition(infrav3.ClusterConditionType_ClusterHealth)
+	NewClusterAgentUpgrade      ClusterConditionFunc = newClusterCondition(infrav3.ClusterConditionType_ClusterAgentUpgrade)
 
 	IsClusterBootstrapAgentPending ClusterConditionReadyFunc = isClusterCondition(constants.Pending, infrav3.ClusterConditionType_ClusterBootstrapAgent)
 	IsClusterBootstrapAgentRetry   ClusterConditionReadyFunc = isClusterCondition(constants.Retry, infrav3.ClusterConditionType_ClusterBootstrapAgent)
@@ -192,3 +193,17 @@ func isClusterCooledDown(conditionType infrav3.ClusterConditionType) func(c *inf
 		return false
 	}
 }
+
+// GetClusterHealth returns the health of the cluster as recorded in its
+// ClusterHealth condition
+func GetClusterHealth(c *infrav3.Cluster) infrav3.Health {
+	switch {
+	case IsClusterHealthy(c):
+		return infrav3.Health_EDGE_HEALTHY
+	case IsClusterUnhealthy(c):
+		return infrav3.Health_EDGE_UNHEALTHY
+	case IsClusterDisconnected(c):
+		return infrav3.Health_EDGE_DISCONNECTED
+	}
+	return infrav3.Health_EDGE_IGNORE
+}
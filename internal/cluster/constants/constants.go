@@ -32,12 +32,25 @@ const (
 	ClusterID                = "paralus.dev/clusterID"
 	Public                   = "paralus.dev/public"
 	ClusterName              = "paralus.dev/clusterName"
+	// labels of the bootstrap agents of the cluster with the project
+	// owning it and whether it is shared with all projects
+	ClusterOwnerProjectKey = "paralus.dev/ownerProject"
+	ClusterShareModeKey    = "paralus.dev/shareMode"
+	ClusterProjectPrefix   = "project/"
+	// label of the bootstrap agents of the cluster with the unix time
+	// it was moved to another project, kubeconfigs issued before it
+	// are not authorized
+	ClusterMovedAtKey = "paralus.dev/movedAt"
 )
 
 const (
 	ApiVersion      = "infra.k8smgmt.io/v3"
 	ClusterKind     = "Cluster"
 	ClusterListKind = "ClusterList"
+
+	AgentUpgradeKind     = "AgentUpgrade"
+	AgentUpgradeListKind = "AgentUpgradeList"
+	AgentVersionListKind = "AgentVersionList"
 )
 
 const (
@@ -0,0 +1,198 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/models"
+	infrav3 "github.com/paralus/paralus/proto/types/infrapb/v3"
+	"github.com/uptrace/bun"
+)
+
+// GetClusterAgent returns the versions of the relay agent of the
+// cluster, nil if none was reported or desired
+func GetClusterAgent(ctx context.Context, db bun.IDB, clusterID uuid.UUID) (*models.ClusterAgent, error) {
+	var ca models.ClusterAgent
+	err := db.NewSelect().Model(&ca).
+		Where("cluster_id = ?", clusterID).
+		Scan(ctx)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &ca, nil
+}
+
+// GetClusterAgents returns the versions of the relay agents of the
+// clusters
+func GetClusterAgents(ctx context.Context, db bun.IDB, clusterIDs []uuid.UUID) ([]models.ClusterAgent, error) {
+	var cas []models.ClusterAgent
+	if len(clusterIDs) == 0 {
+		return cas, nil
+	}
+	err := db.NewSelect().Model(&cas).
+		Where("cluster_id IN (?)", bun.In(clusterIDs)).
+		Scan(ctx)
+	return cas, err
+}
+
+// SetClusterAgentVersion records the version reported by the relay
+// agent of the cluster
+func SetClusterAgentVersion(ctx context.Context, db bun.IDB, clusterID uuid.UUID, version string, reportedAt time.Time) error {
+	_, err := db.NewInsert().Model(&models.ClusterAgent{
+		ClusterId:    clusterID,
+		AgentVersion: version,
+		ReportedAt:   reportedAt,
+	}).
+		On("CONFLICT (cluster_id) DO UPDATE").
+		Set("agent_version = EXCLUDED.agent_version").
+		Set("reported_at = EXCLUDED.reported_at").
+		Exec(ctx)
+	return err
+}
+
+// SetDesiredAgentVersion sets the version the relay agent of the
+// cluster should run
+func SetDesiredAgentVersion(ctx context.Context, db bun.IDB, clusterID uuid.UUID, version string) error {
+	_, err := db.NewInsert().Model(&models.ClusterAgent{
+		ClusterId:           clusterID,
+		DesiredAgentVersion: version,
+	}).
+		On("CONFLICT (cluster_id) DO UPDATE").
+		Set("desired_agent_version = EXCLUDED.desired_agent_version").
+		Exec(ctx)
+	return err
+}
+
+// CreateAgentUpgrade creates the upgrade with its clusters
+func CreateAgentUpgrade(ctx context.Context, db bun.IDB, upgrade *models.AgentUpgrade, clusters []models.AgentUpgradeCluster) error {
+	_, err := db.NewInsert().Model(upgrade).Returning("id").Exec(ctx)
+	if err != nil {
+		return err
+	}
+	for i := range clusters {
+		clusters[i].UpgradeId = upgrade.ID
+	}
+	_, err = db.NewInsert().Model(&clusters).Exec(ctx)
+	return err
+}
+
+// GetAgentUpgrade returns the upgrade of the project with the name
+func GetAgentUpgrade(ctx context.Context, db bun.IDB, projectID uuid.UUID, name string) (*models.AgentUpgrade, error) {
+	var upgrade models.AgentUpgrade
+	err := db.NewSelect().Model(&upgrade).
+		Where("project_id = ?", projectID).
+		Where("name = ?", name).
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &upgrade, nil
+}
+
+// ListAgentUpgrades returns the upgrades of the project, latest first
+func ListAgentUpgrades(ctx context.Context, db bun.IDB, projectID uuid.UUID) ([]models.AgentUpgrade, error) {
+	var upgrades []models.AgentUpgrade
+	err := db.NewSelect().Model(&upgrades).
+		Where("project_id = ?", projectID).
+		Order("created_at DESC").
+		Scan(ctx)
+	return upgrades, err
+}
+
+// GetAgentUpgradeClusters returns the clusters of the upgrade
+func GetAgentUpgradeClusters(ctx context.Context, db bun.IDB, upgradeID uuid.UUID) ([]models.AgentUpgradeCluster, error) {
+	var clusters []models.AgentUpgradeCluster
+	err := db.NewSelect().Model(&clusters).
+		Where("upgrade_id = ?", upgradeID).
+		Order("cluster_name ASC").
+		Scan(ctx)
+	return clusters, err
+}
+
+// GetRunningAgentUpgradeIDs returns the ids of the upgrades in progress
+func GetRunningAgentUpgradeIDs(ctx context.Context, db bun.IDB) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	err := db.NewSelect().Model((*models.AgentUpgrade)(nil)).
+		Column("id").
+		Where("state = ?", infrav3.AgentUpgradeState_AgentUpgradeRunning.String()).
+		Order("created_at ASC").
+		Scan(ctx, &ids)
+	return ids, err
+}
+
+// LockAgentUpgrade locks the upgrade for the transaction if it is in
+// progress, nil if it is not or another transaction holds the lock
+func LockAgentUpgrade(ctx context.Context, tx bun.Tx, id uuid.UUID) (*models.AgentUpgrade, error) {
+	var upgrade models.AgentUpgrade
+	err := tx.NewSelect().Model(&upgrade).
+		Where("id = ?", id).
+		Where("state = ?", infrav3.AgentUpgradeState_AgentUpgradeRunning.String()).
+		For("UPDATE SKIP LOCKED").
+		Scan(ctx)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &upgrade, nil
+}
+
+// UpdateAgentUpgradeState sets the state of the upgrade
+func UpdateAgentUpgradeState(ctx context.Context, db bun.IDB, upgrade *models.AgentUpgrade) error {
+	_, err := db.NewUpdate().Model(upgrade).
+		Column("state", "modified_at").
+		WherePK().
+		Exec(ctx)
+	return err
+}
+
+// UpdateAgentUpgradeCluster sets the progress of the upgrade of the
+// cluster
+func UpdateAgentUpgradeCluster(ctx context.Context, db bun.IDB, cluster *models.AgentUpgradeCluster) error {
+	_, err := db.NewUpdate().Model(cluster).
+		Column("state", "reason", "started_at", "finished_at").
+		WherePK().
+		Exec(ctx)
+	return err
+}
+
+// GetUpgradingAgentUpgradeCluster returns the upgrade of the cluster
+// in progress, nil if the cluster is not upgrading
+func GetUpgradingAgentUpgradeCluster(ctx context.Context, db bun.IDB, clusterID uuid.UUID) (*models.AgentUpgradeCluster, error) {
+	var cluster models.AgentUpgradeCluster
+	err := db.NewSelect().Model(&cluster).
+		Where("cluster_id = ?", clusterID).
+		Where("state = ?", infrav3.AgentUpgradeClusterState_AgentUpgradeClusterInProgress.String()).
+		Where("upgrade_id IN (?)", db.NewSelect().Model((*models.AgentUpgrade)(nil)).
+			Column("id").
+			Where("state = ?", infrav3.AgentUpgradeState_AgentUpgradeRunning.String())).
+		Limit(1).
+		Scan(ctx)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &cluster, nil
+}
+
+// ResetFailedAgentUpgradeClusters sets the clusters of the upgrade
+// which failed to upgrade pending again
+func ResetFailedAgentUpgradeClusters(ctx context.Context, db bun.IDB, upgradeID uuid.UUID) error {
+	_, err := db.NewUpdate().Model((*models.AgentUpgradeCluster)(nil)).
+		Set("state = ?", infrav3.AgentUpgradeClusterState_AgentUpgradeClusterPending.String()).
+		Set("reason = ''").
+		Set("started_at = NULL").
+		Set("finished_at = NULL").
+		Where("upgrade_id = ?", upgradeID).
+		Where("state = ?", infrav3.AgentUpgradeClusterState_AgentUpgradeClusterFailed.String()).
+		Exec(ctx)
+	return err
+}
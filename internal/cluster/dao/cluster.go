@@ -9,6 +9,7 @@ import (
 	"github.com/paralus/paralus/internal/cluster/constants"
 	"github.com/paralus/paralus/internal/dao"
 	"github.com/paralus/paralus/internal/models"
+	"github.com/paralus/paralus/pkg/query"
 	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
 	"github.com/uptrace/bun"
 )
@@ -71,6 +72,50 @@ func UpdateClusterAnnotations(ctx context.Context, db bun.IDB, c *models.Cluster
 	return nil
 }
 
+// UpdateClusterShareMode sets whether the cluster is shared with all
+// projects of its organization
+func UpdateClusterShareMode(ctx context.Context, db bun.IDB, c *models.Cluster) error {
+	_, err := db.NewUpdate().Model((*models.Cluster)(nil)).
+		Set("share_mode = ?", c.ShareMode).
+		Set("modified_at = ?", time.Now()).
+		Where("id = ?", c.ID).Exec(ctx)
+	return err
+}
+
+// UpdateClusterIdentity updates the name and project of the cluster,
+// its id is unchanged
+func UpdateClusterIdentity(ctx context.Context, db bun.IDB, c *models.Cluster) error {
+	_, err := db.NewUpdate().Model((*models.Cluster)(nil)).
+		Set("name = ?", c.Name).
+		Set("display_name = ?", c.DisplayName).
+		Set("project_id = ?", c.ProjectId).
+		Set("labels = ?", c.Labels).
+		Set("modified_at = ?", time.Now()).
+		Where("id = ?", c.ID).Exec(ctx)
+	return err
+}
+
+// ListClusterIDsBySelector returns the ids of the clusters of the
+// organization whose labels match the selector, limited to the clusters
+// of the project if one is given
+func ListClusterIDsBySelector(ctx context.Context, db bun.IDB, partnerID, orgID, projectID uuid.UUID, selector string) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	q := db.NewSelect().Model((*models.Cluster)(nil)).
+		Column("id").
+		Where("partner_id = ?", partnerID).
+		Where("organization_id = ?", orgID).
+		Where("trash = ?", false)
+	if projectID != uuid.Nil {
+		q = q.Where("id IN (SELECT cluster_id FROM cluster_project_cluster WHERE project_id = ? AND trash = ?)", projectID, false)
+	}
+	q, err := query.FilterLabels(q, &commonv3.QueryOptions{Selector: selector})
+	if err != nil {
+		return nil, err
+	}
+	err = q.Scan(ctx, &ids)
+	return ids, err
+}
+
 func GetCluster(ctx context.Context, db bun.IDB, cluster *models.Cluster) (*models.Cluster, error) {
 
 	if cluster.ID != uuid.Nil {
@@ -103,6 +148,10 @@ func ListClusters(ctx context.Context, db bun.IDB, qo commonv3.QueryOptions) (cl
 	oid := uuid.NullUUID{UUID: uuid.MustParse(qo.Organization), Valid: true}
 	prid := uuid.NullUUID{UUID: uuid.MustParse(qo.Project), Valid: true}
 
+	if HasInventoryFilter(&qo) {
+		return listClustersByInventory(ctx, db, pid, oid, prid, &qo)
+	}
+
 	if qo.Q != "" || qo.OrderBy != "" {
 		_, err = dao.ListFiltered(ctx, db, pid, oid, prid, &clusters, qo.Q, qo.OrderBy, qo.Order, int(qo.Limit), int(qo.Offset))
 		if err != nil {
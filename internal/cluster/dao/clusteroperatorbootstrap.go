@@ -11,19 +11,18 @@ import (
 
 var _log = log.GetLogger()
 
-func CreateOperatorBootstrap(ctx context.Context, db bun.Tx, bootstrap *models.ClusterOperatorBootstrap) error {
+func CreateOperatorBootstrap(ctx context.Context, db bun.IDB, bootstrap *models.ClusterOperatorBootstrap) error {
 	_log.Infow("CreateOperatorBootstrap: Creating operator bootstrap data", "cluster", bootstrap.ClusterId)
 
-	var bstrap *models.ClusterOperatorBootstrap
+	bstrap := &models.ClusterOperatorBootstrap{}
 
-	entity, err := dao.GetX(ctx, db, "edge_id", bootstrap.ClusterId, &bstrap)
+	_, err := dao.GetX(ctx, db, "cluster_id", bootstrap.ClusterId, bstrap)
 	if err != nil {
 		_log.Infow("CreateOperatorBootstrap: No existing bootstrap data detected", "edge", bootstrap.ClusterId)
 	} else {
 		_log.Infow("CreateOperatorBootstrap: Removing existing bootstrap data", "edge", bootstrap.ClusterId)
 
-		bstrap = entity.(*models.ClusterOperatorBootstrap)
-		err = dao.DeleteX(ctx, db, "edge_id", bstrap.ClusterId, bstrap)
+		err = dao.DeleteX(ctx, db, "cluster_id", bstrap.ClusterId, bstrap)
 		if err != nil {
 			_log.Errorw("Error while deleting bootstrap data", "Error", err)
 			return err
@@ -45,7 +44,7 @@ func CreateOperatorBootstrap(ctx context.Context, db bun.Tx, bootstrap *models.C
 func GetOperatorBootstrap(ctx context.Context, db bun.IDB, clusterid string) (*models.ClusterOperatorBootstrap, error) {
 
 	var bootstrap models.ClusterOperatorBootstrap
-	entity, err := dao.GetX(ctx, db, "clusterid", clusterid, bootstrap)
+	entity, err := dao.GetX(ctx, db, "cluster_id", clusterid, &bootstrap)
 	if err != nil {
 		_log.Errorw("Error while fetching bootstrap data using tx ", "Error", err)
 		return nil, err
@@ -0,0 +1,78 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/models"
+	"github.com/uptrace/bun"
+)
+
+// RecordClustersSeen records that the relay agents of the clusters
+// were connected at the time, never moving the last seen time back
+func RecordClustersSeen(ctx context.Context, db bun.IDB, clusterIDs []uuid.UUID, at time.Time) error {
+	if len(clusterIDs) == 0 {
+		return nil
+	}
+	health := make([]models.ClusterHealth, len(clusterIDs))
+	for i, id := range clusterIDs {
+		health[i] = models.ClusterHealth{ClusterId: id, LastSeenAt: at}
+	}
+	_, err := db.NewInsert().Model(&health).
+		On("CONFLICT (cluster_id) DO UPDATE").
+		Set("last_seen_at = GREATEST(clusterhealth.last_seen_at, EXCLUDED.last_seen_at)").
+		Exec(ctx)
+	return err
+}
+
+// ListClustersForHealth returns the clusters which are not deleted
+// along with the time they were last seen connected
+func ListClustersForHealth(ctx context.Context, db bun.IDB) ([]models.Cluster, map[uuid.UUID]time.Time, error) {
+	var clusters []models.Cluster
+	err := db.NewSelect().Model(&clusters).
+		Where("trash = ?", false).
+		Scan(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	var health []models.ClusterHealth
+	err = db.NewSelect().Model(&health).Scan(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	lastSeen := make(map[uuid.UUID]time.Time, len(health))
+	for _, h := range health {
+		lastSeen[h.ClusterId] = h.LastSeenAt
+	}
+	return clusters, lastSeen, nil
+}
+
+// UpdateClusterConditions sets the conditions of the cluster unless
+// they were changed since they were read
+func UpdateClusterConditions(ctx context.Context, db bun.IDB, c *models.Cluster, previous []byte) (bool, error) {
+	res, err := db.NewUpdate().Model((*models.Cluster)(nil)).
+		Set("conditions = ?", c.Conditions).
+		Where("id = ?", c.ID).
+		Where("conditions = ?::jsonb", string(previous)).
+		Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n > 0, err
+}
+
+// GetClusterLastSeen returns the time the relay agent of the cluster
+// was last seen connected, zero if it was never seen
+func GetClusterLastSeen(ctx context.Context, db bun.IDB, clusterID uuid.UUID) (time.Time, error) {
+	var health models.ClusterHealth
+	err := db.NewSelect().Model(&health).
+		Where("cluster_id = ?", clusterID).
+		Scan(ctx)
+	if err == sql.ErrNoRows {
+		return time.Time{}, nil
+	}
+	return health.LastSeenAt, err
+}
@@ -0,0 +1,162 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/models"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	"github.com/uptrace/bun"
+)
+
+// actions of cluster node events
+const (
+	ClusterNodeAdded   = "added"
+	ClusterNodeRemoved = "removed"
+)
+
+// GetClusterInventory returns the inventory last reported for the
+// cluster, nil if it was never reported
+func GetClusterInventory(ctx context.Context, db bun.IDB, clusterID uuid.UUID) (*models.ClusterInventory, error) {
+	var inv models.ClusterInventory
+	err := db.NewSelect().Model(&inv).
+		Where("cluster_id = ?", clusterID).
+		Scan(ctx)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &inv, nil
+}
+
+// UpsertClusterInventory sets the inventory of the cluster
+func UpsertClusterInventory(ctx context.Context, db bun.IDB, inv *models.ClusterInventory) error {
+	_, err := db.NewInsert().Model(inv).
+		On("CONFLICT (cluster_id) DO UPDATE").
+		Set("kubernetes_version = EXCLUDED.kubernetes_version").
+		Set("node_count = EXCLUDED.node_count").
+		Set("ready_node_count = EXCLUDED.ready_node_count").
+		Set("capacity = EXCLUDED.capacity").
+		Set("allocatable = EXCLUDED.allocatable").
+		Set("allocated = EXCLUDED.allocated").
+		Set("synced_at = EXCLUDED.synced_at").
+		Exec(ctx)
+	return err
+}
+
+// GetClusterNodes returns the nodes last reported for the cluster
+func GetClusterNodes(ctx context.Context, db bun.IDB, clusterID uuid.UUID) ([]models.ClusterNode, error) {
+	var nodes []models.ClusterNode
+	err := db.NewSelect().Model(&nodes).
+		Where("cluster_id = ?", clusterID).
+		Order("name ASC").
+		Scan(ctx)
+	return nodes, err
+}
+
+// GetClusterNodeNames returns the names of the nodes last reported for
+// the cluster
+func GetClusterNodeNames(ctx context.Context, db bun.IDB, clusterID uuid.UUID) ([]string, error) {
+	var names []string
+	err := db.NewSelect().Model((*models.ClusterNode)(nil)).
+		Column("name").
+		Where("cluster_id = ?", clusterID).
+		Scan(ctx, &names)
+	return names, err
+}
+
+// UpsertClusterNodes creates or updates the nodes of a cluster, keeping
+// the time each node was first reported
+func UpsertClusterNodes(ctx context.Context, db bun.IDB, nodes []models.ClusterNode) error {
+	if len(nodes) == 0 {
+		return nil
+	}
+	_, err := db.NewInsert().Model(&nodes).
+		On("CONFLICT (cluster_id, name) DO UPDATE").
+		Set("node = EXCLUDED.node").
+		Set("modified_at = EXCLUDED.modified_at").
+		Exec(ctx)
+	return err
+}
+
+// DeleteClusterNodes deletes the nodes of the cluster with the names
+func DeleteClusterNodes(ctx context.Context, db bun.IDB, clusterID uuid.UUID, names []string) error {
+	if len(names) == 0 {
+		return nil
+	}
+	_, err := db.NewDelete().Model((*models.ClusterNode)(nil)).
+		Where("cluster_id = ?", clusterID).
+		Where("name IN (?)", bun.In(names)).
+		Exec(ctx)
+	return err
+}
+
+// CreateClusterNodeEvents records nodes added to or removed from
+// clusters
+func CreateClusterNodeEvents(ctx context.Context, db bun.IDB, events []models.ClusterNodeEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+	_, err := db.NewInsert().Model(&events).Returning("NULL").Exec(ctx)
+	return err
+}
+
+// HasInventoryFilter returns true if the query options filter clusters
+// by their inventory
+func HasInventoryFilter(qo *commonv3.QueryOptions) bool {
+	return qo.KubernetesVersion != "" || qo.MinNodes > 0 || qo.MaxNodes > 0
+}
+
+// kubernetesVersionPattern returns the pattern matching versions with
+// the prefix, 1.26 matches v1.26.3 and 1.26.3+k3s1 but not 1.2.6
+func kubernetesVersionPattern(version string) string {
+	version = strings.TrimPrefix(strings.TrimSpace(version), "v")
+	return "^" + regexp.QuoteMeta(version) + "([.+-]|$)"
+}
+
+// inventoryFilter selects the IDs of the clusters whose inventory
+// matches the query options
+func inventoryFilter(db bun.IDB, qo *commonv3.QueryOptions) *bun.SelectQuery {
+	sq := db.NewSelect().Model((*models.ClusterInventory)(nil)).Column("cluster_id")
+	if qo.KubernetesVersion != "" {
+		sq = sq.Where("ltrim(kubernetes_version, 'v') ~ ?", kubernetesVersionPattern(qo.KubernetesVersion))
+	}
+	if qo.MinNodes > 0 {
+		sq = sq.Where("node_count >= ?", qo.MinNodes)
+	}
+	if qo.MaxNodes > 0 {
+		sq = sq.Where("node_count <= ?", qo.MaxNodes)
+	}
+	return sq
+}
+
+// listClustersByInventory lists the clusters of the project whose
+// inventory matches the query options
+func listClustersByInventory(ctx context.Context, db bun.IDB, pid, oid, prid uuid.NullUUID, qo *commonv3.QueryOptions) ([]models.Cluster, error) {
+	var clusters []models.Cluster
+	sq := db.NewSelect().Model(&clusters)
+	if qo.Q != "" {
+		sq = sq.Where("name ILIKE ?", "%"+qo.Q+"%")
+	}
+	sq = sq.Where("partner_id = ?", pid).
+		Where("organization_id = ?", oid).
+		Where("project_id = ?", prid).
+		Where("trash = ?", false).
+		Where("id IN (?)", inventoryFilter(db, qo))
+	if qo.OrderBy != "" && qo.Order != "" {
+		sq.Order(qo.OrderBy + " " + qo.Order)
+	}
+	if qo.Limit > 0 {
+		sq.Limit(int(qo.Limit))
+	}
+	if qo.Offset > 0 {
+		sq.Offset(int(qo.Offset))
+	}
+	err := sq.Scan(ctx)
+	return clusters, err
+}
@@ -32,6 +32,20 @@ func DeleteProjectsForCluster(ctx context.Context, db bun.IDB, clusterID uuid.UU
 	return dao.DeleteX(ctx, db, "cluster_id", clusterID, &models.ProjectCluster{})
 }
 
+// DeleteProjectClusters stops sharing the cluster with the projects
+func DeleteProjectClusters(ctx context.Context, db bun.IDB, clusterID uuid.UUID, projectIDs []uuid.UUID) error {
+	if len(projectIDs) == 0 {
+		return nil
+	}
+	_, err := db.NewUpdate().Model((*models.ProjectCluster)(nil)).
+		Set("trash = ?", true).
+		Where("cluster_id = ?", clusterID).
+		Where("project_id IN (?)", bun.In(projectIDs)).
+		Where("trash = ?", false).
+		Exec(ctx)
+	return err
+}
+
 // Check if the project in scope is owner of the cluster
 func ValidateClusterAccess(ctx context.Context, db bun.IDB, opts commonv3.QueryOptions) (bool, error) {
 	var _c models.Cluster
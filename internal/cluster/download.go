@@ -0,0 +1,299 @@
+package cluster
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/paralus/paralus/internal/cluster/constants"
+	"github.com/paralus/paralus/internal/cluster/fixtures"
+	"github.com/paralus/paralus/pkg/common"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	infrav3 "github.com/paralus/paralus/proto/types/infrapb/v3"
+	"sigs.k8s.io/yaml"
+)
+
+// formats of the relay agent installation of a cluster
+const (
+	DownloadFormatManifest  = "manifest"
+	DownloadFormatHelm      = "helm"
+	DownloadFormatKustomize = "kustomize"
+)
+
+// kubernetesProviderLabel is the label of the kubernetes distribution
+// of a cluster, the manifest differs for some distributions
+const kubernetesProviderLabel = "paralus.dev/kubernetesProvider"
+
+// RelayAgentImage is the image of the relay agent
+type RelayAgentImage struct {
+	Repository string `json:"repository"`
+	Tag        string `json:"tag,omitempty"`
+	Digest     string `json:"digest,omitempty"`
+}
+
+// RelayAgentProxy is the proxy the relay agent dials out through
+type RelayAgentProxy struct {
+	Enabled                bool   `json:"enabled"`
+	HttpProxy              string `json:"httpProxy,omitempty"`
+	HttpsProxy             string `json:"httpsProxy,omitempty"`
+	NoProxy                string `json:"noProxy,omitempty"`
+	ProxyAuth              string `json:"proxyAuth,omitempty"`
+	BootstrapCA            string `json:"bootstrapCA,omitempty"`
+	AllowInsecureBootstrap bool   `json:"allowInsecureBootstrap"`
+}
+
+// RelayAgentValues is the configuration of the relay agent of a
+// cluster, all formats of its installation are rendered from it
+type RelayAgentValues struct {
+	ClusterID          string          `json:"clusterID"`
+	ClusterName        string          `json:"clusterName"`
+	KubernetesProvider string          `json:"kubernetesProvider,omitempty"`
+	Relays             []common.Relay  `json:"relays"`
+	Image              RelayAgentImage `json:"image"`
+	Proxy              RelayAgentProxy `json:"proxy"`
+}
+
+// splitImage splits the image reference into its repository, tag and
+// digest
+func splitImage(image string) RelayAgentImage {
+	var img RelayAgentImage
+	if i := strings.Index(image, "@"); i >= 0 {
+		image, img.Digest = image[:i], image[i+1:]
+	}
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		image, img.Tag = image[:i], image[i+1:]
+	}
+	img.Repository = image
+	return img
+}
+
+// String returns the image reference
+func (i RelayAgentImage) String() string {
+	ref := i.Repository
+	if i.Tag != "" {
+		ref += ":" + i.Tag
+	}
+	if i.Digest != "" {
+		ref += "@" + i.Digest
+	}
+	return ref
+}
+
+// RelayAgentVersion returns the version of the relay agent image, the
+// tag of the image
+func RelayAgentVersion(image string) string {
+	return splitImage(image).Tag
+}
+
+// RelayAgentImageForVersion returns the relay agent image with the tag
+// of the version, the image unchanged when the version is empty
+func RelayAgentImageForVersion(image, version string) string {
+	if version == "" {
+		return image
+	}
+	img := splitImage(image)
+	img.Tag, img.Digest = version, ""
+	return img.String()
+}
+
+// NewRelayAgentValues returns the configuration of the relay agent of
+// the cluster connecting to the relays
+func NewRelayAgentValues(data *common.DownloadData, cluster *infrav3.Cluster, relays []common.Relay) *RelayAgentValues {
+	values := &RelayAgentValues{
+		ClusterID:   cluster.GetMetadata().GetLabels()[constants.ClusterID],
+		ClusterName: cluster.GetMetadata().GetName(),
+		Relays:      relays,
+		Image:       splitImage(data.RelayAgentImage),
+	}
+	if values.Relays == nil {
+		values.Relays = []common.Relay{}
+	}
+	values.KubernetesProvider = cluster.GetMetadata().GetLabels()[kubernetesProviderLabel]
+	if pc := cluster.GetSpec().GetProxyConfig(); pc.GetEnabled() {
+		values.Proxy = RelayAgentProxy{
+			Enabled:                true,
+			HttpProxy:              pc.HttpProxy,
+			HttpsProxy:             pc.HttpsProxy,
+			NoProxy:                pc.NoProxy,
+			ProxyAuth:              pc.ProxyAuth,
+			AllowInsecureBootstrap: pc.AllowInsecureBootstrap,
+		}
+		if pc.BootstrapCA != "" {
+			values.Proxy.BootstrapCA = base64.StdEncoding.EncodeToString([]byte(pc.BootstrapCA))
+		}
+	}
+	return values
+}
+
+// GetRelayAgentHelmValues returns the values of the relay agent chart
+// installing the relay agent of the cluster
+func GetRelayAgentHelmValues(values *RelayAgentValues) ([]byte, error) {
+	b, err := yaml.Marshal(values)
+	if err != nil {
+		return nil, err
+	}
+	header := fmt.Sprintf("# values of the relay agent chart for cluster %s\n", values.ClusterName)
+	return append([]byte(header), b...), nil
+}
+
+// relayAgentBase returns the manifest of the relay agent without the
+// configuration of the cluster
+func relayAgentBase(values *RelayAgentValues) ([]byte, error) {
+	base := &infrav3.Cluster{
+		Metadata: &commonv3.Metadata{},
+		Spec:     &infrav3.ClusterSpec{},
+	}
+	if values.KubernetesProvider != "" {
+		base.Metadata.Labels = map[string]string{kubernetesProviderLabel: values.KubernetesProvider}
+	}
+	bb := new(bytes.Buffer)
+	err := fixtures.DownloadTemplate.Execute(bb, struct {
+		DownloadData *common.DownloadData
+		Cluster      *infrav3.Cluster
+	}{
+		&common.DownloadData{RelayAgentImage: values.Image.String()}, base,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return bb.Bytes(), nil
+}
+
+// relayAgentOverlay returns the kustomization of the base with the
+// configuration of the cluster
+func relayAgentOverlay(values *RelayAgentValues) ([]byte, error) {
+	relays, err := json.Marshal(values.Relays)
+	if err != nil {
+		return nil, err
+	}
+	proxy := []string{}
+	if values.Proxy.Enabled {
+		proxy = []string{
+			"httpProxy=" + values.Proxy.HttpProxy,
+			"httpsProxy=" + values.Proxy.HttpsProxy,
+			"noProxy=" + values.Proxy.NoProxy,
+			"proxyAuth=" + values.Proxy.ProxyAuth,
+			"bootstrapCA=" + values.Proxy.BootstrapCA,
+			"allowInsecureBootstrap=" + strconv.FormatBool(values.Proxy.AllowInsecureBootstrap),
+		}
+	}
+	image := map[string]string{"name": values.Image.Repository, "newName": values.Image.Repository}
+	if values.Image.Tag != "" {
+		image["newTag"] = values.Image.Tag
+	}
+	if values.Image.Digest != "" {
+		image["digest"] = values.Image.Digest
+	}
+	return yaml.Marshal(map[string]interface{}{
+		"apiVersion": "kustomize.config.k8s.io/v1beta1",
+		"kind":       "Kustomization",
+		"resources":  []string{"../../base"},
+		"images":     []map[string]string{image},
+		"generatorOptions": map[string]interface{}{
+			"disableNameSuffixHash": true,
+			"labels":                map[string]string{"app.kubernetes.io/managed-by": "paralus"},
+		},
+		"configMapGenerator": []map[string]interface{}{
+			{
+				"name":      "relay-agent-config",
+				"namespace": "paralus-system",
+				"behavior":  "replace",
+				"literals":  []string{"clusterID=" + values.ClusterID, "relays=" + string(relays)},
+			},
+			{
+				"name":      "proxy-config",
+				"namespace": "paralus-system",
+				"behavior":  "replace",
+				"literals":  proxy,
+			},
+		},
+	})
+}
+
+// GetRelayAgentKustomization returns a gzipped tarball with a base
+// installing the relay agent and an overlay of the base configuring it
+// for the cluster
+func GetRelayAgentKustomization(ctx context.Context, values *RelayAgentValues) ([]byte, error) {
+	base, err := relayAgentBase(values)
+	if err != nil {
+		return nil, err
+	}
+	baseKustomization, err := yaml.Marshal(map[string]interface{}{
+		"apiVersion": "kustomize.config.k8s.io/v1beta1",
+		"kind":       "Kustomization",
+		"resources":  []string{"relay-agent.yaml"},
+	})
+	if err != nil {
+		return nil, err
+	}
+	overlay, err := relayAgentOverlay(values)
+	if err != nil {
+		return nil, err
+	}
+
+	return gzipTarball([]tarFile{
+		{"base/kustomization.yaml", baseKustomization},
+		{"base/relay-agent.yaml", base},
+		{"overlays/" + values.ClusterName + "/kustomization.yaml", overlay},
+	})
+}
+
+// GetClusterManifestBundle returns a gzipped tarball with the relay
+// agent manifests of clusters by name and the results of their
+// registration
+func GetClusterManifestBundle(manifests map[string][]byte, results []byte) ([]byte, error) {
+	names := make([]string, 0, len(manifests))
+	for name := range manifests {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	files := make([]tarFile, 0, len(manifests)+1)
+	for _, name := range names {
+		files = append(files, tarFile{"clusters/" + name + ".yaml", manifests[name]})
+	}
+	files = append(files, tarFile{"results.yaml", results})
+	return gzipTarball(files)
+}
+
+type tarFile struct {
+	name string
+	data []byte
+}
+
+// gzipTarball returns a gzipped tarball of the files
+func gzipTarball(files []tarFile) ([]byte, error) {
+	bb := new(bytes.Buffer)
+	gw := gzip.NewWriter(bb)
+	tw := tar.NewWriter(gw)
+	now := time.Now()
+	for _, f := range files {
+		err := tw.WriteHeader(&tar.Header{
+			Name:    f.name,
+			Mode:    0644,
+			Size:    int64(len(f.data)),
+			ModTime: now,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if _, err := tw.Write(f.data); err != nil {
+			return nil, err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+	if err := gw.Close(); err != nil {
+		return nil, err
+	}
+	return bb.Bytes(), nil
+}
@@ -0,0 +1,157 @@
+package cluster
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/paralus/paralus/pkg/common"
+	"github.com/paralus/paralus/pkg/utils"
+	"sigs.k8s.io/yaml"
+)
+
+func TestSplitImage(t *testing.T) {
+	tt := []struct {
+		image string
+		want  RelayAgentImage
+	}{
+		{"paralusio/relay", RelayAgentImage{Repository: "paralusio/relay"}},
+		{"paralusio/relay:v0.1.2", RelayAgentImage{Repository: "paralusio/relay", Tag: "v0.1.2"}},
+		{"registry.local:5000/paralusio/relay", RelayAgentImage{Repository: "registry.local:5000/paralusio/relay"}},
+		{"registry.local:5000/paralusio/relay:v0.1.2", RelayAgentImage{Repository: "registry.local:5000/paralusio/relay", Tag: "v0.1.2"}},
+		{"paralusio/relay@sha256:0123abcd", RelayAgentImage{Repository: "paralusio/relay", Digest: "sha256:0123abcd"}},
+		{"registry.local:5000/paralusio/relay:v0.1.2@sha256:0123abcd", RelayAgentImage{Repository: "registry.local:5000/paralusio/relay", Tag: "v0.1.2", Digest: "sha256:0123abcd"}},
+	}
+	for _, tc := range tt {
+		t.Run(tc.image, func(t *testing.T) {
+			got := splitImage(tc.image)
+			if got != tc.want {
+				t.Errorf("expected %+v, got %+v", tc.want, got)
+			}
+			if got.String() != tc.image {
+				t.Errorf("image should be unchanged; expected '%v', got '%v'", tc.image, got.String())
+			}
+		})
+	}
+}
+
+func TestRelayAgentVersion(t *testing.T) {
+	tt := []struct {
+		image   string
+		version string
+	}{
+		{"paralusio/relay", ""},
+		{"paralusio/relay:v0.1.2", "v0.1.2"},
+		{"registry.local:5000/paralusio/relay", ""},
+		{"registry.local:5000/paralusio/relay:v0.1.2", "v0.1.2"},
+		{"paralusio/relay@sha256:0123abcd", ""},
+		{"paralusio/relay:v0.1.2@sha256:0123abcd", "v0.1.2"},
+	}
+	for _, tc := range tt {
+		if v := RelayAgentVersion(tc.image); v != tc.version {
+			t.Errorf("version of '%v'; expected '%v', got '%v'", tc.image, tc.version, v)
+		}
+	}
+}
+
+func TestRelayAgentImageForVersion(t *testing.T) {
+	tt := []struct {
+		image   string
+		version string
+		want    string
+	}{
+		{"paralusio/relay:v0.1.2", "", "paralusio/relay:v0.1.2"},
+		{"paralusio/relay", "v0.1.3", "paralusio/relay:v0.1.3"},
+		{"paralusio/relay:v0.1.2", "v0.1.3", "paralusio/relay:v0.1.3"},
+		{"registry.local:5000/paralusio/relay", "v0.1.3", "registry.local:5000/paralusio/relay:v0.1.3"},
+		{"registry.local:5000/paralusio/relay:v0.1.2", "v0.1.3", "registry.local:5000/paralusio/relay:v0.1.3"},
+		// the digest pins the old version, it is dropped
+		{"paralusio/relay:v0.1.2@sha256:0123abcd", "v0.1.3", "paralusio/relay:v0.1.3"},
+		{"paralusio/relay@sha256:0123abcd", "", "paralusio/relay@sha256:0123abcd"},
+	}
+	for _, tc := range tt {
+		if got := RelayAgentImageForVersion(tc.image, tc.version); got != tc.want {
+			t.Errorf("image '%v' for version '%v'; expected '%v', got '%v'", tc.image, tc.version, tc.want, got)
+		}
+	}
+}
+
+func TestGetRelayAgentKustomization(t *testing.T) {
+	values := &RelayAgentValues{
+		ClusterID:   "c-1234",
+		ClusterName: "edge-1",
+		Relays:      []common.Relay{{Token: "t-1", Addr: "relay.paralus.local:443", Name: "paralus-core-relay-agent"}},
+		Image:       splitImage("registry.local:5000/paralusio/relay:v0.1.2"),
+		Proxy:       RelayAgentProxy{Enabled: true, HttpsProxy: "http://proxy.local:3128"},
+	}
+	b, err := GetRelayAgentKustomization(context.Background(), values)
+	if err != nil {
+		t.Fatal("could not get kustomization:", err)
+	}
+
+	files := map[string][]byte{}
+	gr, err := gzip.NewReader(bytes.NewReader(b))
+	if err != nil {
+		t.Fatal("kustomization should be gzipped:", err)
+	}
+	tr := tar.NewReader(gr)
+	for {
+		h, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal("kustomization should be a tarball:", err)
+		}
+		data, err := io.ReadAll(tr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		files[h.Name] = data
+	}
+	for _, name := range []string{"base/kustomization.yaml", "base/relay-agent.yaml", "overlays/edge-1/kustomization.yaml"} {
+		if _, ok := files[name]; !ok {
+			t.Errorf("tarball should have '%v'", name)
+		}
+	}
+
+	var overlay struct {
+		Resources []string            `json:"resources"`
+		Images    []map[string]string `json:"images"`
+		Generator []struct {
+			Name     string   `json:"name"`
+			Behavior string   `json:"behavior"`
+			Literals []string `json:"literals"`
+		} `json:"configMapGenerator"`
+	}
+	if err := yaml.Unmarshal(files["overlays/edge-1/kustomization.yaml"], &overlay); err != nil {
+		t.Fatal("overlay should be yaml:", err)
+	}
+	if len(overlay.Resources) != 1 || overlay.Resources[0] != "../../base" {
+		t.Errorf("overlay should be of the base; got %v", overlay.Resources)
+	}
+	if len(overlay.Images) != 1 || overlay.Images[0]["newName"] != "registry.local:5000/paralusio/relay" || overlay.Images[0]["newTag"] != "v0.1.2" {
+		t.Errorf("overlay should set the relay agent image; got %v", overlay.Images)
+	}
+	literals := map[string][]string{}
+	for _, g := range overlay.Generator {
+		if g.Behavior != "replace" {
+			t.Errorf("config map '%v' should replace the one of the base", g.Name)
+		}
+		literals[g.Name] = g.Literals
+	}
+	config := strings.Join(literals["relay-agent-config"], "\n")
+	if !strings.Contains(config, "clusterID=c-1234") || !strings.Contains(config, `"addr":"relay.paralus.local:443"`) {
+		t.Errorf("overlay should configure the cluster and its relays; got %v", config)
+	}
+	if !utils.Contains(literals["proxy-config"], "httpsProxy=http://proxy.local:3128") {
+		t.Errorf("overlay should configure the proxy; got %v", literals["proxy-config"])
+	}
+	if !bytes.Contains(files["base/relay-agent.yaml"], []byte("registry.local:5000/paralusio/relay:v0.1.2")) {
+		t.Error("base should install the relay agent image")
+	}
+}
@@ -0,0 +1,25 @@
+package dao
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/models"
+	"github.com/uptrace/bun"
+)
+
+// RevokeAccountApiKey removes the API key of the account, it can not be
+// used anymore
+func RevokeAccountApiKey(ctx context.Context, db bun.IDB, id, accountId uuid.UUID) (bool, error) {
+	res, err := db.NewUpdate().Model(&models.ApiKey{}).
+		Set("trash = ?", true).
+		Where("id = ?", id).
+		Where("account_id = ?", accountId).
+		Where("trash = ?", false).
+		Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n > 0, err
+}
@@ -35,6 +35,7 @@ func CreateOrUpdateBootstrapAgentTemplate(ctx context.Context, db bun.IDB, templ
 		Set("incluster_template = ?", template.InclusterTemplate).
 		Set("outofcluster_template = ?", template.OutofclusterTemplate).
 		Set("hosts = ?", template.Hosts).
+		Set("location = ?", template.Location).
 		Set("labels = ?", template.Labels).
 		Set("annotations = ?", template.Annotations).
 		Set("modified_at = ?", time.Now()).
@@ -127,42 +128,137 @@ func CreateBootstrapAgent(ctx context.Context, db bun.IDB, ba *models.BootstrapA
 	return err
 }
 
-func RegisterBootstrapAgent(ctx context.Context, db bun.Tx, token, ip, fingerprint string) error {
+// RegisterBootstrapAgent records the registration of the token and
+// returns the resulting token state. Agents of templates without auto
+// approve remain NotApproved until approved.
+func RegisterBootstrapAgent(ctx context.Context, db bun.Tx, token, ip, fingerprint string) (string, error) {
 	ba, err := getBootstrapAgentForToken(ctx, db, token)
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	bat, err := getBootstrapAgentTemplate(ctx, db, ba.TemplateRef)
 	if err != nil {
-		return err
-	}
-
-	state := sentry.BootstrapAgentState_NotApproved
-	if bat.AutoApprove {
-		state = sentry.BootstrapAgentState_Approved
+		return "", err
 	}
 
+	state := ba.TokenState
 	switch ba.TokenState {
 	case sentry.BootstrapAgentState_NotRegistered.String():
-		ba.TokenState = sentry.BootstrapAgentState_Approved.String()
-	case sentry.BootstrapAgentState_NotApproved.String(), sentry.BootstrapAgentState_Approved.String():
-		if !bat.IgnoreMultipleRegister {
-			return fmt.Errorf("cannot register token %s state is %s", token, ba.TokenState)
+		if !ba.TokenExpiresAt.IsZero() && ba.TokenExpiresAt.Before(time.Now()) {
+			return "", fmt.Errorf("token %s has expired", token)
+		}
+		state = sentry.BootstrapAgentState_NotApproved.String()
+		if bat.AutoApprove {
+			state = sentry.BootstrapAgentState_Approved.String()
+		}
+	case sentry.BootstrapAgentState_NotApproved.String():
+		if ba.Fingerprint != fingerprint {
+			return "", fmt.Errorf("fingerprint mismatch for token %s", token)
+		}
+	case sentry.BootstrapAgentState_Approved.String():
+		// tokens are single use unless the template allows the agent
+		// to register again
+		if !ba.TokenUsedAt.IsZero() && !bat.IgnoreMultipleRegister {
+			return "", fmt.Errorf("cannot register token %s state is %s", token, ba.TokenState)
 		} else if ba.Fingerprint != fingerprint {
-			return fmt.Errorf("fingerprint mismatch for token %s", token)
+			return "", fmt.Errorf("fingerprint mismatch for token %s", token)
 		}
+	case sentry.BootstrapAgentState_Rejected.String():
+		return "", fmt.Errorf("registration of token %s has been rejected", token)
 	default:
-		return fmt.Errorf("invalid token state %s", ba.TokenState)
+		return "", fmt.Errorf("invalid token state %s", ba.TokenState)
 	}
 
-	_, err = db.NewUpdate().Model(ba).
+	q := db.NewUpdate().Model(ba).
 		Set("token_state = ?", state).
 		Set("fingerprint = ?", fingerprint).
-		Set("ip_address = ?", ip).
-		Where("token = ?", token).
+		Set("ip_address = ?", ip)
+	if state == sentry.BootstrapAgentState_Approved.String() && ba.TokenUsedAt.IsZero() {
+		q = q.Set("token_used_at = ?", time.Now())
+	}
+	_, err = q.Where("token = ?", token).Exec(ctx)
+
+	return state, err
+}
+
+// SelectPendingBootstrapAgents returns agents awaiting approval of
+// their registration
+func SelectPendingBootstrapAgents(ctx context.Context, db bun.IDB, templateRef string, opts *commonv3.QueryOptions) (ret []models.BootstrapAgent, count int, err error) {
+
+	q, err := query.Select(db.NewSelect().Model(&ret), opts)
+	if err != nil {
+		return
+	}
+
+	if templateRef != "-" {
+		q = q.Where("template_ref = ?", templateRef)
+	}
+	q = q.Where("token_state = ?", sentry.BootstrapAgentState_NotApproved.String())
+
+	count, err = q.ScanAndCount(ctx)
+
+	return
+}
+
+// UpdateBootstrapAgentState moves the agent from the NotApproved state
+// to the given state
+func UpdateBootstrapAgentState(ctx context.Context, db bun.IDB, ba *models.BootstrapAgent, state string) error {
+	res, err := db.NewUpdate().Model(ba).
+		Set("token_state = ?", state).
+		Set("modified_at = ?", time.Now()).
+		Where("id = ?", ba.ID).
+		Where("token_state = ?", sentry.BootstrapAgentState_NotApproved.String()).
+		Returning("*").
 		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return fmt.Errorf("bootstrap agent %s is not pending approval", ba.Name)
+	}
+	return nil
+}
+
+// MarkBootstrapAgentTokenViewed records that the token of the agent has
+// been viewed, it fails when the token has already been viewed
+func MarkBootstrapAgentTokenViewed(ctx context.Context, db bun.IDB, ba *models.BootstrapAgent) error {
+	res, err := db.NewUpdate().Model(ba).
+		Set("token_viewed_at = ?", time.Now()).
+		Where("id = ?", ba.ID).
+		Where("token_viewed_at IS NULL").
+		Returning("*").
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return fmt.Errorf("token of bootstrap agent %s has already been viewed", ba.Name)
+	}
+	return nil
+}
 
+// ReissueBootstrapAgentToken replaces the token of the agent, the agent
+// has to register again with the new token
+func ReissueBootstrapAgentToken(ctx context.Context, db bun.IDB, ba *models.BootstrapAgent, token string, expiresAt time.Time) error {
+	q := db.NewUpdate().Model(ba).
+		Set("token = ?", token).
+		Set("token_state = ?", sentry.BootstrapAgentState_NotRegistered.String()).
+		Set("fingerprint = ''").
+		Set("ip_address = ''").
+		Set("token_used_at = NULL").
+		Set("token_viewed_at = NULL").
+		Set("modified_at = ?", time.Now())
+	if expiresAt.IsZero() {
+		q = q.Set("token_expires_at = NULL")
+	} else {
+		q = q.Set("token_expires_at = ?", expiresAt)
+	}
+	_, err := q.Where("id = ?", ba.ID).Returning("*").Exec(ctx)
 	return err
 }
 
@@ -237,6 +333,31 @@ func GetBootstrapAgentForClusterID(ctx context.Context, db bun.IDB, clusterID st
 	return &ba, nil
 }
 
+// GetBootstrapAgentsForClusterID returns the bootstrap agents of every
+// template of the cluster
+func GetBootstrapAgentsForClusterID(ctx context.Context, db bun.IDB, clusterID string, orgID uuid.UUID) ([]models.BootstrapAgent, error) {
+	var ba []models.BootstrapAgent
+	err := db.NewSelect().Model(&ba).
+		Where("name = ?", clusterID).
+		Where("organization_id = ?", orgID).
+		Scan(ctx)
+	return ba, err
+}
+
+// UpdateBootstrapAgentCluster updates the project, display name and
+// labels of the bootstrap agent after its cluster is moved or renamed,
+// the token of the agent is unchanged
+func UpdateBootstrapAgentCluster(ctx context.Context, db bun.IDB, ba *models.BootstrapAgent) error {
+	_, err := db.NewUpdate().Model((*models.BootstrapAgent)(nil)).
+		Set("project_id = ?", ba.ProjectId).
+		Set("display_name = ?", ba.DisplayName).
+		Set("labels = ?", ba.Labels).
+		Set("modified_at = ?", time.Now()).
+		Where("id = ?", ba.ID).
+		Exec(ctx)
+	return err
+}
+
 // updateBootstrapAgentDeleteAt builds query for deleting resource
 func UpdateBootstrapAgentDeleteAt(ctx context.Context, db bun.IDB, templateRef string) error {
 	var toBeDeletedAgent *models.BootstrapAgent
@@ -0,0 +1,61 @@
+package dao
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/models"
+	"github.com/uptrace/bun"
+)
+
+// UseBreakGlassAccount marks the credential of the break-glass account
+// as used until the given time, a used credential can not be used
+// again until rotated
+func UseBreakGlassAccount(ctx context.Context, db bun.IDB, id uuid.UUID, until time.Time) (bool, error) {
+	res, err := db.NewUpdate().Model(&models.BreakGlassAccount{}).
+		Set("rotation_required = ?", true).
+		Set("last_used_at = ?", time.Now()).
+		Set("active_until = ?", until).
+		Set("modified_at = ?", time.Now()).
+		Where("id = ?", id).
+		Where("rotation_required = ?", false).
+		Where("trash = ?", false).
+		Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n > 0, err
+}
+
+// RotateBreakGlassSecret replaces the credential of the break-glass
+// account
+func RotateBreakGlassSecret(ctx context.Context, db bun.IDB, ba *models.BreakGlassAccount) error {
+	_, err := db.NewUpdate().Model(ba).
+		Column("secret_hash", "custodians", "rotation_required", "modified_at").
+		WherePK().
+		Returning("*").
+		Exec(ctx)
+	return err
+}
+
+// GetExpiredBreakGlassAccess returns the break-glass accounts whose
+// access has expired before the given time
+func GetExpiredBreakGlassAccess(ctx context.Context, db bun.IDB, before time.Time) ([]models.BreakGlassAccount, error) {
+	var bas []models.BreakGlassAccount
+	err := db.NewSelect().Model(&bas).
+		Where("active_until IS NOT NULL").
+		Where("active_until < ?", before).
+		Scan(ctx)
+	return bas, err
+}
+
+// EndBreakGlassAccess clears the active access of the break-glass account
+func EndBreakGlassAccess(ctx context.Context, db bun.IDB, id uuid.UUID) error {
+	_, err := db.NewUpdate().Model(&models.BreakGlassAccount{}).
+		Set("active_until = NULL").
+		Where("id = ?", id).
+		Exec(ctx)
+	return err
+}
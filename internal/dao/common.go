@@ -12,6 +12,8 @@ import (
 const (
 	KratosPasswordType = "password"
 	KratosOidcType     = "oidc"
+	KratosTotpType     = "totp"
+	KratosWebAuthnType = "webauthn"
 )
 
 func Create(ctx context.Context, db bun.IDB, entity interface{}) (interface{}, error) {
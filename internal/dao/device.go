@@ -0,0 +1,183 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/models"
+	"github.com/uptrace/bun"
+)
+
+func getDevice(ctx context.Context, db bun.IDB, field string, value interface{}) (*models.Device, error) {
+	var device models.Device
+	err := db.NewSelect().Model(&device).
+		Where("? = ?", bun.Ident(field), value).
+		Where("trash = ?", false).
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &device, nil
+}
+
+// GetPendingDevice gets the device waiting for approval with the given
+// user code
+func GetPendingDevice(ctx context.Context, db bun.IDB, userCode string) (*models.Device, error) {
+	var device models.Device
+	err := db.NewSelect().Model(&device).
+		Where("user_code = ?", userCode).
+		Where("status = ?", "pending").
+		Where("trash = ?", false).
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &device, nil
+}
+
+// GetDeviceByDeviceCode gets the device with the given device code hash
+func GetDeviceByDeviceCode(ctx context.Context, db bun.IDB, hash string) (*models.Device, error) {
+	return getDevice(ctx, db, "device_code_hash", hash)
+}
+
+// GetDeviceByAccessToken gets the device with the given access token hash
+func GetDeviceByAccessToken(ctx context.Context, db bun.IDB, hash string) (*models.Device, error) {
+	return getDevice(ctx, db, "access_token_hash", hash)
+}
+
+// GetDeviceByRefreshToken gets the device with the given refresh token hash
+func GetDeviceByRefreshToken(ctx context.Context, db bun.IDB, hash string) (*models.Device, error) {
+	return getDevice(ctx, db, "refresh_token_hash", hash)
+}
+
+// UpdateDevice updates the given columns of the device
+func UpdateDevice(ctx context.Context, db bun.IDB, device *models.Device, columns ...string) error {
+	_, err := db.NewUpdate().Model(device).
+		Column(columns...).
+		WherePK().
+		Where("trash = ?", false).
+		Exec(ctx)
+	return err
+}
+
+// updateDeviceIf updates the given columns of the device if it is in
+// the given state, it fails when a concurrent request changed it first
+func updateDeviceIf(ctx context.Context, db bun.IDB, device *models.Device, where func(*bun.UpdateQuery) *bun.UpdateQuery, columns ...string) error {
+	res, err := where(db.NewUpdate().Model(device).
+		Column(columns...).
+		WherePK().
+		Where("trash = ?", false)).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return fmt.Errorf("device %s has been changed by another request", device.Name)
+	}
+	return nil
+}
+
+// DecidePendingDevice records the approval or denial of the device if
+// it is still waiting for approval
+func DecidePendingDevice(ctx context.Context, db bun.IDB, device *models.Device, columns ...string) error {
+	return updateDeviceIf(ctx, db, device, func(q *bun.UpdateQuery) *bun.UpdateQuery {
+		return q.Where("status = ?", "pending")
+	}, columns...)
+}
+
+// IssueDeviceTokens stores new tokens of the approved device if the
+// device code or refresh token, by column, still has the given hash.
+// Concurrent requests with the same code or token get tokens once.
+func IssueDeviceTokens(ctx context.Context, db bun.IDB, device *models.Device, column, hash string) error {
+	return updateDeviceIf(ctx, db, device, func(q *bun.UpdateQuery) *bun.UpdateQuery {
+		return q.Where("? = ?", bun.Ident(column), hash).
+			Where("status = ?", "approved")
+	}, "device_code_hash", "access_token_hash", "access_expires_at", "refresh_token_hash", "refresh_expires_at")
+}
+
+// ListAccountDevices lists the approved devices of the account
+func ListAccountDevices(ctx context.Context, db bun.IDB, accountId uuid.UUID) ([]models.Device, error) {
+	var devices []models.Device
+	err := db.NewSelect().Model(&devices).
+		Where("account_id = ?", accountId).
+		Where("status = ?", "approved").
+		Where("trash = ?", false).
+		Order("approved_at DESC").
+		Scan(ctx)
+	return devices, err
+}
+
+// RevokeAccountDevice removes the device of the account, its tokens can
+// not be used anymore
+func RevokeAccountDevice(ctx context.Context, db bun.IDB, id, accountId uuid.UUID) (bool, error) {
+	res, err := db.NewUpdate().Model(&models.Device{}).
+		Set("trash = ?", true).
+		Where("id = ?", id).
+		Where("account_id = ?", accountId).
+		Where("trash = ?", false).
+		Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n > 0, err
+}
+
+// DeleteExpiredDevices removes the devices which were not approved in
+// time and the ones whose refresh token has expired
+func DeleteExpiredDevices(ctx context.Context, db bun.IDB, before time.Time) error {
+	_, err := db.NewUpdate().Model(&models.Device{}).
+		Set("trash = ?", true).
+		WhereGroup(" AND ", func(q *bun.UpdateQuery) *bun.UpdateQuery {
+			return q.WhereOr("refresh_expires_at IS NULL AND code_expires_at < ?", before).
+				WhereOr("refresh_expires_at < ?", before)
+		}).
+		Where("trash = ?", false).
+		Exec(ctx)
+	return err
+}
+
+// GetDeviceApprovalAttempt returns the user codes of the account which
+// did not match a device, an account without them has an empty state
+func GetDeviceApprovalAttempt(ctx context.Context, db bun.IDB, accountId uuid.UUID) (models.DeviceApprovalAttempt, error) {
+	var attempt models.DeviceApprovalAttempt
+	err := db.NewSelect().Model(&attempt).
+		Where("account_id = ?", accountId).
+		Scan(ctx)
+	if err != nil && err != sql.ErrNoRows {
+		return attempt, err
+	}
+	attempt.AccountId = accountId
+	return attempt, nil
+}
+
+// RecordFailedDeviceApproval counts a user code of the account which
+// did not match a device, failures before since are not counted
+func RecordFailedDeviceApproval(ctx context.Context, db bun.IDB, accountId uuid.UUID, since time.Time) (models.DeviceApprovalAttempt, error) {
+	attempt := models.DeviceApprovalAttempt{
+		AccountId:      accountId,
+		FailedAttempts: 1,
+		LastFailedAt:   time.Now(),
+	}
+	_, err := db.NewInsert().Model(&attempt).
+		On("CONFLICT (account_id) DO UPDATE").
+		Set("failed_attempts = CASE WHEN deviceapprovalattempt.last_failed_at < ? THEN 1 ELSE deviceapprovalattempt.failed_attempts + 1 END", since).
+		Set("last_failed_at = EXCLUDED.last_failed_at").
+		Returning("*").
+		Exec(ctx)
+	return attempt, err
+}
+
+// DeleteDeviceApprovalAttempts removes the failed user codes of the
+// accounts from before the given time
+func DeleteDeviceApprovalAttempts(ctx context.Context, db bun.IDB, before time.Time) error {
+	_, err := db.NewDelete().Model(&models.DeviceApprovalAttempt{}).
+		Where("last_failed_at < ?", before).
+		Exec(ctx)
+	return err
+}
@@ -0,0 +1,175 @@
+package dao
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/models"
+	"github.com/uptrace/bun"
+)
+
+type roleBindingTable struct {
+	name      string
+	group     bool
+	project   bool
+	namespace bool
+	// project is optional for role bindings scoped by cluster selectors
+	selector bool
+}
+
+// tables holding role bindings of accounts and groups
+var roleBindingTables = []roleBindingTable{
+	{name: "authsrv_accountresourcerole"},
+	{name: "authsrv_projectaccountresourcerole", project: true},
+	{name: "authsrv_projectaccountnamespacerole", project: true, namespace: true},
+	{name: "authsrv_grouprole", group: true},
+	{name: "authsrv_projectgrouprole", group: true, project: true},
+	{name: "authsrv_projectgroupnamespacerole", group: true, project: true, namespace: true},
+	{name: "authsrv_accountclusterselectorrole", selector: true},
+	{name: "authsrv_groupclusterselectorrole", group: true, selector: true},
+}
+
+func selectRoleBindings(db bun.IDB, t roleBindingTable) *bun.SelectQuery {
+	q := db.NewSelect().TableExpr(t.name+" AS b").
+		ColumnExpr("b.id, b.expires_at").
+		ColumnExpr("r.name AS role, r.effect, r.scope").
+		ColumnExpr("b.organization_id, o.name AS organization").
+		Join("JOIN authsrv_resourcerole AS r ON r.id = b.role_id").
+		Join("LEFT JOIN authsrv_organization AS o ON o.id = b.organization_id").
+		Where("b.trash = ?", false)
+	if t.project {
+		q = q.ColumnExpr("p.name AS project").
+			Join("JOIN authsrv_project AS p ON p.id = b.project_id")
+	}
+	if t.namespace {
+		q = q.ColumnExpr("b.namespace")
+	}
+	if t.selector {
+		q = q.ColumnExpr("p.name AS project, b.cluster_selector").
+			Join("LEFT JOIN authsrv_project AS p ON p.id = b.project_id")
+	}
+	if t.group {
+		q = q.ColumnExpr(`g.name AS "group"`).
+			Join("JOIN authsrv_group AS g ON g.id = b.group_id")
+	} else {
+		q = q.ColumnExpr("i.traits ->> 'email' AS account").
+			Join("JOIN identities AS i ON i.id = b.account_id")
+	}
+	return q
+}
+
+func getRoleBindings(ctx context.Context, db bun.IDB, filter func(roleBindingTable, *bun.SelectQuery) *bun.SelectQuery) ([]models.RoleBinding, error) {
+	bindings := []models.RoleBinding{}
+	for _, t := range roleBindingTables {
+		q := filter(t, selectRoleBindings(db, t))
+		if q == nil {
+			continue
+		}
+		var rbs []models.RoleBinding
+		err := q.Scan(ctx, &rbs)
+		if err != nil {
+			return nil, err
+		}
+		for i := range rbs {
+			rbs[i].Table = t.name
+		}
+		bindings = append(bindings, rbs...)
+	}
+	return bindings, nil
+}
+
+// GetExpiredRoleBindings returns the role bindings which expired before the given time
+func GetExpiredRoleBindings(ctx context.Context, db bun.IDB, before time.Time) ([]models.RoleBinding, error) {
+	return getRoleBindings(ctx, db, func(_ roleBindingTable, q *bun.SelectQuery) *bun.SelectQuery {
+		return q.Where("b.expires_at IS NOT NULL").
+			Where("b.expires_at <= ?", before)
+	})
+}
+
+// GetExpiringRoleBindings returns the role bindings expiring before the
+// given time for which no notification has been sent yet
+func GetExpiringRoleBindings(ctx context.Context, db bun.IDB, before time.Time) ([]models.RoleBinding, error) {
+	return getRoleBindings(ctx, db, func(_ roleBindingTable, q *bun.SelectQuery) *bun.SelectQuery {
+		return q.Where("b.expires_at IS NOT NULL").
+			Where("b.expires_at <= ?", before).
+			Where("b.expires_at > ?", time.Now()).
+			Where("b.expiry_notified = ?", false)
+	})
+}
+
+// GetOtherActiveRoleBindings returns the role bindings in effect, other
+// than the given one, which bind the same role to the same account or
+// group. Bindings by cluster selector are not included as they have no
+// authz policy.
+func GetOtherActiveRoleBindings(ctx context.Context, db bun.IDB, rb models.RoleBinding) ([]models.RoleBinding, error) {
+	return getRoleBindings(ctx, db, func(t roleBindingTable, q *bun.SelectQuery) *bun.SelectQuery {
+		if t.selector || t.group != (rb.Group != "") {
+			return nil
+		}
+		q = q.Where("b.id != ?", rb.ID).
+			Where("r.name = ?", rb.Role).
+			Where("b.expires_at IS NULL OR b.expires_at > ?", time.Now())
+		if t.group {
+			return q.Where("g.name = ?", rb.Group)
+		}
+		return q.Where("i.traits ->> 'email' = ?", rb.Account)
+	})
+}
+
+// DeleteRoleBinding marks the role binding as deleted
+func DeleteRoleBinding(ctx context.Context, db bun.IDB, rb models.RoleBinding) error {
+	_, err := db.NewUpdate().Table(rb.Table).
+		Set("trash = ?", true).
+		Set("modified_at = ?", time.Now()).
+		Where("id = ?", rb.ID).
+		Exec(ctx)
+	return err
+}
+
+// SetRoleBindingNotified records that expiry of the role binding was notified
+func SetRoleBindingNotified(ctx context.Context, db bun.IDB, rb models.RoleBinding) error {
+	_, err := db.NewUpdate().Table(rb.Table).
+		Set("expiry_notified = ?", true).
+		Where("id = ?", rb.ID).
+		Exec(ctx)
+	return err
+}
+
+func selectGroupAccounts(db bun.IDB, gas *[]models.GroupAccount) *bun.SelectQuery {
+	return db.NewSelect().Model(gas).
+		Relation("Account").
+		Relation("Group").
+		Where("groupaccount.trash = ?", false).
+		Where("groupaccount.expires_at IS NOT NULL")
+}
+
+// GetExpiredGroupAccounts returns the group memberships which expired before the given time
+func GetExpiredGroupAccounts(ctx context.Context, db bun.IDB, before time.Time) ([]models.GroupAccount, error) {
+	var gas []models.GroupAccount
+	err := selectGroupAccounts(db, &gas).
+		Where("groupaccount.expires_at <= ?", before).
+		Scan(ctx)
+	return gas, err
+}
+
+// GetExpiringGroupAccounts returns the group memberships expiring before
+// the given time for which no notification has been sent yet
+func GetExpiringGroupAccounts(ctx context.Context, db bun.IDB, before time.Time) ([]models.GroupAccount, error) {
+	var gas []models.GroupAccount
+	err := selectGroupAccounts(db, &gas).
+		Where("groupaccount.expires_at <= ?", before).
+		Where("groupaccount.expires_at > ?", time.Now()).
+		Where("groupaccount.expiry_notified = ?", false).
+		Scan(ctx)
+	return gas, err
+}
+
+// SetGroupAccountNotified records that expiry of the group membership was notified
+func SetGroupAccountNotified(ctx context.Context, db bun.IDB, id uuid.UUID) error {
+	_, err := db.NewUpdate().Model(&models.GroupAccount{}).
+		Set("expiry_notified = ?", true).
+		Where("id = ?", id).
+		Exec(ctx)
+	return err
+}
@@ -65,5 +65,44 @@ func GetGroupRoles(ctx context.Context, db bun.IDB, id uuid.UUID) ([]*userv3.Pro
 		return nil, err
 	}
 
-	return append(append(r, pr...), pnr...), err
+	var csr = []*userv3.ProjectNamespaceRole{}
+	err = db.NewSelect().Table("authsrv_groupclusterselectorrole").
+		ColumnExpr("authsrv_resourcerole.name as role, authsrv_project.name as project, cluster_selector, authsrv_group.name as group").
+		Join(`JOIN authsrv_resourcerole ON authsrv_resourcerole.id=authsrv_groupclusterselectorrole.role_id`).
+		Join(`LEFT JOIN authsrv_project ON authsrv_project.id=authsrv_groupclusterselectorrole.project_id`).
+		Join(`JOIN authsrv_group ON authsrv_group.id=authsrv_groupclusterselectorrole.group_id`).
+		Where("authsrv_groupclusterselectorrole.group_id = ?", id).
+		Where("(authsrv_groupclusterselectorrole.project_id IS NULL OR authsrv_project.trash = ?)", false).
+		Where("authsrv_groupclusterselectorrole.trash = ?", false).
+		Where("authsrv_resourcerole.trash = ?", false).
+		Scan(ctx, &csr)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(append(append(r, pr...), pnr...), csr...), err
+}
+
+// GetMemberGroups gets the list of groups nested in a given group
+func GetMemberGroups(ctx context.Context, db bun.IDB, id uuid.UUID) ([]models.Group, error) {
+	var entities = []models.Group{}
+	err := db.NewSelect().Model(&entities).
+		Join(`JOIN authsrv_groupgroup ON authsrv_groupgroup.member_group_id="group".id`).
+		Where("authsrv_groupgroup.group_id = ?", id).
+		Where("authsrv_groupgroup.trash = ?", false).
+		Where(`"group".trash = ?`, false).
+		Scan(ctx)
+	return entities, err
+}
+
+// GetParentGroups gets the list of groups a given group is nested in
+func GetParentGroups(ctx context.Context, db bun.IDB, id uuid.UUID) ([]models.Group, error) {
+	var entities = []models.Group{}
+	err := db.NewSelect().Model(&entities).
+		Join(`JOIN authsrv_groupgroup ON authsrv_groupgroup.group_id="group".id`).
+		Where("authsrv_groupgroup.member_group_id = ?", id).
+		Where("authsrv_groupgroup.trash = ?", false).
+		Where(`"group".trash = ?`, false).
+		Scan(ctx)
+	return entities, err
 }
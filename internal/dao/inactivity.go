@@ -0,0 +1,78 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/models"
+	"github.com/uptrace/bun"
+)
+
+// KratosInactiveState is the state of suspended identities, they can
+// not login
+const KratosInactiveState = "inactive"
+
+// IsAccountSuspended checks if the identity of the account is inactive
+func IsAccountSuspended(ctx context.Context, db bun.IDB, accountId uuid.UUID) (bool, error) {
+	return db.NewSelect().Model((*models.KratosIdentities)(nil)).
+		Where("identities.id = ?", accountId).
+		Where("identities.state = ?", KratosInactiveState).
+		Exists(ctx)
+}
+
+// ListOrganizationIdentities returns the identities of the users of the
+// organization
+func ListOrganizationIdentities(ctx context.Context, db bun.IDB, orgId uuid.UUID) ([]models.KratosIdentities, error) {
+	var users []models.KratosIdentities
+	err := db.NewSelect().Model(&users).
+		Where("identities.metadata_public ->> 'Organization' = ?", orgId.String()).
+		Order("identities.created_at").
+		Scan(ctx)
+	return users, err
+}
+
+// IsBreakGlassAccount checks if the account is used for break-glass
+// access, such accounts are expected to be unused
+func IsBreakGlassAccount(ctx context.Context, db bun.IDB, accountId uuid.UUID) (bool, error) {
+	return db.NewSelect().Model((*models.BreakGlassAccount)(nil)).
+		Where("account_id = ?", accountId).
+		Where("trash = ?", false).
+		Exists(ctx)
+}
+
+// GetAccountInactivity returns the inactivity warnings sent to the
+// account, an account without warnings has an empty state
+func GetAccountInactivity(ctx context.Context, db bun.IDB, accountId uuid.UUID) (models.AccountInactivity, error) {
+	var inactivity models.AccountInactivity
+	err := db.NewSelect().Model(&inactivity).
+		Where("account_id = ?", accountId).
+		Scan(ctx)
+	if err != nil && err != sql.ErrNoRows {
+		return inactivity, err
+	}
+	inactivity.AccountId = accountId
+	return inactivity, nil
+}
+
+// UpdateAccountInactivity records the inactivity warnings sent to the
+// account
+func UpdateAccountInactivity(ctx context.Context, db bun.IDB, inactivity *models.AccountInactivity) error {
+	_, err := db.NewInsert().Model(inactivity).
+		On("CONFLICT (account_id) DO UPDATE").
+		Set("suspend_warned_at = EXCLUDED.suspend_warned_at").
+		Set("delete_warned_at = EXCLUDED.delete_warned_at").
+		Set("modified_at = EXCLUDED.modified_at").
+		Returning("NULL").
+		Exec(ctx)
+	return err
+}
+
+// DeleteAccountInactivity clears the inactivity warnings sent to the
+// account
+func DeleteAccountInactivity(ctx context.Context, db bun.IDB, accountId uuid.UUID) error {
+	_, err := db.NewDelete().Model(&models.AccountInactivity{}).
+		Where("account_id = ?", accountId).
+		Exec(ctx)
+	return err
+}
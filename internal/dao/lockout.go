@@ -0,0 +1,147 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/models"
+	"github.com/uptrace/bun"
+)
+
+// GetAccountLockout returns the failed login state of the account, an
+// account without failed logins has an empty state
+func GetAccountLockout(ctx context.Context, db bun.IDB, accountId uuid.UUID) (models.AccountLockout, error) {
+	var lockout models.AccountLockout
+	err := db.NewSelect().Model(&lockout).
+		Where("account_id = ?", accountId).
+		Scan(ctx)
+	if err != nil && err != sql.ErrNoRows {
+		return lockout, err
+	}
+	lockout.AccountId = accountId
+	return lockout, nil
+}
+
+// RecordFailedLogin counts a failed login of the account, failures
+// before since are not counted
+func RecordFailedLogin(ctx context.Context, db bun.IDB, accountId uuid.UUID, since time.Time) (models.AccountLockout, error) {
+	now := time.Now()
+	lockout := models.AccountLockout{
+		AccountId:      accountId,
+		FailedAttempts: 1,
+		LastFailedAt:   now,
+		ModifiedAt:     now,
+	}
+	_, err := db.NewInsert().Model(&lockout).
+		On("CONFLICT (account_id) DO UPDATE").
+		Set("failed_attempts = CASE WHEN accountlockout.last_failed_at IS NULL OR accountlockout.last_failed_at < ? THEN 1 ELSE accountlockout.failed_attempts + 1 END", since).
+		Set("last_failed_at = EXCLUDED.last_failed_at").
+		Set("modified_at = EXCLUDED.modified_at").
+		Returning("*").
+		Exec(ctx)
+	return lockout, err
+}
+
+// LockAccount locks the account until the given time
+func LockAccount(ctx context.Context, db bun.IDB, accountId uuid.UUID, until time.Time) error {
+	_, err := db.NewUpdate().Model(&models.AccountLockout{}).
+		Set("locked_until = ?", until).
+		Set("modified_at = ?", time.Now()).
+		Where("account_id = ?", accountId).
+		Exec(ctx)
+	return err
+}
+
+// ResetFailedLogins clears the failed logins of the account unless it
+// is locked
+func ResetFailedLogins(ctx context.Context, db bun.IDB, accountId uuid.UUID) error {
+	_, err := db.NewDelete().Model(&models.AccountLockout{}).
+		Where("account_id = ?", accountId).
+		Where("locked_until IS NULL OR locked_until < ?", time.Now()).
+		Exec(ctx)
+	return err
+}
+
+// UnlockAccount clears the failed logins and the lock of the account
+func UnlockAccount(ctx context.Context, db bun.IDB, accountId uuid.UUID) (bool, error) {
+	res, err := db.NewDelete().Model(&models.AccountLockout{}).
+		Where("account_id = ?", accountId).
+		Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n > 0, err
+}
+
+// GetSessionActivity returns the last activity of the session, a
+// session without recorded activity has an empty state
+func GetSessionActivity(ctx context.Context, db bun.IDB, sessionId uuid.UUID) (models.SessionActivity, error) {
+	var activity models.SessionActivity
+	err := db.NewSelect().Model(&activity).
+		Where("session_id = ?", sessionId).
+		Scan(ctx)
+	if err != nil && err != sql.ErrNoRows {
+		return activity, err
+	}
+	activity.SessionId = sessionId
+	return activity, nil
+}
+
+// TouchSession records activity of the session along with the client
+// it was seen from
+func TouchSession(ctx context.Context, db bun.IDB, activity *models.SessionActivity) error {
+	_, err := db.NewInsert().Model(activity).
+		On("CONFLICT (session_id) DO UPDATE").
+		Set("last_active_at = EXCLUDED.last_active_at").
+		Set("client_ip = EXCLUDED.client_ip").
+		Set("client_ua = EXCLUDED.client_ua").
+		Returning("NULL").
+		Exec(ctx)
+	return err
+}
+
+// TerminateSession marks the session as terminated, terminated
+// sessions are not allowed further activity
+func TerminateSession(ctx context.Context, db bun.IDB, sessionId uuid.UUID) error {
+	_, err := db.NewUpdate().Model(&models.SessionActivity{}).
+		Set("terminated = ?", true).
+		Where("session_id = ?", sessionId).
+		Exec(ctx)
+	return err
+}
+
+// ListSessionActivity returns the activity of the sessions of the
+// account
+func ListSessionActivity(ctx context.Context, db bun.IDB, accountId uuid.UUID) ([]models.SessionActivity, error) {
+	var activities []models.SessionActivity
+	err := db.NewSelect().Model(&activities).
+		Where("account_id = ?", accountId).
+		Order("last_active_at DESC").
+		Scan(ctx)
+	return activities, err
+}
+
+// TerminateAccountSessions marks the sessions of the account as
+// terminated, all of them when no kind is given
+func TerminateAccountSessions(ctx context.Context, db bun.IDB, accountId uuid.UUID, kind string) error {
+	q := db.NewUpdate().Model(&models.SessionActivity{}).
+		Set("terminated = ?", true).
+		Where("account_id = ?", accountId)
+	if kind != "" {
+		q = q.Where("kind = ?", kind)
+	}
+	_, err := q.Exec(ctx)
+	return err
+}
+
+// DeleteExpiredSessionActivity removes the activity of sessions which
+// have expired
+func DeleteExpiredSessionActivity(ctx context.Context, db bun.IDB, before time.Time) error {
+	_, err := db.NewDelete().Model(&models.SessionActivity{}).
+		Where("expires_at < ?", before).
+		Exec(ctx)
+	return err
+}
@@ -0,0 +1,84 @@
+package dao
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/models"
+	"github.com/uptrace/bun"
+)
+
+// credentialsOfType selects the credentials of the given types of the
+// identity in the outer query
+func credentialsOfType(db bun.IDB, types ...string) *bun.SelectQuery {
+	return db.NewSelect().TableExpr("identity_credentials AS ic").
+		ColumnExpr("1").
+		Join("JOIN identity_credential_types AS ict ON ict.id = ic.identity_credential_type_id").
+		Where("ic.identity_id = identities.id").
+		Where("ict.name IN (?)", bun.In(types))
+}
+
+// credentialsOfMFAProviders selects the oidc credentials of the identity
+// in the outer query with identity providers which enforce multi-factor
+// authentication. Kratos identifies the credentials by the name of the
+// provider and the subject.
+func credentialsOfMFAProviders(db bun.IDB) *bun.SelectQuery {
+	return credentialsOfType(db, KratosOidcType).
+		Join("JOIN identity_credential_identifiers AS ici ON ici.identity_credential_id = ic.id").
+		Join("JOIN authsrv_oidc_provider AS op ON ici.identifier LIKE op.name || ':%'").
+		Where("op.mfa_enforced = TRUE").
+		Where("op.trash = FALSE")
+}
+
+// MFAEnrollment is the second factor enrollment of an identity
+type MFAEnrollment struct {
+	CreatedAt time.Time
+	Enrolled  bool
+	// IdpMFA is set when the identity authenticates with an identity
+	// provider which enforces multi-factor authentication
+	IdpMFA bool `bun:"idp_mfa"`
+}
+
+// GetMFAEnrollment returns whether the identity has enrolled a second
+// factor or authenticates with an identity provider enforcing one
+func GetMFAEnrollment(ctx context.Context, db bun.IDB, id uuid.UUID) (MFAEnrollment, error) {
+	var enrollment MFAEnrollment
+	err := db.NewSelect().Model((*models.KratosIdentities)(nil)).
+		Column("identities.created_at").
+		ColumnExpr("EXISTS (?) AS enrolled", credentialsOfType(db, KratosTotpType, KratosWebAuthnType)).
+		ColumnExpr("EXISTS (?) AS idp_mfa", credentialsOfMFAProviders(db)).
+		Where("identities.id = ?", id).
+		Scan(ctx, &enrollment)
+	return enrollment, err
+}
+
+// ListUsersWithoutMFA returns the users of the organization who have
+// not enrolled a second factor, SSO users of identity providers which
+// enforce multi-factor authentication are not included. The count is of all such
+// users, not only of the page returned.
+func ListUsersWithoutMFA(ctx context.Context, db bun.IDB, orgId uuid.UUID, limit, offset int) ([]models.KratosIdentities, int, error) {
+	var users []models.KratosIdentities
+	q := db.NewSelect().Model(&users).
+		Where("identities.metadata_public ->> 'Organization' = ?", orgId.String()).
+		Where("NOT EXISTS (?)", credentialsOfType(db, KratosTotpType, KratosWebAuthnType)).
+		Where("NOT EXISTS (?)", credentialsOfMFAProviders(db)).
+		Order("identities.created_at")
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+	if offset > 0 {
+		q = q.Offset(offset)
+	}
+	count, err := q.ScanAndCount(ctx)
+	return users, count, err
+}
+
+// AccountHasRoles checks if the account is bound to any of the roles,
+// directly or through its groups
+func AccountHasRoles(ctx context.Context, db bun.IDB, id uuid.UUID, roles []string) (bool, error) {
+	return db.NewSelect().TableExpr("sentry_account_permission").
+		Where("account_id = ?", id).
+		Where("role_name IN (?)", bun.In(roles)).
+		Exists(ctx)
+}
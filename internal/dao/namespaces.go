@@ -37,7 +37,9 @@ func GetAccountProjectNamespaces(ctx context.Context, db bun.IDB, projectID uuid
 	var cns []string
 
 	var panr []models.ProjectAccountNamespaceRole
-	err := db.NewSelect().Model(&panr).Where("project_id = ?", projectID).Where("account_id = ?", accountID).Scan(ctx)
+	err := db.NewSelect().Model(&panr).Where("project_id = ?", projectID).Where("account_id = ?", accountID).
+		Where("trash = ?", false).
+		Where("expires_at IS NULL OR expires_at > now()").Scan(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -56,7 +58,9 @@ func GetGroupProjectNamespaces(ctx context.Context, db bun.IDB, projectID uuid.U
 		Join(`JOIN authsrv_groupaccount ON projectgroupnamespacerole.group_id=authsrv_groupaccount.group_id`).
 		Where("authsrv_groupaccount.account_id = ?", accountID).
 		Where("projectgroupnamespacerole.trash = ?", false).
-		Where("authsrv_groupaccount.trash = ?", false).Scan(ctx)
+		Where("projectgroupnamespacerole.expires_at IS NULL OR projectgroupnamespacerole.expires_at > now()").
+		Where("authsrv_groupaccount.trash = ?", false).
+		Where("authsrv_groupaccount.expires_at IS NULL OR authsrv_groupaccount.expires_at > now()").Scan(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -2,6 +2,7 @@ package dao
 
 import (
 	"context"
+	"database/sql"
 	"strings"
 
 	"github.com/google/uuid"
@@ -139,6 +140,21 @@ func GetAccountPermissionsByProjectIDPermissions(ctx context.Context, db bun.IDB
 	return aps, err
 }
 
+// GetClusterSelectorPermissions returns the permissions of the account
+// in the clusters matching the selectors of its role bindings
+func GetClusterSelectorPermissions(ctx context.Context, db bun.IDB, accountID, orgID, partnerID uuid.UUID, permissions []string) ([]models.ClusterSelectorPermission, error) {
+	var csps []models.ClusterSelectorPermission
+
+	err := db.NewSelect().Model(&csps).
+		Where("account_id = ?", accountID).
+		Where("organization_id = ?", orgID).
+		Where("partner_id = ?", partnerID).
+		Where("permission_name IN (?)", bun.In(permissions)).
+		Scan(ctx)
+
+	return csps, err
+}
+
 func GetSSOUsersGroupProjectRole(ctx context.Context, db bun.IDB, orgID uuid.UUID) ([]models.SSOAccountGroupProjectRole, error) {
 	var ssos []models.SSOAccountGroupProjectRole
 
@@ -229,6 +245,19 @@ func IsOrgAdmin(ctx context.Context, db bun.IDB, accountID, partnerID uuid.UUID)
 	return isOrgAdmin, nil
 }
 
+// GetOrganizationAdmins returns the usernames of the accounts with the
+// admin role in the organization
+func GetOrganizationAdmins(ctx context.Context, db bun.IDB, orgId uuid.UUID) ([]string, error) {
+	var names []string
+	err := db.NewSelect().TableExpr("identities").
+		ColumnExpr("DISTINCT identities.traits ->> 'email'").
+		Join("JOIN sentry_account_permission AS sap ON sap.account_id = identities.id").
+		Where("sap.organization_id = ?", orgId).
+		Where("sap.role_name = ?", "ADMIN").
+		Scan(ctx, &names)
+	return names, err
+}
+
 func IsOrgReadOnly(ctx context.Context, db bun.IDB, accountID, organizationID uuid.UUID, partnerID uuid.UUID) (isOrgReadOnly bool, err error) {
 	var aps []models.AccountPermission
 
@@ -267,6 +296,14 @@ func GetAccountBasics(ctx context.Context, db bun.IDB, accountID uuid.UUID) (*mo
 		Where("ks.active = ?", true).
 		Group("identities.id").
 		Scan(ctx)
+	if err == sql.ErrNoRows {
+		// service accounts have no identity nor sessions
+		sa, serr := GetServiceAccount(ctx, db, accountID)
+		if serr != nil {
+			return nil, err
+		}
+		return &models.Account{ID: sa.ID, Username: sa.Username, State: "active"}, nil
+	}
 	if err != nil {
 		return nil, err
 	}
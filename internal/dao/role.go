@@ -51,3 +51,49 @@ func GetRolePermissionsByNames(ctx context.Context, db bun.IDB, permissions ...s
 	}
 	return r, nil
 }
+
+// GetRoleEffectivePermissions returns the permissions of the role along
+// with the ones of the roles it includes, recursively
+func GetRoleEffectivePermissions(ctx context.Context, db bun.IDB, id uuid.UUID) ([]models.ResourcePermission, error) {
+	var r = []models.ResourcePermission{}
+	err := db.NewSelect().Table("authsrv_resourcepermission").
+		ColumnExpr("authsrv_resourcepermission.name as name").
+		Join(`JOIN authsrv_resourcerole_effective_permission ON authsrv_resourcerole_effective_permission.permission_id=authsrv_resourcepermission.id`).
+		Where("authsrv_resourcerole_effective_permission.role_id = ?", id).
+		Where("authsrv_resourcepermission.trash = ?", false).
+		Scan(ctx, &r)
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// GetIncludedRoles returns the roles directly included by the role
+func GetIncludedRoles(ctx context.Context, db bun.IDB, id uuid.UUID) ([]models.Role, error) {
+	var r = []models.Role{}
+	err := db.NewSelect().Model(&r).
+		Join(`JOIN authsrv_resourceroleinclude AS ri ON ri.included_role_id = "resourcerole".id`).
+		Where("ri.resource_role_id = ?", id).
+		Where("ri.trash = ?", false).
+		Where(`"resourcerole".trash = ?`, false).
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// GetIncludingRoles returns the roles directly including the role
+func GetIncludingRoles(ctx context.Context, db bun.IDB, id uuid.UUID) ([]models.Role, error) {
+	var r = []models.Role{}
+	err := db.NewSelect().Model(&r).
+		Join(`JOIN authsrv_resourceroleinclude AS ri ON ri.resource_role_id = "resourcerole".id`).
+		Where("ri.included_role_id = ?", id).
+		Where("ri.trash = ?", false).
+		Where(`"resourcerole".trash = ?`, false).
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
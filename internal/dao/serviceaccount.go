@@ -0,0 +1,53 @@
+package dao
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/models"
+	"github.com/uptrace/bun"
+)
+
+// GetServiceAccount gets the service account with the given account id
+func GetServiceAccount(ctx context.Context, db bun.IDB, id uuid.UUID) (*models.ServiceAccount, error) {
+	var sa models.ServiceAccount
+	err := db.NewSelect().Model(&sa).
+		Where("id = ?", id).
+		Where("trash = ?", false).
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &sa, nil
+}
+
+// GetServiceAccountByUsername gets the service account with the given username
+func GetServiceAccountByUsername(ctx context.Context, db bun.IDB, username string) (*models.ServiceAccount, error) {
+	var sa models.ServiceAccount
+	err := db.NewSelect().Model(&sa).
+		Where("username = ?", username).
+		Where("trash = ?", false).
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &sa, nil
+}
+
+// IsServiceAccount checks if the account is a service account
+func IsServiceAccount(ctx context.Context, db bun.IDB, id uuid.UUID) (bool, error) {
+	_, err := GetServiceAccount(ctx, db, id)
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// GroupOwnsServiceAccounts checks if the group owns any service account
+func GroupOwnsServiceAccounts(ctx context.Context, db bun.IDB, groupId uuid.UUID) (bool, error) {
+	return db.NewSelect().Model((*models.ServiceAccount)(nil)).
+		Where("owner_group_id = ?", groupId).
+		Where("trash = ?", false).
+		Exists(ctx)
+}
@@ -29,10 +29,42 @@ func GetGroups(ctx context.Context, db bun.IDB, id uuid.UUID) ([]models.Group, e
 		Join(`JOIN authsrv_groupaccount ON authsrv_groupaccount.group_id="group".id`).
 		Where("authsrv_groupaccount.account_id = ?", id).
 		Where("authsrv_groupaccount.trash = ?", false).
+		Where("authsrv_groupaccount.expires_at IS NULL OR authsrv_groupaccount.expires_at > now()").
 		Scan(ctx)
 	return entities, err
 }
 
+// GetEffectiveGroups gets the groups of a user along with the groups
+// they are nested in, transitively
+func GetEffectiveGroups(ctx context.Context, db bun.IDB, id uuid.UUID) ([]models.Group, error) {
+	groups, err := GetGroups(ctx, db, id)
+	if err != nil {
+		return nil, err
+	}
+	return WithParentGroups(ctx, db, groups)
+}
+
+// WithParentGroups adds all the groups the given groups are transitively nested in
+func WithParentGroups(ctx context.Context, db bun.IDB, groups []models.Group) ([]models.Group, error) {
+	seen := make(map[uuid.UUID]bool)
+	for _, g := range groups {
+		seen[g.ID] = true
+	}
+	for i := 0; i < len(groups); i++ {
+		parents, err := GetParentGroups(ctx, db, groups[i].ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range parents {
+			if !seen[p.ID] {
+				seen[p.ID] = true
+				groups = append(groups, p)
+			}
+		}
+	}
+	return groups, nil
+}
+
 func GetUserRoles(ctx context.Context, db bun.IDB, id uuid.UUID) ([]*userv3.ProjectNamespaceRole, error) {
 	// Could possibly union them later for some speedup
 	// TODO filter by org and partner
@@ -76,7 +108,21 @@ func GetUserRoles(ctx context.Context, db bun.IDB, id uuid.UUID) ([]*userv3.Proj
 		return nil, err
 	}
 
-	return append(append(r, pr...), pnr...), err
+	var csr = []*userv3.ProjectNamespaceRole{}
+	err = db.NewSelect().Table("authsrv_accountclusterselectorrole").
+		ColumnExpr("authsrv_resourcerole.name as role, authsrv_project.name as project, cluster_selector").
+		Join(`JOIN authsrv_resourcerole ON authsrv_resourcerole.id=authsrv_accountclusterselectorrole.role_id`).
+		Join(`LEFT JOIN authsrv_project ON authsrv_project.id=authsrv_accountclusterselectorrole.project_id`).
+		Where("authsrv_accountclusterselectorrole.account_id = ?", id).
+		Where("(authsrv_accountclusterselectorrole.project_id IS NULL OR authsrv_project.trash = ?)", false).
+		Where("authsrv_resourcerole.trash = ?", false).
+		Where("authsrv_accountclusterselectorrole.trash = ?", false).
+		Scan(ctx, &csr)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(append(append(r, pr...), pnr...), csr...), err
 }
 
 func GetQueryFilteredUsers(ctx context.Context, db bun.IDB, partner, org, group, role uuid.UUID, projects []uuid.UUID) ([]uuid.UUID, error) {
@@ -0,0 +1,48 @@
+package dao
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/models"
+	"github.com/uptrace/bun"
+)
+
+// GetWorkloadIdentityPolicy gets the workload identity policy of the
+// project with the given name
+func GetWorkloadIdentityPolicy(ctx context.Context, db bun.IDB, name string, projectId uuid.UUID) (*models.WorkloadIdentityPolicy, error) {
+	var policy models.WorkloadIdentityPolicy
+	err := db.NewSelect().Model(&policy).
+		Where("name = ?", name).
+		Where("project_id = ?", projectId).
+		Where("trash = ?", false).
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &policy, nil
+}
+
+// ListWorkloadIdentityPolicies lists the workload identity policies of
+// the project
+func ListWorkloadIdentityPolicies(ctx context.Context, db bun.IDB, projectId uuid.UUID) ([]models.WorkloadIdentityPolicy, error) {
+	var policies []models.WorkloadIdentityPolicy
+	err := db.NewSelect().Model(&policies).
+		Where("project_id = ?", projectId).
+		Where("trash = ?", false).
+		Order("name").
+		Scan(ctx)
+	return policies, err
+}
+
+// DeleteExpiredApiKeys removes the short-lived api keys which expired
+// before the given time
+func DeleteExpiredApiKeys(ctx context.Context, db bun.IDB, before time.Time) error {
+	_, err := db.NewUpdate().Model(&models.ApiKey{}).
+		Set("trash = ?", true).
+		Where("expires_at < ?", before).
+		Where("trash = ?", false).
+		Exec(ctx)
+	return err
+}
@@ -0,0 +1,31 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// AccountClusterSelectorRole binds a role to the account in the clusters
+// matching the selector, of the project or of the organization when the
+// project is not set
+type AccountClusterSelectorRole struct {
+	bun.BaseModel `bun:"table:authsrv_accountclusterselectorrole,alias:accountclusterselectorrole"`
+
+	ID              uuid.UUID `bun:"id,type:uuid,pk,default:uuid_generate_v4()"`
+	Name            string    `bun:"name,notnull"`
+	Description     string    `bun:"description,notnull"`
+	CreatedAt       time.Time `bun:"created_at,notnull,default:current_timestamp"`
+	ModifiedAt      time.Time `bun:"modified_at,notnull,default:current_timestamp"`
+	Trash           bool      `bun:"trash,notnull,default:false"`
+	OrganizationId  uuid.UUID `bun:"organization_id,type:uuid"`
+	PartnerId       uuid.UUID `bun:"partner_id,type:uuid"`
+	RoleId          uuid.UUID `bun:"role_id,type:uuid"`
+	AccountId       uuid.UUID `bun:"account_id,type:uuid"`
+	ProjectId       uuid.UUID `bun:"project_id,type:uuid,nullzero"`
+	ClusterSelector string    `bun:"cluster_selector,notnull"`
+	Active          bool      `bun:"active,notnull"`
+	ExpiresAt       time.Time `bun:"expires_at,nullzero"`
+	ExpiryNotified  bool      `bun:"expiry_notified,notnull,default:false"`
+}
@@ -0,0 +1,17 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type AccountInactivity struct {
+	bun.BaseModel `bun:"table:authsrv_accountinactivity,alias:accountinactivity"`
+
+	AccountId       uuid.UUID `bun:"account_id,type:uuid,pk"`
+	SuspendWarnedAt time.Time `bun:"suspend_warned_at,nullzero"`
+	DeleteWarnedAt  time.Time `bun:"delete_warned_at,nullzero"`
+	ModifiedAt      time.Time `bun:"modified_at,notnull,default:current_timestamp"`
+}
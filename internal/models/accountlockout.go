@@ -0,0 +1,18 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type AccountLockout struct {
+	bun.BaseModel `bun:"table:authsrv_accountlockout,alias:accountlockout"`
+
+	AccountId      uuid.UUID `bun:"account_id,type:uuid,pk"`
+	FailedAttempts int       `bun:"failed_attempts,notnull,default:0"`
+	LastFailedAt   time.Time `bun:"last_failed_at,nullzero"`
+	LockedUntil    time.Time `bun:"locked_until,nullzero"`
+	ModifiedAt     time.Time `bun:"modified_at,notnull,default:current_timestamp"`
+}
@@ -22,4 +22,5 @@ type ApiKey struct {
 	PartnerID       uuid.UUID `bun:"partner_id,type:uuid"`
 	SecretMigration string    `bun:"secret_migration"`
 	Secret          string    `bun:"secret,notnull"`
+	ExpiresAt       time.Time `bun:"expires_at,nullzero"`
 }
@@ -29,4 +29,7 @@ type BootstrapAgent struct {
 	IPAddress      string          `bun:"ip_address,notnull"`
 	LastCheckedIn  time.Time       `bun:"last_checked_in"`
 	Fingerprint    string          `bun:"fingerprint,notnull"`
+	TokenExpiresAt time.Time       `bun:"token_expires_at,nullzero"`
+	TokenUsedAt    time.Time       `bun:"token_used_at,nullzero"`
+	TokenViewedAt  time.Time       `bun:"token_viewed_at,nullzero"`
 }
@@ -30,5 +30,6 @@ type BootstrapAgentTemplate struct {
 	Token                  string          `bun:"token,notnull"`
 	InclusterTemplate      string          `bun:"incluster_template,notnull"`
 	OutofclusterTemplate   string          `bun:"outofcluster_template,notnull"`
+	Location               json.RawMessage `bun:"location,type:jsonb,notnull,default:'{}'"`
 	Trash                  bool            `bun:"type:bool"`
 }
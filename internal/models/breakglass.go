@@ -0,0 +1,29 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type BreakGlassAccount struct {
+	bun.BaseModel `bun:"table:authsrv_breakglassaccount,alias:breakglassaccount"`
+
+	ID                uuid.UUID `bun:"id,type:uuid,pk,default:uuid_generate_v4()"`
+	Name              string    `bun:"name,notnull"`
+	Description       string    `bun:"description,notnull"`
+	AccountId         uuid.UUID `bun:"account_id,type:uuid,notnull"`
+	OrganizationId    uuid.UUID `bun:"organization_id,type:uuid,notnull"`
+	PartnerId         uuid.UUID `bun:"partner_id,type:uuid,notnull"`
+	SecretHash        string    `bun:"secret_hash,notnull"`
+	Custodians        int       `bun:"custodians,notnull"`
+	AccessDurationMin int       `bun:"access_duration_min,notnull"`
+	NotifyWebhookURL  string    `bun:"notify_webhook_url,notnull"`
+	RotationRequired  bool      `bun:"rotation_required,notnull"`
+	LastUsedAt        time.Time `bun:"last_used_at,nullzero"`
+	ActiveUntil       time.Time `bun:"active_until,nullzero"`
+	CreatedAt         time.Time `bun:"created_at,notnull,default:current_timestamp"`
+	ModifiedAt        time.Time `bun:"modified_at,notnull,default:current_timestamp"`
+	Trash             bool      `bun:"trash,notnull"`
+}
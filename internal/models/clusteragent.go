@@ -0,0 +1,46 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type ClusterAgent struct {
+	bun.BaseModel `bun:"table:cluster_agent,alias:clusteragent"`
+
+	ClusterId           uuid.UUID `bun:"cluster_id,type:uuid,pk"`
+	AgentVersion        string    `bun:"agent_version,notnull"`
+	DesiredAgentVersion string    `bun:"desired_agent_version,notnull"`
+	ReportedAt          time.Time `bun:"reported_at,nullzero"`
+}
+
+type AgentUpgrade struct {
+	bun.BaseModel `bun:"table:cluster_agent_upgrades,alias:agentupgrade"`
+
+	ID             uuid.UUID `bun:"id,type:uuid,pk,default:uuid_generate_v4()"`
+	Name           string    `bun:"name,notnull"`
+	PartnerId      uuid.UUID `bun:"partner_id,type:uuid,notnull"`
+	OrganizationId uuid.UUID `bun:"organization_id,type:uuid,notnull"`
+	ProjectId      uuid.UUID `bun:"project_id,type:uuid,notnull"`
+	Selector       string    `bun:"selector,notnull"`
+	Version        string    `bun:"version,notnull"`
+	MaxConcurrent  int       `bun:"max_concurrent,notnull"`
+	PauseOnFailure bool      `bun:"pause_on_failure,notnull"`
+	State          string    `bun:"state,notnull"`
+	CreatedAt      time.Time `bun:"created_at,notnull,default:current_timestamp"`
+	ModifiedAt     time.Time `bun:"modified_at,notnull,default:current_timestamp"`
+}
+
+type AgentUpgradeCluster struct {
+	bun.BaseModel `bun:"table:cluster_agent_upgrade_clusters,alias:agentupgradecluster"`
+
+	UpgradeId   uuid.UUID `bun:"upgrade_id,type:uuid,pk"`
+	ClusterId   uuid.UUID `bun:"cluster_id,type:uuid,pk"`
+	ClusterName string    `bun:"cluster_name,notnull"`
+	State       string    `bun:"state,notnull"`
+	Reason      string    `bun:"reason,notnull"`
+	StartedAt   time.Time `bun:"started_at,nullzero"`
+	FinishedAt  time.Time `bun:"finished_at,nullzero"`
+}
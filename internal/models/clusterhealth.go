@@ -0,0 +1,15 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type ClusterHealth struct {
+	bun.BaseModel `bun:"table:cluster_health,alias:clusterhealth"`
+
+	ClusterId  uuid.UUID `bun:"cluster_id,type:uuid,pk"`
+	LastSeenAt time.Time `bun:"last_seen_at,notnull"`
+}
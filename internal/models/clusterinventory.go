@@ -0,0 +1,42 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type ClusterInventory struct {
+	bun.BaseModel `bun:"table:cluster_inventory,alias:clusterinventory"`
+
+	ClusterId         uuid.UUID       `bun:"cluster_id,type:uuid,pk"`
+	KubernetesVersion string          `bun:"kubernetes_version,notnull"`
+	NodeCount         int             `bun:"node_count,notnull"`
+	ReadyNodeCount    int             `bun:"ready_node_count,notnull"`
+	Capacity          json.RawMessage `bun:"capacity,type:jsonb"`
+	Allocatable       json.RawMessage `bun:"allocatable,type:jsonb"`
+	Allocated         json.RawMessage `bun:"allocated,type:jsonb"`
+	SyncedAt          time.Time       `bun:"synced_at,notnull"`
+}
+
+type ClusterNode struct {
+	bun.BaseModel `bun:"table:cluster_nodes,alias:clusternode"`
+
+	ClusterId  uuid.UUID       `bun:"cluster_id,type:uuid,pk"`
+	Name       string          `bun:"name,pk"`
+	Node       json.RawMessage `bun:"node,type:jsonb,notnull"`
+	CreatedAt  time.Time       `bun:"created_at,notnull"`
+	ModifiedAt time.Time       `bun:"modified_at,notnull"`
+}
+
+type ClusterNodeEvent struct {
+	bun.BaseModel `bun:"table:cluster_node_events,alias:clusternodeevent"`
+
+	ID        uuid.UUID `bun:"id,type:uuid,pk,default:uuid_generate_v4()"`
+	ClusterId uuid.UUID `bun:"cluster_id,type:uuid,notnull"`
+	NodeName  string    `bun:"node_name,notnull"`
+	Action    string    `bun:"action,notnull"`
+	CreatedAt time.Time `bun:"created_at,notnull"`
+}
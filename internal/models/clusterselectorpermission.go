@@ -0,0 +1,21 @@
+package models
+
+import (
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// ClusterSelectorPermission is a permission of the account in the
+// clusters matching the selector
+type ClusterSelectorPermission struct {
+	bun.BaseModel `bun:"table:sentry_cluster_selector_permission,alias:scsp"`
+
+	AccountId       uuid.UUID `bun:"account_id,type:uuid"`
+	GroupId         uuid.UUID `bun:"group_id,type:uuid"`
+	ProjectId       uuid.UUID `bun:"project_id,type:uuid,nullzero"`
+	OrganizationId  uuid.UUID `bun:"organization_id,type:uuid"`
+	PartnerId       uuid.UUID `bun:"partner_id,type:uuid"`
+	ClusterSelector string    `bun:"cluster_selector"`
+	RoleName        string    `bun:"role_name"`
+	PermissionName  string    `bun:"permission_name"`
+}
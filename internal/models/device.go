@@ -0,0 +1,40 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type Device struct {
+	bun.BaseModel `bun:"table:authsrv_device,alias:device"`
+
+	ID               uuid.UUID     `bun:"id,type:uuid,pk,default:uuid_generate_v4()"`
+	Name             string        `bun:"name,notnull"`
+	Status           string        `bun:"status,notnull"`
+	UserCode         string        `bun:"user_code,notnull"`
+	DeviceCodeHash   string        `bun:"device_code_hash,notnull"`
+	CodeExpiresAt    time.Time     `bun:"code_expires_at,notnull"`
+	LastPolledAt     time.Time     `bun:"last_polled_at,nullzero"`
+	AccountId        uuid.NullUUID `bun:"account_id,type:uuid"`
+	Username         string        `bun:"username,notnull"`
+	OrganizationId   uuid.NullUUID `bun:"organization_id,type:uuid"`
+	PartnerId        uuid.NullUUID `bun:"partner_id,type:uuid"`
+	AccessTokenHash  string        `bun:"access_token_hash,notnull"`
+	AccessExpiresAt  time.Time     `bun:"access_expires_at,nullzero"`
+	RefreshTokenHash string        `bun:"refresh_token_hash,notnull"`
+	RefreshExpiresAt time.Time     `bun:"refresh_expires_at,nullzero"`
+	CreatedAt        time.Time     `bun:"created_at,notnull,default:current_timestamp"`
+	ApprovedAt       time.Time     `bun:"approved_at,nullzero"`
+	LastUsedAt       time.Time     `bun:"last_used_at,nullzero"`
+	Trash            bool          `bun:"trash,notnull"`
+}
+
+type DeviceApprovalAttempt struct {
+	bun.BaseModel `bun:"table:authsrv_deviceapprovalattempt,alias:deviceapprovalattempt"`
+
+	AccountId      uuid.UUID `bun:"account_id,type:uuid,pk"`
+	FailedAttempts int       `bun:"failed_attempts,notnull,default:0"`
+	LastFailedAt   time.Time `bun:"last_failed_at,notnull"`
+}
@@ -0,0 +1,19 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type GroupGroup struct {
+	bun.BaseModel `bun:"table:authsrv_groupgroup,alias:groupgroup"`
+
+	ID            uuid.UUID `bun:"id,type:uuid,pk,default:uuid_generate_v4()"`
+	CreatedAt     time.Time `bun:"created_at,notnull,default:current_timestamp"`
+	ModifiedAt    time.Time `bun:"modified_at,notnull,default:current_timestamp"`
+	Trash         bool      `bun:"trash,notnull,default:false"`
+	GroupId       uuid.UUID `bun:"group_id,type:uuid"`
+	MemberGroupId uuid.UUID `bun:"member_group_id,type:uuid"`
+}
@@ -22,4 +22,6 @@ type GroupRole struct {
 	RoleId         uuid.UUID `bun:"role_id,type:uuid"`
 	GroupId        uuid.UUID `bun:"group_id,type:uuid"`
 	Active         bool      `bun:"active,notnull"`
+	ExpiresAt      time.Time `bun:"expires_at,nullzero"`
+	ExpiryNotified bool      `bun:"expiry_notified,notnull,default:false"`
 }
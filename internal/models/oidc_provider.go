@@ -29,5 +29,6 @@ type OIDCProvider struct {
 	TokenURL        string                 `bun:"token_url"`
 	RequestedClaims map[string]interface{} `bun:"requested_claims,type:jsonb"`
 	Predefined      bool                   `bun:"predefined,notnull"`
+	MFAEnforced     bool                   `bun:"mfa_enforced,notnull"`
 	Trash           bool                   `bun:"trash,default:false"`
 }
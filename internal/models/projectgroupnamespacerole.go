@@ -23,4 +23,6 @@ type ProjectGroupNamespaceRole struct {
 	ProjectId      uuid.UUID `bun:"project_id,type:uuid"`
 	Namespace      string    `bun:"namespace"`
 	Active         bool      `bun:"active,notnull"`
+	ExpiresAt      time.Time `bun:"expires_at,nullzero"`
+	ExpiryNotified bool      `bun:"expiry_notified,notnull,default:false"`
 }
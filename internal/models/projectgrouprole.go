@@ -23,4 +23,6 @@ type ProjectGroupRole struct {
 	GroupId        uuid.UUID `bun:"group_id,type:uuid"`
 	ProjectId      uuid.UUID `bun:"project_id,type:uuid"`
 	Active         bool      `bun:"active,notnull"`
+	ExpiresAt      time.Time `bun:"expires_at,nullzero"`
+	ExpiryNotified bool      `bun:"expiry_notified,notnull,default:false"`
 }
@@ -0,0 +1,19 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type ResourceRoleInclude struct {
+	bun.BaseModel `bun:"table:authsrv_resourceroleinclude,alias:resourceroleinclude"`
+
+	ID             uuid.UUID `bun:"id,type:uuid,pk,default:uuid_generate_v4()"`
+	CreatedAt      time.Time `bun:"created_at,notnull,default:current_timestamp"`
+	ModifiedAt     time.Time `bun:"modified_at,notnull,default:current_timestamp"`
+	Trash          bool      `bun:"trash,notnull,default:false"`
+	ResourceRoleId uuid.UUID `bun:"resource_role_id,type:uuid"`
+	IncludedRoleId uuid.UUID `bun:"included_role_id,type:uuid"`
+}
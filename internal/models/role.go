@@ -21,4 +21,5 @@ type Role struct {
 	IsGlobal       bool      `bun:"is_global,notnull,default:true"`
 	Builtin        bool      `bun:"builtin,notnull,default:true"`
 	Scope          string    `bun:"scope,notnull"`
+	Effect         string    `bun:"effect,notnull,default:'allow'"`
 }
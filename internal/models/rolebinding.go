@@ -0,0 +1,26 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RoleBinding is a binding of a role to an account or group read from
+// any of the role binding tables
+type RoleBinding struct {
+	ID             uuid.UUID `bun:"id,type:uuid"`
+	Table          string    `bun:"-"`
+	ExpiresAt      time.Time `bun:"expires_at"`
+	Role           string    `bun:"role"`
+	Effect         string    `bun:"effect"`
+	Scope          string    `bun:"scope"`
+	OrganizationID uuid.UUID `bun:"organization_id,type:uuid"`
+	Organization   string    `bun:"organization"`
+	Project        string    `bun:"project"`
+	Namespace      string    `bun:"namespace"`
+	// role bindings scoped by a cluster selector have no authz policy
+	ClusterSelector string `bun:"cluster_selector"`
+	Account         string `bun:"account"`
+	Group           string `bun:"group"`
+}
@@ -0,0 +1,25 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type ServiceAccount struct {
+	bun.BaseModel `bun:"table:authsrv_serviceaccount,alias:serviceaccount"`
+
+	ID             uuid.UUID     `bun:"id,type:uuid,pk,default:uuid_generate_v4()"`
+	Name           string        `bun:"name,notnull"`
+	Username       string        `bun:"username,notnull"`
+	Description    string        `bun:"description,notnull"`
+	OrganizationId uuid.UUID     `bun:"organization_id,type:uuid,notnull"`
+	PartnerId      uuid.UUID     `bun:"partner_id,type:uuid,notnull"`
+	ProjectId      uuid.NullUUID `bun:"project_id,type:uuid"`
+	OwnerGroupId   uuid.UUID     `bun:"owner_group_id,type:uuid,notnull"`
+	PublicKey      string        `bun:"public_key,notnull"`
+	CreatedAt      time.Time     `bun:"created_at,notnull,default:current_timestamp"`
+	ModifiedAt     time.Time     `bun:"modified_at,notnull,default:current_timestamp"`
+	Trash          bool          `bun:"trash,notnull"`
+}
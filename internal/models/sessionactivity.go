@@ -0,0 +1,22 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type SessionActivity struct {
+	bun.BaseModel `bun:"table:authsrv_sessionactivity,alias:sessionactivity"`
+
+	SessionId    uuid.UUID `bun:"session_id,type:uuid,pk"`
+	AccountId    uuid.UUID `bun:"account_id,type:uuid,notnull"`
+	LastActiveAt time.Time `bun:"last_active_at,notnull"`
+	Terminated   bool      `bun:"terminated,notnull,default:false"`
+	Kind         string    `bun:"kind,notnull,default:'console'"`
+	ClientIp     string    `bun:"client_ip"`
+	ClientUa     string    `bun:"client_ua"`
+	CreatedAt    time.Time `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+	ExpiresAt    time.Time `bun:"expires_at,nullzero"`
+}
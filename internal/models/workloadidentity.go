@@ -0,0 +1,28 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type WorkloadIdentityPolicy struct {
+	bun.BaseModel `bun:"table:authsrv_workloadidentitypolicy,alias:workloadidentitypolicy"`
+
+	ID               uuid.UUID         `bun:"id,type:uuid,pk,default:uuid_generate_v4()"`
+	Name             string            `bun:"name,notnull"`
+	Description      string            `bun:"description,notnull"`
+	OrganizationId   uuid.UUID         `bun:"organization_id,type:uuid,notnull"`
+	PartnerId        uuid.UUID         `bun:"partner_id,type:uuid,notnull"`
+	ProjectId        uuid.UUID         `bun:"project_id,type:uuid,notnull"`
+	Issuer           string            `bun:"issuer,notnull"`
+	JwksUri          string            `bun:"jwks_uri,notnull"`
+	Audience         string            `bun:"audience,notnull"`
+	Claims           map[string]string `bun:"claims,type:jsonb,notnull,default:'{}'"`
+	ServiceAccountId uuid.UUID         `bun:"service_account_id,type:uuid,notnull"`
+	TTLSeconds       int64             `bun:"ttl_seconds,notnull"`
+	CreatedAt        time.Time         `bun:"created_at,notnull,default:current_timestamp"`
+	ModifiedAt       time.Time         `bun:"modified_at,notnull,default:current_timestamp"`
+	Trash            bool              `bun:"trash,notnull"`
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
 	kclient "github.com/ory/kratos-client-go"
 	logv2 "github.com/paralus/paralus/pkg/log"
@@ -22,6 +23,15 @@ type IdentityPublicMetadata struct {
 	Partner      string
 }
 
+// Session is an active login session of the user
+type Session struct {
+	Id              string
+	AuthenticatedAt time.Time
+	ExpiresAt       time.Time
+	IpAddress       string
+	UserAgent       string
+}
+
 type kratosAuthProvider struct {
 	kc *kclient.APIClient
 }
@@ -36,6 +46,14 @@ type AuthProvider interface {
 	Delete(context.Context, string) error
 	// Get Public metadata of Kratos id.
 	GetPublicMetadata(context.Context, string) (*IdentityPublicMetadata, error)
+	// list active sessions of user
+	ListSessions(context.Context, string) ([]Session, error)
+	// revoke session
+	RevokeSession(context.Context, string) error
+	// revoke all sessions of user
+	RevokeSessions(context.Context, string) error
+	// activate or deactivate user, inactive users can not login
+	SetState(context.Context, string, bool) error
 }
 
 var _log = logv2.GetLogger()
@@ -63,7 +81,17 @@ func (k *kratosAuthProvider) Create(ctx context.Context, password string, traits
 }
 
 func (k *kratosAuthProvider) Update(ctx context.Context, id string, traits map[string]interface{}, metadata IdentityPublicMetadata) error {
-	uib := kclient.NewUpdateIdentityBody("default", "active", traits)
+	identity, _, err := k.kc.IdentityApi.GetIdentity(ctx, id).Execute()
+	if err != nil {
+		_log.Error("failed to get identity ", err)
+		return err
+	}
+	// updating a suspended user should not reactivate it
+	state := identity.GetState()
+	if state == "" {
+		state = kclient.IDENTITYSTATE_ACTIVE
+	}
+	uib := kclient.NewUpdateIdentityBody("default", state, traits)
 
 	ipm, err := k.GetPublicMetadata(ctx, id)
 	if err != nil {
@@ -123,3 +151,63 @@ func (k *kratosAuthProvider) GetPublicMetadata(ctx context.Context, id string) (
 	}
 	return ipm, nil
 }
+
+func (k *kratosAuthProvider) ListSessions(ctx context.Context, id string) ([]Session, error) {
+	ks, _, err := k.kc.IdentityApi.ListIdentitySessions(ctx, id).Active(true).Execute()
+	if err != nil {
+		return nil, err
+	}
+	sessions := make([]Session, 0, len(ks))
+	for _, s := range ks {
+		session := Session{
+			Id:              s.Id,
+			AuthenticatedAt: s.GetAuthenticatedAt(),
+			ExpiresAt:       s.GetExpiresAt(),
+		}
+		// the first device is the one the user logged in from
+		if len(s.Devices) > 0 {
+			session.IpAddress = s.Devices[0].GetIpAddress()
+			session.UserAgent = s.Devices[0].GetUserAgent()
+		}
+		sessions = append(sessions, session)
+	}
+	return sessions, nil
+}
+
+func (k *kratosAuthProvider) RevokeSession(ctx context.Context, id string) error {
+	_, err := k.kc.IdentityApi.DisableSession(ctx, id).Execute()
+	return err
+}
+
+func (k *kratosAuthProvider) RevokeSessions(ctx context.Context, id string) error {
+	hr, err := k.kc.IdentityApi.DeleteIdentitySessions(ctx, id).Execute()
+	// kratos responds with not found when the user has no sessions
+	if hr != nil && hr.StatusCode == http.StatusNotFound {
+		return nil
+	}
+	return err
+}
+
+func (k *kratosAuthProvider) SetState(ctx context.Context, id string, active bool) error {
+	identity, _, err := k.kc.IdentityApi.GetIdentity(ctx, id).Execute()
+	if err != nil {
+		return err
+	}
+	traits, ok := identity.Traits.(map[string]interface{})
+	if !ok {
+		return errors.New("failed to get identity traits")
+	}
+	state := kclient.IDENTITYSTATE_INACTIVE
+	if active {
+		state = kclient.IDENTITYSTATE_ACTIVE
+	}
+	uib := kclient.NewUpdateIdentityBody(identity.SchemaId, state, traits)
+	uib.MetadataPublic = identity.MetadataPublic
+	uib.MetadataAdmin = identity.MetadataAdmin
+
+	_, hr, err := k.kc.IdentityApi.UpdateIdentity(ctx, id).UpdateIdentityBody(*uib).Execute()
+	if err != nil {
+		_log.Error("failed to update identity state ", hr)
+	}
+	return err
+}
@@ -7,11 +7,13 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	goos "os"
 	goruntime "runtime"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	kclient "github.com/ory/kratos-client-go"
 	"github.com/paralus/paralus/internal/fixtures"
@@ -22,6 +24,7 @@ import (
 	"github.com/paralus/paralus/pkg/enforcer"
 	"github.com/paralus/paralus/pkg/gateway"
 	"github.com/paralus/paralus/pkg/grpc"
+	"github.com/paralus/paralus/pkg/leaderelection"
 	"github.com/paralus/paralus/pkg/log"
 	"github.com/paralus/paralus/pkg/notify"
 	"github.com/paralus/paralus/pkg/reconcile"
@@ -86,9 +89,48 @@ const (
 	coreCDRelayConnectorHostEnv = "CORE_CD_RELAY_CONNECTOR_HOST"
 	schedulerNamespaceEnv       = "SCHEDULER_NAMESPACE"
 
+	// namespace of the leases electing the replica running periodic work
+	leaderElectionNamespaceEnv = "LEADER_ELECTION_NAMESPACE"
+
 	// kratos
-	kratosAddrEnv       = "KRATOS_ADDR"
-	kratosPublicAddrEnv = "KRATOS_PUB_ADDR"
+	kratosAddrEnv          = "KRATOS_ADDR"
+	kratosPublicAddrEnv    = "KRATOS_PUB_ADDR"
+	kratosWebhookSecretEnv = "KRATOS_WEBHOOK_SECRET"
+
+	// expiry of time-limited memberships and role bindings
+	expiryCheckIntervalEnv = "EXPIRY_CHECK_INTERVAL"
+	expiryNotifyBeforeEnv  = "EXPIRY_NOTIFY_BEFORE"
+	expiryNotifyURLEnv     = "EXPIRY_NOTIFY_WEBHOOK_URL"
+
+	// suspension and deletion of inactive users
+	inactivityCheckIntervalEnv = "INACTIVITY_CHECK_INTERVAL"
+
+	// health of clusters from when their relay agents were last seen
+	clusterHealthCheckIntervalEnv = "CLUSTER_HEALTH_CHECK_INTERVAL"
+	clusterUnhealthyAfterEnv      = "CLUSTER_UNHEALTHY_AFTER"
+	clusterDisconnectedAfterEnv   = "CLUSTER_DISCONNECTED_AFTER"
+
+	// rolling upgrades of relay agents
+	agentUpgradeIntervalEnv = "AGENT_UPGRADE_INTERVAL"
+	agentUpgradeTimeoutEnv  = "AGENT_UPGRADE_TIMEOUT"
+
+	// number of relays closest to a cluster its relay agent connects to
+	relaySelectionLimitEnv = "RELAY_SELECTION_LIMIT"
+
+	// per user, API key and client address rate limits of the api
+	rateLimitRPSEnv             = "RATE_LIMIT_RPS"
+	rateLimitBurstEnv           = "RATE_LIMIT_BURST"
+	kubeconfigRateLimitRPSEnv   = "KUBECONFIG_RATE_LIMIT_RPS"
+	kubeconfigRateLimitBurstEnv = "KUBECONFIG_RATE_LIMIT_BURST"
+	loginRateLimitRPSEnv        = "LOGIN_RATE_LIMIT_RPS"
+	loginRateLimitBurstEnv      = "LOGIN_RATE_LIMIT_BURST"
+	// header with the client address set by a trusted proxy in front
+	// of the api, clients are limited by the address of the proxy
+	// without it
+	trustedProxyHeaderEnv = "TRUSTED_PROXY_HEADER"
+
+	// validity of bootstrap agent tokens until registration
+	bootstrapTokenTTLEnv = "BOOTSTRAP_TOKEN_TTL"
 )
 
 var (
@@ -130,11 +172,42 @@ var (
 	coreCDRelayConnectorHost string
 	sentryBootstrapAddr      string
 
+	// leader election
+	leaderElectionNamespace string
+	leaderElectionID        string
+
 	// kratos
-	kratosAddr       string
-	kratosPublicAddr string
-	kc               *kclient.APIClient
-	akc              *kclient.APIClient
+	kratosAddr          string
+	kratosPublicAddr    string
+	kratosWebhookSecret string
+	kc                  *kclient.APIClient
+	akc                 *kclient.APIClient
+
+	// expiry
+	expiryCheckInterval time.Duration
+	expiryNotifyBefore  time.Duration
+	expiryNotifyURL     string
+
+	// inactivity
+	inactivityCheckInterval time.Duration
+
+	// cluster health
+	clusterHealthCheckInterval time.Duration
+	clusterUnhealthyAfter      time.Duration
+	clusterDisconnectedAfter   time.Duration
+
+	// agent upgrades
+	agentUpgradeInterval time.Duration
+	agentUpgradeTimeout  time.Duration
+
+	// rate limits
+	rateLimit           authv3.RateLimit
+	kubeconfigRateLimit authv3.RateLimit
+	loginRateLimit      authv3.RateLimit
+	trustedProxyHeader  string
+
+	// bootstrap
+	bootstrapTokenTTL time.Duration
 
 	// services
 	ps    service.PartnerService
@@ -144,6 +217,7 @@ var (
 	aps   service.AccountPermissionService
 	gps   service.GroupPermissionService
 	krs   service.KubeconfigRevocationService
+	bgs   service.BreakGlassService
 	kss   service.KubeconfigSettingService
 	ns    service.NamespaceService
 	kcs   service.KubectlClusterSettingsService
@@ -153,6 +227,10 @@ var (
 	us    service.UserService
 	ks    service.ApiKeyService
 	gs    service.GroupService
+	sas   service.ServiceAccountService
+	wis   service.WorkloadIdentityService
+	ds    service.DeviceService
+	ses   service.SessionService
 	rs    service.RoleService
 	rrs   service.RolepermissionService
 	is    service.IdpService
@@ -160,6 +238,10 @@ var (
 	aus   service.AuditLogService
 	ras   service.RelayAuditService
 	rcs   service.AuditLogService
+	es    service.ExpiryService
+	ls    service.LockoutService
+	mfas  service.MFAService
+	rls   service.RateLimitService
 
 	clusterPool  schedulerrpc.ClusterPool
 	infraAddr    string
@@ -208,9 +290,45 @@ func setup() {
 	viper.SetDefault(coreCDRelayConnectorHostEnv, "*.core-connector.cdrelay.paralus.local:10012")
 	viper.SetDefault(schedulerNamespaceEnv, "default")
 
+	// leader election
+	viper.SetDefault(leaderElectionNamespaceEnv, "default")
+
 	// kratos
 	viper.SetDefault(kratosAddrEnv, "http://localhost:4434")
 	viper.SetDefault(kratosPublicAddrEnv, "http://localhost:4433")
+	viper.SetDefault(kratosWebhookSecretEnv, "")
+
+	// expiry
+	viper.SetDefault(expiryCheckIntervalEnv, time.Minute)
+	viper.SetDefault(expiryNotifyBeforeEnv, 72*time.Hour)
+	viper.SetDefault(expiryNotifyURLEnv, "")
+
+	// inactivity
+	viper.SetDefault(inactivityCheckIntervalEnv, time.Hour)
+
+	// cluster health
+	viper.SetDefault(clusterHealthCheckIntervalEnv, 30*time.Second)
+	viper.SetDefault(clusterUnhealthyAfterEnv, 2*time.Minute)
+	viper.SetDefault(clusterDisconnectedAfterEnv, 5*time.Minute)
+
+	// agent upgrades
+	viper.SetDefault(agentUpgradeIntervalEnv, 30*time.Second)
+	viper.SetDefault(agentUpgradeTimeoutEnv, 15*time.Minute)
+
+	// all relays are configured for clusters by default
+	viper.SetDefault(relaySelectionLimitEnv, 0)
+
+	// rate limits
+	viper.SetDefault(rateLimitRPSEnv, 20)
+	viper.SetDefault(rateLimitBurstEnv, 100)
+	viper.SetDefault(kubeconfigRateLimitRPSEnv, 0.2)
+	viper.SetDefault(kubeconfigRateLimitBurstEnv, 10)
+	viper.SetDefault(loginRateLimitRPSEnv, 1)
+	viper.SetDefault(loginRateLimitBurstEnv, 10)
+	viper.SetDefault(trustedProxyHeaderEnv, "")
+
+	// bootstrap
+	viper.SetDefault(bootstrapTokenTTLEnv, 24*time.Hour)
 
 	viper.BindEnv(rpcPortEnv)
 	viper.BindEnv(apiPortEnv)
@@ -226,6 +344,26 @@ func setup() {
 
 	viper.BindEnv(kratosAddrEnv)
 	viper.BindEnv(kratosPublicAddrEnv)
+	viper.BindEnv(kratosWebhookSecretEnv)
+
+	viper.BindEnv(expiryCheckIntervalEnv)
+	viper.BindEnv(expiryNotifyBeforeEnv)
+	viper.BindEnv(expiryNotifyURLEnv)
+	viper.BindEnv(inactivityCheckIntervalEnv)
+	viper.BindEnv(clusterHealthCheckIntervalEnv)
+	viper.BindEnv(clusterUnhealthyAfterEnv)
+	viper.BindEnv(clusterDisconnectedAfterEnv)
+	viper.BindEnv(agentUpgradeIntervalEnv)
+	viper.BindEnv(agentUpgradeTimeoutEnv)
+	viper.BindEnv(relaySelectionLimitEnv)
+	viper.BindEnv(rateLimitRPSEnv)
+	viper.BindEnv(rateLimitBurstEnv)
+	viper.BindEnv(kubeconfigRateLimitRPSEnv)
+	viper.BindEnv(kubeconfigRateLimitBurstEnv)
+	viper.BindEnv(loginRateLimitRPSEnv)
+	viper.BindEnv(loginRateLimitBurstEnv)
+	viper.BindEnv(trustedProxyHeaderEnv)
+	viper.BindEnv(bootstrapTokenTTLEnv)
 
 	viper.BindEnv(sentryPeeringHostEnv)
 	viper.BindEnv(coreRelayConnectorHostEnv)
@@ -236,6 +374,7 @@ func setup() {
 	viper.BindEnv(coreCDRelayUserHostEnv)
 	viper.BindEnv(relayImageEnv)
 	viper.BindEnv(schedulerNamespaceEnv)
+	viper.BindEnv(leaderElectionNamespaceEnv)
 
 	viper.BindEnv(auditLogStorageEnv)
 	viper.BindEnv(auditFileEnv)
@@ -256,8 +395,31 @@ func setup() {
 	dbUser = viper.GetString(dbUserEnv)
 	dbPassword = viper.GetString(dbPasswordEnv)
 
+	leaderElectionNamespace = viper.GetString(leaderElectionNamespaceEnv)
+	// replicas are identified by their pod name
+	leaderElectionID, _ = goos.Hostname()
+	if leaderElectionID == "" {
+		leaderElectionID = uuid.NewString()
+	}
+
 	kratosAddr = viper.GetString(kratosAddrEnv)
 	kratosPublicAddr = viper.GetString(kratosPublicAddrEnv)
+	kratosWebhookSecret = viper.GetString(kratosWebhookSecretEnv)
+
+	expiryCheckInterval = viper.GetDuration(expiryCheckIntervalEnv)
+	expiryNotifyBefore = viper.GetDuration(expiryNotifyBeforeEnv)
+	expiryNotifyURL = viper.GetString(expiryNotifyURLEnv)
+	inactivityCheckInterval = viper.GetDuration(inactivityCheckIntervalEnv)
+	clusterHealthCheckInterval = viper.GetDuration(clusterHealthCheckIntervalEnv)
+	clusterUnhealthyAfter = viper.GetDuration(clusterUnhealthyAfterEnv)
+	clusterDisconnectedAfter = viper.GetDuration(clusterDisconnectedAfterEnv)
+	agentUpgradeInterval = viper.GetDuration(agentUpgradeIntervalEnv)
+	agentUpgradeTimeout = viper.GetDuration(agentUpgradeTimeoutEnv)
+	rateLimit = authv3.RateLimit{Rate: viper.GetFloat64(rateLimitRPSEnv), Burst: viper.GetInt(rateLimitBurstEnv)}
+	kubeconfigRateLimit = authv3.RateLimit{Rate: viper.GetFloat64(kubeconfigRateLimitRPSEnv), Burst: viper.GetInt(kubeconfigRateLimitBurstEnv)}
+	loginRateLimit = authv3.RateLimit{Rate: viper.GetFloat64(loginRateLimitRPSEnv), Burst: viper.GetInt(loginRateLimitBurstEnv)}
+	trustedProxyHeader = viper.GetString(trustedProxyHeaderEnv)
+	bootstrapTokenTTL = viper.GetDuration(bootstrapTokenTTLEnv)
 
 	bootstrapKEK = viper.GetString(bootstrapKEKEnv)
 	sentryPeeringHost = viper.GetString(sentryPeeringHostEnv)
@@ -347,14 +509,23 @@ func setup() {
 	ks = service.NewApiKeyService(db, auditLogger)
 	us = service.NewUserService(providers.NewKratosAuthProvider(akc), db, as, ks, cc, auditLogger, dev)
 	gs = service.NewGroupService(db, as, auditLogger)
+	sas = service.NewServiceAccountService(db, as, auditLogger)
+	wis = service.NewWorkloadIdentityService(db, auditLogger)
+	ds = service.NewDeviceService(db, sentryBootstrapAddr, auditLogger)
+	es = service.NewExpiryService(db, as, auditLogger, expiryNotifyURL)
+	ls = service.NewLockoutService(db, auditLogger)
+	mfas = service.NewMFAService(db)
+	rls = service.NewRateLimitService(db)
 	rs = service.NewRoleService(db, as, auditLogger)
 	rrs = service.NewRolepermissionService(db)
 	is = service.NewIdpService(db, apiAddr, auditLogger)
 	oidcs = service.NewOIDCProviderService(db, sentryBootstrapAddr, auditLogger)
 
 	//sentry related services
-	bs = service.NewBootstrapService(db)
+	bs = service.NewBootstrapService(db, auditLogger, bootstrapTokenTTL)
 	krs = service.NewKubeconfigRevocationService(db, auditLogger)
+	ses = service.NewSessionService(db, providers.NewKratosAuthProvider(akc), as, krs, auditLogger)
+	bgs = service.NewBreakGlassService(db, krs, auditLogger)
 	kss = service.NewKubeconfigSettingService(db)
 	ns = service.NewNamespaceService(db)
 	kcs = service.NewkubectlClusterSettingsService(db)
@@ -472,7 +643,7 @@ func run() {
 	_log.Infow("registered grpc health server")
 
 	var wg sync.WaitGroup
-	wg.Add(6)
+	wg.Add(10)
 
 	go runAPI(&wg, ctx)
 	go runRPC(&wg, ctx)
@@ -480,6 +651,10 @@ func run() {
 	go runDebug(&wg, ctx)
 	go runEventHandlers(&wg, ctx)
 	go runIdpGroupSync(&wg, ctx)
+	go runExpiry(&wg, ctx)
+	go runInactivity(&wg, ctx)
+	go runClusterHealth(&wg, ctx)
+	go runAgentUpgrades(&wg, ctx)
 
 	<-ctx.Done()
 	_log.Infow("shutting down, waiting for children to die")
@@ -493,10 +668,14 @@ func runAPI(wg *sync.WaitGroup, ctx context.Context) {
 
 	mux := http.NewServeMux()
 
+	serveMuxOptions := make([]runtime.ServeMuxOption, 0)
+	if trustedProxyHeader != "" {
+		serveMuxOptions = append(serveMuxOptions, runtime.WithMetadata(gateway.NewClientAddressAnnotator(trustedProxyHeader)))
+	}
 	gwHandler, err := gateway.NewGateway(
 		ctx,
 		fmt.Sprintf(":%d", rpcPort),
-		make([]runtime.ServeMuxOption, 0),
+		serveMuxOptions,
 		systemrpc.RegisterPartnerServiceHandlerFromEndpoint,
 		systemrpc.RegisterOrganizationServiceHandlerFromEndpoint,
 		systemrpc.RegisterProjectServiceHandlerFromEndpoint,
@@ -508,10 +687,14 @@ func runAPI(wg *sync.WaitGroup, ctx context.Context) {
 		systemrpc.RegisterLocationServiceHandlerFromEndpoint,
 		userrpc.RegisterUserServiceHandlerFromEndpoint,
 		userrpc.RegisterGroupServiceHandlerFromEndpoint,
+		userrpc.RegisterServiceAccountServiceHandlerFromEndpoint,
+		userrpc.RegisterWorkloadIdentityServiceHandlerFromEndpoint,
+		userrpc.RegisterDeviceAuthorizationServiceHandlerFromEndpoint,
 		rolerpc.RegisterRoleServiceHandlerFromEndpoint,
 		rolerpc.RegisterRolepermissionServiceHandlerFromEndpoint,
 		systemrpc.RegisterIdpServiceHandlerFromEndpoint,
 		systemrpc.RegisterOIDCProviderServiceHandlerFromEndpoint,
+		systemrpc.RegisterBreakGlassServiceHandlerFromEndpoint,
 		auditrpc.RegisterAuditLogServiceHandlerFromEndpoint,
 		auditrpc.RegisterRelayAuditServiceHandlerFromEndpoint,
 	)
@@ -547,15 +730,36 @@ func runRelayPeerRPC(wg *sync.WaitGroup, ctx context.Context) {
 		_log.Fatalw("unable to get peering server cerds", "error", err)
 	}
 
-	relayPeerService, err := server.NewRelayPeerService()
+	// relay agents push the inventory of their clusters and pull
+	// their upgrades with the client certs issued to them at
+	// registration
+	agentCA, err := peering.GetRelayAgentCA(context.Background(), bs)
+	if err != nil {
+		_log.Fatalw("unable to get relay agent ca", "error", err)
+	}
+	unaryAuthz, streamAuthz, err := grpc.NewClientCAInterceptors(ca, map[string][]byte{
+		"/" + sentryrpc.ClusterInventoryService_ServiceDesc.ServiceName + "/": agentCA,
+		"/" + sentryrpc.ClusterAgentService_ServiceDesc.ServiceName + "/":     agentCA,
+	})
+	if err != nil {
+		_log.Fatalw("unable to create peer service interceptors", "error", err)
+	}
+
+	relayPeerService, err := server.NewRelayPeerService(cs)
 	if err != nil {
 		_log.Fatalw("unable to get create relay peer service")
 	}
-	clusterAuthzServer := server.NewClusterAuthzServer(bs, aps, gps, krs, kcs, kss, ns)
+	clusterAuthzServer := server.NewClusterAuthzServer(bs, aps, gps, krs, kcs, kss, ns, cs)
 	auditInfoServer := server.NewAuditInfoServer(bs, aps, pps)
 	crpc := server.NewClusterServer(cs, downloadData)
+	clusterInventoryServer := server.NewClusterInventoryServer(bs, cs)
+	clusterAgentServer := server.NewClusterAgentServer(bs, cs)
 
-	s, err := grpc.NewSecureServerWithPEM(cert, key, ca)
+	clientCAs := append(append([]byte{}, ca...), agentCA...)
+	s, err := grpc.NewSecureServerWithPEM(cert, key, clientCAs,
+		_grpc.UnaryInterceptor(unaryAuthz),
+		_grpc.StreamInterceptor(streamAuthz),
+	)
 	if err != nil {
 		_log.Fatalw("cannot grpc secure server failed", "error", err)
 
@@ -572,6 +776,8 @@ func runRelayPeerRPC(wg *sync.WaitGroup, ctx context.Context) {
 	sentryrpc.RegisterClusterAuthorizationServiceServer(s, clusterAuthzServer)
 	sentryrpc.RegisterAuditInformationServiceServer(s, auditInfoServer)
 	schedulerrpc.RegisterClusterServiceServer(s, crpc)
+	sentryrpc.RegisterClusterInventoryServiceServer(s, clusterInventoryServer)
+	sentryrpc.RegisterClusterAgentServiceServer(s, clusterAgentServer)
 
 	l, err := net.Listen("tcp", fmt.Sprintf(":%d", rpcRelayPeeringPort))
 	if err != nil {
@@ -598,19 +804,23 @@ func runRPC(wg *sync.WaitGroup, ctx context.Context) {
 	projectServer := server.NewProjectServer(pps)
 
 	bootstrapServer := server.NewBootstrapServer(bs, kekFunc, cs)
-	kubeConfigServer := server.NewKubeConfigServer(bs, aps, gps, kss, krs, kekFunc, ks, os, ps, auditLogger)
+	kubeConfigServer := server.NewKubeConfigServer(bs, aps, gps, cs, kss, krs, ses, kekFunc, ks, os, ps, auditLogger)
 	auditInfoServer := server.NewAuditInfoServer(bs, aps, pps)
-	clusterAuthzServer := server.NewClusterAuthzServer(bs, aps, gps, krs, kcs, kss, ns)
+	clusterAuthzServer := server.NewClusterAuthzServer(bs, aps, gps, krs, kcs, kss, ns, cs)
 	kubectlClusterSettingsServer := server.NewKubectlClusterSettingsServer(bs, kcs)
 	crpc := server.NewClusterServer(cs, downloadData)
 	mserver := server.NewLocationServer(ms)
 
-	userServer := server.NewUserServer(us, ks)
+	userServer := server.NewUserServer(us, ks, ls, ses)
 	groupServer := server.NewGroupServer(gs)
+	serviceAccountServer := server.NewServiceAccountServer(sas)
+	workloadIdentityServer := server.NewWorkloadIdentityServer(wis, bs, aps, gps, cs, kss, kekFunc, ks, os, ps, auditLogger)
+	deviceServer := server.NewDeviceServer(ds)
 	roleServer := server.NewRoleServer(rs)
 	rolepermissionServer := server.NewRolePermissionServer(rrs)
 	idpServer := server.NewIdpServer(is)
 	oidcProviderServer := server.NewOIDCServer(oidcs)
+	breakGlassServer := server.NewBreakGlassServer(bgs, bs, aps, gps, cs, kss, kekFunc, ks, os, ps, auditLogger)
 
 	// audit
 	auditLogServer, err := server.NewAuditLogServer(aus)
@@ -628,7 +838,7 @@ func runRPC(wg *sync.WaitGroup, ctx context.Context) {
 	}
 
 	var opts []_grpc.ServerOption
-	ac := authv3.NewAuthContext(db, kc, ks, as)
+	ac := authv3.NewAuthContext(db, kc, ks, as, ls, mfas, sas, ds, rls)
 	asv := authv3.NewAuthService(ac)
 	o := authv3.Option{
 		ExcludeRPCMethods: []string{
@@ -636,11 +846,66 @@ func runRPC(wg *sync.WaitGroup, ctx context.Context) {
 			"/paralus.dev.sentry.rpc.BootstrapService/RegisterBootstrapAgent",
 			"/paralus.dev.sentry.rpc.KubeConfigService/GetForClusterWebSession", //TODO: enable auth from prompt
 			"/paralus.dev.rpc.auth.v3.AuthService/IsRequestAllowed",
+			"/paralus.dev.rpc.system.v3.BreakGlassService/BreakGlass",
+			"/paralus.dev.rpc.user.v3.WorkloadIdentityService/ExchangeToken",
+			"/paralus.dev.rpc.user.v3.WorkloadIdentityService/ExchangeTokenForKubeconfig",
+			"/paralus.dev.rpc.user.v3.DeviceAuthorizationService/AuthorizeDevice",
+			"/paralus.dev.rpc.user.v3.DeviceAuthorizationService/RequestDeviceToken",
+		},
+		// webhooks of kratos are authenticated with the shared secret
+		WebhookRPCMethods: []string{
 			"/paralus.dev.rpc.user.v3.UserService/AuditLogWebhook",
+			"/paralus.dev.rpc.user.v3.UserService/LoginFailureWebhook",
 		},
+		WebhookSecret: kratosWebhookSecret,
 		ExcludeAuthzMethods: []string{
 			"/paralus.dev.rpc.user.v3.UserService/GetUserInfo",
 			"/paralus.dev.rpc.user.v3.UserService/UpdateUserForceReset",
+			"/paralus.dev.rpc.user.v3.DeviceAuthorizationService/ApproveDevice",
+			"/paralus.dev.rpc.user.v3.DeviceAuthorizationService/GetDevices",
+			"/paralus.dev.rpc.user.v3.DeviceAuthorizationService/RevokeDevice",
+			// users manage their own sessions, the permission to manage
+			// the sessions of other users is checked by the service
+			"/paralus.dev.rpc.user.v3.UserService/ListSessions",
+			"/paralus.dev.rpc.user.v3.UserService/RevokeSession",
+			"/paralus.dev.rpc.user.v3.UserService/RevokeAllSessions",
+		},
+		RequireMFAMethods: []string{
+			"/paralus.dev.sentry.rpc.KubeConfigService/GetForUser",
+		},
+		StepUpMFAMethods: []string{
+			"/paralus.dev.sentry.rpc.KubeConfigService/RevokeKubeconfig",
+			"/paralus.dev.sentry.rpc.KubeConfigService/UpdateOrganizationSetting",
+			"/paralus.dev.rpc.system.v3.OrganizationService/UpdateOrganization",
+			"/paralus.dev.rpc.user.v3.UserService/DeleteUser",
+			"/paralus.dev.rpc.user.v3.UserService/ReactivateUser",
+			"/paralus.dev.rpc.system.v3.BreakGlassService/CreateBreakGlassAccount",
+			"/paralus.dev.rpc.system.v3.BreakGlassService/RotateBreakGlassAccount",
+			"/paralus.dev.rpc.system.v3.BreakGlassService/DeleteBreakGlassAccount",
+			"/paralus.dev.rpc.user.v3.ServiceAccountService/CreateServiceAccount",
+			"/paralus.dev.rpc.user.v3.ServiceAccountService/UpdateServiceAccount",
+			"/paralus.dev.rpc.user.v3.ServiceAccountService/DeleteServiceAccount",
+			"/paralus.dev.rpc.user.v3.ServiceAccountService/CreateServiceAccountApiKey",
+			"/paralus.dev.rpc.user.v3.WorkloadIdentityService/CreateWorkloadIdentityPolicy",
+			"/paralus.dev.rpc.user.v3.WorkloadIdentityService/UpdateWorkloadIdentityPolicy",
+			"/paralus.dev.rpc.user.v3.DeviceAuthorizationService/ApproveDevice",
+		},
+		RateLimits: map[string]authv3.RateLimit{
+			service.RateLimitClassDefault:    rateLimit,
+			service.RateLimitClassKubeconfig: kubeconfigRateLimit,
+			service.RateLimitClassLogin:      loginRateLimit,
+		},
+		// signing certificates and checking credentials are
+		// expensive and the usual target of abuse
+		RateLimitMethods: map[string]string{
+			"/paralus.dev.sentry.rpc.KubeConfigService/GetForUser":                        service.RateLimitClassKubeconfig,
+			"/paralus.dev.sentry.rpc.KubeConfigService/GetForServiceAccount":              service.RateLimitClassKubeconfig,
+			"/paralus.dev.rpc.user.v3.WorkloadIdentityService/ExchangeTokenForKubeconfig": service.RateLimitClassKubeconfig,
+			"/paralus.dev.rpc.user.v3.UserService/LoginFailureWebhook":                    service.RateLimitClassLogin,
+			"/paralus.dev.rpc.system.v3.BreakGlassService/BreakGlass":                     service.RateLimitClassLogin,
+			"/paralus.dev.rpc.user.v3.WorkloadIdentityService/ExchangeToken":              service.RateLimitClassLogin,
+			"/paralus.dev.rpc.user.v3.DeviceAuthorizationService/AuthorizeDevice":         service.RateLimitClassLogin,
+			"/paralus.dev.rpc.user.v3.DeviceAuthorizationService/RequestDeviceToken":      service.RateLimitClassLogin,
 		},
 	}
 	opts = append(opts, _grpc.UnaryInterceptor(
@@ -675,10 +940,14 @@ func runRPC(wg *sync.WaitGroup, ctx context.Context) {
 	systemrpc.RegisterLocationServiceServer(s, mserver)
 	userrpc.RegisterUserServiceServer(s, userServer)
 	userrpc.RegisterGroupServiceServer(s, groupServer)
+	userrpc.RegisterServiceAccountServiceServer(s, serviceAccountServer)
+	userrpc.RegisterWorkloadIdentityServiceServer(s, workloadIdentityServer)
+	userrpc.RegisterDeviceAuthorizationServiceServer(s, deviceServer)
 	rolerpc.RegisterRoleServiceServer(s, roleServer)
 	rolerpc.RegisterRolepermissionServiceServer(s, rolepermissionServer)
 	systemrpc.RegisterIdpServiceServer(s, idpServer)
 	systemrpc.RegisterOIDCProviderServiceServer(s, oidcProviderServer)
+	systemrpc.RegisterBreakGlassServiceServer(s, breakGlassServer)
 	auditrpc.RegisterAuditLogServiceServer(s, auditLogServer)
 	auditrpc.RegisterRelayAuditServiceServer(s, relayAuditServer)
 
@@ -753,6 +1022,135 @@ listen:
 	<-ctx.Done()
 }
 
+// runExpiry periodically removes expired group memberships and role
+// bindings, notifies about the ones expiring soon, ends expired
+// break-glass access and removes expired workload credentials
+func runExpiry(wg *sync.WaitGroup, ctx context.Context) {
+	defer wg.Done()
+	runAsLeader(ctx, "paralus-expiry", func(ctx context.Context) {
+		ticker := time.NewTicker(expiryCheckInterval)
+		defer ticker.Stop()
+
+		_log.Infow("starting expiry of memberships and role bindings", "interval", expiryCheckInterval)
+		for {
+			select {
+			case <-ticker.C:
+				if err := es.RemoveExpired(ctx); err != nil {
+					_log.Warnw("unable to remove expired memberships and role bindings", "error", err)
+				}
+				if err := es.NotifyExpiring(ctx, expiryNotifyBefore); err != nil {
+					_log.Warnw("unable to notify expiring memberships and role bindings", "error", err)
+				}
+				if err := bgs.ExpireAccess(ctx); err != nil {
+					_log.Warnw("unable to expire break-glass access", "error", err)
+				}
+				if err := wis.RemoveExpiredCredentials(ctx); err != nil {
+					_log.Warnw("unable to remove expired workload identity credentials", "error", err)
+				}
+				if err := ds.RemoveExpired(ctx); err != nil {
+					_log.Warnw("unable to remove expired device codes and tokens", "error", err)
+				}
+				if err := ses.RemoveExpired(ctx); err != nil {
+					_log.Warnw("unable to remove expired session activity", "error", err)
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	})
+}
+
+// runInactivity periodically suspends and deletes users inactive for
+// longer than their organization allows
+func runInactivity(wg *sync.WaitGroup, ctx context.Context) {
+	defer wg.Done()
+	runAsLeader(ctx, "paralus-inactivity", func(ctx context.Context) {
+		ticker := time.NewTicker(inactivityCheckInterval)
+		defer ticker.Stop()
+
+		_log.Infow("starting suspension and deletion of inactive users", "interval", inactivityCheckInterval)
+		for {
+			select {
+			case <-ticker.C:
+				if err := us.ProcessInactive(ctx); err != nil {
+					_log.Warnw("unable to process inactive users", "error", err)
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	})
+}
+
+// runClusterHealth periodically evaluates the health of clusters from
+// when their relay agents were last seen
+func runClusterHealth(wg *sync.WaitGroup, ctx context.Context) {
+	defer wg.Done()
+	runAsLeader(ctx, "paralus-cluster-health", func(ctx context.Context) {
+		ticker := time.NewTicker(clusterHealthCheckInterval)
+		defer ticker.Stop()
+
+		_log.Infow("starting cluster health evaluation", "interval", clusterHealthCheckInterval, "unhealthyAfter", clusterUnhealthyAfter, "disconnectedAfter", clusterDisconnectedAfter)
+		for {
+			select {
+			case <-ticker.C:
+				if err := cs.EvaluateHealth(ctx, clusterUnhealthyAfter, clusterDisconnectedAfter); err != nil {
+					_log.Warnw("unable to evaluate cluster health", "error", err)
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	})
+}
+
+// runAgentUpgrades periodically rolls out relay agent upgrades and fails
+// the ones not done within the timeout
+func runAgentUpgrades(wg *sync.WaitGroup, ctx context.Context) {
+	defer wg.Done()
+	runAsLeader(ctx, "paralus-agent-upgrades", func(ctx context.Context) {
+		ticker := time.NewTicker(agentUpgradeInterval)
+		defer ticker.Stop()
+
+		_log.Infow("starting relay agent upgrades", "interval", agentUpgradeInterval, "timeout", agentUpgradeTimeout)
+		for {
+			select {
+			case <-ticker.C:
+				if err := cs.ProcessAgentUpgrades(ctx, agentUpgradeTimeout); err != nil {
+					_log.Warnw("unable to process relay agent upgrades", "error", err)
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	})
+}
+
+// runAsLeader runs fn while this replica leads the lease of name, so that
+// periodic work is done by one replica at a time. The context passed to
+// fn is done when the replica stops leading. Without a kubernetes api to
+// hold the lease, e.g. in development, fn runs on this replica.
+func runAsLeader(ctx context.Context, name string, fn func(ctx context.Context)) {
+	lock, err := leaderelection.NewLock(name, leaderElectionNamespace, leaderElectionID)
+	if err != nil {
+		_log.Warnw("unable to create leader election lock, running without election", "for", name, "error", err)
+		fn(ctx)
+		return
+	}
+	err = leaderelection.Run(lock, func(stop <-chan struct{}) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		go func() {
+			<-stop
+			cancel()
+		}()
+		fn(ctx)
+	}, ctx.Done())
+	if err != nil {
+		_log.Warnw("unable to run leader election", "for", name, "error", err)
+	}
+}
+
 func main() {
 	setup()
 	run()
@@ -0,0 +1,313 @@
+-- memberships and role bindings can be time-limited, expired entries are
+-- removed by the expiry job
+ALTER TABLE authsrv_groupaccount ADD COLUMN IF NOT EXISTS expires_at timestamp with time zone;
+ALTER TABLE authsrv_groupaccount ADD COLUMN IF NOT EXISTS expiry_notified boolean NOT NULL DEFAULT FALSE;
+CREATE INDEX IF NOT EXISTS authsrv_groupaccount_expires_at_idx ON authsrv_groupaccount USING btree (expires_at) WHERE expires_at IS NOT NULL AND trash = FALSE;
+
+ALTER TABLE authsrv_accountresourcerole ADD COLUMN IF NOT EXISTS expires_at timestamp with time zone;
+ALTER TABLE authsrv_accountresourcerole ADD COLUMN IF NOT EXISTS expiry_notified boolean NOT NULL DEFAULT FALSE;
+CREATE INDEX IF NOT EXISTS authsrv_accountresourcerole_expires_at_idx ON authsrv_accountresourcerole USING btree (expires_at) WHERE expires_at IS NOT NULL AND trash = FALSE;
+
+ALTER TABLE authsrv_projectaccountresourcerole ADD COLUMN IF NOT EXISTS expires_at timestamp with time zone;
+ALTER TABLE authsrv_projectaccountresourcerole ADD COLUMN IF NOT EXISTS expiry_notified boolean NOT NULL DEFAULT FALSE;
+CREATE INDEX IF NOT EXISTS authsrv_projectaccountresourcerole_expires_at_idx ON authsrv_projectaccountresourcerole USING btree (expires_at) WHERE expires_at IS NOT NULL AND trash = FALSE;
+
+ALTER TABLE authsrv_projectaccountnamespacerole ADD COLUMN IF NOT EXISTS expires_at timestamp with time zone;
+ALTER TABLE authsrv_projectaccountnamespacerole ADD COLUMN IF NOT EXISTS expiry_notified boolean NOT NULL DEFAULT FALSE;
+CREATE INDEX IF NOT EXISTS authsrv_projectaccountnamespacerole_expires_at_idx ON authsrv_projectaccountnamespacerole USING btree (expires_at) WHERE expires_at IS NOT NULL AND trash = FALSE;
+
+ALTER TABLE authsrv_grouprole ADD COLUMN IF NOT EXISTS expires_at timestamp with time zone;
+ALTER TABLE authsrv_grouprole ADD COLUMN IF NOT EXISTS expiry_notified boolean NOT NULL DEFAULT FALSE;
+CREATE INDEX IF NOT EXISTS authsrv_grouprole_expires_at_idx ON authsrv_grouprole USING btree (expires_at) WHERE expires_at IS NOT NULL AND trash = FALSE;
+
+ALTER TABLE authsrv_projectgrouprole ADD COLUMN IF NOT EXISTS expires_at timestamp with time zone;
+ALTER TABLE authsrv_projectgrouprole ADD COLUMN IF NOT EXISTS expiry_notified boolean NOT NULL DEFAULT FALSE;
+CREATE INDEX IF NOT EXISTS authsrv_projectgrouprole_expires_at_idx ON authsrv_projectgrouprole USING btree (expires_at) WHERE expires_at IS NOT NULL AND trash = FALSE;
+
+ALTER TABLE authsrv_projectgroupnamespacerole ADD COLUMN IF NOT EXISTS expires_at timestamp with time zone;
+ALTER TABLE authsrv_projectgroupnamespacerole ADD COLUMN IF NOT EXISTS expiry_notified boolean NOT NULL DEFAULT FALSE;
+CREATE INDEX IF NOT EXISTS authsrv_projectgroupnamespacerole_expires_at_idx ON authsrv_projectgroupnamespacerole USING btree (expires_at) WHERE expires_at IS NOT NULL AND trash = FALSE;
+
+-- expired memberships and role bindings no longer grant permissions,
+-- even before the expiry job removes them
+CREATE OR REPLACE VIEW authsrv_groupaccount_effective AS
+WITH RECURSIVE membership (account_id, group_id, depth) AS (
+    SELECT
+        account_id,
+        group_id,
+        1
+    FROM
+        authsrv_groupaccount
+    WHERE
+        trash = FALSE
+        AND (expires_at IS NULL OR expires_at > now())
+    UNION
+    SELECT
+        m.account_id,
+        gg.group_id,
+        m.depth + 1
+    FROM
+        membership m
+        INNER JOIN authsrv_groupgroup gg ON gg.member_group_id = m.group_id
+    WHERE
+        gg.trash = FALSE
+        AND m.depth < 10
+)
+SELECT DISTINCT
+    account_id,
+    group_id,
+    FALSE AS trash
+FROM
+    membership;
+
+CREATE OR REPLACE VIEW sentry_account_permission AS
+SELECT
+    apr.account_id,
+    apr.group_id,
+    apr.project_id,
+    apr.organization_id,
+    apr.partner_id,
+    rbu.role_name, -- could be dropped in future
+    rbu.role_id,
+    rbu.is_global,
+    rbu.scope,
+    rbu.permission_name,
+    rbu.base_url,
+    rbu.urls
+FROM (
+    SELECT
+        ga.account_id,
+        gr.group_id,
+        uuid_nil() project_id,
+        gr.role_id,
+        gr.organization_id,
+        gr.partner_id
+    FROM
+        authsrv_groupaccount_effective ga
+        INNER JOIN authsrv_grouprole gr ON ga.group_id = gr.group_id
+    WHERE
+        ga.trash = FALSE
+        AND gr.trash = FALSE
+        AND (gr.expires_at IS NULL OR gr.expires_at > now())
+    UNION
+    SELECT
+        ga.account_id,
+        gr.group_id,
+	p.id,
+        gr.role_id,
+        gr.organization_id,
+        gr.partner_id
+    FROM
+        authsrv_groupaccount_effective ga
+        INNER JOIN authsrv_grouprole gr ON ga.group_id = gr.group_id
+        INNER JOIN authsrv_resourcerole rr ON rr.id = gr.role_id AND rr.scope = 'organization'
+        INNER JOIN authsrv_project p ON p.organization_id = gr.organization_id AND p.partner_id = gr.partner_id
+    WHERE
+        ga.trash = FALSE
+        AND gr.trash = FALSE
+        AND (gr.expires_at IS NULL OR gr.expires_at > now())
+    UNION
+    SELECT
+        account_id,
+        uuid_nil() as group_id,
+        uuid_nil() project_id,
+        role_id,
+        organization_id,
+        partner_id
+    FROM
+        authsrv_accountresourcerole
+    WHERE
+        trash = FALSE
+        AND (expires_at IS NULL OR expires_at > now())
+    UNION
+    SELECT
+        ga.account_id,
+        ga.group_id,
+        pgr.project_id,
+        pgr.role_id,
+        pgr.organization_id,
+        pgr.partner_id
+    FROM
+        authsrv_projectgrouprole pgr
+        INNER JOIN authsrv_groupaccount_effective ga ON pgr.group_id = ga.group_id
+    WHERE
+        pgr.trash = FALSE
+        AND (pgr.expires_at IS NULL OR pgr.expires_at > now())
+        AND ga.trash = FALSE
+    UNION
+    SELECT
+        account_id,
+        uuid_nil() as group_id,
+        project_id,
+        role_id,
+        organization_id,
+        partner_id
+    FROM
+        authsrv_projectaccountresourcerole
+    WHERE
+        trash = FALSE
+        AND (expires_at IS NULL OR expires_at > now())
+    UNION
+    SELECT
+        account_id,
+        uuid_nil() as group_id,
+        project_id,
+        role_id,
+        organization_id,
+        partner_id
+    FROM
+        authsrv_projectaccountnamespacerole
+    WHERE
+        trash = FALSE
+        AND (expires_at IS NULL OR expires_at > now())
+    UNION    
+    SELECT
+        ga.account_id,
+        ga.group_id,
+        pgnr.project_id,
+        pgnr.role_id,
+        pgnr.organization_id,
+        pgnr.partner_id
+    FROM
+        authsrv_projectgroupnamespacerole pgnr
+        INNER JOIN authsrv_groupaccount_effective ga ON pgnr.group_id = ga.group_id
+    WHERE
+        pgnr.trash = FALSE
+        AND (pgnr.expires_at IS NULL OR pgnr.expires_at > now())
+        AND ga.trash = FALSE) AS apr
+    INNER JOIN (
+        SELECT
+            rp.role_id,
+            rr.role_name,
+            rr.is_global,
+            rr.scope,
+            p.permission_name,
+            p.base_url,
+            p.urls
+        FROM (
+            SELECT
+                role_id,
+                permission_id
+            FROM
+                authsrv_resourcerole_effective_permission) rp
+            INNER JOIN (
+                SELECT
+                    rp.id AS permission_id,
+                    rp.base_url,
+                    rp.name AS permission_name,
+                    rp.resource_urls || rp.resource_action_urls AS urls
+                FROM
+                    authsrv_resourcepermission rp) p ON rp.permission_id = p.permission_id
+                INNER JOIN (
+                    SELECT
+                        id,
+                        name AS role_name,
+                        is_global,
+                        scope
+                    FROM
+                        authsrv_resourcerole
+                    WHERE
+                        trash = FALSE
+                        AND effect = 'allow') rr ON rr.id = rp.role_id) rbu ON apr.role_id = rbu.role_id
+    INNER JOIN identities ON identities.id = apr.account_id
+WHERE
+    lower(identities.state) = 'active';
+
+CREATE OR REPLACE VIEW sentry_group_permission AS
+SELECT
+    gpr.group_id,
+    gpr.project_id,
+    gpr.organization_id,
+    gpr.partner_id,
+    gpr.group_name,
+    rbu.role_name,
+    rbu.is_global,
+    rbu.scope,
+    rbu.permission_name,
+    rbu.base_url,
+    rbu.urls,
+    gpr.project_name
+FROM (
+    SELECT
+        gr.group_id,
+        g.organization_id,
+        g.partner_id,
+        g.name AS group_name,
+        null project_id,
+        '' AS project_name,
+        gr.role_id
+    FROM
+        authsrv_group g
+        INNER JOIN authsrv_grouprole gr ON g.id = gr.group_id
+    WHERE
+        g.trash = FALSE
+        AND gr.trash = FALSE
+        AND (gr.expires_at IS NULL OR gr.expires_at > now())
+    UNION
+    SELECT
+        pgr.group_id,
+        g.organization_id,
+        g.partner_id,
+        g.name AS group_name,
+        pgr.project_id::text,
+        pj.name AS project_name,
+        pgr.role_id
+    FROM
+        authsrv_projectgrouprole pgr
+        INNER JOIN authsrv_group g ON pgr.group_id = g.id
+        INNER JOIN authsrv_project pj ON pgr.project_id = pj.id
+    WHERE
+        pgr.trash = FALSE
+        AND (pgr.expires_at IS NULL OR pgr.expires_at > now())
+        AND g.trash = FALSE    
+    UNION    
+    SELECT
+        pgnr.group_id,
+        g.organization_id,
+        g.partner_id,
+        g.name AS group_name,
+        pgnr.project_id::text,
+        pj.name AS project_name,
+        pgnr.role_id
+    FROM
+        authsrv_projectgroupnamespacerole pgnr
+        INNER JOIN authsrv_group g ON pgnr.group_id = g.id
+        INNER JOIN authsrv_project pj ON pgnr.project_id = pj.id
+    WHERE
+        pgnr.trash = FALSE
+        AND (pgnr.expires_at IS NULL OR pgnr.expires_at > now())
+        AND g.trash = FALSE) AS gpr
+    INNER JOIN (
+        SELECT
+            rp.role_id,
+            rr.role_name,
+            rr.is_global,
+            rr.scope,
+            p.permission_name,
+            p.base_url,
+            p.urls
+        FROM (
+            SELECT
+                role_id,
+                permission_id
+            FROM
+                authsrv_resourcerole_effective_permission) rp
+            INNER JOIN (
+                SELECT
+                    rp.id AS permission_id,
+                    rp.base_url,
+                    rp.name AS permission_name,
+                    rp.resource_urls || rp.resource_action_urls AS urls
+                FROM
+                    authsrv_resourcepermission rp) p ON rp.permission_id = p.permission_id
+                INNER JOIN (
+                    SELECT
+                        id,
+                        name AS role_name,
+                        is_global,
+                        scope
+                    FROM
+                        authsrv_resourcerole
+                    WHERE
+                        trash = FALSE
+                        AND effect = 'allow') rr ON rr.id = rp.role_id) rbu ON gpr.role_id = rbu.role_id;
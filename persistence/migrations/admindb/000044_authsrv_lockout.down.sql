@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS authsrv_sessionactivity;
+
+DROP TABLE IF EXISTS authsrv_accountlockout;
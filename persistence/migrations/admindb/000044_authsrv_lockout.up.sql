@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS authsrv_accountlockout (
+    account_id uuid PRIMARY KEY,
+    failed_attempts integer NOT NULL DEFAULT 0,
+    last_failed_at timestamp with time zone,
+    locked_until timestamp with time zone,
+    modified_at timestamp with time zone NOT NULL DEFAULT current_timestamp
+);
+
+CREATE TABLE IF NOT EXISTS authsrv_sessionactivity (
+    session_id uuid PRIMARY KEY,
+    account_id uuid NOT NULL,
+    last_active_at timestamp with time zone NOT NULL,
+    terminated boolean NOT NULL DEFAULT FALSE
+);
+
+CREATE INDEX IF NOT EXISTS authsrv_sessionactivity_account_id ON authsrv_sessionactivity USING btree (account_id);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS sentry_bootstrap_agent_token_state_idx;
+ALTER TABLE sentry_bootstrap_agent DROP COLUMN IF EXISTS token_used_at;
+ALTER TABLE sentry_bootstrap_agent DROP COLUMN IF EXISTS token_expires_at;
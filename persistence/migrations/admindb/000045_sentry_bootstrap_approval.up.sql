@@ -0,0 +1,7 @@
+ALTER TABLE sentry_bootstrap_agent ADD COLUMN IF NOT EXISTS token_expires_at timestamp with time zone;
+ALTER TABLE sentry_bootstrap_agent ADD COLUMN IF NOT EXISTS token_used_at timestamp with time zone;
+
+-- tokens registered before approvals were tracked have been used
+UPDATE sentry_bootstrap_agent SET token_used_at = COALESCE(modified_at, created_at) WHERE token_state = 'Approved';
+
+CREATE INDEX IF NOT EXISTS sentry_bootstrap_agent_token_state_idx ON sentry_bootstrap_agent USING btree (token_state);
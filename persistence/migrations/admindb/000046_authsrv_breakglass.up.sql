@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS authsrv_breakglassaccount (
+    id uuid DEFAULT uuid_generate_v4() PRIMARY KEY,
+    name character varying(256) NOT NULL,
+    description character varying(512) NOT NULL DEFAULT '',
+    account_id uuid NOT NULL,
+    organization_id uuid NOT NULL,
+    partner_id uuid NOT NULL,
+    secret_hash character varying(128) NOT NULL,
+    custodians integer NOT NULL DEFAULT 1,
+    access_duration_min integer NOT NULL,
+    notify_webhook_url text NOT NULL DEFAULT '',
+    rotation_required boolean NOT NULL DEFAULT FALSE,
+    last_used_at timestamp with time zone,
+    active_until timestamp with time zone,
+    created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+    modified_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+    trash boolean NOT NULL DEFAULT FALSE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS authsrv_breakglassaccount_name ON authsrv_breakglassaccount USING btree (organization_id, name) WHERE trash = FALSE;
+
+CREATE INDEX IF NOT EXISTS authsrv_breakglassaccount_active_until ON authsrv_breakglassaccount USING btree (active_until) WHERE active_until IS NOT NULL;
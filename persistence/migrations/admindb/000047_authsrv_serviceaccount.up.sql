@@ -0,0 +1,177 @@
+CREATE TABLE IF NOT EXISTS authsrv_serviceaccount (
+    id uuid DEFAULT uuid_generate_v4() PRIMARY KEY,
+    name character varying(256) NOT NULL,
+    username character varying(512) NOT NULL,
+    description character varying(512) NOT NULL DEFAULT '',
+    organization_id uuid NOT NULL,
+    partner_id uuid NOT NULL,
+    project_id uuid REFERENCES authsrv_project(id) DEFERRABLE INITIALLY DEFERRED,
+    owner_group_id uuid NOT NULL REFERENCES authsrv_group(id) DEFERRABLE INITIALLY DEFERRED,
+    public_key text NOT NULL DEFAULT '',
+    created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+    modified_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+    trash boolean NOT NULL DEFAULT FALSE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS authsrv_serviceaccount_name ON authsrv_serviceaccount USING btree (organization_id, name) WHERE trash = FALSE;
+
+CREATE UNIQUE INDEX IF NOT EXISTS authsrv_serviceaccount_username ON authsrv_serviceaccount USING btree (username) WHERE trash = FALSE;
+
+CREATE INDEX IF NOT EXISTS authsrv_serviceaccount_owner_group_id ON authsrv_serviceaccount USING btree (owner_group_id);
+
+-- service accounts have no kratos identity, their role bindings grant
+-- permissions as long as the service account is not deleted
+CREATE OR REPLACE VIEW sentry_account_permission AS
+SELECT
+    apr.account_id,
+    apr.group_id,
+    apr.project_id,
+    apr.organization_id,
+    apr.partner_id,
+    rbu.role_name, -- could be dropped in future
+    rbu.role_id,
+    rbu.is_global,
+    rbu.scope,
+    rbu.permission_name,
+    rbu.base_url,
+    rbu.urls
+FROM (
+    SELECT
+        ga.account_id,
+        gr.group_id,
+        uuid_nil() project_id,
+        gr.role_id,
+        gr.organization_id,
+        gr.partner_id
+    FROM
+        authsrv_groupaccount_effective ga
+        INNER JOIN authsrv_grouprole gr ON ga.group_id = gr.group_id
+    WHERE
+        ga.trash = FALSE
+        AND gr.trash = FALSE
+        AND (gr.expires_at IS NULL OR gr.expires_at > now())
+    UNION
+    SELECT
+        ga.account_id,
+        gr.group_id,
+	p.id,
+        gr.role_id,
+        gr.organization_id,
+        gr.partner_id
+    FROM
+        authsrv_groupaccount_effective ga
+        INNER JOIN authsrv_grouprole gr ON ga.group_id = gr.group_id
+        INNER JOIN authsrv_resourcerole rr ON rr.id = gr.role_id AND rr.scope = 'organization'
+        INNER JOIN authsrv_project p ON p.organization_id = gr.organization_id AND p.partner_id = gr.partner_id
+    WHERE
+        ga.trash = FALSE
+        AND gr.trash = FALSE
+        AND (gr.expires_at IS NULL OR gr.expires_at > now())
+    UNION
+    SELECT
+        account_id,
+        uuid_nil() as group_id,
+        uuid_nil() project_id,
+        role_id,
+        organization_id,
+        partner_id
+    FROM
+        authsrv_accountresourcerole
+    WHERE
+        trash = FALSE
+        AND (expires_at IS NULL OR expires_at > now())
+    UNION
+    SELECT
+        ga.account_id,
+        ga.group_id,
+        pgr.project_id,
+        pgr.role_id,
+        pgr.organization_id,
+        pgr.partner_id
+    FROM
+        authsrv_projectgrouprole pgr
+        INNER JOIN authsrv_groupaccount_effective ga ON pgr.group_id = ga.group_id
+    WHERE
+        pgr.trash = FALSE
+        AND (pgr.expires_at IS NULL OR pgr.expires_at > now())
+        AND ga.trash = FALSE
+    UNION
+    SELECT
+        account_id,
+        uuid_nil() as group_id,
+        project_id,
+        role_id,
+        organization_id,
+        partner_id
+    FROM
+        authsrv_projectaccountresourcerole
+    WHERE
+        trash = FALSE
+        AND (expires_at IS NULL OR expires_at > now())
+    UNION
+    SELECT
+        account_id,
+        uuid_nil() as group_id,
+        project_id,
+        role_id,
+        organization_id,
+        partner_id
+    FROM
+        authsrv_projectaccountnamespacerole
+    WHERE
+        trash = FALSE
+        AND (expires_at IS NULL OR expires_at > now())
+    UNION    
+    SELECT
+        ga.account_id,
+        ga.group_id,
+        pgnr.project_id,
+        pgnr.role_id,
+        pgnr.organization_id,
+        pgnr.partner_id
+    FROM
+        authsrv_projectgroupnamespacerole pgnr
+        INNER JOIN authsrv_groupaccount_effective ga ON pgnr.group_id = ga.group_id
+    WHERE
+        pgnr.trash = FALSE
+        AND (pgnr.expires_at IS NULL OR pgnr.expires_at > now())
+        AND ga.trash = FALSE) AS apr
+    INNER JOIN (
+        SELECT
+            rp.role_id,
+            rr.role_name,
+            rr.is_global,
+            rr.scope,
+            p.permission_name,
+            p.base_url,
+            p.urls
+        FROM (
+            SELECT
+                role_id,
+                permission_id
+            FROM
+                authsrv_resourcerole_effective_permission) rp
+            INNER JOIN (
+                SELECT
+                    rp.id AS permission_id,
+                    rp.base_url,
+                    rp.name AS permission_name,
+                    rp.resource_urls || rp.resource_action_urls AS urls
+                FROM
+                    authsrv_resourcepermission rp) p ON rp.permission_id = p.permission_id
+                INNER JOIN (
+                    SELECT
+                        id,
+                        name AS role_name,
+                        is_global,
+                        scope
+                    FROM
+                        authsrv_resourcerole
+                    WHERE
+                        trash = FALSE
+                        AND effect = 'allow') rr ON rr.id = rp.role_id) rbu ON apr.role_id = rbu.role_id
+    LEFT JOIN identities ON identities.id = apr.account_id
+    LEFT JOIN authsrv_serviceaccount sa ON sa.id = apr.account_id AND sa.trash = FALSE
+WHERE
+    lower(identities.state) = 'active'
+    OR sa.id IS NOT NULL;
@@ -0,0 +1,3 @@
+ALTER TABLE authsrv_apikey DROP COLUMN IF EXISTS expires_at;
+
+DROP TABLE IF EXISTS authsrv_workloadidentitypolicy;
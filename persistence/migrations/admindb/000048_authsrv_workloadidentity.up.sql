@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS authsrv_workloadidentitypolicy (
+    id uuid DEFAULT uuid_generate_v4() PRIMARY KEY,
+    name character varying(256) NOT NULL,
+    description character varying(512) NOT NULL DEFAULT '',
+    organization_id uuid NOT NULL,
+    partner_id uuid NOT NULL,
+    project_id uuid NOT NULL REFERENCES authsrv_project(id) DEFERRABLE INITIALLY DEFERRED,
+    issuer character varying(512) NOT NULL,
+    jwks_uri character varying(512) NOT NULL DEFAULT '',
+    audience character varying(512) NOT NULL,
+    claims jsonb NOT NULL DEFAULT '{}',
+    service_account_id uuid NOT NULL REFERENCES authsrv_serviceaccount(id) DEFERRABLE INITIALLY DEFERRED,
+    ttl_seconds integer NOT NULL DEFAULT 0,
+    created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+    modified_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+    trash boolean NOT NULL DEFAULT FALSE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS authsrv_workloadidentitypolicy_name ON authsrv_workloadidentitypolicy USING btree (project_id, name) WHERE trash = FALSE;
+
+CREATE INDEX IF NOT EXISTS authsrv_workloadidentitypolicy_service_account_id ON authsrv_workloadidentitypolicy USING btree (service_account_id);
+
+-- api keys exchanged for OIDC tokens are short-lived
+ALTER TABLE authsrv_apikey ADD COLUMN IF NOT EXISTS expires_at timestamp with time zone;
@@ -0,0 +1,33 @@
+-- devices authorized with the OAuth2 device authorization grant, the
+-- codes and tokens are stored hashed
+CREATE TABLE IF NOT EXISTS authsrv_device (
+    id uuid DEFAULT uuid_generate_v4() PRIMARY KEY,
+    name character varying(256) NOT NULL DEFAULT '',
+    status character varying(16) NOT NULL DEFAULT 'pending',
+    user_code character varying(16) NOT NULL,
+    device_code_hash character varying(64) NOT NULL DEFAULT '',
+    code_expires_at timestamp with time zone NOT NULL,
+    last_polled_at timestamp with time zone,
+    account_id uuid,
+    username character varying(512) NOT NULL DEFAULT '',
+    organization_id uuid,
+    partner_id uuid,
+    access_token_hash character varying(64) NOT NULL DEFAULT '',
+    access_expires_at timestamp with time zone,
+    refresh_token_hash character varying(64) NOT NULL DEFAULT '',
+    refresh_expires_at timestamp with time zone,
+    created_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
+    approved_at timestamp with time zone,
+    last_used_at timestamp with time zone,
+    trash boolean NOT NULL DEFAULT FALSE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS authsrv_device_user_code ON authsrv_device USING btree (user_code) WHERE status = 'pending' AND trash = FALSE;
+
+CREATE INDEX IF NOT EXISTS authsrv_device_device_code_hash ON authsrv_device USING btree (device_code_hash) WHERE trash = FALSE;
+
+CREATE INDEX IF NOT EXISTS authsrv_device_access_token_hash ON authsrv_device USING btree (access_token_hash) WHERE trash = FALSE;
+
+CREATE INDEX IF NOT EXISTS authsrv_device_refresh_token_hash ON authsrv_device USING btree (refresh_token_hash) WHERE trash = FALSE;
+
+CREATE INDEX IF NOT EXISTS authsrv_device_account_id ON authsrv_device USING btree (account_id) WHERE trash = FALSE;
@@ -0,0 +1,5 @@
+ALTER TABLE authsrv_sessionactivity DROP COLUMN IF EXISTS expires_at;
+ALTER TABLE authsrv_sessionactivity DROP COLUMN IF EXISTS created_at;
+ALTER TABLE authsrv_sessionactivity DROP COLUMN IF EXISTS client_ua;
+ALTER TABLE authsrv_sessionactivity DROP COLUMN IF EXISTS client_ip;
+ALTER TABLE authsrv_sessionactivity DROP COLUMN IF EXISTS kind;
@@ -0,0 +1,5 @@
+ALTER TABLE authsrv_sessionactivity ADD COLUMN IF NOT EXISTS kind character varying NOT NULL DEFAULT 'console';
+ALTER TABLE authsrv_sessionactivity ADD COLUMN IF NOT EXISTS client_ip character varying;
+ALTER TABLE authsrv_sessionactivity ADD COLUMN IF NOT EXISTS client_ua character varying;
+ALTER TABLE authsrv_sessionactivity ADD COLUMN IF NOT EXISTS created_at timestamp with time zone NOT NULL DEFAULT current_timestamp;
+ALTER TABLE authsrv_sessionactivity ADD COLUMN IF NOT EXISTS expires_at timestamp with time zone;
@@ -0,0 +1,6 @@
+CREATE TABLE IF NOT EXISTS authsrv_accountinactivity (
+    account_id uuid PRIMARY KEY,
+    suspend_warned_at timestamp with time zone,
+    delete_warned_at timestamp with time zone,
+    modified_at timestamp with time zone NOT NULL DEFAULT current_timestamp
+);
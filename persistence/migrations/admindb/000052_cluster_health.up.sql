@@ -0,0 +1,4 @@
+CREATE TABLE IF NOT EXISTS cluster_health (
+    cluster_id uuid PRIMARY KEY,
+    last_seen_at timestamp with time zone NOT NULL
+);
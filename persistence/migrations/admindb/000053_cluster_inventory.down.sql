@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS cluster_node_events;
+DROP TABLE IF EXISTS cluster_nodes;
+DROP TABLE IF EXISTS cluster_inventory;
@@ -0,0 +1,32 @@
+CREATE TABLE IF NOT EXISTS cluster_inventory (
+    cluster_id uuid PRIMARY KEY,
+    kubernetes_version character varying(256) NOT NULL DEFAULT '',
+    node_count integer NOT NULL DEFAULT 0,
+    ready_node_count integer NOT NULL DEFAULT 0,
+    capacity jsonb,
+    allocatable jsonb,
+    allocated jsonb,
+    synced_at timestamp with time zone NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS cluster_inventory_kubernetes_version_idx ON cluster_inventory (kubernetes_version);
+CREATE INDEX IF NOT EXISTS cluster_inventory_node_count_idx ON cluster_inventory (node_count);
+
+CREATE TABLE IF NOT EXISTS cluster_nodes (
+    cluster_id uuid NOT NULL,
+    name character varying(256) NOT NULL,
+    node jsonb NOT NULL,
+    created_at timestamp with time zone NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    modified_at timestamp with time zone NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (cluster_id, name)
+);
+
+CREATE TABLE IF NOT EXISTS cluster_node_events (
+    id uuid default uuid_generate_v4() PRIMARY KEY,
+    cluster_id uuid NOT NULL,
+    node_name character varying(256) NOT NULL,
+    action character varying(16) NOT NULL,
+    created_at timestamp with time zone NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS cluster_node_events_cluster_id_idx ON cluster_node_events (cluster_id, created_at);
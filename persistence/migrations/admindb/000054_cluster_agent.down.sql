@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS cluster_agent_upgrade_clusters;
+DROP TABLE IF EXISTS cluster_agent_upgrades;
+DROP TABLE IF EXISTS cluster_agent;
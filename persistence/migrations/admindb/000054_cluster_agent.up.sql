@@ -0,0 +1,37 @@
+CREATE TABLE IF NOT EXISTS cluster_agent (
+    cluster_id uuid PRIMARY KEY,
+    agent_version character varying(256) NOT NULL DEFAULT '',
+    desired_agent_version character varying(256) NOT NULL DEFAULT '',
+    reported_at timestamp with time zone
+);
+
+CREATE TABLE IF NOT EXISTS cluster_agent_upgrades (
+    id uuid default uuid_generate_v4() PRIMARY KEY,
+    name character varying(256) NOT NULL,
+    partner_id uuid NOT NULL,
+    organization_id uuid NOT NULL,
+    project_id uuid NOT NULL,
+    selector text NOT NULL DEFAULT '',
+    version character varying(256) NOT NULL,
+    max_concurrent integer NOT NULL DEFAULT 1,
+    pause_on_failure boolean NOT NULL DEFAULT false,
+    state character varying(32) NOT NULL,
+    created_at timestamp with time zone NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    modified_at timestamp with time zone NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (project_id, name)
+);
+
+CREATE INDEX IF NOT EXISTS cluster_agent_upgrades_state_idx ON cluster_agent_upgrades (state);
+
+CREATE TABLE IF NOT EXISTS cluster_agent_upgrade_clusters (
+    upgrade_id uuid NOT NULL REFERENCES cluster_agent_upgrades (id) ON DELETE CASCADE,
+    cluster_id uuid NOT NULL,
+    cluster_name character varying(256) NOT NULL,
+    state character varying(32) NOT NULL,
+    reason text NOT NULL DEFAULT '',
+    started_at timestamp with time zone,
+    finished_at timestamp with time zone,
+    PRIMARY KEY (upgrade_id, cluster_id)
+);
+
+CREATE INDEX IF NOT EXISTS cluster_agent_upgrade_clusters_cluster_id_idx ON cluster_agent_upgrade_clusters (cluster_id, state);
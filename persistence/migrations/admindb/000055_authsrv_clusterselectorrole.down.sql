@@ -0,0 +1,3 @@
+DROP VIEW IF EXISTS sentry_cluster_selector_permission;
+DROP TABLE IF EXISTS authsrv_groupclusterselectorrole;
+DROP TABLE IF EXISTS authsrv_accountclusterselectorrole;
@@ -0,0 +1,100 @@
+-- role bindings of accounts and groups scoped to the clusters matching a
+-- label selector, in a project or in the organization when the project
+-- is not set; they only grant kubectl access
+CREATE TABLE IF NOT EXISTS authsrv_accountclusterselectorrole (
+    id uuid default uuid_generate_v4() PRIMARY KEY,
+    name character varying(256) NOT NULL,
+    description character varying(512) NOT NULL,
+    created_at timestamp with time zone NOT NULL,
+    modified_at timestamp with time zone NOT NULL,
+    trash boolean NOT NULL,
+    cluster_selector character varying(512) NOT NULL,
+    active boolean NOT NULL,
+    account_id uuid NOT NULL,
+    organization_id uuid REFERENCES authsrv_organization(id) DEFERRABLE INITIALLY DEFERRED,
+    partner_id uuid REFERENCES authsrv_partner(id) DEFERRABLE INITIALLY DEFERRED,
+    project_id uuid REFERENCES authsrv_project(id) DEFERRABLE INITIALLY DEFERRED,
+    role_id uuid NOT NULL REFERENCES authsrv_resourcerole(id) DEFERRABLE INITIALLY DEFERRED,
+    expires_at timestamp with time zone,
+    expiry_notified boolean NOT NULL DEFAULT FALSE
+);
+
+CREATE INDEX IF NOT EXISTS authsrv_accountclusterselectorrole_account_id ON authsrv_accountclusterselectorrole USING btree (account_id);
+
+CREATE INDEX IF NOT EXISTS authsrv_accountclusterselectorrole_organization_id ON authsrv_accountclusterselectorrole USING btree (organization_id);
+
+CREATE INDEX IF NOT EXISTS authsrv_accountclusterselectorrole_project_id ON authsrv_accountclusterselectorrole USING btree (project_id);
+
+CREATE INDEX IF NOT EXISTS authsrv_accountclusterselectorrole_expires_at_idx ON authsrv_accountclusterselectorrole USING btree (expires_at) WHERE expires_at IS NOT NULL AND trash = FALSE;
+
+CREATE TABLE IF NOT EXISTS authsrv_groupclusterselectorrole (
+    id uuid default uuid_generate_v4() PRIMARY KEY,
+    name character varying(256) NOT NULL,
+    description character varying(512) NOT NULL,
+    created_at timestamp with time zone NOT NULL,
+    modified_at timestamp with time zone NOT NULL,
+    trash boolean NOT NULL,
+    cluster_selector character varying(512) NOT NULL,
+    active boolean NOT NULL,
+    group_id uuid NOT NULL REFERENCES authsrv_group(id) DEFERRABLE INITIALLY DEFERRED,
+    organization_id uuid REFERENCES authsrv_organization(id) DEFERRABLE INITIALLY DEFERRED,
+    partner_id uuid REFERENCES authsrv_partner(id) DEFERRABLE INITIALLY DEFERRED,
+    project_id uuid REFERENCES authsrv_project(id) DEFERRABLE INITIALLY DEFERRED,
+    role_id uuid NOT NULL REFERENCES authsrv_resourcerole(id) DEFERRABLE INITIALLY DEFERRED,
+    expires_at timestamp with time zone,
+    expiry_notified boolean NOT NULL DEFAULT FALSE
+);
+
+CREATE INDEX IF NOT EXISTS authsrv_groupclusterselectorrole_group_id ON authsrv_groupclusterselectorrole USING btree (group_id);
+
+CREATE INDEX IF NOT EXISTS authsrv_groupclusterselectorrole_organization_id ON authsrv_groupclusterselectorrole USING btree (organization_id);
+
+CREATE INDEX IF NOT EXISTS authsrv_groupclusterselectorrole_project_id ON authsrv_groupclusterselectorrole USING btree (project_id);
+
+CREATE INDEX IF NOT EXISTS authsrv_groupclusterselectorrole_expires_at_idx ON authsrv_groupclusterselectorrole USING btree (expires_at) WHERE expires_at IS NOT NULL AND trash = FALSE;
+
+-- permissions of the accounts in the clusters matching the selectors,
+-- directly or through their groups
+CREATE OR REPLACE VIEW sentry_cluster_selector_permission AS
+SELECT
+    b.account_id,
+    b.group_id,
+    b.project_id,
+    b.organization_id,
+    b.partner_id,
+    b.cluster_selector,
+    rr.name AS role_name,
+    rp.name AS permission_name
+FROM (
+    SELECT
+        account_id,
+        uuid_nil() AS group_id,
+        project_id,
+        organization_id,
+        partner_id,
+        role_id,
+        cluster_selector
+    FROM
+        authsrv_accountclusterselectorrole
+    WHERE
+        trash = FALSE
+        AND (expires_at IS NULL OR expires_at > now())
+    UNION
+    SELECT
+        ga.account_id,
+        gcsr.group_id,
+        gcsr.project_id,
+        gcsr.organization_id,
+        gcsr.partner_id,
+        gcsr.role_id,
+        gcsr.cluster_selector
+    FROM
+        authsrv_groupaccount_effective ga
+        INNER JOIN authsrv_groupclusterselectorrole gcsr ON ga.group_id = gcsr.group_id
+    WHERE
+        gcsr.trash = FALSE
+        AND (gcsr.expires_at IS NULL OR gcsr.expires_at > now())
+) b
+INNER JOIN authsrv_resourcerole rr ON rr.id = b.role_id AND rr.trash = FALSE AND rr.effect = 'allow'
+INNER JOIN authsrv_resourcerole_effective_permission rep ON rep.role_id = b.role_id
+INNER JOIN authsrv_resourcepermission rp ON rp.id = rep.permission_id;
@@ -0,0 +1 @@
+ALTER TABLE sentry_bootstrap_agent_template DROP COLUMN IF EXISTS location;
@@ -0,0 +1 @@
+ALTER TABLE sentry_bootstrap_agent_template ADD COLUMN IF NOT EXISTS location jsonb NOT NULL DEFAULT '{}';
@@ -0,0 +1 @@
+ALTER TABLE sentry_bootstrap_agent DROP COLUMN IF EXISTS token_viewed_at;
@@ -0,0 +1 @@
+ALTER TABLE sentry_bootstrap_agent ADD COLUMN IF NOT EXISTS token_viewed_at timestamp with time zone;
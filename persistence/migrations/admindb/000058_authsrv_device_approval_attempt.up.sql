@@ -0,0 +1,7 @@
+-- user codes entered by each account which did not match a device
+-- waiting for approval, accounts entering too many are held off
+CREATE TABLE IF NOT EXISTS authsrv_deviceapprovalattempt (
+    account_id uuid PRIMARY KEY,
+    failed_attempts integer NOT NULL DEFAULT 0,
+    last_failed_at timestamp with time zone NOT NULL
+);
@@ -0,0 +1 @@
+ALTER TABLE authsrv_oidc_provider DROP COLUMN IF EXISTS mfa_enforced;
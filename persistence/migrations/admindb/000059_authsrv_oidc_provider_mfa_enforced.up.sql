@@ -0,0 +1,3 @@
+-- identity providers trusted to enforce multi-factor authentication,
+-- their users are not asked for a second factor by paralus
+ALTER TABLE authsrv_oidc_provider ADD COLUMN IF NOT EXISTS mfa_enforced boolean NOT NULL DEFAULT false;
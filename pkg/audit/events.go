@@ -21,6 +21,8 @@ type (
 	EventCategory string
 	// EventTopic is the topic to which event has to be published
 	EventTopic string
+	// EventSeverity is the severity of the event
+	EventSeverity string
 )
 
 // Audit events constants
@@ -30,6 +32,7 @@ const (
 	OriginCore         EventOrigin   = "core"
 	OriginCluster      EventOrigin   = "cluster"
 	AuditCategory      EventCategory = "AUDIT"
+	SeverityCritical   EventSeverity = "critical"
 )
 
 // EventActorAccount Event's initiator account
@@ -73,6 +76,7 @@ type Event struct {
 	Client    *EventClient  `json:"client"`
 	Detail    *EventDetail  `json:"detail"`
 	Timestamp string        `json:"timestamp"`
+	Severity  EventSeverity `json:"severity,omitempty"`
 }
 
 type createEventOptions struct {
@@ -243,8 +247,12 @@ func GetActorFromSessionData(sd *commonv3.SessionData) *EventActor {
 	}
 	groups := sd.Groups
 
+	actorType := "USER"
+	if sd.GetIsServiceAccount() {
+		actorType = "SERVICE_ACCOUNT"
+	}
 	return &EventActor{
-		Type:    "USER",
+		Type:    actorType,
 		Account: account,
 		Groups:  groups,
 	}
@@ -282,6 +290,16 @@ func GetEvent(r *http.Request, sd *commonv3.SessionData, detail *EventDetail, ev
 }
 
 func CreateV1Event(al *zap.Logger, sd *commonv3.SessionData, detail *EventDetail, eventType string, project string) error {
+	return createV1Event(al, sd, detail, eventType, project, "")
+}
+
+// CreateV1CriticalEvent creates an event which should be brought to
+// the attention of the administrators
+func CreateV1CriticalEvent(al *zap.Logger, sd *commonv3.SessionData, detail *EventDetail, eventType string, project string) error {
+	return createV1Event(al, sd, detail, eventType, project, SeverityCritical)
+}
+
+func createV1Event(al *zap.Logger, sd *commonv3.SessionData, detail *EventDetail, eventType string, project string, severity EventSeverity) error {
 	actor := GetActorFromSessionData(sd)
 	client := GetClientFromSessionData(sd)
 
@@ -295,6 +313,7 @@ func CreateV1Event(al *zap.Logger, sd *commonv3.SessionData, detail *EventDetail
 		Type:     eventType,
 		Portal:   "OPS",
 		Project:  project,
+		Severity: severity,
 	}
 
 	go WriteEvent(event, al)
@@ -302,8 +321,7 @@ func CreateV1Event(al *zap.Logger, sd *commonv3.SessionData, detail *EventDetail
 }
 
 func WriteEvent(event *Event, al *zap.Logger) {
-	al.Info(
-		"audit",
+	fields := []zap.Field{
 		zap.String("version", string(event.Version)),
 		zap.String("category", string(event.Category)),
 		zap.String("origin", string(event.Origin)),
@@ -313,5 +331,9 @@ func WriteEvent(event *Event, al *zap.Logger) {
 		zap.String("type", event.Type),
 		zap.String("portal", event.Portal),
 		zap.String("project", event.Project),
-	)
+	}
+	if event.Severity != "" {
+		fields = append(fields, zap.String("severity", string(event.Severity)))
+	}
+	al.Info("audit", fields...)
 }
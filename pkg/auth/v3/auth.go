@@ -27,6 +27,12 @@ type Option struct {
 	// be excluded from the auth interceptor.
 	ExcludeRPCMethods []string
 
+	// WebhookRPCMethods is a list of RPC method strings called by
+	// the identity provider instead of users, they are authenticated
+	// with WebhookSecret. Webhooks are rejected when it is not set.
+	WebhookRPCMethods []string
+	WebhookSecret     string
+
 	// ExcludeURLs is a list of URL regular expressions that are
 	// excluded from the auth middleware.
 	ExcludeURLs []string
@@ -34,6 +40,25 @@ type Option struct {
 	// ExcludeAuthzMethods is a list of RPC method strings which only
 	// do authentication and not authorization.
 	ExcludeAuthzMethods []string
+
+	// RequireMFAMethods is a list of RPC method strings which
+	// require a second factor from users covered by the multi-factor
+	// authentication policy, even during the enrollment grace period.
+	RequireMFAMethods []string
+
+	// StepUpMFAMethods is a list of RPC method strings which require
+	// a recently authenticated second factor from users covered by
+	// the multi-factor authentication policy.
+	StepUpMFAMethods []string
+
+	// RateLimits is the default rate limit of each class of RPC
+	// methods, organizations can override them in their settings.
+	RateLimits map[string]RateLimit
+
+	// RateLimitMethods maps RPC method strings to the class of rate
+	// limits they count against. Methods which are not listed count
+	// against the default class.
+	RateLimitMethods map[string]string
 }
 
 type authContext struct {
@@ -41,6 +66,11 @@ type authContext struct {
 	kc *kclient.APIClient
 	ks service.ApiKeyService
 	as service.AuthzService
+	ls service.LockoutService
+	ms service.MFAService
+	ss service.ServiceAccountService
+	ds service.DeviceService
+	rs service.RateLimitService
 }
 
 // SetupAuthContext sets up new authContext along with its
@@ -79,7 +109,7 @@ func SetupAuthContext(auditLogger *zap.Logger) authContext {
 	}
 	as := service.NewAuthzService(db, enforcer)
 
-	return authContext{db: db, kc: kc, as: as, ks: service.NewApiKeyService(db, auditLogger)}
+	return authContext{db: db, kc: kc, as: as, ks: service.NewApiKeyService(db, auditLogger), ls: service.NewLockoutService(db, auditLogger), ms: service.NewMFAService(db), ss: service.NewServiceAccountService(db, as, auditLogger), ds: service.NewDeviceService(db, "", auditLogger), rs: service.NewRateLimitService(db)}
 }
 
 func getDSN() string {
@@ -111,11 +141,21 @@ func NewAuthContext(
 	kc *kclient.APIClient,
 	apiKeySvc service.ApiKeyService,
 	authzSvc service.AuthzService,
+	lockoutSvc service.LockoutService,
+	mfaSvc service.MFAService,
+	serviceAccountSvc service.ServiceAccountService,
+	deviceSvc service.DeviceService,
+	rateLimitSvc service.RateLimitService,
 ) authContext {
 	return authContext{
 		db: db,
 		kc: kc,
 		ks: apiKeySvc,
 		as: authzSvc,
+		ls: lockoutSvc,
+		ms: mfaSvc,
+		ss: serviceAccountSvc,
+		ds: deviceSvc,
+		rs: rateLimitSvc,
 	}
 }
@@ -5,10 +5,14 @@ import (
 	"crypto/md5"
 	"encoding/base64"
 	"errors"
+	"fmt"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
+	kclient "github.com/ory/kratos-client-go"
 	"github.com/paralus/paralus/internal/dao"
+	"github.com/paralus/paralus/pkg/service"
 	rpcv3 "github.com/paralus/paralus/proto/rpc/user"
 	authzv1 "github.com/paralus/paralus/proto/types/authz"
 	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
@@ -59,6 +63,8 @@ func getTokenCheckSum(body []byte) string {
 // authenticate validate whether the request is from a legitimate user
 // and populate relevant information in res.
 func (ac *authContext) authenticate(ctx context.Context, req *commonv3.IsRequestAllowedRequest, res *commonv3.IsRequestAllowedResponse) (bool, error) {
+	res.SessionData.ClientIp = req.ClientIp
+	res.SessionData.ClientUa = req.ClientUa
 	if len(req.XApiKey) > 0 && len(req.XSessionToken) == 0 {
 		resp, err := ac.ks.GetByKey(ctx, &rpcv3.ApiKeyRequest{
 			Id: req.XApiKey,
@@ -76,6 +82,53 @@ func (ac *authContext) authenticate(ctx context.Context, req *commonv3.IsRequest
 		res.SessionData.Account = resp.AccountID.String()
 		res.SessionData.Organization = resp.OrganizationID.String()
 		res.SessionData.Partner = resp.PartnerID.String()
+		isServiceAccount, err := dao.IsServiceAccount(ctx, ac.db, resp.AccountID)
+		if err != nil {
+			return false, err
+		}
+		res.SessionData.IsServiceAccount = isServiceAccount
+		res.SessionData.Session = resp.ID.String()
+		succ, err := ac.checkLockout(ctx, res)
+		if !succ || err != nil {
+			return succ, err
+		}
+		if err := ac.ls.RecordActivity(ctx, service.SessionTypeApiKey, res.SessionData); err != nil {
+			_log.Infow("unable to record api key activity", "key", req.XApiKey, "error", err)
+		}
+		return ac.checkMFA(ctx, req, res, nil)
+	} else if strings.HasPrefix(req.BearerToken, service.DeviceAccessTokenPrefix) && len(req.XSessionToken) == 0 {
+		sd, err := ac.ds.AuthenticateToken(ctx, req.BearerToken)
+		if err != nil {
+			_log.Infow("unable to authenticate device token", "error", err)
+			res.Status = commonv3.RequestStatus_RequestNotAuthenticated
+			res.Reason = "invalid device token"
+			return false, nil
+		}
+		res.Status = commonv3.RequestStatus_RequestAllowed
+		sd.ClientIp = req.ClientIp
+		sd.ClientUa = req.ClientUa
+		res.SessionData = sd
+		succ, err := ac.checkLockout(ctx, res)
+		if !succ || err != nil {
+			return succ, err
+		}
+		if err := ac.ls.RecordActivity(ctx, service.SessionTypeDevice, res.SessionData); err != nil {
+			_log.Infow("unable to record device activity", "error", err)
+		}
+		return ac.checkMFA(ctx, req, res, nil)
+	} else if len(req.BearerToken) > 0 && len(req.XSessionToken) == 0 {
+		sd, err := ac.ss.AuthenticateToken(ctx, req.BearerToken)
+		if err != nil {
+			_log.Infow("unable to authenticate service account token", "error", err)
+			res.Status = commonv3.RequestStatus_RequestNotAuthenticated
+			res.Reason = "invalid service account token"
+			return false, nil
+		}
+		res.Status = commonv3.RequestStatus_RequestAllowed
+		sd.ClientIp = req.ClientIp
+		sd.ClientUa = req.ClientUa
+		res.SessionData = sd
+		return ac.checkMFA(ctx, req, res, nil)
 	} else {
 
 		tsr := ac.kc.FrontendApi.ToSession(ctx).XSessionToken(req.GetXSessionToken()).Cookie(req.GetCookie())
@@ -93,6 +146,7 @@ func (ac *authContext) authenticate(ctx context.Context, req *commonv3.IsRequest
 		if session.GetActive() {
 			res.Status = commonv3.RequestStatus_RequestAllowed
 			res.SessionData.Account = session.Identity.GetId()
+			res.SessionData.Session = session.GetId()
 			if session.Identity.HasMetadataPublic() {
 				m := session.Identity.MetadataPublic.(map[string]interface{})
 				if org, ok := m["Organization"].(string); ok {
@@ -111,7 +165,7 @@ func (ac *authContext) authenticate(ctx context.Context, req *commonv3.IsRequest
 				res.Reason = "unable to find identity"
 				return false, err
 			}
-			groups, err := dao.GetGroups(ctx, ac.db, uid)
+			groups, err := dao.GetEffectiveGroups(ctx, ac.db, uid)
 			if err != nil {
 				res.Status = commonv3.RequestStatus_RequestNotAuthenticated
 				res.Reason = "unable to find identity"
@@ -122,6 +176,21 @@ func (ac *authContext) authenticate(ctx context.Context, req *commonv3.IsRequest
 				groupNames = append(groupNames, g.Name)
 			}
 			res.SessionData.Groups = groupNames
+
+			succ, err := ac.checkLockout(ctx, res)
+			if !succ || err != nil {
+				return succ, err
+			}
+			idle, err := ac.ls.IsIdle(ctx, session.GetId(), res.SessionData)
+			if err != nil {
+				return false, err
+			}
+			if idle {
+				res.Status = commonv3.RequestStatus_RequestNotAuthenticated
+				res.Reason = "session terminated due to inactivity"
+				return false, nil
+			}
+			return ac.checkMFA(ctx, req, res, session)
 		} else {
 			res.Status = commonv3.RequestStatus_RequestNotAuthenticated
 			res.Reason = "no active session"
@@ -130,6 +199,85 @@ func (ac *authContext) authenticate(ctx context.Context, req *commonv3.IsRequest
 	return true, nil
 }
 
+// checkLockout denies requests of suspended accounts and of accounts
+// locked out after too many failed logins
+func (ac *authContext) checkLockout(ctx context.Context, res *commonv3.IsRequestAllowedResponse) (bool, error) {
+	aid, err := uuid.Parse(res.SessionData.Account)
+	if err != nil {
+		return false, err
+	}
+	suspended, err := dao.IsAccountSuspended(ctx, ac.db, aid)
+	if err != nil {
+		return false, err
+	}
+	if suspended {
+		res.Status = commonv3.RequestStatus_RequestNotAuthenticated
+		res.Reason = "account suspended"
+		return false, nil
+	}
+	locked, err := ac.ls.IsLocked(ctx, res.SessionData.Account)
+	if err != nil {
+		return false, err
+	}
+	if locked {
+		res.Status = commonv3.RequestStatus_RequestNotAuthenticated
+		res.Reason = "account locked due to too many failed logins"
+		return false, nil
+	}
+	return true, nil
+}
+
+// checkMFA denies requests which do not satisfy the multi-factor
+// authentication policy of the organization, requests authenticated
+// with api keys, device tokens or by service accounts have no session
+// and are denied the actions which require a second factor
+func (ac *authContext) checkMFA(ctx context.Context, req *commonv3.IsRequestAllowedRequest, res *commonv3.IsRequestAllowedResponse, session *kclient.Session) (bool, error) {
+	requirement, err := ac.ms.GetRequirement(ctx, res.SessionData)
+	if err != nil {
+		return false, err
+	}
+	if !requirement.Required {
+		return true, nil
+	}
+
+	if session == nil {
+		if req.Mfa != commonv3.MFARequirement_MFAPolicy {
+			res.Status = commonv3.RequestStatus_RequestNotAuthenticated
+			res.Reason = "multi-factor authentication required; api keys can not be used for this action"
+			return false, nil
+		}
+		return true, nil
+	}
+
+	if !isMFA(session.GetAuthenticatorAssuranceLevel()) {
+		if req.Mfa == commonv3.MFARequirement_MFAPolicy && time.Now().Before(requirement.EnrollBy) {
+			return true, nil
+		}
+		res.Status = commonv3.RequestStatus_RequestNotAuthenticated
+		res.Reason = "multi-factor authentication required"
+		return false, nil
+	}
+
+	if req.Mfa == commonv3.MFARequirement_MFAStepUp {
+		var authenticatedAt time.Time
+		for _, m := range session.GetAuthenticationMethods() {
+			if isMFA(m.GetAal()) && m.GetCompletedAt().After(authenticatedAt) {
+				authenticatedAt = m.GetCompletedAt()
+			}
+		}
+		if time.Since(authenticatedAt) > requirement.StepUpMaxAge {
+			res.Status = commonv3.RequestStatus_RequestNotAuthenticated
+			res.Reason = "recent multi-factor authentication required"
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func isMFA(aal kclient.AuthenticatorAssuranceLevel) bool {
+	return aal == kclient.AUTHENTICATORASSURANCELEVEL_AAL2 || aal == kclient.AUTHENTICATORASSURANCELEVEL_AAL3
+}
+
 // authorize performs authorization of the request
 func (ac *authContext) authorize(ctx context.Context, req *commonv3.IsRequestAllowedRequest, res *commonv3.IsRequestAllowedResponse) error {
 	// user,namespace,project,org,url(perm),method
@@ -145,13 +293,19 @@ func (ac *authContext) authorize(ctx context.Context, req *commonv3.IsRequestAll
 	er := authzv1.EnforceRequest{
 		Params: []string{"u:" + res.SessionData.Username, "*", proj, org, req.Url, req.Method},
 	}
-	authenticated, err := ac.as.Enforce(ctx, &er)
+	authenticated, err := ac.as.EnforceEx(ctx, &er)
 	if err != nil {
 		return err
 	}
 	if !authenticated.Res {
 		res.Status = commonv3.RequestStatus_RequestMethodOrURLNotAllowed
 		res.Reason = "not authorized to perform action"
+		// report the deny rule which blocked the request
+		if p := authenticated.GetMatched(); p.GetEft() == "deny" {
+			res.DeniedBy = fmt.Sprintf("%s, %s, %s, %s, %s", p.GetSub(), p.GetNs(), p.GetProj(), p.GetOrg(), p.GetObj())
+			res.Reason = fmt.Sprintf("not authorized to perform action; denied by role '%s' bound to '%s'", p.GetObj(), p.GetSub())
+			_log.Infow("request denied by policy", "user", res.SessionData.Username, "url", req.Url, "method", req.Method, "policy", res.DeniedBy)
+		}
 		return nil
 	}
 
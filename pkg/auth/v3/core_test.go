@@ -0,0 +1,54 @@
+package authv3
+
+import (
+	"context"
+	"testing"
+
+	kclient "github.com/ory/kratos-client-go"
+	"github.com/paralus/paralus/pkg/service"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+)
+
+type mfaRequirement service.MFARequirement
+
+func (r mfaRequirement) GetRequirement(context.Context, *commonv3.SessionData) (*service.MFARequirement, error) {
+	requirement := service.MFARequirement(r)
+	return &requirement, nil
+}
+
+func ssoSession(aal kclient.AuthenticatorAssuranceLevel) *kclient.Session {
+	session := &kclient.Session{Id: "s-1"}
+	session.SetAuthenticatorAssuranceLevel(aal)
+	session.SetAuthenticationMethods([]kclient.SessionAuthenticationMethod{{Method: kclient.PtrString("oidc"), Aal: &aal}})
+	return session
+}
+
+func TestCheckMFASSOSession(t *testing.T) {
+	tt := []struct {
+		name     string
+		required bool
+		aal      kclient.AuthenticatorAssuranceLevel
+		allowed  bool
+	}{
+		{"identity provider enforces mfa", false, kclient.AUTHENTICATORASSURANCELEVEL_AAL1, true},
+		{"identity provider does not enforce mfa", true, kclient.AUTHENTICATORASSURANCELEVEL_AAL1, false},
+		{"second factor after sso", true, kclient.AUTHENTICATORASSURANCELEVEL_AAL2, true},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ac := &authContext{ms: mfaRequirement{Required: tc.required}}
+			req := &commonv3.IsRequestAllowedRequest{Mfa: commonv3.MFARequirement_MFAPolicy}
+			res := &commonv3.IsRequestAllowedResponse{Status: commonv3.RequestStatus_RequestAllowed, SessionData: &commonv3.SessionData{}}
+			allowed, err := ac.checkMFA(context.Background(), req, res, ssoSession(tc.aal))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if allowed != tc.allowed {
+				t.Errorf("expected allowed %v, got %v; %s", tc.allowed, allowed, res.Reason)
+			}
+			if !allowed && res.Status != commonv3.RequestStatus_RequestNotAuthenticated {
+				t.Errorf("expected denied request not to be authenticated, got %v", res.Status)
+			}
+		})
+	}
+}
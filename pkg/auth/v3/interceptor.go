@@ -2,6 +2,7 @@ package authv3
 
 import (
 	context "context"
+	"crypto/subtle"
 	"strings"
 
 	"github.com/paralus/paralus/pkg/common"
@@ -18,12 +19,57 @@ type hasMetadata interface {
 	GetMetadata() *commonv3.Metadata
 }
 
+// getBearerToken returns the token of an authorization header using
+// the bearer scheme
+func getBearerToken(header string) string {
+	if len(header) > len("Bearer ") && strings.EqualFold(header[:len("Bearer ")], "Bearer ") {
+		return header[len("Bearer "):]
+	}
+	return ""
+}
+
+// checkWebhookToken checks the webhook is called with the shared
+// secret of the identity provider
+func checkWebhookToken(ctx context.Context, secret string) error {
+	if secret == "" {
+		return status.Error(codes.Unauthenticated, "webhook secret is not configured")
+	}
+	var token string
+	if md, ok := metadata.FromIncomingContext(ctx); ok && len(md.Get(gateway.WebhookToken)) != 0 {
+		token = md.Get(gateway.WebhookToken)[0]
+	}
+	if subtle.ConstantTimeCompare([]byte(token), []byte(secret)) != 1 {
+		return status.Error(codes.Unauthenticated, "invalid webhook token")
+	}
+	return nil
+}
+
 func (ac authContext) NewAuthUnaryInterceptor(opt Option) grpc.UnaryServerInterceptor {
+	rl := newRateLimiter(opt, ac.rs)
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		// requests which are not authenticated are limited by the
+		// address of the client, not by any user they name. Failed
+		// authentications have a bucket of their own, so anonymous
+		// requests do not hold up authenticated ones.
+		addr := clientAddress(ctx)
+		client, failedClient := "ip:"+addr, "failed-ip:"+addr
+		if utils.Contains(opt.WebhookRPCMethods, info.FullMethod) {
+			if err := rl.throttle(ctx, info.FullMethod, "", client); err != nil {
+				return nil, err
+			}
+			if err := checkWebhookToken(ctx, opt.WebhookSecret); err != nil {
+				return nil, err
+			}
+			return handler(ctx, req)
+		}
+
 		// TODO: Optimize authentication for a session/gRPC
 		// channel
 		for _, ex := range opt.ExcludeRPCMethods {
 			if ex == info.FullMethod {
+				if err := rl.throttle(ctx, info.FullMethod, "", client); err != nil {
+					return nil, err
+				}
 				return handler(ctx, req)
 			}
 		}
@@ -55,6 +101,13 @@ func (ac authContext) NewAuthUnaryInterceptor(opt Option) grpc.UnaryServerInterc
 
 		noAuthz := utils.Contains(opt.ExcludeAuthzMethods, info.FullMethod)
 
+		mfa := commonv3.MFARequirement_MFAPolicy
+		if utils.Contains(opt.StepUpMFAMethods, info.FullMethod) {
+			mfa = commonv3.MFARequirement_MFAStepUp
+		} else if utils.Contains(opt.RequireMFAMethods, info.FullMethod) {
+			mfa = commonv3.MFARequirement_MFARequired
+		}
+
 		md, ok := metadata.FromIncomingContext(ctx)
 		if !ok {
 			return nil, status.Error(codes.InvalidArgument, "grpc metadata not exist")
@@ -65,6 +118,7 @@ func (ac authContext) NewAuthUnaryInterceptor(opt Option) grpc.UnaryServerInterc
 			token  string
 			apiKey string
 			apiTkn string
+			bearer string
 			cookie string
 			host   string
 			ua     string
@@ -85,6 +139,9 @@ func (ac authContext) NewAuthUnaryInterceptor(opt Option) grpc.UnaryServerInterc
 		if len(md.Get("X-API-TOKEN")) != 0 {
 			apiTkn = md.Get("X-API-TOKEN")[0]
 		}
+		if len(md.Get("authorization")) != 0 {
+			bearer = getBearerToken(md.Get("authorization")[0])
+		}
 		if len(md.Get("grpcgateway-cookie")) != 0 {
 			cookie = md.Get("grpcgateway-cookie")[0]
 		}
@@ -104,12 +161,21 @@ func (ac authContext) NewAuthUnaryInterceptor(opt Option) grpc.UnaryServerInterc
 			XSessionToken: token,
 			XApiKey:       apiKey,
 			XApiToken:     apiTkn,
+			BearerToken:   bearer,
 			Cookie:        cookie,
 			Org:           org,
 			Project:       project,
 			NoAuthz:       noAuthz, // FIXME: any better way to do this?
+			Mfa:           mfa,
+			ClientIp:      ip,
+			ClientUa:      ua,
 		}
 
+		// clients which failed to authenticate too often are rejected
+		// before authenticating them again
+		if err := rl.check(ctx, info.FullMethod, "", failedClient); err != nil {
+			return nil, err
+		}
 		res, err := ac.IsRequestAllowed(ctx, acReq)
 		if err != nil {
 			_log.Errorf("Failed to authenticate a request: %s", err)
@@ -124,11 +190,25 @@ func (ac authContext) NewAuthUnaryInterceptor(opt Option) grpc.UnaryServerInterc
 			sd.ClientIp = ip
 			sd.ClientHost = host
 			sd.ClientUa = ua
+			// requests with an API key are limited by the key, so
+			// scripts do not starve the other sessions of the user
+			principal := "account:" + sd.Account
+			if apiKey != "" {
+				principal = "apikey:" + apiKey
+			}
+			if err := rl.throttle(ctx, info.FullMethod, sd.Organization, principal); err != nil {
+				return nil, err
+			}
 			ctx := context.WithValue(ctx, common.SessionDataKey, sd)
 			return handler(ctx, req)
 		case commonv3.RequestStatus_RequestMethodOrURLNotAllowed:
 			return nil, status.Error(codes.PermissionDenied, res.GetReason())
 		case commonv3.RequestStatus_RequestNotAuthenticated:
+			// failed authentications count against the client so
+			// that credentials can not be guessed at full speed
+			if err := rl.throttle(ctx, info.FullMethod, "", failedClient); err != nil {
+				return nil, err
+			}
 			return nil, status.Error(codes.Unauthenticated, res.GetReason())
 		}
 
@@ -0,0 +1,33 @@
+package authv3
+
+import (
+	"context"
+	"testing"
+
+	"github.com/paralus/paralus/pkg/gateway"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestCheckWebhookToken(t *testing.T) {
+	tt := []struct {
+		name   string
+		secret string
+		token  string
+		code   codes.Code
+	}{
+		{"valid token", "secret", "secret", codes.OK},
+		{"invalid token", "secret", "other", codes.Unauthenticated},
+		{"missing token", "secret", "", codes.Unauthenticated},
+		{"secret not configured", "", "", codes.Unauthenticated},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{gateway.WebhookToken: tc.token}))
+			if code := status.Code(checkWebhookToken(ctx, tc.secret)); code != tc.code {
+				t.Errorf("expected %s, got %s", tc.code, code)
+			}
+		})
+	}
+}
@@ -112,6 +112,7 @@ func serveHTTP(opt Option,
 		XSessionToken: r.Header.Get("X-Session-Token"),
 		XApiKey:       r.Header.Get("X-API-KEYID"),
 		XApiToken:     r.Header.Get("X-API-TOKEN"),
+		BearerToken:   getBearerToken(r.Header.Get("Authorization")),
 		Cookie:        r.Header.Get("Cookie"),
 		Project:       poResp.Project,
 		Org:           poResp.Organization,
@@ -0,0 +1,256 @@
+package authv3
+
+import (
+	context "context"
+	"expvar"
+	"fmt"
+	"math"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/paralus/paralus/pkg/gateway"
+	"github.com/paralus/paralus/pkg/service"
+	"golang.org/x/time/rate"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// RetryAfterHeader is the metadata key of the number of seconds after
+// which a throttled request can be retried
+const RetryAfterHeader = "retry-after"
+
+const (
+	// orgLimitsTTL is how long the rate limits of an organization are
+	// cached before they are looked up again
+	orgLimitsTTL = time.Minute
+	// bucketIdleTTL is how long a bucket which is not used is kept,
+	// buckets idle for longer are full again anyway
+	bucketIdleTTL = 10 * time.Minute
+)
+
+// throttledRequests counts the throttled requests by class of RPC
+// methods, exported at /debug/vars of the debug server
+var throttledRequests = expvar.NewMap("throttled_requests")
+
+// RateLimit is a token bucket which regains Rate requests per second
+// and allows Burst requests at once. A zero Rate does not limit.
+type RateLimit struct {
+	Rate  float64
+	Burst int
+}
+
+type bucketKey struct {
+	class     string
+	principal string
+}
+
+type bucket struct {
+	limiter  *rate.Limiter
+	lastUsed time.Time
+}
+
+type orgLimits struct {
+	limits    map[string]RateLimit
+	fetchedAt time.Time
+}
+
+// rateLimiter throttles requests with a token bucket for each
+// principal and class of RPC methods. Buckets are kept in memory of
+// the replica, so each replica of the API server enforces the limits
+// on its own and the effective limit is the configured limit times the
+// number of replicas.
+type rateLimiter struct {
+	defaults map[string]RateLimit
+	methods  map[string]string
+	rs       service.RateLimitService
+	now      func() time.Time
+
+	mu        sync.Mutex
+	buckets   map[bucketKey]*bucket
+	orgs      map[string]orgLimits
+	lastSweep time.Time
+}
+
+func newRateLimiter(opt Option, rs service.RateLimitService) *rateLimiter {
+	return &rateLimiter{
+		defaults: opt.RateLimits,
+		methods:  opt.RateLimitMethods,
+		rs:       rs,
+		now:      time.Now,
+		buckets:  map[bucketKey]*bucket{},
+		orgs:     map[string]orgLimits{},
+	}
+}
+
+// class returns the class of rate limits of the RPC method
+func (rl *rateLimiter) class(method string) string {
+	if c, ok := rl.methods[method]; ok {
+		return c
+	}
+	return service.RateLimitClassDefault
+}
+
+// limit returns the rate limit of the class in the organization,
+// falling back to the defaults when the organization does not
+// override it
+func (rl *rateLimiter) limit(ctx context.Context, org, class string) RateLimit {
+	if org == "" || rl.rs == nil {
+		return rl.defaults[class]
+	}
+	now := rl.now()
+	rl.mu.Lock()
+	ol, ok := rl.orgs[org]
+	rl.mu.Unlock()
+	if !ok || now.Sub(ol.fetchedAt) > orgLimitsTTL {
+		limits, err := rl.rs.GetOrganizationLimits(ctx, org)
+		if err != nil {
+			// not failing requests because the overrides could
+			// not be looked up
+			_log.Warnw("unable to get rate limits of organization", "organization", org, "error", err)
+			return rl.defaults[class]
+		}
+		ol = orgLimits{limits: map[string]RateLimit{}, fetchedAt: now}
+		for _, l := range limits {
+			ol.limits[l.GetClass()] = RateLimit{Rate: l.GetRequestsPerSecond(), Burst: int(l.GetBurst())}
+		}
+		rl.mu.Lock()
+		rl.orgs[org] = ol
+		rl.mu.Unlock()
+	}
+	if l, ok := ol.limits[class]; ok {
+		return l
+	}
+	return rl.defaults[class]
+}
+
+// allow takes a request of the principal from its bucket for the
+// class of the method. If the bucket is empty it returns how long
+// until the request can be retried.
+func (rl *rateLimiter) allow(ctx context.Context, method, org, principal string) (bool, time.Duration) {
+	return rl.reserve(ctx, method, org, principal, true)
+}
+
+// reserve checks the bucket of the principal for the class of the
+// method, the request is only taken from the bucket if take is set
+func (rl *rateLimiter) reserve(ctx context.Context, method, org, principal string, take bool) (bool, time.Duration) {
+	class := rl.class(method)
+	l := rl.limit(ctx, org, class)
+	if l.Rate <= 0 {
+		return true, 0
+	}
+	if l.Burst < 1 {
+		l.Burst = 1
+	}
+
+	now := rl.now()
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	rl.sweep(now)
+	key := bucketKey{class: class, principal: principal}
+	b, ok := rl.buckets[key]
+	if !ok {
+		b = &bucket{limiter: rate.NewLimiter(rate.Limit(l.Rate), l.Burst)}
+		rl.buckets[key] = b
+	} else if b.limiter.Limit() != rate.Limit(l.Rate) || b.limiter.Burst() != l.Burst {
+		b.limiter.SetLimitAt(now, rate.Limit(l.Rate))
+		b.limiter.SetBurstAt(now, l.Burst)
+	}
+	b.lastUsed = now
+
+	r := b.limiter.ReserveN(now, 1)
+	delay := r.DelayFrom(now)
+	if delay == 0 {
+		if !take {
+			r.CancelAt(now)
+		}
+		return true, 0
+	}
+	r.CancelAt(now)
+	throttledRequests.Add(class, 1)
+	_log.Infow("throttled request", "method", method, "class", class, "principal", principal, "retryAfter", delay)
+	return false, delay
+}
+
+// sweep drops buckets which have not been used for long, caller
+// should hold the lock
+func (rl *rateLimiter) sweep(now time.Time) {
+	if now.Sub(rl.lastSweep) < bucketIdleTTL {
+		return
+	}
+	rl.lastSweep = now
+	for k, b := range rl.buckets {
+		if now.Sub(b.lastUsed) > bucketIdleTTL {
+			delete(rl.buckets, k)
+		}
+	}
+	for o, ol := range rl.orgs {
+		if now.Sub(ol.fetchedAt) > orgLimitsTTL {
+			delete(rl.orgs, o)
+		}
+	}
+}
+
+// throttle returns ResourceExhausted with the time after which the
+// request can be retried if the principal made too many requests
+func (rl *rateLimiter) throttle(ctx context.Context, method, org, principal string) error {
+	ok, retryAfter := rl.allow(ctx, method, org, principal)
+	if ok {
+		return nil
+	}
+	return throttled(ctx, retryAfter)
+}
+
+// check is throttle without taking the request from the bucket, the
+// request is rejected early if it would be throttled once taken
+func (rl *rateLimiter) check(ctx context.Context, method, org, principal string) error {
+	ok, retryAfter := rl.reserve(ctx, method, org, principal, false)
+	if ok {
+		return nil
+	}
+	return throttled(ctx, retryAfter)
+}
+
+func throttled(ctx context.Context, retryAfter time.Duration) error {
+	seconds := fmt.Sprintf("%d", int64(math.Ceil(retryAfter.Seconds())))
+	if err := grpc.SetHeader(ctx, metadata.Pairs(RetryAfterHeader, seconds)); err != nil {
+		_log.Debugw("unable to set retry after header", "error", err)
+	}
+	return status.Errorf(codes.ResourceExhausted, "too many requests, retry after %s seconds", seconds)
+}
+
+// lastValue returns the last value of the metadata key, values set by
+// the gateway come after any a client forwards with the metadata
+// header prefix
+func lastValue(md metadata.MD, key string) string {
+	if vals := md.Get(key); len(vals) != 0 {
+		return vals[len(vals)-1]
+	}
+	return ""
+}
+
+// clientAddress returns the address of the client without the port.
+// Behind a proxy it is the address from the header of the trusted
+// proxy when one is configured at the gateway, else the address the
+// gateway received the request from or the address of the gRPC peer.
+func clientAddress(ctx context.Context) string {
+	var addr string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		addr = lastValue(md, gateway.ClientAddr)
+		if addr == "" {
+			addr = lastValue(md, gateway.RemoteAddr)
+		}
+	}
+	if addr == "" {
+		if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+			addr = p.Addr.String()
+		}
+	}
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+	return addr
+}
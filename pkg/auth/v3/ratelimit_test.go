@@ -0,0 +1,144 @@
+package authv3
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/paralus/paralus/pkg/gateway"
+	"github.com/paralus/paralus/pkg/service"
+	systemv3 "github.com/paralus/paralus/proto/types/systempb/v3"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const getForUser = "/paralus.dev.sentry.rpc.KubeConfigService/GetForUser"
+
+type mockRateLimitService struct {
+	limits map[string][]*systemv3.RateLimit
+	calls  int
+}
+
+func (m *mockRateLimitService) GetOrganizationLimits(ctx context.Context, org string) ([]*systemv3.RateLimit, error) {
+	m.calls++
+	return m.limits[org], nil
+}
+
+func newTestRateLimiter(rs service.RateLimitService, now *time.Time) *rateLimiter {
+	rl := newRateLimiter(Option{
+		RateLimits: map[string]RateLimit{
+			service.RateLimitClassDefault:    {Rate: 10, Burst: 10},
+			service.RateLimitClassKubeconfig: {Rate: 0.5, Burst: 2},
+		},
+		RateLimitMethods: map[string]string{getForUser: service.RateLimitClassKubeconfig},
+	}, rs)
+	rl.now = func() time.Time { return *now }
+	return rl
+}
+
+func TestRateLimiterThrottlesClass(t *testing.T) {
+	now := time.Now()
+	rl := newTestRateLimiter(&mockRateLimitService{}, &now)
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		if ok, _ := rl.allow(ctx, getForUser, "org", "account:a"); !ok {
+			t.Fatalf("request %d within burst was throttled", i)
+		}
+	}
+	ok, retryAfter := rl.allow(ctx, getForUser, "org", "account:a")
+	if ok {
+		t.Fatal("request beyond burst was not throttled")
+	}
+	if retryAfter != 2*time.Second {
+		t.Errorf("expected retry after 2s, got %v", retryAfter)
+	}
+	if ok, _ := rl.allow(ctx, getForUser, "org", "account:b"); !ok {
+		t.Error("request of other account was throttled")
+	}
+	if ok, _ := rl.allow(ctx, "/paralus.dev.rpc.v3.Project/GetProjects", "org", "account:a"); !ok {
+		t.Error("request of other class was throttled")
+	}
+
+	now = now.Add(2 * time.Second)
+	if ok, _ := rl.allow(ctx, getForUser, "org", "account:a"); !ok {
+		t.Error("request after retry after was throttled")
+	}
+}
+
+func TestRateLimiterOrganizationOverride(t *testing.T) {
+	now := time.Now()
+	rs := &mockRateLimitService{limits: map[string][]*systemv3.RateLimit{
+		"unlimited": {{Class: service.RateLimitClassKubeconfig}},
+	}}
+	rl := newTestRateLimiter(rs, &now)
+	ctx := context.Background()
+
+	for i := 0; i < 5; i++ {
+		if ok, _ := rl.allow(ctx, getForUser, "unlimited", "account:a"); !ok {
+			t.Fatalf("request %d of organization without limit was throttled", i)
+		}
+	}
+	if rs.calls != 1 {
+		t.Errorf("expected limits of organization to be looked up once, looked up %d times", rs.calls)
+	}
+}
+
+func TestRateLimiterThrottleStatus(t *testing.T) {
+	now := time.Now()
+	rl := newTestRateLimiter(nil, &now)
+	ctx := context.Background()
+
+	var err error
+	for i := 0; i < 3; i++ {
+		err = rl.throttle(ctx, getForUser, "", "ip:10.0.0.1")
+	}
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("expected resource exhausted, got %v", err)
+	}
+}
+
+func TestRateLimiterCheck(t *testing.T) {
+	now := time.Now()
+	rl := newTestRateLimiter(nil, &now)
+	ctx := context.Background()
+
+	// checks do not take requests from the bucket
+	for i := 0; i < 5; i++ {
+		if err := rl.check(ctx, getForUser, "", "failed-ip:10.0.0.1"); err != nil {
+			t.Fatalf("check %d was throttled: %v", i, err)
+		}
+	}
+	for i := 0; i < 2; i++ {
+		rl.throttle(ctx, getForUser, "", "failed-ip:10.0.0.1")
+	}
+	if err := rl.check(ctx, getForUser, "", "failed-ip:10.0.0.1"); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("check of exhausted bucket should be throttled, got %v", err)
+	}
+}
+
+func TestClientAddress(t *testing.T) {
+	tt := []struct {
+		name string
+		md   metadata.MD
+		addr string
+	}{
+		{"gateway", metadata.Pairs(gateway.RemoteAddr, "10.0.0.1:41234"), "10.0.0.1"},
+		{"trusted proxy", metadata.Pairs(gateway.RemoteAddr, "10.0.0.1:41234", gateway.ClientAddr, "203.0.113.7"), "203.0.113.7"},
+		// values forwarded by the client come before the ones of the gateway
+		{"forwarded by client", metadata.Pairs(gateway.RemoteAddr, "198.51.100.1", gateway.RemoteAddr, "10.0.0.1:41234"), "10.0.0.1"},
+		{"peer", metadata.MD{}, "192.0.2.1"},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("192.0.2.1"), Port: 50051}})
+			ctx = metadata.NewIncomingContext(ctx, tc.md)
+			if addr := clientAddress(ctx); addr != tc.addr {
+				t.Errorf("expected '%v', got '%v'", tc.addr, addr)
+			}
+		})
+	}
+}
@@ -38,14 +38,14 @@ func (e *casbinEnforcer) Init() (*casbin.CachedEnforcer, error) {
 r = sub, ns, proj, org, obj, act
 
 [policy_definition]
-p = sub, ns, proj, org, obj
+p = sub, ns, proj, org, obj, eft
 
 [role_definition]
 g = _, _, _
 g2 = _, _
 
 [policy_effect]
-e = some(where (p.eft == allow))
+e = some(where (p.eft == allow)) && !some(where (p.eft == deny))
 
 [matchers]
 m = g2(r.sub, p.sub) && (globMatch(r.ns, p.ns) || globMatch(p.ns, r.ns)) && (globMatch(r.proj, p.proj) || globMatch(p.proj, r.proj)) && (globMatch(r.org, p.org) || globMatch(p.org, r.org)) && g(r.obj, p.obj, r.act)
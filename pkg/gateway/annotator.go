@@ -3,6 +3,7 @@ package gateway
 import (
 	"context"
 	"net/http"
+	"strings"
 
 	"google.golang.org/grpc/metadata"
 )
@@ -19,6 +20,8 @@ const (
 	UserAgent            = "x-gateway-user-agent"
 	Host                 = "x-gateway-host"
 	RemoteAddr           = "x-gateway-remote-addr"
+	ClientAddr           = "x-gateway-client-addr"
+	WebhookToken         = "X-Webhook-Token"
 )
 
 // paralusGatewayAnnotator adds paralus gateway specific annotations
@@ -33,5 +36,25 @@ var paralusGatewayAnnotator = func(ctx context.Context, r *http.Request) metadat
 		UserAgent:      r.UserAgent(),
 		Host:           r.Host,
 		RemoteAddr:     r.RemoteAddr,
+		WebhookToken:   r.Header.Get(WebhookToken),
 	})
 }
+
+// NewClientAddressAnnotator returns an annotator adding the address of
+// the client from the header a trusted proxy in front of the gateway
+// sets, for X-Forwarded-For the address the proxy appended. It should
+// only be used when all requests reach the gateway through the proxy,
+// else clients can set the header themselves.
+func NewClientAddressAnnotator(header string) func(ctx context.Context, r *http.Request) metadata.MD {
+	return func(ctx context.Context, r *http.Request) metadata.MD {
+		vals := r.Header.Values(header)
+		if len(vals) == 0 {
+			return nil
+		}
+		addr := vals[len(vals)-1]
+		if i := strings.LastIndex(addr, ","); i >= 0 {
+			addr = addr[i+1:]
+		}
+		return metadata.Pairs(ClientAddr, strings.TrimSpace(addr))
+	}
+}
@@ -0,0 +1,26 @@
+package gateway_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/paralus/paralus/pkg/gateway"
+)
+
+func TestClientAddressAnnotator(t *testing.T) {
+	annotate := gateway.NewClientAddressAnnotator("X-Forwarded-For")
+
+	r := httptest.NewRequest("GET", "/auth/v3/users", nil)
+	if md := annotate(context.Background(), r); len(md.Get(gateway.ClientAddr)) != 0 {
+		t.Errorf("request without the header should not have a client address; got %v", md)
+	}
+
+	// the proxy appends the address it received the request from
+	r.Header.Add("X-Forwarded-For", "198.51.100.1")
+	r.Header.Add("X-Forwarded-For", "198.51.100.2, 203.0.113.7")
+	md := annotate(context.Background(), r)
+	if addr := md.Get(gateway.ClientAddr); len(addr) != 1 || addr[0] != "203.0.113.7" {
+		t.Errorf("expected client address '203.0.113.7', got %v", addr)
+	}
+}
@@ -3,7 +3,9 @@ package gateway
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
+	"strings"
 
 	"google.golang.org/grpc"
 
@@ -16,6 +18,20 @@ var ErrNoHandlers = errors.New("no handlers defined")
 // HandlerFromEndpoint defines the function for registering grpc gateway handlers to grpc endpoint
 type HandlerFromEndpoint func(ctx context.Context, mux *runtime.ServeMux, endpoint string, opts []grpc.DialOption) (err error)
 
+// retryAfterHeader is forwarded as the Retry-After HTTP header of
+// throttled requests
+const retryAfterHeader = "retry-after"
+
+// paralusOutgoingHeaderMatcher forwards the retry after metadata as
+// the standard HTTP header and any other metadata with the default
+// grpc gateway prefix
+func paralusOutgoingHeaderMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, retryAfterHeader) {
+		return "Retry-After", true
+	}
+	return fmt.Sprintf("%s%s", runtime.MetadataHeaderPrefix, key), true
+}
+
 // NewGateway returns new grpc gateway
 func NewGateway(ctx context.Context, endpoint string, serveMuxOptions []runtime.ServeMuxOption, handlers ...HandlerFromEndpoint) (http.Handler, error) {
 
@@ -27,6 +43,7 @@ func NewGateway(ctx context.Context, endpoint string, serveMuxOptions []runtime.
 		runtime.WithMarshalerOption(jsonContentType, paralusJSON),
 		runtime.WithMarshalerOption(yamlContentType, paralusYAML),
 		runtime.WithMetadata(paralusGatewayAnnotator),
+		runtime.WithOutgoingHeaderMatcher(paralusOutgoingHeaderMatcher),
 	)
 
 	mux := runtime.NewServeMux(serveMuxOptions...)
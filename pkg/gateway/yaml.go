@@ -1,11 +1,15 @@
 package gateway
 
 import (
+	"bufio"
+	"bytes"
 	"io"
 	"io/ioutil"
+	"reflect"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/segmentio/encoding/json"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
 	"sigs.k8s.io/yaml"
 )
 
@@ -38,9 +42,18 @@ func (m *paralusYAML) Marshal(v interface{}) ([]byte, error) {
 }
 
 // Unmarshal unmarshals "data" into "v".
-// "v" must be a pointer value.
+// "v" must be a pointer value. When "v" points to a slice, the
+// documents of a multi-document YAML are its elements.
 func (m *paralusYAML) Unmarshal(yb []byte, v interface{}) error {
-	jb, err := yaml.YAMLToJSON(yb)
+	var (
+		jb  []byte
+		err error
+	)
+	if rv := reflect.ValueOf(v); rv.Kind() == reflect.Ptr && rv.Elem().Kind() == reflect.Slice {
+		jb, err = yamlDocumentsToJSON(yb)
+	} else {
+		jb, err = yaml.YAMLToJSON(yb)
+	}
 	if err != nil {
 		return err
 	}
@@ -48,6 +61,35 @@ func (m *paralusYAML) Unmarshal(yb []byte, v interface{}) error {
 	return err
 }
 
+// yamlDocumentsToJSON converts a multi-document YAML to a JSON list of
+// its documents, a single document which is a list is converted as is
+func yamlDocumentsToJSON(yb []byte) ([]byte, error) {
+	var docs [][]byte
+	r := utilyaml.NewYAMLReader(bufio.NewReader(bytes.NewReader(yb)))
+	for {
+		doc, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		jb, err := yaml.YAMLToJSON(doc)
+		if err != nil {
+			return nil, err
+		}
+		// documents with only comments or separators are null
+		if len(jb) == 0 || bytes.Equal(jb, []byte("null")) {
+			continue
+		}
+		docs = append(docs, jb)
+	}
+	if len(docs) == 1 && docs[0][0] == '[' {
+		return docs[0], nil
+	}
+	return append(append([]byte("["), bytes.Join(docs, []byte(","))...), ']'), nil
+}
+
 // NewDecoder returns a Decoder which reads byte sequence from "r".
 func (m *paralusYAML) NewDecoder(r io.Reader) runtime.Decoder {
 	return runtime.DecoderFunc(func(v interface{}) error {
@@ -60,3 +60,35 @@ func TestYamlMarshaller(t *testing.T) {
 	t.Log(bb2.String())
 
 }
+
+func TestYamlUnmarshalDocuments(t *testing.T) {
+	m := gateway.NewParalusYAML()
+
+	tt := []struct {
+		name  string
+		yaml  string
+		names []string
+	}{
+		{"single document", "name: c1\n", []string{"c1"}},
+		{"multiple documents", "name: c1\n---\nname: c2\n---\n# comment\n", []string{"c1", "c2"}},
+		{"list", "- name: c1\n- name: c2\n", []string{"c1", "c2"}},
+		{"json list", `[{"name": "c1"}, {"name": "c2"}]`, []string{"c1", "c2"}},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			var objs []*testdata.TestYAML
+			if err := m.Unmarshal([]byte(tc.yaml), &objs); err != nil {
+				t.Fatal(err)
+			}
+			if len(objs) != len(tc.names) {
+				t.Fatalf("expected %d objects, got %d", len(tc.names), len(objs))
+			}
+			for i, o := range objs {
+				if o.Name != tc.names[i] {
+					t.Errorf("expected %s, got %s", tc.names[i], o.Name)
+				}
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/tls"
 	"crypto/x509"
+	"encoding/pem"
 	"errors"
 	"fmt"
 	"io/ioutil"
@@ -13,8 +14,10 @@ import (
 	"google.golang.org/grpc/peer"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/status"
 )
 
 var (
@@ -111,3 +114,97 @@ func GetClientOU(ctx context.Context) (string, error) {
 	}
 	return "", ErrInvalidClient
 }
+
+// ParseCertificates returns the certificates of the PEM encoded bundle
+func ParseCertificates(bundle []byte) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	for {
+		var block *pem.Block
+		block, bundle = pem.Decode(bundle)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, cert)
+	}
+	if len(certs) == 0 {
+		return nil, fmt.Errorf("no certificates found")
+	}
+	return certs, nil
+}
+
+// ClientVerifiedBy returns true if the client cert was verified by one
+// of the CA certs
+func ClientVerifiedBy(ctx context.Context, cas []*x509.Certificate) bool {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return false
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok {
+		return false
+	}
+	for _, chain := range tlsInfo.State.VerifiedChains {
+		if len(chain) == 0 {
+			continue
+		}
+		root := chain[len(chain)-1]
+		for _, ca := range cas {
+			if root.Equal(ca) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// NewClientCAInterceptors returns interceptors for servers trusting
+// several CAs. Methods with a prefix in methodCAs can only be called
+// by clients verified by its CA, other methods only by clients
+// verified by the default CA.
+func NewClientCAInterceptors(defaultCA []byte, methodCAs map[string][]byte) (grpc.UnaryServerInterceptor, grpc.StreamServerInterceptor, error) {
+	defaultCerts, err := ParseCertificates(defaultCA)
+	if err != nil {
+		return nil, nil, err
+	}
+	methodCerts := make(map[string][]*x509.Certificate, len(methodCAs))
+	for prefix, ca := range methodCAs {
+		certs, err := ParseCertificates(ca)
+		if err != nil {
+			return nil, nil, err
+		}
+		methodCerts[prefix] = certs
+	}
+	authorize := func(ctx context.Context, method string) error {
+		trusted := defaultCerts
+		for prefix, certs := range methodCerts {
+			if strings.HasPrefix(method, prefix) {
+				trusted = certs
+				break
+			}
+		}
+		if !ClientVerifiedBy(ctx, trusted) {
+			return status.Error(codes.PermissionDenied, "client is not allowed to call the method")
+		}
+		return nil
+	}
+	unary := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := authorize(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+	stream := func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := authorize(ss.Context(), info.FullMethod); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+	return unary, stream, nil
+}
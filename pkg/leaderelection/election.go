@@ -12,7 +12,10 @@ var (
 	_log = log.GetLogger()
 )
 
-// Run runs leader election and calls onStarted when runner becomes leader
+// Run runs leader election and calls onStarted when runner becomes leader,
+// the stop channel passed to onStarted is closed when runner stops leading
+// or stop is closed. Runner takes part in the election again after it
+// stops leading until stop is closed.
 func Run(lock rl.Interface, onStarted func(stop <-chan struct{}), stop <-chan struct{}) error {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -24,9 +27,9 @@ func Run(lock rl.Interface, onStarted func(stop <-chan struct{}), stop <-chan st
 		RenewDeadline:   RenewDeadline,
 		RetryPeriod:     RetryPeriod,
 		Callbacks: le.LeaderCallbacks{
-			OnStartedLeading: func(_ context.Context) {
+			OnStartedLeading: func(ctx context.Context) {
 				_log.Infow("started leading", "for", lock.Describe(), "id", lock.Identity())
-				onStarted(stop)
+				onStarted(leading(ctx, stop))
 			},
 			OnStoppedLeading: func() {
 				_log.Infow("stopped leading", "for", lock.Describe(), "id", lock.Identity())
@@ -41,7 +44,12 @@ func Run(lock rl.Interface, onStarted func(stop <-chan struct{}), stop <-chan st
 		return err
 	}
 
-	go elector.Run(ctx)
+	go func() {
+		// elector returns when it stops leading
+		for ctx.Err() == nil {
+			elector.Run(ctx)
+		}
+	}()
 	_log.Infow("started leader election", "for", lock.Describe(), "id", lock.Identity())
 
 	<-stop
@@ -49,3 +57,17 @@ func Run(lock rl.Interface, onStarted func(stop <-chan struct{}), stop <-chan st
 	return nil
 
 }
+
+// leading returns a channel closed when ctx of the leader is done or stop
+// is closed
+func leading(ctx context.Context, stop <-chan struct{}) <-chan struct{} {
+	ch := make(chan struct{})
+	go func() {
+		defer close(ch)
+		select {
+		case <-ctx.Done():
+		case <-stop:
+		}
+	}()
+	return ch
+}
@@ -1,6 +1,7 @@
 package leaderelection
 
 import (
+	"context"
 	"fmt"
 	"testing"
 	"time"
@@ -112,3 +113,28 @@ func TestLeaderElectionConfigMapRun(t *testing.T) {
 	close(stop1)
 
 }
+
+func TestLeading(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	stop := make(chan struct{})
+	ch := leading(ctx, stop)
+	select {
+	case <-ch:
+		t.Fatal("should be leading")
+	case <-time.After(10 * time.Millisecond):
+	}
+	cancel()
+	select {
+	case <-ch:
+	case <-time.After(time.Second):
+		t.Fatal("should stop leading when leadership is lost")
+	}
+
+	ch = leading(context.Background(), stop)
+	close(stop)
+	select {
+	case <-ch:
+	case <-time.After(time.Second):
+		t.Fatal("should stop leading when stopped")
+	}
+}
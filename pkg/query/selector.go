@@ -104,3 +104,13 @@ func FilterLabels(q *bun.SelectQuery, opts *commonv3.QueryOptions) (*bun.SelectQ
 
 	return q, nil
 }
+
+// MatchLabels checks if the labels match the selector, it has the same
+// semantics as the selector of a query
+func MatchLabels(selector string, lbls map[string]string) (bool, error) {
+	sel, err := labels.Parse(selector)
+	if err != nil {
+		return false, err
+	}
+	return sel.Matches(labels.Set(lbls)), nil
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	clstrconstants "github.com/paralus/paralus/internal/cluster/constants"
 	"github.com/paralus/paralus/internal/constants"
 	"github.com/paralus/paralus/pkg/controller/runtime"
 	"github.com/paralus/paralus/pkg/log"
@@ -17,6 +18,7 @@ import (
 	sentryrpc "github.com/paralus/paralus/proto/rpc/sentry"
 	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
 	"github.com/paralus/paralus/proto/types/controller"
+	infrav3 "github.com/paralus/paralus/proto/types/infrapb/v3"
 	"github.com/paralus/paralus/proto/types/sentry"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
@@ -56,6 +58,102 @@ type roleBindExclusionList struct {
 	namespace string
 }
 
+// kubectlObjects are the roles and bindings of the service account of a
+// kubectl user and the highest permission granted to it
+type kubectlObjects struct {
+	sa              *corev1.ServiceAccount
+	crMap           map[string]*rbacv1.ClusterRole
+	crbMap          map[string]*rbacv1.ClusterRoleBinding
+	rMap            map[string]*rbacv1.Role
+	rbMap           map[string]*rbacv1.RoleBinding
+	nsMap           map[string]*corev1.Namespace
+	crbExclusionMap map[string]bool
+	rbExclusionMap  map[string]*roleBindExclusionList
+	rolePrevilage   int
+	highestRole     string
+}
+
+// addPermissions adds the roles and bindings of the permissions of the
+// user in the organization, keyed by the empty project, and in its
+// projects. userNamespaces returns the namespaces the user is assigned
+// in a project.
+func (o *kubectlObjects) addPermissions(projectPermissions map[string][]string, userNamespaces func(project string) []string, ownerProject string, namespacesByProject map[string][]string) error {
+	for project, permissions := range projectPermissions {
+		_log.Infow("authorization", "project", project, "user", o.sa.Name, "permissions", permissions)
+
+		// org scope, the order of the projects is random so the
+		// projects are still to be added after it
+		if project == "" {
+			for _, permission := range permissions {
+				cr, err := getClusterRole(permission)
+				if err != nil {
+					return err
+				}
+
+				o.setHighestRole(permission)
+
+				crb := getClusterRoleBinding(o.sa, cr.Name)
+				o.crMap[cr.Name] = cr
+				o.crbMap[crb.Name] = crb
+				o.crbExclusionMap[crb.Name] = false
+			}
+			continue
+		}
+		namespaces := userNamespaces(project)
+		for _, permission := range permissions {
+
+			// projects the cluster is shared with only get access
+			// to their namespaces
+			permissionNamespaces := namespaces
+			if ownerProject != "" && project != ownerProject && isClusterScopePermission(permission) {
+				permission = getSharedProjectPermission(permission)
+				permissionNamespaces = namespacesByProject[project]
+			}
+
+			o.setHighestRole(permission)
+
+			if isClusterScopePermission(permission) {
+				cr, err := getClusterRole(permission)
+				if err != nil {
+					return err
+				}
+				crb := getClusterRoleBinding(o.sa, cr.Name)
+				o.crMap[cr.Name] = cr
+				o.crbMap[crb.Name] = crb
+				o.crbExclusionMap[crb.Name] = false
+			} else if isNamespaceScopePermission(permission) {
+				for _, namespace := range permissionNamespaces {
+					ns, err := GetNamespace()
+					if err != nil {
+						return err
+					}
+					ns.Name = namespace
+					o.nsMap[namespace] = ns
+
+					r, err := getRole(permission)
+					if err != nil {
+						return err
+					}
+					setRoleValues(r, namespace, permission)
+					rb := getRoleBinding(o.sa, r.Name, namespace)
+					o.rMap[r.Name] = r
+					o.rbMap[rb.Name] = rb
+					o.rbExclusionMap[rb.Name] = &roleBindExclusionList{false, namespace}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (o *kubectlObjects) setHighestRole(permission string) {
+	rp := sentry.GetKubeConfigPermissionPrivilege(permission)
+	if rp > o.rolePrevilage {
+		o.rolePrevilage = rp
+		o.highestRole = permission
+	}
+}
+
 func getCurrentEpoch() string {
 	return strconv.FormatInt(time.Now().Unix(), 10)
 }
@@ -273,10 +371,150 @@ func getDeleteRoleBinding(name, namespace string) *rbacv1.RoleBinding {
 	return rb
 }
 
+// getKubectlProjects returns the projects of the organization in which
+// the account has kubectl permissions
+func getKubectlProjects(ctx context.Context, accountID, orgID, partnerID string, isSSO bool, aps service.AccountPermissionService, gps service.GroupPermissionService) ([]string, error) {
+	var groups []string
+	if isSSO {
+		var err error
+		groups, err = aps.GetAccountGroups(ctx, accountID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	projects := make([]string, 0)
+	for _, permission := range permissions {
+		if !isSSO {
+			accountPermissions, err := aps.GetAccountProjectsByPermission(ctx, accountID, orgID, partnerID, permission)
+			if err != nil {
+				return nil, err
+			}
+			for i := range accountPermissions {
+				projects = append(projects, accountPermissions[i].ProjectID)
+			}
+		} else if len(groups) > 0 {
+			groupPermissions, err := gps.GetGroupProjectsByPermission(ctx, groups, orgID, partnerID, permission)
+			if err != nil {
+				return nil, err
+			}
+			for i := range groupPermissions {
+				projects = append(projects, groupPermissions[i].ProjectID)
+			}
+		}
+	}
+	return projects, nil
+}
+
+// issuedBeforeMove returns whether the kubeconfig was issued before the
+// cluster was moved to another project, its contexts were authorized in
+// the projects the cluster was moved from
+func issuedBeforeMove(labels map[string]string, certIssueSeconds int64) bool {
+	movedAt, err := strconv.ParseInt(labels[clstrconstants.ClusterMovedAtKey], 10, 64)
+	if err != nil {
+		return false
+	}
+	return movedAt >= certIssueSeconds
+}
+
+// matchClusterSelectorPermissions returns the permissions granted by
+// the role bindings whose cluster selector matches the labels of the
+// cluster. Bindings of a project only apply if the cluster is in the
+// project, bindings of the organization only grant cluster scoped
+// permissions.
+func matchClusterSelectorPermissions(csps []sentry.AccountPermission, labels map[string]string, projects []string) map[string][]string {
+	inProjects := make(map[string]bool, len(projects))
+	for _, project := range projects {
+		inProjects[project] = true
+	}
+
+	projectPermissions := make(map[string][]string)
+	for i := range csps {
+		p := csps[i].ProjectID
+		if p != "" && !inProjects[p] {
+			continue
+		}
+		if p == "" && !isClusterScopePermission(csps[i].PermissionName) {
+			continue
+		}
+		ok, err := query.MatchLabels(csps[i].ClusterSelector, labels)
+		if err != nil {
+			_log.Infow("invalid cluster selector of role binding", "selector", csps[i].ClusterSelector, "error", err.Error())
+			continue
+		}
+		if !ok {
+			continue
+		}
+		projectPermissions[p] = addPermission(projectPermissions[p], csps[i].PermissionName)
+	}
+	return projectPermissions
+}
+
+// getClusterSelectorPermissions returns the permissions granted to the
+// account in the cluster by role bindings scoped by cluster selectors
+func getClusterSelectorPermissions(ctx context.Context, clusterID string, projects []string, accountID, orgID, partnerID string, aps service.AccountPermissionService, cs service.ClusterService) (map[string][]string, error) {
+	csps, err := aps.GetAccountClusterSelectorPermissions(ctx, accountID, orgID, partnerID, permissions)
+	if err != nil {
+		return nil, err
+	}
+	if len(csps) == 0 || len(projects) == 0 {
+		return nil, nil
+	}
+
+	cluster, err := cs.Get(ctx, query.WithOptions(&commonv3.QueryOptions{
+		ClusterID:    clusterID,
+		Project:      projects[0],
+		Organization: orgID,
+		Partner:      partnerID,
+	}))
+	if err != nil {
+		return nil, err
+	}
+	return matchClusterSelectorPermissions(csps, cluster.GetMetadata().GetLabels(), projects), nil
+}
+
+// addPermission adds the permission if it is missing from the permissions
+func addPermission(permissions []string, permission string) []string {
+	for _, p := range permissions {
+		if p == permission {
+			return permissions
+		}
+	}
+	return append(permissions, permission)
+}
+
+// addProjects adds the projects missing from the projects, the org
+// scope is not a project
+func addProjects(projects []string, add []string) []string {
+	set := make(map[string]bool, len(projects))
+	for _, project := range projects {
+		set[project] = true
+	}
+	for _, project := range add {
+		if project == "" || set[project] {
+			continue
+		}
+		set[project] = true
+		projects = append(projects, project)
+	}
+	return projects
+}
+
+// getSharedProjectPermission returns the namespace scoped permission
+// granted in the namespaces of a project the cluster is shared with in
+// place of a cluster scoped permission, only the project owning the
+// cluster gets access to cluster scoped resources
+func getSharedProjectPermission(permission string) string {
+	if sentry.GetKubeConfigPermissionIsRead(permission) {
+		return sentry.KubectlNamespaceReadPermission
+	}
+	return sentry.KubectlNamespaceWritePermission
+}
+
 func getProjectsFromLabels(labels map[string]string) ([]string, error) {
 	projects := make([]string, 0)
 	for key := range labels {
-		if !strings.HasPrefix(key, "project/") {
+		if !strings.HasPrefix(key, clstrconstants.ClusterProjectPrefix) {
 			continue
 		}
 		s := strings.Split(key, "/")
@@ -314,10 +552,8 @@ func getProjectsFromLabels(labels map[string]string) ([]string, error) {
 // ENV_READ
 //   - NO Access to cluster scoped resources
 //   - Read Access to namespace scoped resources (only within the environment)
-func GetAuthorization(ctx context.Context, req *sentryrpc.GetUserAuthorizationRequest, bs service.BootstrapService, aps service.AccountPermissionService, gps service.GroupPermissionService, krs service.KubeconfigRevocationService, kcs service.KubectlClusterSettingsService, kss service.KubeconfigSettingService, ns service.NamespaceService) (resp *sentryrpc.GetUserAuthorizationResponse, err error) {
+func GetAuthorization(ctx context.Context, req *sentryrpc.GetUserAuthorizationRequest, bs service.BootstrapService, aps service.AccountPermissionService, gps service.GroupPermissionService, krs service.KubeconfigRevocationService, kcs service.KubectlClusterSettingsService, kss service.KubeconfigSettingService, ns service.NamespaceService, cs service.ClusterService) (resp *sentryrpc.GetUserAuthorizationResponse, err error) {
 	var userName string
-	var groups []string
-	var rolePrevilage int
 	var highestRole string
 	var enforceOrgAdminOnlySecretAccess, isOrgAdmin bool
 	const defaultSaValiditySeconds = 28800
@@ -348,6 +584,16 @@ func GetAuthorization(ctx context.Context, req *sentryrpc.GetUserAuthorizationRe
 		return getSystemUserAuthz(cnAttr, fmtSaValidityDuration)
 	}
 
+	// suspended users keep their kubeconfigs but can not use them, not
+	// even admins
+	suspended, err := aps.IsSuspended(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+	if suspended {
+		return nil, fmt.Errorf("kubeconfig user suspended")
+	}
+
 	isOrgAdmin, _ = aps.IsOrgAdmin(ctx, accountID, partnerID)
 
 	// Check user is partner / super admin to bypass cluster/user checks.
@@ -402,7 +648,14 @@ func GetAuthorization(ctx context.Context, req *sentryrpc.GetUserAuthorizationRe
 			}
 		}
 
-		if errUser == nil && ks != nil && ks.EnableSessionCheck {
+		// service accounts do not login to the portal and are not
+		// members of the default group
+		isServiceAccount, err := aps.IsServiceAccount(ctx, accountID)
+		if err != nil {
+			return nil, err
+		}
+
+		if errUser == nil && ks != nil && ks.EnableSessionCheck && !isServiceAccount {
 			// check the last login timestamp
 			var lastLogin time.Time
 			accountData, err := aps.GetAccount(ctx, accountID)
@@ -418,7 +671,7 @@ func GetAuthorization(ctx context.Context, req *sentryrpc.GetUserAuthorizationRe
 		}
 
 		// is local user active
-		if ok, _ := aps.IsSSOAccount(ctx, accountID); !ok {
+		if ok, _ := aps.IsSSOAccount(ctx, accountID); !ok && !isServiceAccount {
 			active, err := aps.IsAccountActive(ctx, accountID, orgID)
 			_log.Infow("accountID ", accountID, "orgID ", orgID, "active ", fmt.Sprint(active))
 			if err != nil {
@@ -456,6 +709,9 @@ func GetAuthorization(ctx context.Context, req *sentryrpc.GetUserAuthorizationRe
 	}
 	ba := bal.Items[0]
 	labels := ba.Metadata.GetLabels()
+	if issuedBeforeMove(labels, req.CertIssueSeconds) {
+		return nil, fmt.Errorf("kubeconfig issued before cluster was moved to another project, download a new kubeconfig")
+	}
 
 	// get projects
 	projects, err := getProjectsFromLabels(labels)
@@ -463,18 +719,41 @@ func GetAuthorization(ctx context.Context, req *sentryrpc.GetUserAuthorizationRe
 		_log.Errorw("error getting projects from bootstrap agents labels", "labels", labels, "error", err.Error())
 		return nil, err
 	}
+	// clusters shared with all projects are shared with the projects
+	// created after they were shared
+	if labels[clstrconstants.ClusterShareModeKey] == infrav3.ClusterShareMode_ALL.String() {
+		kubectlProjects, err := getKubectlProjects(ctx, accountID, orgID, partnerID, cnAttr.IsSSO, aps, gps)
+		if err != nil {
+			_log.Errorw("error getting kubectl projects", "userCN", req.UserCN, "error", err.Error())
+			return nil, err
+		}
+		projects = addProjects(projects, kubectlProjects)
+	}
+	// clusters without an owner label were not shared with projects
+	// through the API, all their projects are treated as owners
+	ownerProject := labels[clstrconstants.ClusterOwnerProjectKey]
 
 	// get permissions in the cluster's projects
 	var projectPermissions map[string][]string
 	if !cnAttr.IsSSO {
 		projectPermissions, userName, err = getProjectPermissions(ctx, projects, accountID, orgID, partnerID, aps)
 	} else {
-		projectPermissions, userName, groups, err = getSSOProjectPermissions(ctx, projects, orgID, partnerID, accountID, aps, gps)
+		projectPermissions, userName, _, err = getSSOProjectPermissions(ctx, projects, orgID, partnerID, accountID, aps, gps)
 	}
 	if err != nil {
 		_log.Errorw("error getting project permission", "projects", projects, "userCN", req.UserCN, "error", err.Error())
 		return nil, err
 	}
+	selectorPermissions, err := getClusterSelectorPermissions(ctx, req.ClusterID, projects, accountID, orgID, partnerID, aps, cs)
+	if err != nil {
+		_log.Errorw("error getting cluster selector permission", "clusterID", req.ClusterID, "userCN", req.UserCN, "error", err.Error())
+		return nil, err
+	}
+	for project, pms := range selectorPermissions {
+		for _, permission := range pms {
+			projectPermissions[project] = addPermission(projectPermissions[project], permission)
+		}
+	}
 
 	// get sa, clusterroles, roles, bindings
 	sa := &corev1.ServiceAccount{}
@@ -492,6 +771,7 @@ func GetAuthorization(ctx context.Context, req *sentryrpc.GetUserAuthorizationRe
 	rbExclusionMap := make(map[string]*roleBindExclusionList)
 
 	// Get all namespaces
+	namespacesByProject := make(map[string][]string)
 	projectNamespaces, err := func() ([]string, error) {
 		nsl := make([]string, 0)
 
@@ -504,6 +784,7 @@ func GetAuthorization(ctx context.Context, req *sentryrpc.GetUserAuthorizationRe
 			if err == nil {
 				_log.Debugw("Get namespaces ", "project", project, "namespaces", namespaces, "itemslen", len(namespaces))
 				nsl = append(nsl, namespaces...)
+				namespacesByProject[project] = namespaces
 			}
 		}
 		return nsl, nil
@@ -535,12 +816,20 @@ func GetAuthorization(ctx context.Context, req *sentryrpc.GetUserAuthorizationRe
 		}
 	}
 
-	rolePrevilage = -1
-	for project, permissions := range projectPermissions {
+	objects := &kubectlObjects{
+		sa:              sa,
+		crMap:           crMap,
+		crbMap:          crbMap,
+		rMap:            rMap,
+		rbMap:           rbMap,
+		nsMap:           nsMap,
+		crbExclusionMap: crbExclusionMap,
+		rbExclusionMap:  rbExclusionMap,
+		rolePrevilage:   -1,
+	}
+	// need to get the namesapces assigned to this user.
+	userNamespaces := func(project string) []string {
 		var namespaces []string
-		_log.Infow("authorization", "project", project, "user", sa.Name, "permissions", permissions)
-		groups = append(groups, permissions...)
-		// need to get the namesapces assigned to this user.
 		ns1, _ := getAccountProjectNamespace(ctx, project, accountID, ns)
 		ns2, _ := getGroupAccountProjectNamespace(ctx, project, accountID, ns)
 		if len(ns1) > 0 {
@@ -550,68 +839,13 @@ func GetAuthorization(ctx context.Context, req *sentryrpc.GetUserAuthorizationRe
 			namespaces = append(namespaces, ns2...)
 		}
 		_log.Infow("namespaces", "project", project, "accountID", accountID, "namespaces", namespaces)
-
-		// org scope
-		if project == "" {
-			for _, permission := range permissions {
-				cr, err := getClusterRole(permission)
-				if err != nil {
-					return nil, err
-				}
-
-				rp := sentry.GetKubeConfigPermissionPrivilege(permission)
-				if rp > rolePrevilage {
-					rolePrevilage = rp
-					highestRole = permission
-				}
-
-				crb := getClusterRoleBinding(sa, cr.Name)
-				crMap[cr.Name] = cr
-				crbMap[crb.Name] = crb
-				crbExclusionMap[crb.Name] = false
-			}
-			break
-		}
-		for _, permission := range permissions {
-
-			rp := sentry.GetKubeConfigPermissionPrivilege(permission)
-			if rp > rolePrevilage {
-				rolePrevilage = rp
-				highestRole = permission
-			}
-
-			if isClusterScopePermission(permission) {
-				cr, err := getClusterRole(permission)
-				if err != nil {
-					return nil, err
-				}
-				crb := getClusterRoleBinding(sa, cr.Name)
-				crMap[cr.Name] = cr
-				crbMap[crb.Name] = crb
-				crbExclusionMap[crb.Name] = false
-			} else if isNamespaceScopePermission(permission) {
-				for _, namespace := range namespaces {
-					ns, err := GetNamespace()
-					if err != nil {
-						return nil, err
-					}
-					ns.Name = namespace
-					nsMap[namespace] = ns
-
-					r, err := getRole(permission)
-					if err != nil {
-						return nil, err
-					}
-					setRoleValues(r, namespace, permission)
-					rb := getRoleBinding(sa, r.Name, namespace)
-					rMap[r.Name] = r
-					rbMap[rb.Name] = rb
-					rbExclusionMap[rb.Name] = &roleBindExclusionList{false, namespace}
-				}
-			}
-		}
-
+		return namespaces
+	}
+	err = objects.addPermissions(projectPermissions, userNamespaces, ownerProject, namespacesByProject)
+	if err != nil {
+		return nil, err
 	}
+	highestRole = objects.highestRole
 
 	// add authz labels
 	authzLabels := getAuthzLabels(cnAttr.Username, fmtSaValidityDuration)
@@ -0,0 +1,120 @@
+package authz
+
+import (
+	"testing"
+
+	"github.com/paralus/paralus/proto/types/sentry"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+func TestGetSharedProjectPermission(t *testing.T) {
+	tt := []struct {
+		permission string
+		expected   string
+	}{
+		{sentry.KubectlClusterReadPermission, sentry.KubectlNamespaceReadPermission},
+		{sentry.KubectlClusterWritePermission, sentry.KubectlNamespaceWritePermission},
+		{sentry.KubectlFullAccessPermission, sentry.KubectlNamespaceWritePermission},
+	}
+	for _, tc := range tt {
+		if p := getSharedProjectPermission(tc.permission); p != tc.expected {
+			t.Errorf("expected %s in shared project for %s, got %s", tc.expected, tc.permission, p)
+		}
+	}
+}
+
+func TestAddProjects(t *testing.T) {
+	projects := addProjects([]string{"p1", "p2"}, []string{"p2", "", "p3", "p3"})
+	if len(projects) != 3 || projects[2] != "p3" {
+		t.Errorf("expected projects p1, p2 and p3, got %v", projects)
+	}
+}
+
+func TestIssuedBeforeMove(t *testing.T) {
+	labels := map[string]string{"paralus.dev/movedAt": "1700000000"}
+	if !issuedBeforeMove(labels, 1600000000) {
+		t.Error("expected kubeconfig issued before the move to be rejected")
+	}
+	if issuedBeforeMove(labels, 1800000000) {
+		t.Error("expected kubeconfig issued after the move to be authorized")
+	}
+	if issuedBeforeMove(map[string]string{}, 1600000000) {
+		t.Error("expected kubeconfig for cluster which was not moved to be authorized")
+	}
+}
+
+func TestMatchClusterSelectorPermissions(t *testing.T) {
+	csps := []sentry.AccountPermission{
+		{ProjectID: "p1", ClusterSelector: "env=prod", PermissionName: sentry.KubectlClusterReadPermission},
+		{ProjectID: "p1", ClusterSelector: "env in (prod, stage)", PermissionName: sentry.KubectlClusterReadPermission},
+		{ProjectID: "p2", ClusterSelector: "env=prod", PermissionName: sentry.KubectlClusterWritePermission},
+		{ProjectID: "p1", ClusterSelector: "env=dev", PermissionName: sentry.KubectlClusterWritePermission},
+		{ProjectID: "", ClusterSelector: "region=eu", PermissionName: sentry.KubectlClusterReadPermission},
+		{ProjectID: "", ClusterSelector: "region=eu", PermissionName: sentry.KubectlNamespaceWritePermission},
+		{ProjectID: "", ClusterSelector: "region in (eu", PermissionName: sentry.KubectlFullAccessPermission},
+	}
+	labels := map[string]string{"env": "prod", "region": "eu"}
+
+	pp := matchClusterSelectorPermissions(csps, labels, []string{"p1"})
+	if len(pp) != 2 {
+		t.Fatalf("expected permissions in project p1 and organization, got %v", pp)
+	}
+	if len(pp["p1"]) != 1 || pp["p1"][0] != sentry.KubectlClusterReadPermission {
+		t.Errorf("expected only cluster read in project p1, got %v", pp["p1"])
+	}
+	if len(pp[""]) != 1 || pp[""][0] != sentry.KubectlClusterReadPermission {
+		t.Errorf("expected only cluster scoped permission in organization, got %v", pp[""])
+	}
+}
+
+func TestAddPermissionsOrgScope(t *testing.T) {
+	projectPermissions := map[string][]string{
+		"":   {sentry.KubectlClusterReadPermission},
+		"p1": {sentry.KubectlNamespaceReadPermission},
+		"p2": {sentry.KubectlClusterWritePermission},
+	}
+	userNamespaces := func(project string) []string {
+		if project == "" {
+			t.Error("expected no namespaces to be looked up for the org scope")
+		}
+		return map[string][]string{"p1": {"ns1"}}[project]
+	}
+	cr, err := getClusterRole(sentry.KubectlClusterReadPermission)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// projects are in random order, the org scope must not end the
+	// projects added after it
+	for i := 0; i < 20; i++ {
+		o := &kubectlObjects{
+			sa:              &corev1.ServiceAccount{},
+			crMap:           make(map[string]*rbacv1.ClusterRole),
+			crbMap:          make(map[string]*rbacv1.ClusterRoleBinding),
+			rMap:            make(map[string]*rbacv1.Role),
+			rbMap:           make(map[string]*rbacv1.RoleBinding),
+			nsMap:           make(map[string]*corev1.Namespace),
+			crbExclusionMap: make(map[string]bool),
+			rbExclusionMap:  make(map[string]*roleBindExclusionList),
+			rolePrevilage:   -1,
+		}
+		o.sa.Name = "u1"
+		err := o.addPermissions(projectPermissions, userNamespaces, "p1", map[string][]string{"p2": {"ns2"}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := o.crMap[cr.Name]; !ok {
+			t.Errorf("expected cluster role %s of the org scope, got %v", cr.Name, o.crMap)
+		}
+		if len(o.crMap) != 1 {
+			t.Errorf("expected cluster scoped access of the org scope only, got %v", o.crMap)
+		}
+		if o.nsMap["ns1"] == nil || o.nsMap["ns2"] == nil {
+			t.Errorf("expected namespaces of projects p1 and p2, got %v", o.nsMap)
+		}
+		if o.highestRole != sentry.KubectlClusterReadPermission {
+			t.Errorf("expected highest role %s, got %s", sentry.KubectlClusterReadPermission, o.highestRole)
+		}
+	}
+}
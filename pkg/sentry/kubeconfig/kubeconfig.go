@@ -15,12 +15,14 @@ import (
 	sentryrpc "github.com/paralus/paralus/proto/rpc/sentry"
 	rpcv3 "github.com/paralus/paralus/proto/rpc/user"
 	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	infrav3 "github.com/paralus/paralus/proto/types/infrapb/v3"
 	sentry "github.com/paralus/paralus/proto/types/sentry"
 	"go.uber.org/zap"
 
 	clientcmdapiv1 "k8s.io/client-go/tools/clientcmd/api/v1"
 	"sigs.k8s.io/yaml"
 
+	clstrconstants "github.com/paralus/paralus/internal/cluster/constants"
 	"github.com/paralus/paralus/internal/constants"
 	"github.com/paralus/paralus/pkg/sentry/cryptoutil"
 	"github.com/paralus/paralus/pkg/sentry/util"
@@ -111,8 +113,103 @@ func getProjectsForAccount(ctx context.Context, accountID, orgID, partnerID stri
 	return projects, isOrgScope, nil
 }
 
+// addClusterSelectorBootstrapAgents adds the bootstrap agents of the
+// clusters matching the cluster selectors of the role bindings of the
+// account which grant kubeconfig access
+func addClusterSelectorBootstrapAgents(ctx context.Context, opts *commonv3.QueryOptions, bas []*sentry.BootstrapAgent, aps service.AccountPermissionService, bs service.BootstrapService, cs service.ClusterService) ([]*sentry.BootstrapAgent, error) {
+	csps, err := aps.GetAccountClusterSelectorPermissions(ctx, opts.Account, opts.Organization, opts.Partner, []string{kubeconfigPermission})
+	if err != nil {
+		return nil, err
+	}
+	if len(csps) == 0 {
+		return bas, nil
+	}
+
+	set := make(map[string]bool, len(bas))
+	for _, ba := range bas {
+		set[ba.Metadata.Name] = true
+	}
+	selectors := make(map[string]bool)
+	for i := range csps {
+		key := csps[i].ProjectID + "/" + csps[i].ClusterSelector
+		if selectors[key] {
+			continue
+		}
+		selectors[key] = true
+
+		clusterIDs, err := cs.SelectClusterIDs(ctx, opts.Organization, opts.Partner, csps[i].ProjectID, csps[i].ClusterSelector)
+		if err != nil {
+			return nil, err
+		}
+		for _, clusterID := range clusterIDs {
+			if set[clusterID] {
+				continue
+			}
+			bal, err := bs.GetBootstrapAgents(ctx, "-",
+				query.WithOptions(&commonv3.QueryOptions{
+					Name:         clusterID,
+					Organization: opts.Organization,
+					Partner:      opts.Partner,
+				}),
+				// ignore project id, because kubeconfig is not project scoped
+				query.WithIgnoreScopeDefault(),
+			)
+			if err != nil {
+				return nil, err
+			}
+			for _, ba := range bal.Items {
+				if _, ok := ba.Metadata.GetLabels()["paralus.dev/cdRelayAgent"]; ok {
+					continue
+				}
+				set[clusterID] = true
+				bas = append(bas, ba)
+				break
+			}
+		}
+	}
+	return bas, nil
+}
+
 // GetConfigForUser returns YAML encoding of kubeconfig
-func GetConfigForUser(ctx context.Context, bs service.BootstrapService, aps service.AccountPermissionService, gps service.GroupPermissionService, req *sentryrpc.GetForUserRequest, pf cryptoutil.PasswordFunc, kss service.KubeconfigSettingService, ksvc service.ApiKeyService, os service.OrganizationService, ps service.PartnerService, al *zap.Logger) ([]byte, error) {
+func GetConfigForUser(ctx context.Context, bs service.BootstrapService, aps service.AccountPermissionService, gps service.GroupPermissionService, cs service.ClusterService, req *sentryrpc.GetForUserRequest, pf cryptoutil.PasswordFunc, kss service.KubeconfigSettingService, ksvc service.ApiKeyService, os service.OrganizationService, ps service.PartnerService, al *zap.Logger) ([]byte, error) {
+	return getConfigForUser(ctx, bs, aps, gps, cs, req, pf, kss, ksvc, os, ps, al, 0)
+}
+
+// GetBreakGlassConfigForUser returns YAML encoding of kubeconfig valid
+// only for the duration of break-glass access, ignoring the kubeconfig
+// settings
+func GetBreakGlassConfigForUser(ctx context.Context, bs service.BootstrapService, aps service.AccountPermissionService, gps service.GroupPermissionService, cs service.ClusterService, req *sentryrpc.GetForUserRequest, pf cryptoutil.PasswordFunc, kss service.KubeconfigSettingService, ksvc service.ApiKeyService, os service.OrganizationService, ps service.PartnerService, al *zap.Logger, validity time.Duration) ([]byte, error) {
+	if validity <= 0 {
+		return nil, fmt.Errorf("break-glass access has expired")
+	}
+	return getConfigForUser(ctx, bs, aps, gps, cs, req, pf, kss, ksvc, os, ps, al, validity)
+}
+
+// GetShortLivedConfigForUser returns YAML encoding of kubeconfig valid
+// for the given duration, used for credentials of workloads
+func GetShortLivedConfigForUser(ctx context.Context, bs service.BootstrapService, aps service.AccountPermissionService, gps service.GroupPermissionService, cs service.ClusterService, req *sentryrpc.GetForUserRequest, pf cryptoutil.PasswordFunc, kss service.KubeconfigSettingService, ksvc service.ApiKeyService, os service.OrganizationService, ps service.PartnerService, al *zap.Logger, validity time.Duration) ([]byte, error) {
+	if validity <= 0 {
+		return nil, fmt.Errorf("kubeconfig validity should be positive")
+	}
+	return getConfigForUser(ctx, bs, aps, gps, cs, req, pf, kss, ksvc, os, ps, al, validity)
+}
+
+// getUserTemplateForRegion returns the user bootstrap agent template
+// in the region, the first template when the region is not set or no
+// template is in the region
+func getUserTemplateForRegion(templates []*sentry.BootstrapAgentTemplate, region string) *sentry.BootstrapAgentTemplate {
+	if region != "" {
+		for _, t := range templates {
+			if strings.EqualFold(t.GetSpec().GetLocation().GetRegion(), region) {
+				return t
+			}
+		}
+		_log.Infow("no user bootstrap agent template in region, using default", "region", region)
+	}
+	return templates[0]
+}
+
+func getConfigForUser(ctx context.Context, bs service.BootstrapService, aps service.AccountPermissionService, gps service.GroupPermissionService, cs service.ClusterService, req *sentryrpc.GetForUserRequest, pf cryptoutil.PasswordFunc, kss service.KubeconfigSettingService, ksvc service.ApiKeyService, os service.OrganizationService, ps service.PartnerService, al *zap.Logger, validity time.Duration) ([]byte, error) {
 	opts := req.Opts
 	if opts.Selector != "" {
 		opts.Selector = fmt.Sprintf("%s,!paralus.dev/cdRelayAgent", opts.Selector)
@@ -129,12 +226,13 @@ func GetConfigForUser(ctx context.Context, bs service.BootstrapService, aps serv
 		_log.Errorw("no user bootstrap agent template found")
 		return nil, fmt.Errorf("no user bootstrap agent template found")
 	}
+	userTemplate := getUserTemplateForRegion(batl.Items, req.Region)
 
 	_log.Infow("get config for user ", "opts", opts)
 
-	bi, err := bs.GetBootstrapInfra(ctx, batl.Items[0].Spec.InfraRef)
+	bi, err := bs.GetBootstrapInfra(ctx, userTemplate.Spec.InfraRef)
 	if err != nil {
-		_log.Errorw("error getting bootstrap infra", "infraRef", batl.Items[0].Spec.InfraRef, "error", err.Error())
+		_log.Errorw("error getting bootstrap infra", "infraRef", userTemplate.Spec.InfraRef, "error", err.Error())
 		return nil, err
 	}
 	isSSOAcc := opts.GetIsSSOUser()
@@ -258,13 +356,22 @@ func GetConfigForUser(ctx context.Context, bs service.BootstrapService, aps serv
 		}
 		bas = bal.Items
 	} else {
-		set := make(map[string]interface{})
+		projectSelectors := make([]string, 0, len(projects)+1)
 		for _, ap := range projects {
+			projectSelectors = append(projectSelectors, fmt.Sprintf("%s%s", clstrconstants.ClusterProjectPrefix, ap))
+		}
+		// clusters shared with all projects of the organization
+		if len(projects) > 0 {
+			projectSelectors = append(projectSelectors, fmt.Sprintf("%s=%s", clstrconstants.ClusterShareModeKey, infrav3.ClusterShareMode_ALL.String()))
+		}
+
+		set := make(map[string]interface{})
+		for _, ps := range projectSelectors {
 			selector := ""
 			if opts.Selector != "" {
-				selector = fmt.Sprintf("%s,project/%s", opts.Selector, ap)
+				selector = fmt.Sprintf("%s,%s", opts.Selector, ps)
 			} else {
-				selector = fmt.Sprintf("project/%s", ap)
+				selector = ps
 			}
 			bal, err := bs.SelectBootstrapAgents(ctx, "-",
 				query.WithOptions(opts),
@@ -298,8 +405,17 @@ func GetConfigForUser(ctx context.Context, bs service.BootstrapService, aps serv
 		}
 	}
 
+	// clusters granted through role bindings scoped by cluster selectors
+	if !isOrgScope && opts.Account != "" {
+		bas, err = addClusterSelectorBootstrapAgents(ctx, opts, bas, aps, bs, cs)
+		if err != nil {
+			_log.Errorw("error getting bootstrap agents of cluster selectors", "account", opts.Account, "error", err.Error())
+			return nil, err
+		}
+	}
+
 	serverHost := ""
-	for _, host := range batl.Items[0].Spec.Hosts {
+	for _, host := range userTemplate.Spec.Hosts {
 		if host.Type == sentry.BootstrapTemplateHostType_HostTypeExternal {
 			serverHost = host.Host
 		}
@@ -310,10 +426,13 @@ func GetConfigForUser(ctx context.Context, bs service.BootstrapService, aps serv
 	}
 
 	// get cert validity setting
-	certValidity, err := getCertValidity(ctx, opts.Organization, opts.Account, isSSOAcc, kss)
-	if err != nil {
-		_log.Errorw("error getting cert validity settings", "error", err.Error())
-		return nil, err
+	certValidity := validity
+	if certValidity == 0 {
+		certValidity, err = GetCertValidity(ctx, opts.Organization, opts.Account, isSSOAcc, kss)
+		if err != nil {
+			_log.Errorw("error getting cert validity settings", "error", err.Error())
+			return nil, err
+		}
 	}
 
 	if certValidity == 0 {
@@ -337,7 +456,9 @@ func GetConfigForUser(ctx context.Context, bs service.BootstrapService, aps serv
 	return yaml.JSONToYAML(jb)
 }
 
-func getCertValidity(ctx context.Context, orgID, accountID string, isSSO bool, kss service.KubeconfigSettingService) (time.Duration, error) {
+// GetCertValidity gets how long kubeconfigs issued to the account are
+// valid for
+func GetCertValidity(ctx context.Context, orgID, accountID string, isSSO bool, kss service.KubeconfigSettingService) (time.Duration, error) {
 	ksUser, err := kss.Get(ctx, orgID, accountID, isSSO)
 	if err == nil && ksUser.ValiditySeconds >= 0 {
 		return time.Second * time.Duration(ksUser.ValiditySeconds), nil
@@ -540,7 +661,7 @@ func GetConfigForCluster(ctx context.Context, bs service.BootstrapService, req *
 
 	cn := cnAttr.GetCN()
 
-	certValidity, err := getCertValidity(ctx, opts.Organization, opts.Account, isSSOAcc, kss)
+	certValidity, err := GetCertValidity(ctx, opts.Organization, opts.Account, isSSOAcc, kss)
 	if err != nil {
 		_log.Errorw("error getting cert validity settings", "error", err.Error())
 		return nil, err
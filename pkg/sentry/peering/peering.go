@@ -72,12 +72,14 @@ func GetPeerCache(cache *ristretto.Cache, key interface{}) (string, bool) {
 	return "", found
 }
 
-// sends periodic heartbeats to core service
-func helloRPCSend(ctx context.Context, stream sentryrpc.RelayPeerService_RelayPeerHelloRPCClient, interval time.Duration, relayUUID string, ip func() string) {
+// sends periodic heartbeats to core service along with the clusters
+// whose connectors are connected to the relay
+func helloRPCSend(ctx context.Context, stream sentryrpc.RelayPeerService_RelayPeerHelloRPCClient, interval time.Duration, relayUUID string, ip func() string, clusters func() []string) {
 	_log.Infow("send first hello")
 	msg := &sentryrpc.PeerHelloRequest{
-		Relayuuid: relayUUID,
-		Relayip:   ip(),
+		Relayuuid:   relayUUID,
+		Relayip:     ip(),
+		Clustersnis: clusters(),
 	}
 	// send first hello message
 	err := stream.Send(msg)
@@ -96,6 +98,7 @@ helloRPCSendLoop:
 			stream.CloseSend()
 			break helloRPCSendLoop
 		case <-tick.C:
+			msg.Clustersnis = clusters()
 			err := stream.Send(msg)
 			if err != nil {
 				_log.Errorw("failed to send hello message", err)
@@ -107,9 +110,11 @@ helloRPCSendLoop:
 }
 
 // ClientHelloRPC will handle periodic heartbeat messages between relay and the core service.
-func ClientHelloRPC(ctx context.Context, stream sentryrpc.RelayPeerService_RelayPeerHelloRPCClient, interval time.Duration, relayUUID string, ip func() string) {
+// clusters returns the SNI of the clusters connected to the relay, which
+// the core service tracks the health of the clusters with.
+func ClientHelloRPC(ctx context.Context, stream sentryrpc.RelayPeerService_RelayPeerHelloRPCClient, interval time.Duration, relayUUID string, ip func() string, clusters func() []string) {
 
-	go helloRPCSend(ctx, stream, interval, relayUUID, ip)
+	go helloRPCSend(ctx, stream, interval, relayUUID, ip, clusters)
 
 	for {
 		in, err := stream.Recv()
@@ -408,3 +413,20 @@ func GetPeeringServerCreds(ctx context.Context, bs service.BootstrapService, rpc
 
 	return
 }
+
+// GetRelayAgentCA returns the CA cert of the client certs issued to the
+// relay agents of clusters
+func GetRelayAgentCA(ctx context.Context, bs service.BootstrapService) ([]byte, error) {
+	nctx, cancel := context.WithTimeout(ctx, time.Second*10)
+	defer cancel()
+
+	template, err := bs.GetBootstrapAgentTemplate(nctx, "paralus-core-relay-agent")
+	if err != nil {
+		return nil, err
+	}
+	infra, err := bs.GetBootstrapInfra(nctx, template.Spec.InfraRef)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(infra.Spec.CaCert), nil
+}
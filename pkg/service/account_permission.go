@@ -18,6 +18,7 @@ type AccountPermissionService interface {
 	IsPartnerSuperAdmin(ctx context.Context, accountID, partnerID string) (isPartnerAdmin, isSuperAdmin bool, err error)
 	GetAccountProjectsByPermission(ctx context.Context, accountID, orgID, partnerID string, permission string) ([]sentry.AccountPermission, error)
 	GetAccountPermissionsByProjectIDPermissions(ctx context.Context, accountID, orgID, partnerID string, projects, permissions []string) ([]sentry.AccountPermission, error)
+	GetAccountClusterSelectorPermissions(ctx context.Context, accountID, orgID, partnerID string, permissions []string) ([]sentry.AccountPermission, error)
 	GetAcccountsWithApprovalPermission(ctx context.Context, orgID, partnerID string) ([]string, error)
 	GetSSOAcccountsWithApprovalPermission(ctx context.Context, orgID, partnerID string) ([]string, error)
 	IsOrgAdmin(ctx context.Context, accountID, partnerID string) (isOrgAdmin bool, err error)
@@ -25,6 +26,8 @@ type AccountPermissionService interface {
 	GetAccountGroups(ctx context.Context, accountID string) ([]string, error)
 	IsAccountActive(ctx context.Context, accountID, orgID string) (bool, error)
 	IsSSOAccount(ctx context.Context, accountID string) (bool, error)
+	IsServiceAccount(ctx context.Context, accountID string) (bool, error)
+	IsSuspended(ctx context.Context, accountID string) (bool, error)
 }
 
 // accountPermissionService implements AccountPermissionService
@@ -87,12 +90,40 @@ func (a *accountPermissionService) GetAccountPermissionsByProjectIDPermissions(c
 	return accountPermissions, nil
 }
 
+// GetAccountClusterSelectorPermissions returns the permissions granted to
+// the account by role bindings scoped by cluster selectors, the project of
+// the permission is empty for bindings applying to the whole organization
+func (a *accountPermissionService) GetAccountClusterSelectorPermissions(ctx context.Context, accountID, orgID, partnerID string, permissions []string) ([]sentry.AccountPermission, error) {
+	csps, err := dao.GetClusterSelectorPermissions(ctx, a.db, uuid.MustParse(accountID), uuid.MustParse(orgID), uuid.MustParse(partnerID), permissions)
+	if err != nil {
+		return nil, err
+	}
+	accountPermissions := []sentry.AccountPermission{}
+	for _, csp := range csps {
+		projectID := ""
+		if csp.ProjectId != uuid.Nil {
+			projectID = csp.ProjectId.String()
+		}
+		accountPermissions = append(accountPermissions, sentry.AccountPermission{
+			AccountID:       csp.AccountId.String(),
+			ProjectID:       projectID,
+			OrganizationID:  csp.OrganizationId.String(),
+			PartnerID:       csp.PartnerId.String(),
+			RoleName:        csp.RoleName,
+			PermissionName:  csp.PermissionName,
+			ClusterSelector: csp.ClusterSelector,
+		})
+	}
+
+	return accountPermissions, nil
+}
+
 func (a *accountPermissionService) GetAccount(ctx context.Context, accountID string) (*models.Account, error) {
 	return dao.GetAccountBasics(ctx, a.db, uuid.MustParse(accountID))
 }
 
 func (a *accountPermissionService) GetAccountGroups(ctx context.Context, accountID string) ([]string, error) {
-	ag, err := dao.GetAccountGroups(ctx, a.db, uuid.MustParse(accountID))
+	ag, err := dao.GetEffectiveGroups(ctx, a.db, uuid.MustParse(accountID))
 	if err != nil {
 		return nil, err
 	}
@@ -171,6 +202,14 @@ func (a *accountPermissionService) IsSSOAccount(ctx context.Context, accountID s
 	return dao.IsSSOAccount(ctx, a.db, uuid.MustParse(accountID))
 }
 
+func (a *accountPermissionService) IsServiceAccount(ctx context.Context, accountID string) (bool, error) {
+	return dao.IsServiceAccount(ctx, a.db, uuid.MustParse(accountID))
+}
+
+func (a *accountPermissionService) IsSuspended(ctx context.Context, accountID string) (bool, error) {
+	return dao.IsAccountSuspended(ctx, a.db, uuid.MustParse(accountID))
+}
+
 func prepareAccountPermissionResponse(aps models.AccountPermission) sentry.AccountPermission {
 	var urls []*sentry.PermissionURL
 	if aps.Urls != nil {
@@ -148,12 +148,21 @@ func TestGetAccountGroups(t *testing.T) {
 	ps := NewAccountPermissionService(db)
 
 	aid := uuid.New().String()
+	guuid := uuid.New().String()
+	pguuid := uuid.New().String()
 
-	mock.ExpectQuery(`SELECT "groupaccount"."id", "groupaccount"."name"`).
-		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(aid))
+	mock.ExpectQuery(`SELECT "group"."id", "group"."name", .* JOIN authsrv_groupaccount ON authsrv_groupaccount.group_id="group".id WHERE .authsrv_groupaccount.account_id = '` + aid + `'`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(guuid, "group-"+guuid))
+	mock.ExpectQuery(`SELECT "group"."id", "group"."name", .* JOIN authsrv_groupgroup ON authsrv_groupgroup.group_id="group".id WHERE .authsrv_groupgroup.member_group_id = '` + guuid + `'`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(pguuid, "group-"+pguuid))
+	mock.ExpectQuery(`SELECT "group"."id", "group"."name", .* JOIN authsrv_groupgroup ON authsrv_groupgroup.group_id="group".id WHERE .authsrv_groupgroup.member_group_id = '` + pguuid + `'`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id", "name"}))
 
-	_, err := ps.GetAccountGroups(context.Background(), aid)
+	groups, err := ps.GetAccountGroups(context.Background(), aid)
 	if err != nil {
 		t.Fatal("could not get account groups:", err)
 	}
+	if len(groups) != 2 || groups[1] != "group-"+pguuid {
+		t.Errorf("expected direct and parent group; got %v", groups)
+	}
 }
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
@@ -114,5 +115,8 @@ func (s *apiKeyService) GetByKey(ctx context.Context, req *rpcv3.ApiKeyRequest)
 	if err != nil {
 		return nil, err
 	}
+	if !apikey.ExpiresAt.IsZero() && apikey.ExpiresAt.Before(time.Now()) {
+		return nil, fmt.Errorf("api key '%v' has expired", req.Id)
+	}
 	return &apikey, err
 }
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/google/uuid"
@@ -100,6 +101,24 @@ func TestApiKeyGetByKey(t *testing.T) {
 	}
 }
 
+func TestApiKeyGetByKeyExpired(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	ak := NewApiKeyService(db, getLogger())
+	uuuid := uuid.NewString()
+	req := &userrpcv3.ApiKeyRequest{Username: "user-" + uuuid, Id: uuuid}
+
+	// mocks
+	mock.ExpectQuery(`SELECT "apikey"."id", "apikey"."name", .*FROM "authsrv_apikey" AS "apikey" WHERE \(key = '` + uuuid + `'\) AND \(trash = FALSE\)`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id", "name", "expires_at"}).AddRow(uuuid, "user-"+uuuid, time.Now().Add(-time.Minute)))
+
+	_, err := ak.GetByKey(context.Background(), req)
+	if err == nil {
+		t.Error("got expired apikey")
+	}
+}
+
 func TestApiKeyList(t *testing.T) {
 	db, mock := getDB(t)
 	defer db.Close()
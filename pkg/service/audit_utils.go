@@ -3,13 +3,16 @@ package service
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/paralus/paralus/internal/dao"
 	"github.com/paralus/paralus/internal/models"
 	"github.com/paralus/paralus/pkg/audit"
 	"github.com/paralus/paralus/pkg/utils"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
 	systemv3 "github.com/paralus/paralus/proto/types/systempb/v3"
 	"github.com/uptrace/bun"
 	"go.uber.org/zap"
@@ -20,6 +23,12 @@ const (
 	AuditActionDelete   = "delete"
 	AuditActionUpdate   = "update"
 	AuditActionDownload = "download"
+	AuditActionApprove  = "approve"
+	AuditActionReject   = "reject"
+	AuditActionReissue  = "reissue"
+	AuditActionRotate   = "rotate"
+	AuditActionMove     = "move"
+	AuditActionRename   = "rename"
 )
 
 func CreateUserAuditEvent(ctx context.Context, al *zap.Logger, db bun.IDB, action string, name string, id uuid.UUID, rolesBefore, rolesAfter, groupsBefore, groupsAfter []uuid.UUID) {
@@ -481,6 +490,27 @@ func CreateClusterAuditEvent(ctx context.Context, al *zap.Logger, action string,
 	}
 }
 
+func CreateBootstrapAgentAuditEvent(ctx context.Context, al *zap.Logger, action string, ba *models.BootstrapAgent, project string) {
+	sd, ok := GetSessionDataFromContext(ctx)
+	if !ok {
+		_log.Warn("unable to create audit event: could not fetch info from context")
+		return
+	}
+
+	detail := &audit.EventDetail{
+		Message: fmt.Sprintf("Bootstrap agent %s of template %s: %s", ba.Name, ba.TemplateRef, action),
+		Meta: map[string]string{
+			"agent_name":    ba.Name,
+			"template_name": ba.TemplateRef,
+			"ip_address":    ba.IPAddress,
+			"fingerprint":   ba.Fingerprint,
+		},
+	}
+	if err := audit.CreateV1Event(al, sd, detail, fmt.Sprintf("bootstrapagent.%s.success", action), project); err != nil {
+		_log.Warn("unable to create audit event", err)
+	}
+}
+
 // TODO: figure out how this is to be added
 func CreateLocationAuditEvent(ctx context.Context, al *zap.Logger, action string, name string, id uuid.UUID) {
 	sd, ok := GetSessionDataFromContext(ctx)
@@ -499,3 +529,352 @@ func CreateLocationAuditEvent(ctx context.Context, al *zap.Logger, action string
 		_log.Warn("unable to create audit event", err)
 	}
 }
+
+// expiryActor is the actor of audit events created on expiry of
+// memberships and role bindings
+var expiryActor = &commonv3.SessionData{Username: "system"}
+
+func roleBindingSubject(rb models.RoleBinding) (string, string) {
+	if rb.Group != "" {
+		return "group", rb.Group
+	}
+	return "user", rb.Account
+}
+
+func CreateMembershipExpiryAuditEvent(al *zap.Logger, user, group string, expiresAt time.Time) {
+	detail := &audit.EventDetail{
+		Message: fmt.Sprintf("User %s removed from group %s, membership expired at %s", user, group, expiresAt.Format(time.RFC3339)),
+		Meta: map[string]string{
+			"group_name": group,
+			"username":   user,
+			"expires_at": expiresAt.Format(time.RFC3339),
+		},
+	}
+	if err := audit.CreateV1Event(al, expiryActor, detail, "group.user.expired", ""); err != nil {
+		_log.Warn("unable to create audit event", err)
+	}
+}
+
+// CreateMembershipExpiringAuditEvent notifies the user and the group
+// about an upcoming expiry of the membership
+func CreateMembershipExpiringAuditEvent(al *zap.Logger, user, group string, expiresAt time.Time) {
+	detail := &audit.EventDetail{
+		Message: fmt.Sprintf("Membership of user %s in group %s expires at %s", user, group, expiresAt.Format(time.RFC3339)),
+		Meta: map[string]string{
+			"group_name": group,
+			"username":   user,
+			"expires_at": expiresAt.Format(time.RFC3339),
+		},
+	}
+	if err := audit.CreateV1Event(al, expiryActor, detail, "user.group.expiring", ""); err != nil {
+		_log.Warn("unable to create audit event", err)
+	}
+	if err := audit.CreateV1Event(al, expiryActor, detail, "group.user.expiring", ""); err != nil {
+		_log.Warn("unable to create audit event", err)
+	}
+}
+
+func CreateRoleBindingExpiryAuditEvent(al *zap.Logger, rb models.RoleBinding) {
+	kind, name := roleBindingSubject(rb)
+	detail := &audit.EventDetail{
+		Message: fmt.Sprintf("Role %s removed from %s %s, binding expired at %s", rb.Role, kind, name, rb.ExpiresAt.Format(time.RFC3339)),
+		Meta: map[string]string{
+			kind + "_name": name,
+			"role_name":    rb.Role,
+			"expires_at":   rb.ExpiresAt.Format(time.RFC3339),
+		},
+	}
+	if err := audit.CreateV1Event(al, expiryActor, detail, kind+".role.expired", rb.Project); err != nil {
+		_log.Warn("unable to create audit event", err)
+	}
+}
+
+// CreateRoleBindingExpiringAuditEvent notifies the user or group about
+// an upcoming expiry of the role binding
+func CreateRoleBindingExpiringAuditEvent(al *zap.Logger, rb models.RoleBinding) {
+	kind, name := roleBindingSubject(rb)
+	detail := &audit.EventDetail{
+		Message: fmt.Sprintf("Role %s of %s %s expires at %s", rb.Role, kind, name, rb.ExpiresAt.Format(time.RFC3339)),
+		Meta: map[string]string{
+			kind + "_name": name,
+			"role_name":    rb.Role,
+			"expires_at":   rb.ExpiresAt.Format(time.RFC3339),
+		},
+	}
+	if err := audit.CreateV1Event(al, expiryActor, detail, kind+".role.expiring", rb.Project); err != nil {
+		_log.Warn("unable to create audit event", err)
+	}
+}
+
+func CreateUserLoginFailureAuditEvent(al *zap.Logger, username string) {
+	detail := &audit.EventDetail{
+		Message: fmt.Sprintf("User login failed: %s", username),
+		Meta: map[string]string{
+			"user": username,
+		},
+	}
+	if err := audit.CreateV1Event(al, &commonv3.SessionData{Username: username}, detail, "user.login.failure", ""); err != nil {
+		_log.Warn("unable to create audit event", err)
+	}
+}
+
+func CreateUserLockedAuditEvent(al *zap.Logger, username string, attempts int, until time.Time) {
+	detail := &audit.EventDetail{
+		Message: fmt.Sprintf("User %s locked out until %s after %d failed logins", username, until.Format(time.RFC3339), attempts),
+		Meta: map[string]string{
+			"user":         username,
+			"attempts":     strconv.Itoa(attempts),
+			"locked_until": until.Format(time.RFC3339),
+		},
+	}
+	if err := audit.CreateV1Event(al, &commonv3.SessionData{Username: username}, detail, "user.locked", ""); err != nil {
+		_log.Warn("unable to create audit event", err)
+	}
+}
+
+func CreateUserUnlockedAuditEvent(ctx context.Context, al *zap.Logger, username string) {
+	sd, ok := GetSessionDataFromContext(ctx)
+	if !ok {
+		_log.Warn("unable to create audit event: could not fetch info from context")
+		return
+	}
+
+	detail := &audit.EventDetail{
+		Message: fmt.Sprintf("User %s unlocked", username),
+		Meta: map[string]string{
+			"user": username,
+		},
+	}
+	if err := audit.CreateV1Event(al, sd, detail, "user.unlocked", ""); err != nil {
+		_log.Warn("unable to create audit event", err)
+	}
+}
+
+func CreateUserSuspendAuditEvent(al *zap.Logger, sd *commonv3.SessionData, username string, suspended bool) {
+	action, event := "reactivated", "user.reactivated"
+	if suspended {
+		action, event = "suspended", "user.suspended"
+	}
+	detail := &audit.EventDetail{
+		Message: fmt.Sprintf("User %s %s", username, action),
+		Meta: map[string]string{
+			"user": username,
+		},
+	}
+	if err := audit.CreateV1Event(al, sd, detail, event, ""); err != nil {
+		_log.Warn("unable to create audit event", err)
+	}
+}
+
+// CreateUserInactiveAuditEvent notifies the user about an upcoming
+// suspension or deletion for not having logged in
+func CreateUserInactiveAuditEvent(al *zap.Logger, username string, deletion bool, lastActive, at time.Time) {
+	action, event := "suspended", "user.suspension.warning"
+	if deletion {
+		action, event = "deleted", "user.deletion.warning"
+	}
+	detail := &audit.EventDetail{
+		Message: fmt.Sprintf("User %s inactive since %s will be %s at %s", username, lastActive.Format(time.RFC3339), action, at.Format(time.RFC3339)),
+		Meta: map[string]string{
+			"user":        username,
+			"last_active": lastActive.Format(time.RFC3339),
+			"action_at":   at.Format(time.RFC3339),
+		},
+	}
+	if err := audit.CreateV1Event(al, expiryActor, detail, event, ""); err != nil {
+		_log.Warn("unable to create audit event", err)
+	}
+}
+
+func CreateSessionIdleAuditEvent(al *zap.Logger, sd *commonv3.SessionData, lastActive time.Time) {
+	detail := &audit.EventDetail{
+		Message: fmt.Sprintf("Session of user %s terminated, idle since %s", sd.GetUsername(), lastActive.Format(time.RFC3339)),
+		Meta: map[string]string{
+			"user":        sd.GetUsername(),
+			"last_active": lastActive.Format(time.RFC3339),
+		},
+	}
+	if err := audit.CreateV1Event(al, sd, detail, "user.session.idle", ""); err != nil {
+		_log.Warn("unable to create audit event", err)
+	}
+}
+
+func CreateBreakGlassAccountAuditEvent(ctx context.Context, al *zap.Logger, action string, name string, user string) {
+	sd, ok := GetSessionDataFromContext(ctx)
+	if !ok {
+		_log.Warn("unable to create audit event: could not fetch info from context")
+		return
+	}
+
+	detail := &audit.EventDetail{
+		Message: fmt.Sprintf("Break-glass account %s for user %s: %s", name, user, action),
+		Meta: map[string]string{
+			"breakglass_name": name,
+			"user":            user,
+		},
+	}
+	if err := audit.CreateV1Event(al, sd, detail, fmt.Sprintf("breakglass.%s.success", action), ""); err != nil {
+		_log.Warn("unable to create audit event", err)
+	}
+}
+
+func CreateBreakGlassUsedAuditEvent(al *zap.Logger, sd *commonv3.SessionData, name string, reason string, until time.Time, admins []string) {
+	detail := &audit.EventDetail{
+		Message: fmt.Sprintf("Break-glass account %s used by %s until %s: %s", name, sd.GetUsername(), until.Format(time.RFC3339), reason),
+		Meta: map[string]string{
+			"breakglass_name": name,
+			"user":            sd.GetUsername(),
+			"reason":          reason,
+			"active_until":    until.Format(time.RFC3339),
+			"notified":        strings.Join(admins, ","),
+		},
+	}
+	if err := audit.CreateV1CriticalEvent(al, sd, detail, "breakglass.used", ""); err != nil {
+		_log.Warn("unable to create audit event", err)
+	}
+}
+
+func CreateBreakGlassFailedAuditEvent(al *zap.Logger, sd *commonv3.SessionData, name string, reason string, cause string) {
+	detail := &audit.EventDetail{
+		Message: fmt.Sprintf("Break-glass attempt on account %s failed: %s", name, cause),
+		Meta: map[string]string{
+			"breakglass_name": name,
+			"reason":          reason,
+			"error":           cause,
+		},
+	}
+	if err := audit.CreateV1CriticalEvent(al, sd, detail, "breakglass.failed", ""); err != nil {
+		_log.Warn("unable to create audit event", err)
+	}
+}
+
+func CreateBreakGlassExpiredAuditEvent(al *zap.Logger, name string, user string) {
+	detail := &audit.EventDetail{
+		Message: fmt.Sprintf("Break-glass access of account %s expired, kubeconfig of user %s revoked", name, user),
+		Meta: map[string]string{
+			"breakglass_name": name,
+			"user":            user,
+		},
+	}
+	if err := audit.CreateV1Event(al, &commonv3.SessionData{Username: user}, detail, "breakglass.expired", ""); err != nil {
+		_log.Warn("unable to create audit event", err)
+	}
+}
+
+func CreateServiceAccountAuditEvent(ctx context.Context, al *zap.Logger, action string, name string, ownerGroup string, project string) {
+	sd, ok := GetSessionDataFromContext(ctx)
+	if !ok {
+		_log.Warn("unable to create audit event: could not fetch info from context")
+		return
+	}
+
+	detail := &audit.EventDetail{
+		Message: fmt.Sprintf("Service account %s %sd", name, action),
+		Meta: map[string]string{
+			"serviceaccount_name": name,
+			"owner_group":         ownerGroup,
+		},
+	}
+	if err := audit.CreateV1Event(al, sd, detail, fmt.Sprintf("serviceaccount.%s.success", action), project); err != nil {
+		_log.Warn("unable to create audit event", err)
+	}
+}
+
+func CreateServiceAccountApiKeyAuditEvent(ctx context.Context, al *zap.Logger, action string, name string, key string) {
+	sd, ok := GetSessionDataFromContext(ctx)
+	if !ok {
+		_log.Warn("unable to create audit event: could not fetch info from context")
+		return
+	}
+
+	detail := &audit.EventDetail{
+		Message: fmt.Sprintf("ApiKey %s of service account %s %sd", key, name, action),
+		Meta: map[string]string{
+			"serviceaccount_name": name,
+			"apikey":              key,
+		},
+	}
+	if err := audit.CreateV1Event(al, sd, detail, fmt.Sprintf("serviceaccount.apikey.%s.success", action), ""); err != nil {
+		_log.Warn("unable to create audit event", err)
+	}
+}
+
+func CreateWorkloadIdentityPolicyAuditEvent(ctx context.Context, al *zap.Logger, action string, name string, serviceAccount string, project string) {
+	sd, ok := GetSessionDataFromContext(ctx)
+	if !ok {
+		_log.Warn("unable to create audit event: could not fetch info from context")
+		return
+	}
+
+	detail := &audit.EventDetail{
+		Message: fmt.Sprintf("Workload identity policy %s %sd", name, action),
+		Meta: map[string]string{
+			"policy_name":         name,
+			"serviceaccount_name": serviceAccount,
+		},
+	}
+	if err := audit.CreateV1Event(al, sd, detail, fmt.Sprintf("workloadidentity.policy.%s.success", action), project); err != nil {
+		_log.Warn("unable to create audit event", err)
+	}
+}
+
+func CreateWorkloadIdentityExchangeAuditEvent(al *zap.Logger, sd *commonv3.SessionData, policy string, subject string, project string, cause string) {
+	detail := &audit.EventDetail{
+		Message: fmt.Sprintf("OIDC token of %s exchanged using workload identity policy %s", subject, policy),
+		Meta: map[string]string{
+			"policy_name": policy,
+			"subject":     subject,
+		},
+	}
+	eventType := "workloadidentity.exchange.success"
+	if cause != "" {
+		detail.Message = fmt.Sprintf("OIDC token of %s rejected by workload identity policy %s: %s", subject, policy, cause)
+		detail.Meta["error"] = cause
+		eventType = "workloadidentity.exchange.failed"
+	}
+	if err := audit.CreateV1Event(al, sd, detail, eventType, project); err != nil {
+		_log.Warn("unable to create audit event", err)
+	}
+}
+
+func CreateDeviceAuditEvent(ctx context.Context, al *zap.Logger, action string, name string, id string) {
+	sd, ok := GetSessionDataFromContext(ctx)
+	if !ok {
+		_log.Warn("unable to create audit event: could not fetch info from context")
+		return
+	}
+
+	detail := &audit.EventDetail{
+		Message: fmt.Sprintf("Device %s: %s", name, action),
+		Meta: map[string]string{
+			"device_name": name,
+			"device_id":   id,
+		},
+	}
+	if err := audit.CreateV1Event(al, sd, detail, fmt.Sprintf("device.%s.success", action), ""); err != nil {
+		_log.Warn("unable to create audit event", err)
+	}
+}
+
+func CreateSessionRevokeAuditEvent(ctx context.Context, al *zap.Logger, user string, sessionType string, id string) {
+	sd, ok := GetSessionDataFromContext(ctx)
+	if !ok {
+		_log.Warn("unable to create audit event: could not fetch info from context")
+		return
+	}
+
+	detail := &audit.EventDetail{
+		Message: fmt.Sprintf("User %s signed out everywhere", user),
+		Meta: map[string]string{
+			"user": user,
+		},
+	}
+	if sessionType != "" {
+		detail.Message = fmt.Sprintf("User %s %s session %s revoked", user, sessionType, id)
+		detail.Meta["session_type"] = sessionType
+		detail.Meta["session_id"] = id
+	}
+	if err := audit.CreateV1Event(al, sd, detail, "user.session.revoke", ""); err != nil {
+		_log.Warn("unable to create audit event", err)
+	}
+}
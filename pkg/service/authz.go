@@ -5,8 +5,10 @@ import (
 	"fmt"
 
 	"github.com/casbin/casbin/v2"
+	"github.com/casbin/casbin/v2/util"
 	"github.com/paralus/paralus/internal/dao"
 	"github.com/paralus/paralus/internal/models"
+	"github.com/paralus/paralus/pkg/enforcer"
 	authzpbv1 "github.com/paralus/paralus/proto/types/authz"
 	"github.com/uptrace/bun"
 	"google.golang.org/grpc/codes"
@@ -15,6 +17,8 @@ import (
 
 type AuthzService interface {
 	Enforce(context.Context, *authzpbv1.EnforceRequest) (*authzpbv1.BoolReply, error)
+	EnforceEx(context.Context, *authzpbv1.EnforceRequest) (*authzpbv1.EnforceExReply, error)
+	Explain(context.Context, *authzpbv1.ExplainRequest) (*authzpbv1.ExplainReply, error)
 	ListPolicies(context.Context, *authzpbv1.Policy) (*authzpbv1.Policies, error)
 	CreatePolicies(context.Context, *authzpbv1.Policies) (*authzpbv1.BoolReply, error)
 	DeletePolicies(context.Context, *authzpbv1.Policy) (*authzpbv1.BoolReply, error)
@@ -46,6 +50,11 @@ const (
 	roleGtype  = "g"
 )
 
+const (
+	allowEffect = "allow"
+	denyEffect  = "deny"
+)
+
 type rpmUrlAction struct {
 	url     string
 	methods []string
@@ -116,21 +125,37 @@ func (s *authzService) toPolicies(policies [][]string) *authzpbv1.Policies {
 	res := &authzpbv1.Policies{}
 	res.Policies = make([]*authzpbv1.Policy, len(policies))
 	for i := range policies {
-		res.Policies[i] = &authzpbv1.Policy{
-			Sub:  policies[i][0],
-			Ns:   policies[i][1],
-			Proj: policies[i][2],
-			Org:  policies[i][3],
-			Obj:  policies[i][4],
-		}
+		res.Policies[i] = s.toPolicy(policies[i])
 	}
 	return res
 }
 
+func (s *authzService) toPolicy(policy []string) *authzpbv1.Policy {
+	p := &authzpbv1.Policy{
+		Sub:  policy[0],
+		Ns:   policy[1],
+		Proj: policy[2],
+		Org:  policy[3],
+		Obj:  policy[4],
+		Eft:  allowEffect,
+	}
+	if len(policy) > 5 && policy[5] != "" {
+		p.Eft = policy[5]
+	}
+	return p
+}
+
 func (s *authzService) fromPolicies(policies *authzpbv1.Policies) ([][]string, error) {
 	res := [][]string{}
 	for i, p := range policies.GetPolicies() {
-		rule := []string{p.GetSub(), p.GetNs(), p.GetProj(), p.GetOrg(), p.GetObj()}
+		eft := p.GetEft()
+		if eft == "" {
+			eft = allowEffect
+		}
+		if eft != allowEffect && eft != denyEffect {
+			return res, fmt.Errorf("index %d: unknown policy effect '%v'", i, eft)
+		}
+		rule := []string{p.GetSub(), p.GetNs(), p.GetProj(), p.GetOrg(), p.GetObj(), eft}
 		for _, field := range rule {
 			if field == "" {
 				return res, fmt.Errorf(fmt.Sprintf("index %d: policy elements do not meet definition", i))
@@ -240,8 +265,139 @@ func (s *authzService) Enforce(ctx context.Context, req *authzpbv1.EnforceReques
 	return &authzpbv1.BoolReply{Res: res}, nil
 }
 
+// EnforceEx is same as Enforce but also returns the policy which
+// decided the result. When a deny policy matches, it is the (first)
+// matched deny policy.
+func (s *authzService) EnforceEx(ctx context.Context, req *authzpbv1.EnforceRequest) (*authzpbv1.EnforceExReply, error) {
+	params := make([]interface{}, 0, len(req.Params))
+	for index := range req.Params {
+		params = append(params, req.Params[index])
+	}
+
+	res, explain, err := s.enforcer.EnforceEx(params...)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+
+	reply := &authzpbv1.EnforceExReply{Res: res}
+	if len(explain) >= 5 {
+		reply.Matched = s.toPolicy(explain)
+	}
+	return reply, nil
+}
+
+// Explain evaluates the request the same way as the enforcer model
+// does, but reports every policy, group link and role permission which
+// matched. When a proposed role is given, its effect and permissions
+// replace the stored ones for the evaluation.
+func (s *authzService) Explain(ctx context.Context, req *authzpbv1.ExplainRequest) (*authzpbv1.ExplainReply, error) {
+	if len(req.GetParams()) != 6 {
+		return nil, status.Errorf(codes.InvalidArgument, "explain requires sub, ns, proj, org, obj and act")
+	}
+	sub, ns, proj, org, obj, act := req.Params[0], req.Params[1], req.Params[2], req.Params[3], req.Params[4], req.Params[5]
+
+	var proposed []rpmUrlAction
+	if req.GetProposedRole() != "" {
+		if len(s.mappingCache) == 0 {
+			if err := s.cacheResourceRolePermissions(ctx); err != nil {
+				return nil, status.Errorf(codes.Internal, err.Error())
+			}
+		}
+		for _, permission := range req.GetProposedPermissions() {
+			rpms, ok := s.mappingCache[permission]
+			if !ok {
+				return nil, status.Errorf(codes.InvalidArgument, "unknown permission '%v'", permission)
+			}
+			proposed = append(proposed, rpms...)
+		}
+	}
+
+	reply := &authzpbv1.ExplainReply{}
+
+	// follow g2 links breadth first, as the role manager does
+	subjects := []string{sub}
+	seen := map[string]bool{sub: true}
+	for i := 0; i < len(subjects); i++ {
+		for _, ug := range s.enforcer.GetFilteredNamedGroupingPolicy(groupGtype, 0, subjects[i]) {
+			reply.Groups = append(reply.Groups, subjects[i]+" -> "+ug[1])
+			if !seen[ug[1]] {
+				seen[ug[1]] = true
+				subjects = append(subjects, ug[1])
+			}
+		}
+	}
+
+	var allowed, denied bool
+	for _, subject := range subjects {
+		for _, policy := range s.enforcer.GetFilteredPolicy(0, subject) {
+			p := s.toPolicy(policy)
+			if !scopeMatch(ns, p.Ns) || !scopeMatch(proj, p.Proj) || !scopeMatch(org, p.Org) {
+				continue
+			}
+
+			var roles []*authzpbv1.RoleMatch
+			if p.Obj == req.GetProposedRole() {
+				if req.GetProposedEffect() != "" {
+					p.Eft = req.GetProposedEffect()
+				}
+				for _, rpm := range proposed {
+					for _, method := range rpm.methods {
+						if method == act && enforcer.KeyMatchCu(obj, rpm.url) {
+							roles = append(roles, &authzpbv1.RoleMatch{Role: p.Obj, Url: rpm.url, Method: method})
+						}
+					}
+				}
+			} else {
+				for _, rule := range s.enforcer.GetFilteredNamedGroupingPolicy(roleGtype, 1, p.Obj) {
+					if len(rule) > 2 && rule[2] == act && enforcer.KeyMatchCu(obj, rule[0]) {
+						roles = append(roles, &authzpbv1.RoleMatch{Role: p.Obj, Url: rule[0], Method: rule[2]})
+					}
+				}
+			}
+			if len(roles) == 0 {
+				continue
+			}
+
+			reply.Matched = append(reply.Matched, p)
+			reply.Roles = append(reply.Roles, roles...)
+			switch p.Eft {
+			case denyEffect:
+				denied = true
+			case allowEffect:
+				allowed = true
+			}
+		}
+	}
+	reply.Res = allowed && !denied
+
+	if req.GetProposedRole() == "" {
+		// the enforcer stays the source of truth for stored policies
+		params := make([]interface{}, 0, len(req.Params))
+		for index := range req.Params {
+			params = append(params, req.Params[index])
+		}
+		res, err := s.enforcer.Enforce(params...)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		reply.Res = res
+	}
+
+	return reply, nil
+}
+
+// scopeMatch matches a request scope with a policy scope in either
+// direction, same as globMatch in the enforcer matcher.
+func scopeMatch(r, p string) bool {
+	if ok, _ := util.GlobMatch(r, p); ok {
+		return true
+	}
+	ok, _ := util.GlobMatch(p, r)
+	return ok
+}
+
 func (s *authzService) ListPolicies(ctx context.Context, p *authzpbv1.Policy) (*authzpbv1.Policies, error) {
-	return s.toPolicies(s.enforcer.GetFilteredPolicy(0, p.GetSub(), p.GetNs(), p.GetProj(), p.GetOrg(), p.GetObj())), nil
+	return s.toPolicies(s.enforcer.GetFilteredPolicy(0, p.GetSub(), p.GetNs(), p.GetProj(), p.GetOrg(), p.GetObj(), p.GetEft())), nil
 }
 
 func (s *authzService) CreatePolicies(ctx context.Context, p *authzpbv1.Policies) (*authzpbv1.BoolReply, error) {
@@ -264,7 +420,7 @@ func (s *authzService) CreatePolicies(ctx context.Context, p *authzpbv1.Policies
 
 func (s *authzService) DeletePolicies(ctx context.Context, p *authzpbv1.Policy) (*authzpbv1.BoolReply, error) {
 	// err could be from db, policy assertions, cache; dispatcher, watcher updates (not pertinent)
-	res, err := s.enforcer.RemoveFilteredPolicy(0, p.GetSub(), p.GetNs(), p.GetProj(), p.GetOrg(), p.GetObj())
+	res, err := s.enforcer.RemoveFilteredPolicy(0, p.GetSub(), p.GetNs(), p.GetProj(), p.GetOrg(), p.GetObj(), p.GetEft())
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
@@ -4,10 +4,12 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/google/uuid"
+	cdao "github.com/paralus/paralus/internal/cluster/dao"
 	"github.com/paralus/paralus/internal/dao"
 	"github.com/paralus/paralus/internal/models"
 	"github.com/paralus/paralus/pkg/converter"
@@ -15,12 +17,20 @@ import (
 	"github.com/paralus/paralus/pkg/sentry/cryptoutil"
 	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
 	"github.com/paralus/paralus/proto/types/sentry"
+	"github.com/rs/xid"
 	"github.com/uptrace/bun"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 var KEKFunc cryptoutil.PasswordFunc
 
+// ErrBootstrapAgentPendingApproval is returned when the registration of
+// a bootstrap agent is awaiting approval
+var ErrBootstrapAgentPendingApproval = errors.New("bootstrap agent registration is pending approval")
+
 // BootstrapService is the interface for bootstrap operations
 type BootstrapService interface {
 	// bootstrap infra methods
@@ -43,16 +53,32 @@ type BootstrapService interface {
 	RegisterBootstrapAgent(ctx context.Context, token, ip, fingerprint string) error
 	DeleteBootstrapAgent(ctx context.Context, templateRef string, opts ...query.Option) error
 	PatchBootstrapAgent(ctx context.Context, ba *sentry.BootstrapAgent, templateRef string, opts ...query.Option) error
+	// bootstrap agent approval methods
+	SelectPendingBootstrapAgents(ctx context.Context, templateRef string, opts ...query.Option) (*sentry.BootstrapAgentList, error)
+	ApproveBootstrapAgent(ctx context.Context, templateRef string, opts ...query.Option) (*sentry.BootstrapAgent, error)
+	RejectBootstrapAgent(ctx context.Context, templateRef string, opts ...query.Option) (*sentry.BootstrapAgent, error)
+	ReissueBootstrapAgentToken(ctx context.Context, templateRef string, opts ...query.Option) (*sentry.BootstrapAgent, error)
+	ViewBootstrapAgentToken(ctx context.Context, templateRef, project string, render func(*sentry.BootstrapAgent) ([]byte, error), opts ...query.Option) ([]byte, error)
 }
 
 // bootstrapService implements BootstrapService
 type bootstrapService struct {
-	db *bun.DB
+	db       *bun.DB
+	al       *zap.Logger
+	tokenTTL time.Duration
+}
+
+// NewBootstrapService return new bootstrap service, tokens of agents
+// which have not registered within tokenTTL are invalid
+func NewBootstrapService(db *bun.DB, al *zap.Logger, tokenTTL time.Duration) BootstrapService {
+	return &bootstrapService{db: db, al: al, tokenTTL: tokenTTL}
 }
 
-// NewBootstrapService return new bootstrap service
-func NewBootstrapService(db *bun.DB) BootstrapService {
-	return &bootstrapService{db}
+func (s *bootstrapService) tokenExpiry() time.Time {
+	if s.tokenTTL <= 0 {
+		return time.Time{}
+	}
+	return time.Now().Add(s.tokenTTL)
 }
 
 func (s *bootstrapService) PatchBootstrapInfra(ctx context.Context, infra *sentry.BootstrapInfra) error {
@@ -85,6 +111,7 @@ func (s *bootstrapService) PatchBootstrapAgentTemplate(ctx context.Context, temp
 		OutofclusterTemplate:   template.Spec.OutOfClusterTemplate,
 		Token:                  template.Spec.Token,
 		Hosts:                  converter.ConvertToJsonRawMessage(template.Spec.Hosts),
+		Location:               converter.ConvertToJsonRawMessage(template.Spec.Location),
 		CreatedAt:              time.Now(),
 	}
 
@@ -133,6 +160,7 @@ func (s *bootstrapService) SelectBootstrapAgentTemplates(ctx context.Context, op
 func (s *bootstrapService) CreateBootstrapAgent(ctx context.Context, agent *sentry.BootstrapAgent) error {
 	ba := convertToAgentModel(agent)
 	ba.CreatedAt = time.Now()
+	ba.TokenExpiresAt = s.tokenExpiry()
 	return dao.CreateBootstrapAgent(ctx, s.db, ba)
 }
 
@@ -196,12 +224,21 @@ func prepareAgentResponse(agent *models.BootstrapAgent) *sentry.BootstrapAgent {
 			AgentMode:   sentry.BootstrapAgentMode(sentry.BootstrapAgentMode_value[agent.AgentMode]),
 		},
 		Status: &sentry.BootStrapAgentStatus{
-			TokenState:    sentry.BootstrapAgentState(sentry.BootstrapAgentMode_value[agent.TokenState]),
+			TokenState:    sentry.BootstrapAgentState(sentry.BootstrapAgentState_value[agent.TokenState]),
 			IpAddress:     agent.IPAddress,
 			LastCheckedIn: timestamppb.New(agent.LastCheckedIn),
 			Fingerprint:   agent.Fingerprint,
 		},
 	}
+	if !agent.TokenExpiresAt.IsZero() {
+		ba.Status.TokenExpiresAt = timestamppb.New(agent.TokenExpiresAt)
+	}
+	if !agent.TokenUsedAt.IsZero() {
+		ba.Status.TokenUsedAt = timestamppb.New(agent.TokenUsedAt)
+	}
+	if !agent.TokenViewedAt.IsZero() {
+		ba.Status.TokenViewedAt = timestamppb.New(agent.TokenViewedAt)
+	}
 	return ba
 }
 
@@ -244,6 +281,10 @@ func prepareTemplateResponse(template *models.BootstrapAgentTemplate) *sentry.Bo
 	if template.Hosts != nil {
 		json.Unmarshal(template.Hosts, &hosts)
 	}
+	var location *sentry.BootstrapTemplateLocation
+	if template.Location != nil {
+		json.Unmarshal(template.Location, &location)
+	}
 	templResp := sentry.BootstrapAgentTemplate{
 		Kind: "BootstrapAgentTemplate",
 		Metadata: &commonv3.Metadata{
@@ -263,6 +304,7 @@ func prepareTemplateResponse(template *models.BootstrapAgentTemplate) *sentry.Bo
 			Hosts:                  hosts,
 			InClusterTemplate:      template.InclusterTemplate,
 			OutOfClusterTemplate:   template.OutofclusterTemplate,
+			Location:               location,
 		},
 	}
 	return &templResp
@@ -325,10 +367,170 @@ func (s *bootstrapService) SelectBootstrapAgents(ctx context.Context, templateRe
 }
 
 func (s *bootstrapService) RegisterBootstrapAgent(ctx context.Context, token, ip, fingerprint string) error {
+	var state string
 	err := s.db.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
-		return dao.RegisterBootstrapAgent(ctx, tx, token, ip, fingerprint)
+		var err error
+		state, err = dao.RegisterBootstrapAgent(ctx, tx, token, ip, fingerprint)
+		return err
 	})
-	return err
+	if err != nil {
+		return err
+	}
+	if state == sentry.BootstrapAgentState_NotApproved.String() {
+		return ErrBootstrapAgentPendingApproval
+	}
+	return nil
+}
+
+func (s *bootstrapService) SelectPendingBootstrapAgents(ctx context.Context, templateRef string, opts ...query.Option) (ret *sentry.BootstrapAgentList, err error) {
+	queryOptions := &commonv3.QueryOptions{}
+	for _, opt := range opts {
+		opt(queryOptions)
+	}
+
+	agl, count, err := dao.SelectPendingBootstrapAgents(ctx, s.db, templateRef, queryOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	ret = new(sentry.BootstrapAgentList)
+	ret.Metadata = &commonv3.ListMetadata{
+		Count: int64(count),
+	}
+	for _, ag := range agl {
+		ret.Items = append(ret.Items, prepareAgentResponse(&ag))
+	}
+
+	return
+}
+
+// agentProject returns the name of the project of the agent for audit
+// events, agents not bound to a project have none
+func (s *bootstrapService) agentProject(ctx context.Context, ba *models.BootstrapAgent) string {
+	if ba.ProjectId == uuid.Nil {
+		return ""
+	}
+	entity, err := dao.GetNameById(ctx, s.db, ba.ProjectId, &models.Project{})
+	if err != nil {
+		return ""
+	}
+	if p, ok := entity.(*models.Project); ok {
+		return p.Name
+	}
+	return ""
+}
+
+func (s *bootstrapService) updateBootstrapAgentState(ctx context.Context, templateRef string, state sentry.BootstrapAgentState, action string, opts ...query.Option) (*sentry.BootstrapAgent, error) {
+	queryOptions := &commonv3.QueryOptions{}
+	for _, opt := range opts {
+		opt(queryOptions)
+	}
+
+	ba, err := dao.GetBootstrapAgent(ctx, s.db, templateRef, queryOptions)
+	if err != nil {
+		return nil, err
+	}
+	err = dao.UpdateBootstrapAgentState(ctx, s.db, ba, state.String())
+	if err != nil {
+		return nil, err
+	}
+	CreateBootstrapAgentAuditEvent(ctx, s.al, action, ba, s.agentProject(ctx, ba))
+
+	return prepareAgentResponse(ba), nil
+}
+
+func (s *bootstrapService) ApproveBootstrapAgent(ctx context.Context, templateRef string, opts ...query.Option) (*sentry.BootstrapAgent, error) {
+	return s.updateBootstrapAgentState(ctx, templateRef, sentry.BootstrapAgentState_Approved, AuditActionApprove, opts...)
+}
+
+func (s *bootstrapService) RejectBootstrapAgent(ctx context.Context, templateRef string, opts ...query.Option) (*sentry.BootstrapAgent, error) {
+	return s.updateBootstrapAgentState(ctx, templateRef, sentry.BootstrapAgentState_Rejected, AuditActionReject, opts...)
+}
+
+func (s *bootstrapService) ReissueBootstrapAgentToken(ctx context.Context, templateRef string, opts ...query.Option) (*sentry.BootstrapAgent, error) {
+	queryOptions := &commonv3.QueryOptions{}
+	for _, opt := range opts {
+		opt(queryOptions)
+	}
+
+	ba, err := dao.GetBootstrapAgent(ctx, s.db, templateRef, queryOptions)
+	if err != nil {
+		return nil, err
+	}
+	err = dao.ReissueBootstrapAgentToken(ctx, s.db, ba, xid.New().String(), s.tokenExpiry())
+	if err != nil {
+		return nil, err
+	}
+	CreateBootstrapAgentAuditEvent(ctx, s.al, AuditActionReissue, ba, s.agentProject(ctx, ba))
+
+	return prepareAgentResponse(ba), nil
+}
+
+// agentInProject returns whether the agent belongs to the project, the
+// agents of clusters belong to the projects the cluster is in
+func (s *bootstrapService) agentInProject(ctx context.Context, ba *models.BootstrapAgent, projectID uuid.UUID) (bool, error) {
+	if ba.ProjectId == projectID {
+		return true, nil
+	}
+	clusterID, err := uuid.Parse(ba.Name)
+	if err != nil {
+		return false, nil
+	}
+	projects, err := cdao.GetProjectsForCluster(ctx, s.db, clusterID)
+	if err != nil {
+		return false, err
+	}
+	for _, p := range projects {
+		if p.ProjectID == projectID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// ViewBootstrapAgentToken renders the agent of the project with its
+// token. The token can be viewed only once, it has to be reissued to
+// be viewed again. It is recorded as viewed only when the agent has
+// been rendered.
+func (s *bootstrapService) ViewBootstrapAgentToken(ctx context.Context, templateRef, project string, render func(*sentry.BootstrapAgent) ([]byte, error), opts ...query.Option) ([]byte, error) {
+	queryOptions := &commonv3.QueryOptions{}
+	for _, opt := range opts {
+		opt(queryOptions)
+	}
+	if project == "" {
+		return nil, status.Error(codes.InvalidArgument, "project is missing")
+	}
+	var proj models.Project
+	if _, err := dao.GetByName(ctx, s.db, project, &proj); err != nil {
+		return nil, err
+	}
+	queryOptions.Project = ""
+
+	ba, err := dao.GetBootstrapAgent(ctx, s.db, templateRef, queryOptions)
+	if err != nil {
+		return nil, err
+	}
+	ok, err := s.agentInProject(ctx, ba, proj.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	if !ba.TokenViewedAt.IsZero() {
+		return nil, status.Errorf(codes.FailedPrecondition, "token of bootstrap agent %s has already been viewed, reissue the token to view it again", ba.Name)
+	}
+
+	b, err := render(prepareAgentResponse(ba))
+	if err != nil {
+		return nil, err
+	}
+	if err := dao.MarkBootstrapAgentTokenViewed(ctx, s.db, ba); err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "%s, reissue the token to view it again", err)
+	}
+	CreateBootstrapAgentAuditEvent(ctx, s.al, AuditActionDownload, ba, proj.Name)
+
+	return b, nil
 }
 
 func (s *bootstrapService) DeleteBootstrapAgent(ctx context.Context, templateRef string, opts ...query.Option) error {
@@ -373,6 +575,9 @@ func (s *bootstrapService) PatchBootstrapAgent(ctx context.Context, ba *sentry.B
 		}
 		bdb.ModifiedAt = time.Now()
 		bdb.DisplayName = ba.Metadata.DisplayName
+		if ba.Metadata.Labels != nil {
+			bdb.Labels = converter.ConvertToJsonRawMessage(ba.Metadata.Labels)
+		}
 		return dao.UpdateBootstrapAgent(ctx, s.db, bdb, queryOptions)
 	})
 	return err
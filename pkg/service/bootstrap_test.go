@@ -0,0 +1,359 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/pkg/query"
+	v3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	"github.com/paralus/paralus/proto/types/sentry"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var bootstrapAgentColumns = []string{"id", "name", "template_ref", "token", "token_state", "fingerprint", "ip_address", "token_expires_at", "token_used_at"}
+
+func addBootstrapTemplateFetchExpectation(mock sqlmock.Sqlmock, template string, autoApprove, ignoreMultipleRegister bool) {
+	mock.ExpectQuery(`SELECT "bat"."name", .* FROM "sentry_bootstrap_agent_template" AS "bat" WHERE .name = '` + template + `'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"name", "auto_approve", "ignore_multiple_register"}).AddRow(template, autoApprove, ignoreMultipleRegister))
+}
+
+func TestRegisterBootstrapAgentPendingApproval(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	bs := NewBootstrapService(db, getLogger(), time.Hour)
+
+	bauuid := uuid.New().String()
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT "ba"."id", .* FROM "sentry_bootstrap_agent" AS "ba" WHERE .token = 'token-` + bauuid + `'.`).
+		WillReturnRows(sqlmock.NewRows(bootstrapAgentColumns).
+			AddRow(bauuid, "agent-"+bauuid, "template", "token-"+bauuid, "NotRegistered", "", "", time.Now().Add(time.Hour), nil))
+	addBootstrapTemplateFetchExpectation(mock, "template", false, false)
+	mock.ExpectExec(`UPDATE "sentry_bootstrap_agent" AS "ba" SET token_state = 'NotApproved', fingerprint = 'fp', ip_address = '10.0.0.1' WHERE .token = 'token-` + bauuid + `'.`).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	err := bs.RegisterBootstrapAgent(context.Background(), "token-"+bauuid, "10.0.0.1", "fp")
+	if err != ErrBootstrapAgentPendingApproval {
+		t.Fatalf("registration should be pending approval; got %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRegisterBootstrapAgentExpiredToken(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	bs := NewBootstrapService(db, getLogger(), time.Hour)
+
+	bauuid := uuid.New().String()
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT "ba"."id", .* FROM "sentry_bootstrap_agent" AS "ba" WHERE .token = 'token-` + bauuid + `'.`).
+		WillReturnRows(sqlmock.NewRows(bootstrapAgentColumns).
+			AddRow(bauuid, "agent-"+bauuid, "template", "token-"+bauuid, "NotRegistered", "", "", time.Now().Add(-time.Minute), nil))
+	addBootstrapTemplateFetchExpectation(mock, "template", true, true)
+	mock.ExpectRollback()
+
+	err := bs.RegisterBootstrapAgent(context.Background(), "token-"+bauuid, "10.0.0.1", "fp")
+	if err == nil {
+		t.Fatal("registration with expired token should fail")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRegisterBootstrapAgentTokenReuse(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	bs := NewBootstrapService(db, getLogger(), time.Hour)
+
+	bauuid := uuid.New().String()
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT "ba"."id", .* FROM "sentry_bootstrap_agent" AS "ba" WHERE .token = 'token-` + bauuid + `'.`).
+		WillReturnRows(sqlmock.NewRows(bootstrapAgentColumns).
+			AddRow(bauuid, "agent-"+bauuid, "template", "token-"+bauuid, "Approved", "fp", "10.0.0.1", nil, time.Now().Add(-time.Minute)))
+	addBootstrapTemplateFetchExpectation(mock, "template", true, false)
+	mock.ExpectRollback()
+
+	err := bs.RegisterBootstrapAgent(context.Background(), "token-"+bauuid, "10.0.0.1", "fp")
+	if err == nil {
+		t.Fatal("registration with used token should fail")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRegisterBootstrapAgentApproved(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	bs := NewBootstrapService(db, getLogger(), time.Hour)
+
+	bauuid := uuid.New().String()
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT "ba"."id", .* FROM "sentry_bootstrap_agent" AS "ba" WHERE .token = 'token-` + bauuid + `'.`).
+		WillReturnRows(sqlmock.NewRows(bootstrapAgentColumns).
+			AddRow(bauuid, "agent-"+bauuid, "template", "token-"+bauuid, "Approved", "fp", "10.0.0.1", nil, nil))
+	addBootstrapTemplateFetchExpectation(mock, "template", false, false)
+	mock.ExpectExec(`UPDATE "sentry_bootstrap_agent" AS "ba" SET token_state = 'Approved', fingerprint = 'fp', ip_address = '10.0.0.1', token_used_at = .* WHERE .token = 'token-` + bauuid + `'.`).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	err := bs.RegisterBootstrapAgent(context.Background(), "token-"+bauuid, "10.0.0.1", "fp")
+	if err != nil {
+		t.Fatal("could not register approved agent:", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSelectPendingBootstrapAgents(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	bs := NewBootstrapService(db, getLogger(), time.Hour)
+
+	bauuid := uuid.New().String()
+	mock.ExpectQuery(`SELECT "ba"."id", .* FROM "sentry_bootstrap_agent" AS "ba" WHERE .*.token_state = 'NotApproved'.`).
+		WillReturnRows(sqlmock.NewRows(bootstrapAgentColumns).
+			AddRow(bauuid, "agent-"+bauuid, "template", "token-"+bauuid, "NotApproved", "fp", "10.0.0.1", nil, nil))
+	mock.ExpectQuery(`SELECT count\(\*\) FROM "sentry_bootstrap_agent" AS "ba" WHERE .*.token_state = 'NotApproved'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+
+	bal, err := bs.SelectPendingBootstrapAgents(context.Background(), "-", query.WithOptions(&v3.QueryOptions{GlobalScope: true}))
+	if err != nil {
+		t.Fatal("could not list pending agents:", err)
+	}
+	if len(bal.Items) != 1 {
+		t.Fatalf("expected 1 pending agent; got %d", len(bal.Items))
+	}
+	st := bal.Items[0].Status
+	if st.TokenState != sentry.BootstrapAgentState_NotApproved || st.IpAddress != "10.0.0.1" || st.Fingerprint != "fp" {
+		t.Errorf("invalid pending agent status; got %v", st)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestApproveBootstrapAgent(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	bs := NewBootstrapService(db, getLogger(), time.Hour)
+
+	bauuid := uuid.New().String()
+	mock.ExpectQuery(`SELECT "ba"."id", .* FROM "sentry_bootstrap_agent" AS "ba" WHERE .*name = 'agent-` + bauuid + `'.* AND .template_ref = 'template'.`).
+		WillReturnRows(sqlmock.NewRows(bootstrapAgentColumns).
+			AddRow(bauuid, "agent-"+bauuid, "template", "token-"+bauuid, "NotApproved", "fp", "10.0.0.1", nil, nil))
+	mock.ExpectQuery(`UPDATE "sentry_bootstrap_agent" AS "ba" SET token_state = 'Approved', modified_at = .* WHERE .id = '` + bauuid + `'. AND .token_state = 'NotApproved'. RETURNING`).
+		WillReturnRows(sqlmock.NewRows(bootstrapAgentColumns).
+			AddRow(bauuid, "agent-"+bauuid, "template", "token-"+bauuid, "Approved", "fp", "10.0.0.1", nil, nil))
+
+	ba, err := bs.ApproveBootstrapAgent(context.Background(), "template", query.WithMeta(&v3.Metadata{Name: "agent-" + bauuid}), query.WithGlobalScope())
+	if err != nil {
+		t.Fatal("could not approve agent:", err)
+	}
+	if ba.Status.TokenState != sentry.BootstrapAgentState_Approved {
+		t.Errorf("agent should be approved; got %v", ba.Status.TokenState)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestReissueBootstrapAgentToken(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	bs := NewBootstrapService(db, getLogger(), time.Hour)
+
+	bauuid := uuid.New().String()
+	mock.ExpectQuery(`SELECT "ba"."id", .* FROM "sentry_bootstrap_agent" AS "ba" WHERE .*name = 'agent-` + bauuid + `'.`).
+		WillReturnRows(sqlmock.NewRows(bootstrapAgentColumns).
+			AddRow(bauuid, "agent-"+bauuid, "template", "token-"+bauuid, "Rejected", "fp", "10.0.0.1", nil, nil))
+	mock.ExpectQuery(`UPDATE "sentry_bootstrap_agent" AS "ba" SET token = '.*', token_state = 'NotRegistered', fingerprint = '', ip_address = '', token_used_at = NULL, token_viewed_at = NULL, modified_at = .*, token_expires_at = .* WHERE .id = '` + bauuid + `'. RETURNING`).
+		WillReturnRows(sqlmock.NewRows(bootstrapAgentColumns).
+			AddRow(bauuid, "agent-"+bauuid, "template", "token-new", "NotRegistered", "", "", time.Now().Add(time.Hour), nil))
+
+	ba, err := bs.ReissueBootstrapAgentToken(context.Background(), "template", query.WithMeta(&v3.Metadata{Name: "agent-" + bauuid}), query.WithGlobalScope())
+	if err != nil {
+		t.Fatal("could not reissue token:", err)
+	}
+	if ba.Spec.Token != "token-new" || ba.Status.TokenState != sentry.BootstrapAgentState_NotRegistered {
+		t.Errorf("token should be reissued; got %v %v", ba.Spec.Token, ba.Status.TokenState)
+	}
+	if ba.Status.TokenExpiresAt == nil {
+		t.Error("reissued token should expire")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func renderAgentToken(ba *sentry.BootstrapAgent) ([]byte, error) {
+	return []byte(ba.Spec.Token), nil
+}
+
+func expectViewedAgent(mock sqlmock.Sqlmock, puuid, bauuid string, viewedAt interface{}) []string {
+	columns := append([]string{"project_id", "token_viewed_at"}, bootstrapAgentColumns...)
+	mock.ExpectQuery(`SELECT "project"."id", .* FROM "authsrv_project" AS "project" WHERE .name = 'project-1'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(puuid, "project-1"))
+	mock.ExpectQuery(`SELECT "ba"."id", .* FROM "sentry_bootstrap_agent" AS "ba" WHERE .*name = 'agent-` + bauuid + `'.* AND .template_ref = 'template'.`).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow(puuid, viewedAt, bauuid, "agent-"+bauuid, "template", "token-"+bauuid, "NotRegistered", "", "", nil, nil))
+	return columns
+}
+
+func TestViewBootstrapAgentToken(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	bs := NewBootstrapService(db, getLogger(), time.Hour)
+
+	puuid := uuid.New().String()
+	bauuid := uuid.New().String()
+	columns := expectViewedAgent(mock, puuid, bauuid, nil)
+	mock.ExpectQuery(`UPDATE "sentry_bootstrap_agent" AS "ba" SET token_viewed_at = .* WHERE .id = '` + bauuid + `'. AND .token_viewed_at IS NULL. RETURNING`).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow(puuid, time.Now(), bauuid, "agent-"+bauuid, "template", "token-"+bauuid, "NotRegistered", "", "", nil, nil))
+
+	b, err := bs.ViewBootstrapAgentToken(context.Background(), "template", "project-1", renderAgentToken, query.WithMeta(&v3.Metadata{Name: "agent-" + bauuid, Project: "project-1"}))
+	if err != nil {
+		t.Fatal("could not view token:", err)
+	}
+	if string(b) != "token-"+bauuid {
+		t.Errorf("expected token of agent, got %s", b)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestViewBootstrapAgentTokenViewed(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	bs := NewBootstrapService(db, getLogger(), time.Hour)
+
+	bauuid := uuid.New().String()
+	expectViewedAgent(mock, uuid.New().String(), bauuid, time.Now())
+
+	_, err := bs.ViewBootstrapAgentToken(context.Background(), "template", "project-1", renderAgentToken, query.WithMeta(&v3.Metadata{Name: "agent-" + bauuid, Project: "project-1"}))
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("expected failed precondition for token already viewed, got %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestViewBootstrapAgentTokenViewedConcurrently(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	bs := NewBootstrapService(db, getLogger(), time.Hour)
+
+	puuid := uuid.New().String()
+	bauuid := uuid.New().String()
+	columns := expectViewedAgent(mock, puuid, bauuid, nil)
+	mock.ExpectQuery(`UPDATE "sentry_bootstrap_agent" AS "ba" SET token_viewed_at = .* WHERE .id = '` + bauuid + `'. AND .token_viewed_at IS NULL. RETURNING`).
+		WillReturnRows(sqlmock.NewRows(columns))
+
+	_, err := bs.ViewBootstrapAgentToken(context.Background(), "template", "project-1", renderAgentToken, query.WithMeta(&v3.Metadata{Name: "agent-" + bauuid, Project: "project-1"}))
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("expected failed precondition for token viewed by another request, got %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestViewBootstrapAgentTokenRenderFailure(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	bs := NewBootstrapService(db, getLogger(), time.Hour)
+
+	bauuid := uuid.New().String()
+	expectViewedAgent(mock, uuid.New().String(), bauuid, nil)
+
+	render := func(*sentry.BootstrapAgent) ([]byte, error) { return nil, errors.New("render failed") }
+	_, err := bs.ViewBootstrapAgentToken(context.Background(), "template", "project-1", render, query.WithMeta(&v3.Metadata{Name: "agent-" + bauuid, Project: "project-1"}))
+	if err == nil {
+		t.Error("expected render failure")
+	}
+	// the token is not recorded as viewed
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestViewBootstrapAgentTokenOtherProject(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	bs := NewBootstrapService(db, getLogger(), time.Hour)
+
+	bauuid := uuid.New().String()
+	columns := append([]string{"project_id"}, bootstrapAgentColumns...)
+	mock.ExpectQuery(`SELECT "project"."id", .* FROM "authsrv_project" AS "project" WHERE .name = 'project-1'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(uuid.New().String(), "project-1"))
+	mock.ExpectQuery(`SELECT "ba"."id", .* FROM "sentry_bootstrap_agent" AS "ba" WHERE .*name = 'agent-` + bauuid + `'.`).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow(uuid.New().String(), bauuid, "agent-"+bauuid, "template", "token-"+bauuid, "NotRegistered", "", "", nil, nil))
+
+	_, err := bs.ViewBootstrapAgentToken(context.Background(), "template", "project-1", renderAgentToken, query.WithMeta(&v3.Metadata{Name: "agent-" + bauuid, Project: "project-1"}))
+	if err != sql.ErrNoRows {
+		t.Errorf("expected agent of another project not to be found, got %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetBootstrapAgentTokenViewed(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	bs := NewBootstrapService(db, getLogger(), time.Hour)
+
+	bauuid := uuid.New().String()
+	columns := append([]string{"token_viewed_at"}, bootstrapAgentColumns...)
+	mock.ExpectQuery(`SELECT "ba"."id", .* FROM "sentry_bootstrap_agent" AS "ba" WHERE .*name = 'agent-` + bauuid + `'.* AND .template_ref = 'template'.`).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow(time.Now(), bauuid, "agent-"+bauuid, "template", "token-"+bauuid, "NotRegistered", "", "", nil, nil))
+	mock.ExpectQuery(`SELECT "ba"."id", .* FROM "sentry_bootstrap_agent" AS "ba" WHERE .*name = 'agent-` + bauuid + `'.* AND .template_ref = 'template'.`).
+		WillReturnRows(sqlmock.NewRows(bootstrapAgentColumns).
+			AddRow(bauuid, "agent-"+bauuid, "template", "token-"+bauuid, "NotRegistered", "", "", nil, nil))
+
+	ba, err := bs.GetBootstrapAgent(context.Background(), "template", query.WithMeta(&v3.Metadata{Name: "agent-" + bauuid}))
+	if err != nil {
+		t.Fatal("could not get agent:", err)
+	}
+	if ba.Status.TokenViewedAt == nil {
+		t.Error("expected agent whose token was viewed to have the time of the view")
+	}
+	ba, err = bs.GetBootstrapAgent(context.Background(), "template", query.WithMeta(&v3.Metadata{Name: "agent-" + bauuid}))
+	if err != nil {
+		t.Fatal("could not get agent:", err)
+	}
+	if ba.Status.TokenViewedAt != nil {
+		t.Errorf("expected agent whose token was not viewed to have no time of view, got %v", ba.Status.TokenViewedAt)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
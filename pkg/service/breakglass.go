@@ -0,0 +1,493 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/dao"
+	"github.com/paralus/paralus/internal/models"
+	systemrpc "github.com/paralus/paralus/proto/rpc/system"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	"github.com/paralus/paralus/proto/types/sentry"
+	systemv3 "github.com/paralus/paralus/proto/types/systempb/v3"
+	bun "github.com/uptrace/bun"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	breakGlassKind     = "BreakGlassAccount"
+	breakGlassListKind = "BreakGlassAccountList"
+
+	// breakGlassShareSize is the size of each credential share in bytes
+	breakGlassShareSize             = 32
+	maxBreakGlassCustodians         = 16
+	defaultBreakGlassAccessDuration = time.Hour
+	maxBreakGlassAccessDuration     = 24 * time.Hour
+	breakGlassNotifyTimeout         = 10 * time.Second
+)
+
+// ErrBreakGlassDenied is returned when a break-glass account can not be
+// unsealed, the cause is only recorded in the audit trail
+var ErrBreakGlassDenied = errors.New("break-glass denied")
+
+// BreakGlassService is the interface for emergency access accounts
+type BreakGlassService interface {
+	// create break-glass account, the credential shares are only returned here
+	Create(context.Context, *systemv3.BreakGlassAccount) (*systemv3.BreakGlassAccount, error)
+	// get break-glass account by name
+	GetByName(context.Context, *systemv3.BreakGlassAccount) (*systemv3.BreakGlassAccount, error)
+	// list break-glass accounts of the organization
+	List(context.Context, *systemv3.BreakGlassAccount) (*systemv3.BreakGlassAccountList, error)
+	// delete break-glass account
+	Delete(context.Context, *systemv3.BreakGlassAccount) error
+	// replace the credential of the break-glass account
+	Rotate(context.Context, *systemv3.BreakGlassAccount) (*systemv3.BreakGlassAccount, error)
+	// unseal the break-glass account, returns the session of its user
+	// and the time its access expires
+	Unseal(context.Context, *systemrpc.BreakGlassRequest, *commonv3.SessionData) (*commonv3.SessionData, time.Time, error)
+	// revoke the kubeconfig of break-glass accounts whose access has expired
+	ExpireAccess(context.Context) error
+}
+
+// breakGlassService implements BreakGlassService
+type breakGlassService struct {
+	db  *bun.DB
+	krs KubeconfigRevocationService
+	al  *zap.Logger
+	hc  *http.Client
+}
+
+// NewBreakGlassService return new break-glass service
+func NewBreakGlassService(db *bun.DB, krs KubeconfigRevocationService, al *zap.Logger) BreakGlassService {
+	return &breakGlassService{db: db, krs: krs, al: al, hc: &http.Client{Timeout: breakGlassNotifyTimeout}}
+}
+
+// sealBreakGlassSecret generates a credential split among the
+// custodians, every share is needed to recover it. Only the hash of
+// the credential is stored.
+func sealBreakGlassSecret(custodians int) ([]string, string, error) {
+	secret := make([]byte, breakGlassShareSize)
+	shares := make([]string, custodians)
+	for i := range shares {
+		share := make([]byte, breakGlassShareSize)
+		if _, err := rand.Read(share); err != nil {
+			return nil, "", err
+		}
+		for j := range secret {
+			secret[j] ^= share[j]
+		}
+		shares[i] = base64.StdEncoding.EncodeToString(share)
+	}
+	return shares, hashBreakGlassSecret(secret), nil
+}
+
+// unsealBreakGlassSecret recovers the hash of the credential from the shares
+func unsealBreakGlassSecret(shares []string) (string, error) {
+	secret := make([]byte, breakGlassShareSize)
+	for _, s := range shares {
+		share, err := base64.StdEncoding.DecodeString(s)
+		if err != nil || len(share) != breakGlassShareSize {
+			return "", fmt.Errorf("invalid share")
+		}
+		for j := range secret {
+			secret[j] ^= share[j]
+		}
+	}
+	return hashBreakGlassSecret(secret), nil
+}
+
+func hashBreakGlassSecret(secret []byte) string {
+	sum := sha256.Sum256(secret)
+	return hex.EncodeToString(sum[:])
+}
+
+func validateWebhookURL(rawURL string) error {
+	if rawURL == "" {
+		return nil
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid notify webhook url")
+	}
+	return nil
+}
+
+func (s *breakGlassService) getPartnerOrganization(ctx context.Context, partner, org string) (uuid.UUID, uuid.UUID, error) {
+	partnerId, err := dao.GetPartnerId(ctx, s.db, partner)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("unable to find partner '%v'", partner)
+	}
+	orgId, err := dao.GetOrganizationId(ctx, s.db, org)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("unable to find organization '%v'", org)
+	}
+	return partnerId, orgId, nil
+}
+
+func (s *breakGlassService) get(ctx context.Context, name string, partnerId, orgId uuid.UUID) (*models.BreakGlassAccount, error) {
+	var ba models.BreakGlassAccount
+	_, err := dao.GetByNamePartnerOrg(ctx, s.db, name,
+		uuid.NullUUID{UUID: partnerId, Valid: true},
+		uuid.NullUUID{UUID: orgId, Valid: true},
+		&ba,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &ba, nil
+}
+
+// accountUsername returns the username of the account of the break-glass account
+func (s *breakGlassService) accountUsername(ctx context.Context, ba *models.BreakGlassAccount) string {
+	var identity models.KratosIdentities
+	_, err := dao.GetM(ctx, s.db, map[string]interface{}{"id": ba.AccountId}, &identity)
+	if err != nil {
+		return ""
+	}
+	return getUserTraits(identity.Traits).Email
+}
+
+func (s *breakGlassService) toBreakGlassAccount(ba *models.BreakGlassAccount, partner, org, user string) *systemv3.BreakGlassAccount {
+	res := &systemv3.BreakGlassAccount{
+		ApiVersion: apiVersion,
+		Kind:       breakGlassKind,
+		Metadata: &commonv3.Metadata{
+			Name:         ba.Name,
+			Description:  ba.Description,
+			Id:           ba.ID.String(),
+			Partner:      partner,
+			Organization: org,
+			CreatedAt:    timestamppb.New(ba.CreatedAt),
+			ModifiedAt:   timestamppb.New(ba.ModifiedAt),
+		},
+		Spec: &systemv3.BreakGlassAccountSpec{
+			User:              user,
+			Custodians:        int32(ba.Custodians),
+			AccessDurationMin: int32(ba.AccessDurationMin),
+			NotifyWebhookUrl:  ba.NotifyWebhookURL,
+		},
+		Status: &systemv3.BreakGlassAccountStatus{
+			RotationRequired: ba.RotationRequired,
+		},
+	}
+	if !ba.LastUsedAt.IsZero() {
+		res.Status.LastUsedAt = timestamppb.New(ba.LastUsedAt)
+	}
+	if !ba.ActiveUntil.IsZero() {
+		res.Status.ActiveUntil = timestamppb.New(ba.ActiveUntil)
+	}
+	return res
+}
+
+func (s *breakGlassService) Create(ctx context.Context, bga *systemv3.BreakGlassAccount) (*systemv3.BreakGlassAccount, error) {
+	name := bga.GetMetadata().GetName()
+	if name == "" {
+		return nil, fmt.Errorf("empty name for break-glass account")
+	}
+	partnerId, orgId, err := s.getPartnerOrganization(ctx, bga.GetMetadata().GetPartner(), bga.GetMetadata().GetOrganization())
+	if err != nil {
+		return nil, err
+	}
+	if existing, _ := s.get(ctx, name, partnerId, orgId); existing != nil {
+		return nil, fmt.Errorf("break-glass account '%v' already exists", name)
+	}
+
+	custodians := int(bga.GetSpec().GetCustodians())
+	if custodians == 0 {
+		custodians = 1
+	}
+	if custodians < 1 || custodians > maxBreakGlassCustodians {
+		return nil, fmt.Errorf("custodians should be between 1 and %d", maxBreakGlassCustodians)
+	}
+	duration := time.Duration(bga.GetSpec().GetAccessDurationMin()) * time.Minute
+	if duration == 0 {
+		duration = defaultBreakGlassAccessDuration
+	}
+	if duration < 0 || duration > maxBreakGlassAccessDuration {
+		return nil, fmt.Errorf("access duration should be at most %v", maxBreakGlassAccessDuration)
+	}
+	if err := validateWebhookURL(bga.GetSpec().GetNotifyWebhookUrl()); err != nil {
+		return nil, err
+	}
+
+	user := bga.GetSpec().GetUser()
+	identity := &models.KratosIdentities{}
+	_, err = dao.GetUserByEmail(ctx, s.db, user, identity)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find user '%v'", user)
+	}
+	if identityOrganization(identity) != orgId.String() {
+		return nil, fmt.Errorf("user '%v' does not belong to the organization", user)
+	}
+	// break-glass access is meant to reach every cluster of the organization
+	aps, err := dao.GetAccountProjectsByPermission(ctx, s.db, identity.ID, orgId, partnerId, sentry.KubeconfigReadPermission)
+	if err != nil {
+		return nil, err
+	}
+	orgScope := false
+	for _, ap := range aps {
+		if ap.Scope == "organization" {
+			orgScope = true
+		}
+	}
+	if !orgScope {
+		return nil, fmt.Errorf("user '%v' does not have organization wide kubeconfig access", user)
+	}
+
+	shares, hash, err := sealBreakGlassSecret(custodians)
+	if err != nil {
+		return nil, err
+	}
+	ba := &models.BreakGlassAccount{
+		Name:              name,
+		Description:       bga.GetMetadata().GetDescription(),
+		AccountId:         identity.ID,
+		OrganizationId:    orgId,
+		PartnerId:         partnerId,
+		SecretHash:        hash,
+		Custodians:        custodians,
+		AccessDurationMin: int(duration / time.Minute),
+		NotifyWebhookURL:  bga.GetSpec().GetNotifyWebhookUrl(),
+		CreatedAt:         time.Now(),
+		ModifiedAt:        time.Now(),
+	}
+	_, err = dao.Create(ctx, s.db, ba)
+	if err != nil {
+		return nil, err
+	}
+
+	CreateBreakGlassAccountAuditEvent(ctx, s.al, AuditActionCreate, name, user)
+	res := s.toBreakGlassAccount(ba, bga.GetMetadata().GetPartner(), bga.GetMetadata().GetOrganization(), user)
+	res.Status.Shares = shares
+	return res, nil
+}
+
+func (s *breakGlassService) GetByName(ctx context.Context, bga *systemv3.BreakGlassAccount) (*systemv3.BreakGlassAccount, error) {
+	partnerId, orgId, err := s.getPartnerOrganization(ctx, bga.GetMetadata().GetPartner(), bga.GetMetadata().GetOrganization())
+	if err != nil {
+		return nil, err
+	}
+	ba, err := s.get(ctx, bga.GetMetadata().GetName(), partnerId, orgId)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find break-glass account '%v'", bga.GetMetadata().GetName())
+	}
+	return s.toBreakGlassAccount(ba, bga.GetMetadata().GetPartner(), bga.GetMetadata().GetOrganization(), s.accountUsername(ctx, ba)), nil
+}
+
+func (s *breakGlassService) List(ctx context.Context, bga *systemv3.BreakGlassAccount) (*systemv3.BreakGlassAccountList, error) {
+	partnerId, orgId, err := s.getPartnerOrganization(ctx, bga.GetMetadata().GetPartner(), bga.GetMetadata().GetOrganization())
+	if err != nil {
+		return nil, err
+	}
+	var bas []models.BreakGlassAccount
+	_, err = dao.List(ctx, s.db, uuid.NullUUID{UUID: partnerId, Valid: true}, uuid.NullUUID{UUID: orgId, Valid: true}, &bas)
+	if err != nil {
+		return nil, err
+	}
+	items := []*systemv3.BreakGlassAccount{}
+	for i := range bas {
+		items = append(items, s.toBreakGlassAccount(&bas[i], bga.GetMetadata().GetPartner(), bga.GetMetadata().GetOrganization(), s.accountUsername(ctx, &bas[i])))
+	}
+	return &systemv3.BreakGlassAccountList{
+		ApiVersion: apiVersion,
+		Kind:       breakGlassListKind,
+		Metadata:   &commonv3.ListMetadata{Count: int64(len(items))},
+		Items:      items,
+	}, nil
+}
+
+func (s *breakGlassService) Delete(ctx context.Context, bga *systemv3.BreakGlassAccount) error {
+	partnerId, orgId, err := s.getPartnerOrganization(ctx, bga.GetMetadata().GetPartner(), bga.GetMetadata().GetOrganization())
+	if err != nil {
+		return err
+	}
+	ba, err := s.get(ctx, bga.GetMetadata().GetName(), partnerId, orgId)
+	if err != nil {
+		return fmt.Errorf("unable to find break-glass account '%v'", bga.GetMetadata().GetName())
+	}
+	err = dao.Delete(ctx, s.db, ba.ID, &models.BreakGlassAccount{})
+	if err != nil {
+		return err
+	}
+	CreateBreakGlassAccountAuditEvent(ctx, s.al, AuditActionDelete, ba.Name, s.accountUsername(ctx, ba))
+	return nil
+}
+
+func (s *breakGlassService) Rotate(ctx context.Context, bga *systemv3.BreakGlassAccount) (*systemv3.BreakGlassAccount, error) {
+	partnerId, orgId, err := s.getPartnerOrganization(ctx, bga.GetMetadata().GetPartner(), bga.GetMetadata().GetOrganization())
+	if err != nil {
+		return nil, err
+	}
+	ba, err := s.get(ctx, bga.GetMetadata().GetName(), partnerId, orgId)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find break-glass account '%v'", bga.GetMetadata().GetName())
+	}
+	// the number of custodians can change on rotation
+	if custodians := int(bga.GetSpec().GetCustodians()); custodians != 0 {
+		if custodians < 1 || custodians > maxBreakGlassCustodians {
+			return nil, fmt.Errorf("custodians should be between 1 and %d", maxBreakGlassCustodians)
+		}
+		ba.Custodians = custodians
+	}
+	shares, hash, err := sealBreakGlassSecret(ba.Custodians)
+	if err != nil {
+		return nil, err
+	}
+	ba.SecretHash = hash
+	ba.RotationRequired = false
+	ba.ModifiedAt = time.Now()
+	err = dao.RotateBreakGlassSecret(ctx, s.db, ba)
+	if err != nil {
+		return nil, err
+	}
+
+	user := s.accountUsername(ctx, ba)
+	CreateBreakGlassAccountAuditEvent(ctx, s.al, AuditActionRotate, ba.Name, user)
+	res := s.toBreakGlassAccount(ba, bga.GetMetadata().GetPartner(), bga.GetMetadata().GetOrganization(), user)
+	res.Status.Shares = shares
+	return res, nil
+}
+
+func (s *breakGlassService) Unseal(ctx context.Context, req *systemrpc.BreakGlassRequest, client *commonv3.SessionData) (*commonv3.SessionData, time.Time, error) {
+	sd := &commonv3.SessionData{
+		ClientIp:   client.GetClientIp(),
+		ClientHost: client.GetClientHost(),
+		ClientUa:   client.GetClientUa(),
+	}
+	deny := func(cause string) (*commonv3.SessionData, time.Time, error) {
+		CreateBreakGlassFailedAuditEvent(s.al, sd, req.GetName(), req.GetReason(), cause)
+		return nil, time.Time{}, ErrBreakGlassDenied
+	}
+
+	if req.GetReason() == "" {
+		return nil, time.Time{}, fmt.Errorf("reason is required to break glass")
+	}
+	partnerId, orgId, err := s.getPartnerOrganization(ctx, req.GetPartner(), req.GetOrganization())
+	if err != nil {
+		return deny(err.Error())
+	}
+	ba, err := s.get(ctx, req.GetName(), partnerId, orgId)
+	if err != nil {
+		return deny("unknown break-glass account")
+	}
+	if len(req.GetShares()) != ba.Custodians {
+		return deny(fmt.Sprintf("expected %d shares, got %d", ba.Custodians, len(req.GetShares())))
+	}
+	hash, err := unsealBreakGlassSecret(req.GetShares())
+	if err != nil {
+		return deny(err.Error())
+	}
+	if subtle.ConstantTimeCompare([]byte(hash), []byte(ba.SecretHash)) != 1 {
+		return deny("invalid credential")
+	}
+	if ba.RotationRequired {
+		return deny("credential has already been used and has to be rotated")
+	}
+
+	until := time.Now().Add(time.Duration(ba.AccessDurationMin) * time.Minute)
+	used, err := dao.UseBreakGlassAccount(ctx, s.db, ba.ID, until)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	if !used {
+		return deny("credential has already been used and has to be rotated")
+	}
+
+	sd.Account = ba.AccountId.String()
+	sd.Organization = ba.OrganizationId.String()
+	sd.Partner = ba.PartnerId.String()
+	sd.Username = s.accountUsername(ctx, ba)
+
+	admins, err := dao.GetOrganizationAdmins(ctx, s.db, orgId)
+	if err != nil {
+		_log.Warnw("unable to get organization admins", "organization", req.GetOrganization(), "error", err)
+	}
+	CreateBreakGlassUsedAuditEvent(s.al, sd, ba.Name, req.GetReason(), until, admins)
+	s.notify(ctx, ba, req, sd, until, admins)
+	return sd, until, nil
+}
+
+// breakGlassNotification is the payload posted to the notify webhook
+type breakGlassNotification struct {
+	Organization string    `json:"organization"`
+	Name         string    `json:"name"`
+	User         string    `json:"user"`
+	Reason       string    `json:"reason"`
+	ClientIP     string    `json:"clientIp"`
+	ActiveUntil  time.Time `json:"activeUntil"`
+	Admins       []string  `json:"admins"`
+}
+
+// notify posts the use of the break-glass account to its webhook, a
+// failure to notify does not hold back the emergency access
+func (s *breakGlassService) notify(ctx context.Context, ba *models.BreakGlassAccount, req *systemrpc.BreakGlassRequest, sd *commonv3.SessionData, until time.Time, admins []string) {
+	if ba.NotifyWebhookURL == "" {
+		return
+	}
+	body, err := json.Marshal(&breakGlassNotification{
+		Organization: req.GetOrganization(),
+		Name:         ba.Name,
+		User:         sd.GetUsername(),
+		Reason:       req.GetReason(),
+		ClientIP:     sd.GetClientIp(),
+		ActiveUntil:  until,
+		Admins:       admins,
+	})
+	if err != nil {
+		_log.Warnw("unable to notify break-glass", "name", ba.Name, "error", err)
+		return
+	}
+	hreq, err := http.NewRequestWithContext(ctx, http.MethodPost, ba.NotifyWebhookURL, bytes.NewReader(body))
+	if err != nil {
+		_log.Warnw("unable to notify break-glass", "name", ba.Name, "error", err)
+		return
+	}
+	hreq.Header.Set("Content-Type", "application/json")
+	resp, err := s.hc.Do(hreq)
+	if err != nil {
+		_log.Warnw("unable to notify break-glass", "name", ba.Name, "error", err)
+		return
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		_log.Warnw("unable to notify break-glass", "name", ba.Name, "status", resp.Status)
+	}
+}
+
+func (s *breakGlassService) ExpireAccess(ctx context.Context) error {
+	now := time.Now()
+	bas, err := dao.GetExpiredBreakGlassAccess(ctx, s.db, now)
+	if err != nil {
+		return err
+	}
+	for _, ba := range bas {
+		err = s.krs.Patch(ctx, &sentry.KubeconfigRevocation{
+			OrganizationID: ba.OrganizationId.String(),
+			PartnerID:      ba.PartnerId.String(),
+			AccountID:      ba.AccountId.String(),
+			RevokedAt:      timestamppb.New(now),
+		})
+		if err != nil {
+			return err
+		}
+		err = dao.EndBreakGlassAccess(ctx, s.db, ba.ID)
+		if err != nil {
+			return err
+		}
+		CreateBreakGlassExpiredAuditEvent(s.al, ba.Name, s.accountUsername(ctx, &ba))
+	}
+	return nil
+}
@@ -0,0 +1,150 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	systemrpc "github.com/paralus/paralus/proto/rpc/system"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+)
+
+var breakGlassColumns = []string{"id", "name", "account_id", "organization_id", "partner_id", "secret_hash", "custodians", "access_duration_min", "notify_webhook_url", "rotation_required"}
+
+func TestBreakGlassSecretSealing(t *testing.T) {
+	shares, hash, err := sealBreakGlassSecret(3)
+	if err != nil {
+		t.Fatal("could not seal secret:", err)
+	}
+	if len(shares) != 3 {
+		t.Fatalf("expected 3 shares; got %d", len(shares))
+	}
+	unsealed, err := unsealBreakGlassSecret(shares)
+	if err != nil {
+		t.Fatal("could not unseal secret:", err)
+	}
+	if unsealed != hash {
+		t.Error("all shares should unseal the secret")
+	}
+	unsealed, err = unsealBreakGlassSecret(shares[:2])
+	if err != nil {
+		t.Fatal("could not unseal secret:", err)
+	}
+	if unsealed == hash {
+		t.Error("missing shares should not unseal the secret")
+	}
+}
+
+func TestBreakGlassUnseal(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	var notification breakGlassNotification
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&notification)
+	}))
+	defer ts.Close()
+
+	bgs := NewBreakGlassService(db, nil, getLogger())
+
+	shares, hash, _ := sealBreakGlassSecret(2)
+	buuid := uuid.New().String()
+	uuuid := uuid.New().String()
+	pid, oid := addParterOrgFetchExpectation(mock)
+	mock.ExpectQuery(`SELECT "breakglassaccount"."id", .* FROM "authsrv_breakglassaccount" AS "breakglassaccount" WHERE .organization_id = '` + oid + `'. AND .partner_id = '` + pid + `'. AND .name = 'emergency'.`).
+		WillReturnRows(sqlmock.NewRows(breakGlassColumns).AddRow(buuid, "emergency", uuuid, oid, pid, hash, 2, 30, ts.URL, false))
+	mock.ExpectExec(`UPDATE "authsrv_breakglassaccount" AS "breakglassaccount" SET rotation_required = TRUE, last_used_at = .*, active_until = .* WHERE .id = '` + buuid + `'. AND .rotation_required = FALSE.`).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery(`SELECT "identities"."id", .* FROM "identities"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "traits"}).AddRow(uuuid, []byte(`{"email":"breakglass@provider.com"}`)))
+	mock.ExpectQuery(`SELECT DISTINCT identities.traits ->> 'email' FROM identities JOIN sentry_account_permission AS sap .* WHERE .sap.organization_id = '` + oid + `'. AND .sap.role_name = 'ADMIN'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"email"}).AddRow("admin@provider.com"))
+
+	sd, until, err := bgs.Unseal(context.Background(), &systemrpc.BreakGlassRequest{
+		Partner: "partner", Organization: "org", Name: "emergency", Shares: shares, Reason: "idp outage",
+	}, &commonv3.SessionData{ClientIp: "10.0.0.1"})
+	if err != nil {
+		t.Fatal("could not break glass:", err)
+	}
+	if sd.Account != uuuid || sd.Username != "breakglass@provider.com" || sd.Organization != oid {
+		t.Errorf("invalid break-glass session; got %v", sd)
+	}
+	if time.Until(until) > 30*time.Minute || time.Until(until) < 29*time.Minute {
+		t.Errorf("access should expire in 30 minutes; got %v", until)
+	}
+	if notification.Reason != "idp outage" || len(notification.Admins) != 1 || notification.Admins[0] != "admin@provider.com" {
+		t.Errorf("admins should be notified; got %v", notification)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBreakGlassUnsealInvalidShares(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	bgs := NewBreakGlassService(db, nil, getLogger())
+
+	_, hash, _ := sealBreakGlassSecret(2)
+	shares, _, _ := sealBreakGlassSecret(2)
+	buuid := uuid.New().String()
+	pid, oid := addParterOrgFetchExpectation(mock)
+	mock.ExpectQuery(`SELECT "breakglassaccount"."id", .* FROM "authsrv_breakglassaccount" AS "breakglassaccount"`).
+		WillReturnRows(sqlmock.NewRows(breakGlassColumns).AddRow(buuid, "emergency", uuid.New().String(), oid, pid, hash, 2, 30, "", false))
+
+	_, _, err := bgs.Unseal(context.Background(), &systemrpc.BreakGlassRequest{
+		Partner: "partner", Organization: "org", Name: "emergency", Shares: shares, Reason: "idp outage",
+	}, &commonv3.SessionData{})
+	if err != ErrBreakGlassDenied {
+		t.Fatalf("break-glass with invalid shares should be denied; got %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBreakGlassUnsealRequiresRotation(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	bgs := NewBreakGlassService(db, nil, getLogger())
+
+	shares, hash, _ := sealBreakGlassSecret(1)
+	buuid := uuid.New().String()
+	pid, oid := addParterOrgFetchExpectation(mock)
+	mock.ExpectQuery(`SELECT "breakglassaccount"."id", .* FROM "authsrv_breakglassaccount" AS "breakglassaccount"`).
+		WillReturnRows(sqlmock.NewRows(breakGlassColumns).AddRow(buuid, "emergency", uuid.New().String(), oid, pid, hash, 1, 30, "", true))
+
+	_, _, err := bgs.Unseal(context.Background(), &systemrpc.BreakGlassRequest{
+		Partner: "partner", Organization: "org", Name: "emergency", Shares: shares, Reason: "idp outage",
+	}, &commonv3.SessionData{})
+	if err != ErrBreakGlassDenied {
+		t.Fatalf("break-glass with used credential should be denied; got %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBreakGlassUnsealRequiresReason(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	bgs := NewBreakGlassService(db, nil, getLogger())
+
+	_, _, err := bgs.Unseal(context.Background(), &systemrpc.BreakGlassRequest{
+		Partner: "partner", Organization: "org", Name: "emergency", Shares: []string{"share"},
+	}, &commonv3.SessionData{})
+	if err == nil {
+		t.Fatal("break-glass without reason should fail")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
@@ -21,7 +22,7 @@ import (
 	"github.com/paralus/paralus/pkg/log"
 	"github.com/paralus/paralus/pkg/patch"
 	"github.com/paralus/paralus/pkg/query"
-	sentryutil "github.com/paralus/paralus/pkg/sentry/util"
+	sentryrpc "github.com/paralus/paralus/proto/rpc/sentry"
 	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
 	infrav3 "github.com/paralus/paralus/proto/types/infrapb/v3"
 	"github.com/paralus/paralus/proto/types/sentry"
@@ -55,6 +56,8 @@ type ClusterService interface {
 	Delete(ctx context.Context, cluster *infrav3.Cluster) error
 	// list cluster
 	List(ctx context.Context, opts ...query.Option) (*infrav3.ClusterList, error)
+	// list the ids of the clusters of the organization matching the selector
+	SelectClusterIDs(ctx context.Context, orgID, partnerID, projectID, selector string) ([]string, error)
 	//update cluster status
 	UpdateClusterConditionStatus(ctx context.Context, current *infrav3.Cluster) error
 	// update cluster annotations
@@ -73,6 +76,40 @@ type ClusterService interface {
 	UpdateProjectsForBootstrapAgentForCluster(ctx context.Context, cluster *infrav3.Cluster) error
 	//Add event handlers
 	AddEventHandler(evh event.Handler)
+	// record that the relay agents of the clusters are connected
+	RecordClustersSeen(ctx context.Context, clusterIDs []string) error
+	// update the health of clusters from when their relay agents were last seen
+	EvaluateHealth(ctx context.Context, unhealthyAfter, disconnectedAfter time.Duration) error
+	// update the nodes of the cluster reported by its relay agent
+	UpdateClusterNodes(ctx context.Context, clusterID string, req *sentryrpc.UpdateClusterNodesRequest) (*sentryrpc.UpdateClusterNodesResponse, error)
+	// record the version reported by the relay agent of the cluster
+	RecordAgentVersion(ctx context.Context, clusterID string, version string) error
+	// get the manifest of the version the relay agent of the cluster should run
+	GetAgentManifest(ctx context.Context, clusterID string, version string) (*sentryrpc.GetAgentManifestResponse, error)
+	// record the upgrade of the relay agent of the cluster failed
+	ReportAgentUpgrade(ctx context.Context, clusterID string, req *sentryrpc.ReportAgentUpgradeRequest) error
+	// get the versions of the relay agents of the clusters of the project
+	GetAgentVersions(ctx context.Context, opts ...query.Option) (*infrav3.AgentVersionList, error)
+	// create upgrade of the relay agents of a batch of clusters
+	CreateAgentUpgrade(ctx context.Context, upgrade *infrav3.AgentUpgrade) (*infrav3.AgentUpgrade, error)
+	// get upgrade of relay agents
+	GetAgentUpgrade(ctx context.Context, upgrade *infrav3.AgentUpgrade) (*infrav3.AgentUpgrade, error)
+	// list upgrades of relay agents of the project
+	ListAgentUpgrades(ctx context.Context, opts ...query.Option) (*infrav3.AgentUpgradeList, error)
+	// resume paused upgrade of relay agents
+	ResumeAgentUpgrade(ctx context.Context, upgrade *infrav3.AgentUpgrade) (*infrav3.AgentUpgrade, error)
+	// advance the upgrades of relay agents in progress
+	ProcessAgentUpgrades(ctx context.Context, timeout time.Duration) error
+	// share cluster with projects of its organization
+	ShareCluster(ctx context.Context, meta *commonv3.Metadata, shareMode infrav3.ClusterShareMode, projects []string) (*infrav3.Cluster, error)
+	// stop sharing cluster with projects
+	UnshareCluster(ctx context.Context, meta *commonv3.Metadata, projects []string) (*infrav3.Cluster, error)
+	// move cluster to another project of its organization
+	MoveCluster(ctx context.Context, meta *commonv3.Metadata, project string) (*infrav3.Cluster, error)
+	// rename cluster keeping its id and relay agent tokens
+	RenameCluster(ctx context.Context, meta *commonv3.Metadata, name string) (*infrav3.Cluster, error)
+	// register clusters of a project, updating the ones already registered
+	RegisterClusters(ctx context.Context, project string, clusters []*infrav3.Cluster) ([]*infrav3.Cluster, error)
 }
 
 // clusterService implements ClusterService
@@ -82,11 +119,15 @@ type clusterService struct {
 	clusterHandlers []event.Handler
 	bs              BootstrapService
 	al              *zap.Logger
+
+	// last time clusters were recorded as seen by this replica
+	seenMu sync.Mutex
+	seen   map[uuid.UUID]time.Time
 }
 
 // NewClusterService return new cluster service
 func NewClusterService(db *bun.DB, data *common.DownloadData, bs BootstrapService, al *zap.Logger) ClusterService {
-	return &clusterService{db: db, downloadData: *data, bs: bs, al: al}
+	return &clusterService{db: db, downloadData: *data, bs: bs, al: al, seen: map[uuid.UUID]time.Time{}}
 }
 
 func (s *clusterService) Create(ctx context.Context, cluster *infrav3.Cluster) (*infrav3.Cluster, error) {
@@ -329,7 +370,7 @@ func (s *clusterService) Select(ctx context.Context, cluster *infrav3.Cluster, i
 
 	var projects []models.ProjectCluster
 	if isExtended {
-		projects, err = cdao.GetProjectsForCluster(ctx, s.db, c.ID)
+		projects, err = s.getSharedProjects(ctx, s.db, c)
 		if err != nil {
 			return &infrav3.Cluster{}, err
 		}
@@ -382,7 +423,7 @@ func (s *clusterService) Get(ctx context.Context, opts ...query.Option) (*infrav
 	}
 	var projects []models.ProjectCluster
 	if queryOptions.Extended {
-		projects, err = cdao.GetProjectsForCluster(ctx, s.db, c.ID)
+		projects, err = s.getSharedProjects(ctx, s.db, c)
 		if err != nil {
 			return &infrav3.Cluster{}, err
 		}
@@ -450,25 +491,46 @@ func (s *clusterService) prepareClusterResponse(ctx context.Context, clstr *infr
 	if c.Extra != nil {
 		json.Unmarshal(c.Extra, &params)
 	}
-	var pcs []*infrav3.ProjectCluster
-	if len(projects) > 0 {
-		pcs = make([]*infrav3.ProjectCluster, len(projects)-1)
-		for _, pc := range projects {
-			pcs = append(pcs, &infrav3.ProjectCluster{
-				ProjectID: pc.ProjectID.String(),
-				ClusterID: pc.ClusterID.String(),
-			})
-		}
+	pcs := make([]*infrav3.ProjectCluster, 0, len(projects))
+	for _, pc := range projects {
+		pcs = append(pcs, &infrav3.ProjectCluster{
+			ProjectID: pc.ProjectID.String(),
+			ClusterID: pc.ClusterID.String(),
+		})
 	}
 	var conditions []*infrav3.ClusterCondition
 	if c.Conditions != nil {
 		json.Unmarshal(c.Conditions, &conditions)
 	}
+	var lastSeenAt *timestamppb.Timestamp
+	var nodes []*infrav3.ClusterNode
+	var nodeSummary *infrav3.ClusterNodeSummary
+	var agent models.ClusterAgent
+	if isExtended {
+		lastSeen, err := cdao.GetClusterLastSeen(ctx, s.db, c.ID)
+		if err != nil {
+			_log.Infow("unable to fetch last seen time of cluster, ", err.Error())
+		} else if !lastSeen.IsZero() {
+			lastSeenAt = timestamppb.New(lastSeen)
+		}
+		nodes, nodeSummary, err = s.getClusterNodes(ctx, c.ID)
+		if err != nil {
+			_log.Infow("unable to fetch nodes of cluster, ", err.Error())
+		}
+		ca, err := cdao.GetClusterAgent(ctx, s.db, c.ID)
+		if err != nil {
+			_log.Infow("unable to fetch agent version of cluster, ", err.Error())
+		} else if ca != nil {
+			agent = *ca
+		}
+	}
 	clstr.Spec = &infrav3.ClusterSpec{
-		ClusterType:      c.ClusterType,
-		OverrideSelector: c.OverrideSelector,
-		ProxyConfig:      &proxy,
-		Params:           &params,
+		ClusterType:         c.ClusterType,
+		OverrideSelector:    c.OverrideSelector,
+		ShareMode:           infrav3.ClusterShareMode(infrav3.ClusterShareMode_value[c.ShareMode]),
+		ProxyConfig:         &proxy,
+		Params:              &params,
+		DesiredAgentVersion: agent.DesiredAgentVersion,
 		ClusterData: &infrav3.ClusterData{
 			ClusterBlueprint: c.BlueprintRef,
 			Projects:         pcs,
@@ -477,14 +539,22 @@ func (s *clusterService) prepareClusterResponse(ctx context.Context, clstr *infr
 				Token:              c.Token,
 				PublishedBlueprint: c.BlueprintRef,
 			},
+			LastSeenAt:   lastSeenAt,
+			Nodes:        nodes,
+			NodeSummary:  nodeSummary,
+			AgentVersion: agent.AgentVersion,
 		},
 	}
+	clstr.Spec.ClusterData.Health = clstrutil.GetClusterHealth(clstr)
 	if metro != nil {
 		clstr.Spec.Metro = &infrav3.Metro{
-			Name:    metro.Name,
-			City:    metro.City,
-			State:   metro.State,
-			Country: metro.Country,
+			Name:        metro.Name,
+			City:        metro.City,
+			State:       metro.State,
+			Country:     metro.Country,
+			Latitude:    metro.Latitude,
+			Longitude:   metro.Longitude,
+			CountryCode: metro.CountryCode,
 		}
 	}
 	return clstr
@@ -549,7 +619,8 @@ func (s *clusterService) Update(ctx context.Context, cluster *infrav3.Cluster) (
 	//update editable fields
 	cdb.ModifiedAt = time.Now()
 	cdb.OverrideSelector = cluster.Spec.OverrideSelector
-	cdb.ShareMode = cluster.Spec.ShareMode.String()
+	// the share mode is changed by sharing the cluster so that the
+	// bootstrap agents of the cluster are updated with its projects
 	cdb.Labels = json.RawMessage(lbsBytes)
 
 	if len(cluster.Metadata.Annotations) > 0 {
@@ -606,6 +677,22 @@ func (s *clusterService) Update(ctx context.Context, cluster *infrav3.Cluster) (
 		return &infrav3.Cluster{}, err
 	}
 
+	// an empty desired agent version keeps the current one
+	if version := cluster.Spec.DesiredAgentVersion; version != "" {
+		if err := validateAgentVersion(version); err != nil {
+			return cluster, err
+		}
+		ca, err := cdao.GetClusterAgent(ctx, s.db, cdb.ID)
+		if err != nil {
+			return cluster, err
+		}
+		if ca == nil || ca.DesiredAgentVersion != version {
+			if err := s.setDesiredAgentVersion(ctx, s.db, cdb, version); err != nil {
+				return cluster, err
+			}
+		}
+	}
+
 	s.notifyCluster(ctx, cluster)
 
 	ev := event.Resource{
@@ -732,6 +819,10 @@ func (cs *clusterService) List(ctx context.Context, opts ...query.Option) (*infr
 		Order:        queryOptions.Order,
 		Limit:        queryOptions.Limit,
 		Offset:       queryOptions.Offset,
+
+		KubernetesVersion: queryOptions.KubernetesVersion,
+		MinNodes:          queryOptions.MinNodes,
+		MaxNodes:          queryOptions.MaxNodes,
 	})
 	if err != nil {
 		return nil, err
@@ -771,6 +862,29 @@ func (cs *clusterService) List(ctx context.Context, opts ...query.Option) (*infr
 	return &clusters, nil
 }
 
+// SelectClusterIDs returns the ids of the clusters of the organization
+// whose labels match the selector, limited to the clusters of the project
+// if one is given
+func (s *clusterService) SelectClusterIDs(ctx context.Context, orgID, partnerID, projectID, selector string) ([]string, error) {
+	prid := uuid.Nil
+	if projectID != "" {
+		var err error
+		prid, err = uuid.Parse(projectID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	ids, err := cdao.ListClusterIDsBySelector(ctx, s.db, uuid.MustParse(partnerID), uuid.MustParse(orgID), prid, selector)
+	if err != nil {
+		return nil, err
+	}
+	clusterIDs := make([]string, 0, len(ids))
+	for _, id := range ids {
+		clusterIDs = append(clusterIDs, id.String())
+	}
+	return clusterIDs, nil
+}
+
 func (s *clusterService) UpdateClusterConditionStatus(ctx context.Context, current *infrav3.Cluster) error {
 
 	existing, err := s.Get(ctx, func(qo *commonv3.QueryOptions) {
@@ -870,7 +984,7 @@ func (s *clusterService) GetClusterProjects(ctx context.Context, cluster *infrav
 	if err != nil {
 		return nil, err
 	}
-	projects, err := cdao.GetProjectsForCluster(ctx, s.db, c.ID)
+	projects, err := s.getSharedProjects(ctx, s.db, c)
 	if err != nil {
 		return nil, err
 	}
@@ -930,7 +1044,7 @@ func (s *clusterService) CreateBootstrapAgentForCluster(ctx context.Context, clu
 	}
 
 	// create bootstrap agent
-	for _, bat := range resp.Items {
+	for _, bat := range s.selectRelayTemplates(ctx, cluster, resp.Items, relaySelectionLimit()) {
 		found := true
 		agent, err := s.bs.GetBootstrapAgent(ctx, bat.Metadata.Name, query.WithMeta(&commonv3.Metadata{
 			Name: cluster.Metadata.Id,
@@ -964,9 +1078,7 @@ func (s *clusterService) CreateBootstrapAgentForCluster(ctx context.Context, clu
 				},
 			}
 
-			for _, project := range cluster.Spec.ClusterData.Projects {
-				agent.Metadata.Labels[fmt.Sprintf("project/%s", project.ProjectID)] = ""
-			}
+			setBootstrapAgentProjectLabels(agent.Metadata.Labels, cluster.Metadata.Project, cluster.Spec.ShareMode, cluster.Spec.ClusterData.Projects)
 
 			err = s.bs.CreateBootstrapAgent(ctx, agent)
 			if err != nil {
@@ -988,7 +1100,7 @@ func (s *clusterService) CreateBootstrapAgentForCluster(ctx context.Context, clu
 
 		relays = append(relays, common.Relay{
 			Token:         agent.Spec.Token,
-			Addr:          getRelayBootstrapAddr(),
+			Addr:          GetRelayBootstrapAddr(),
 			Endpoint:      endpoint,
 			Name:          bat.Metadata.Name,
 			TemplateToken: bat.Spec.Token,
@@ -1017,7 +1129,14 @@ func (s *clusterService) GetRelaysConfigForCluster(ctx context.Context, cluster
 		return nil, err
 	}
 
-	for _, bat := range resp.Items {
+	// the closest relays may have changed since the agents of the
+	// cluster were created, relays the cluster has no agent for are
+	// skipped
+	limit := relaySelectionLimit()
+	for _, bat := range s.selectRelayTemplates(ctx, cluster, resp.Items, 0) {
+		if limit > 0 && len(relays) >= limit {
+			break
+		}
 		agent, err := s.bs.GetBootstrapAgent(ctx, bat.Metadata.Name, query.WithMeta(&commonv3.Metadata{
 			Id:           cluster.Metadata.Id,
 			Name:         cluster.Metadata.Name,
@@ -1025,6 +1144,9 @@ func (s *clusterService) GetRelaysConfigForCluster(ctx context.Context, cluster
 			Organization: cluster.Metadata.Organization,
 			Project:      cluster.Metadata.Project,
 		}))
+		if err == sql.ErrNoRows {
+			continue
+		}
 		if err != nil {
 			err = errors.Wrap(err, "unable to get bootstrap agent")
 			return nil, err
@@ -1043,18 +1165,41 @@ func (s *clusterService) GetRelaysConfigForCluster(ctx context.Context, cluster
 
 		relays = append(relays, common.Relay{
 			Token:         agent.Spec.Token,
-			Addr:          getRelayBootstrapAddr(),
+			Addr:          GetRelayBootstrapAddr(),
 			Endpoint:      endpoint,
 			Name:          bat.Metadata.Name,
 			TemplateToken: bat.Spec.Token,
 		})
 	}
+	if len(relays) == 0 && len(resp.Items) > 0 {
+		return nil, fmt.Errorf("no bootstrap agent for cluster %s", cluster.Metadata.Name)
+	}
 
 	return relays, nil
 }
 
 // UpdateProjectsForCluster updates projects for bootstrap agent for cluster
 func (s *clusterService) UpdateProjectsForBootstrapAgentForCluster(ctx context.Context, cluster *infrav3.Cluster) error {
+	id, err := uuid.Parse(cluster.Metadata.Id)
+	if err != nil {
+		id = uuid.Nil
+	}
+	c, err := cdao.GetCluster(ctx, s.db, &models.Cluster{ID: id, Name: cluster.Metadata.Name})
+	if err != nil {
+		return err
+	}
+	projects, err := s.getSharedProjects(ctx, s.db, c)
+	if err != nil {
+		return err
+	}
+	pcs := make([]*infrav3.ProjectCluster, 0, len(projects))
+	for _, pc := range projects {
+		pcs = append(pcs, &infrav3.ProjectCluster{
+			ProjectID: pc.ProjectID.String(),
+			ClusterID: pc.ClusterID.String(),
+		})
+	}
+	shareMode := infrav3.ClusterShareMode(infrav3.ClusterShareMode_value[c.ShareMode])
 
 	resp, err := s.bs.SelectBootstrapAgentTemplates(ctx, query.WithOptions(&commonv3.QueryOptions{
 		GlobalScope: true,
@@ -1064,44 +1209,36 @@ func (s *clusterService) UpdateProjectsForBootstrapAgentForCluster(ctx context.C
 		return err
 	}
 
-	// create bootstrap agent
 	for _, bat := range resp.Items {
-
-		agent := &sentry.BootstrapAgent{
-			Metadata: &commonv3.Metadata{
-				Id:           cluster.Metadata.Id,
-				Name:         cluster.Metadata.Name,
-				Partner:      cluster.Metadata.Partner,
-				Organization: cluster.Metadata.Organization,
-				Project:      cluster.Metadata.Project,
-				Labels: map[string]string{
-					"paralus.dev/clusterName": cluster.Metadata.Name,
-				},
-			},
-			Spec: &sentry.BootstrapAgentSpec{
-				TemplateRef: fmt.Sprintf("template/%s", bat.Metadata.Name),
-			},
-		}
-
-		for _, project := range cluster.Spec.ClusterData.Projects {
-			agent.Metadata.Labels[fmt.Sprintf("project/%s", project.ProjectID)] = ""
+		agent, err := s.bs.GetBootstrapAgent(ctx, bat.Metadata.Name, query.WithMeta(&commonv3.Metadata{
+			Name: c.ID.String(),
+		}))
+		if err == sql.ErrNoRows {
+			// bootstrap agents created later get the projects of
+			// the cluster
+			continue
 		}
-
-		templateRef, err := sentryutil.GetTemplateScope(agent.Spec.TemplateRef)
 		if err != nil {
 			return err
 		}
 
-		err = s.bs.PatchBootstrapAgent(ctx, agent, templateRef, query.WithMeta(agent.Metadata))
+		if agent.Metadata.Labels == nil {
+			agent.Metadata.Labels = make(map[string]string)
+		}
+		setBootstrapAgentProjectLabels(agent.Metadata.Labels, c.ProjectId.String(), shareMode, pcs)
+
+		err = s.bs.PatchBootstrapAgent(ctx, agent, bat.Metadata.Name, query.WithMeta(&commonv3.Metadata{
+			Name: c.ID.String(),
+		}))
 		if err != nil {
 			return err
 		}
-
 	}
 	return nil
 }
 
-func getRelayBootstrapAddr() string {
+// GetRelayBootstrapAddr returns the address agents register with
+func GetRelayBootstrapAddr() string {
 	return viper.GetString("SENTRY_BOOTSTRAP_ADDR")
 }
 
@@ -33,7 +33,7 @@ func TestCreateCluster(t *testing.T) {
 		RelayAgentImage: "paralus/relay:latest",
 	}
 
-	ps := NewClusterService(db, downloadData, NewBootstrapService(db), getLogger())
+	ps := NewClusterService(db, downloadData, NewBootstrapService(db, getLogger(), 0), getLogger())
 
 	puuid := uuid.New().String()
 	cuuid := uuid.New().String()
@@ -77,7 +77,7 @@ func TestUpdateCluster(t *testing.T) {
 		RelayAgentImage: "paralus/relay:latest",
 	}
 
-	ps := NewClusterService(db, downloadData, NewBootstrapService(db), getLogger())
+	ps := NewClusterService(db, downloadData, NewBootstrapService(db, getLogger(), 0), getLogger())
 
 	puuid := uuid.New().String()
 	cuuid := uuid.New().String()
@@ -113,7 +113,7 @@ func TestSelectCluster(t *testing.T) {
 		RelayAgentImage: "paralus/relay:latest",
 	}
 
-	ps := NewClusterService(db, downloadData, NewBootstrapService(db), getLogger())
+	ps := NewClusterService(db, downloadData, NewBootstrapService(db, getLogger(), 0), getLogger())
 
 	puuid := uuid.New().String()
 	cuuid := uuid.New().String()
@@ -149,7 +149,7 @@ func TestGetCluster(t *testing.T) {
 		RelayAgentImage: "paralus/relay:latest",
 	}
 
-	ps := NewClusterService(db, downloadData, NewBootstrapService(db), getLogger())
+	ps := NewClusterService(db, downloadData, NewBootstrapService(db, getLogger(), 0), getLogger())
 
 	puuid := uuid.New().String()
 	cuuid := uuid.New().String()
@@ -184,7 +184,7 @@ func TestListCluster(t *testing.T) {
 		RelayAgentImage: "paralus/relay:latest",
 	}
 
-	ps := NewClusterService(db, downloadData, NewBootstrapService(db), getLogger())
+	ps := NewClusterService(db, downloadData, NewBootstrapService(db, getLogger(), 0), getLogger())
 
 	puuid := uuid.New().String()
 	ouuid := uuid.New().String()
@@ -215,7 +215,7 @@ func TestListCluster(t *testing.T) {
 func TestListClusterNoProject(t *testing.T) {
 	db, mock := getDB(t)
 	defer db.Close()
-	ps := NewClusterService(db, &common.DownloadData{}, NewBootstrapService(db), getLogger())
+	ps := NewClusterService(db, &common.DownloadData{}, NewBootstrapService(db, getLogger(), 0), getLogger())
 
 	pruuid := uuid.New().String()
 
@@ -0,0 +1,625 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	clstrutil "github.com/paralus/paralus/internal/cluster"
+	"github.com/paralus/paralus/internal/cluster/constants"
+	cdao "github.com/paralus/paralus/internal/cluster/dao"
+	"github.com/paralus/paralus/internal/dao"
+	"github.com/paralus/paralus/internal/models"
+	"github.com/paralus/paralus/pkg/query"
+	sentryrpc "github.com/paralus/paralus/proto/rpc/sentry"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	infrav3 "github.com/paralus/paralus/proto/types/infrapb/v3"
+	"github.com/uptrace/bun"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// agentVersionPattern is the pattern of relay agent versions, they are
+// tags of the relay agent image
+var agentVersionPattern = regexp.MustCompile(`^[A-Za-z0-9_][A-Za-z0-9_.-]{0,127}$`)
+
+func validateAgentVersion(version string) error {
+	if !agentVersionPattern.MatchString(version) {
+		return status.Errorf(codes.InvalidArgument, "invalid relay agent version %q", version)
+	}
+	return nil
+}
+
+// defaultAgentVersion returns the version of the relay agent installed
+// on clusters without a desired version
+func (s *clusterService) defaultAgentVersion() string {
+	return clstrutil.RelayAgentVersion(s.downloadData.RelayAgentImage)
+}
+
+func (s *clusterService) getCluster(ctx context.Context, clusterID string) (*models.Cluster, error) {
+	id, err := uuid.Parse(clusterID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid cluster id %s", clusterID)
+	}
+	c, err := cdao.GetCluster(ctx, s.db, &models.Cluster{ID: id})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "cluster %s not found", clusterID)
+		}
+		return nil, err
+	}
+	return c, nil
+}
+
+func (s *clusterService) RecordAgentVersion(ctx context.Context, clusterID string, version string) error {
+	id, err := uuid.Parse(clusterID)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid cluster id %s", clusterID)
+	}
+	return cdao.SetClusterAgentVersion(ctx, s.db, id, version, time.Now())
+}
+
+// renderAgentManifest returns the manifest installing the version of
+// the relay agent on the cluster
+func (s *clusterService) renderAgentManifest(ctx context.Context, c *models.Cluster, version string) (string, error) {
+	var lbls, ann map[string]string
+	if c.Labels != nil {
+		json.Unmarshal(c.Labels, &lbls)
+	}
+	if c.Annotations != nil {
+		json.Unmarshal(c.Annotations, &ann)
+	}
+	var proxy infrav3.ProxyConfig
+	if c.ProxyConfig != nil {
+		json.Unmarshal(c.ProxyConfig, &proxy)
+	}
+	data := s.downloadData
+	data.RelayAgentImage = clstrutil.RelayAgentImageForVersion(data.RelayAgentImage, version)
+	return clstrutil.GetClusterOperatorYaml(ctx, &data, &infrav3.Cluster{
+		Metadata: &commonv3.Metadata{Name: c.Name, Labels: lbls, Annotations: ann},
+		Spec:     &infrav3.ClusterSpec{ProxyConfig: &proxy},
+	})
+}
+
+// setDesiredAgentVersion sets the version the relay agent of the
+// cluster should run and regenerates the operator manifest the agent
+// pulls to upgrade itself
+func (s *clusterService) setDesiredAgentVersion(ctx context.Context, db bun.IDB, c *models.Cluster, version string) error {
+	if err := cdao.SetDesiredAgentVersion(ctx, db, c.ID, version); err != nil {
+		return err
+	}
+	manifest, err := s.renderAgentManifest(ctx, c, version)
+	if err != nil {
+		return err
+	}
+	return cdao.CreateOperatorBootstrap(ctx, db, &models.ClusterOperatorBootstrap{
+		ClusterId:   c.ID,
+		YamlContent: base64.StdEncoding.EncodeToString([]byte(manifest)),
+	})
+}
+
+func (s *clusterService) GetAgentManifest(ctx context.Context, clusterID string, version string) (*sentryrpc.GetAgentManifestResponse, error) {
+	c, err := s.getCluster(ctx, clusterID)
+	if err != nil {
+		return nil, err
+	}
+	if version != "" {
+		if err := cdao.SetClusterAgentVersion(ctx, s.db, c.ID, version, time.Now()); err != nil {
+			return nil, err
+		}
+	}
+
+	ca, err := cdao.GetClusterAgent(ctx, s.db, c.ID)
+	if err != nil {
+		return nil, err
+	}
+	resp := &sentryrpc.GetAgentManifestResponse{DesiredVersion: s.defaultAgentVersion()}
+	if ca != nil && ca.DesiredAgentVersion != "" {
+		resp.DesiredVersion = ca.DesiredAgentVersion
+	}
+	if resp.DesiredVersion == "" || resp.DesiredVersion == version {
+		return resp, nil
+	}
+
+	if ca != nil && ca.DesiredAgentVersion != "" {
+		bootstrap, err := cdao.GetOperatorBootstrap(ctx, s.db, c.ID.String())
+		if err == nil {
+			resp.Manifest, err = base64.StdEncoding.DecodeString(bootstrap.YamlContent)
+			if err == nil {
+				return resp, nil
+			}
+		}
+		_log.Infow("unable to get operator manifest of cluster, rendering it", "cluster", c.Name, "error", err)
+	}
+	manifest, err := s.renderAgentManifest(ctx, c, resp.DesiredVersion)
+	if err != nil {
+		return nil, err
+	}
+	resp.Manifest = []byte(manifest)
+	return resp, nil
+}
+
+func (s *clusterService) ReportAgentUpgrade(ctx context.Context, clusterID string, req *sentryrpc.ReportAgentUpgradeRequest) error {
+	c, err := s.getCluster(ctx, clusterID)
+	if err != nil {
+		return err
+	}
+	if !req.GetFailed() {
+		// the upgrade succeeds once the relay agent reports the version
+		return nil
+	}
+
+	uc, err := cdao.GetUpgradingAgentUpgradeCluster(ctx, s.db, c.ID)
+	if err != nil || uc == nil {
+		return err
+	}
+	uc.State = infrav3.AgentUpgradeClusterState_AgentUpgradeClusterFailed.String()
+	uc.Reason = fmt.Sprintf("Relay agent could not upgrade to %s: %s", req.GetVersion(), req.GetReason())
+	uc.FinishedAt = time.Now()
+	if err := cdao.UpdateAgentUpgradeCluster(ctx, s.db, uc); err != nil {
+		return err
+	}
+	_log.Infow("relay agent upgrade failed", "cluster", c.Name, "id", c.ID, "version", req.GetVersion(), "reason", req.GetReason())
+	return s.setClusterCondition(ctx, c, clstrutil.NewClusterAgentUpgrade(constants.Failed, uc.Reason))
+}
+
+// getProjectByName returns the project with the name
+func (s *clusterService) getProjectByName(ctx context.Context, name string) (*models.Project, error) {
+	var proj models.Project
+	_, err := dao.GetByName(ctx, s.db, name, &proj)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "project %s not found", name)
+		}
+		return nil, err
+	}
+	return &proj, nil
+}
+
+// listProjectClusters returns the clusters of the project
+func (s *clusterService) listProjectClusters(ctx context.Context, proj *models.Project) ([]models.Cluster, error) {
+	return cdao.ListClusters(ctx, s.db, commonv3.QueryOptions{
+		Project:      proj.ID.String(),
+		Organization: proj.OrganizationId.String(),
+		Partner:      proj.PartnerId.String(),
+	})
+}
+
+// summarizeAgentVersions groups the clusters by the version reported by
+// their relay agents, the most common version first, along with the
+// number of clusters not running their desired version
+func summarizeAgentVersions(clusters []models.Cluster, agents map[uuid.UUID]models.ClusterAgent, defaultVersion string) ([]*infrav3.AgentVersion, int64) {
+	var skewed int64
+	byVersion := map[string]*infrav3.AgentVersion{}
+	for _, c := range clusters {
+		ca := agents[c.ID]
+		av, ok := byVersion[ca.AgentVersion]
+		if !ok {
+			av = &infrav3.AgentVersion{Version: ca.AgentVersion}
+			byVersion[ca.AgentVersion] = av
+		}
+		av.Count++
+		av.Clusters = append(av.Clusters, c.Name)
+
+		desired := ca.DesiredAgentVersion
+		if desired == "" {
+			desired = defaultVersion
+		}
+		if ca.AgentVersion != "" && desired != "" && ca.AgentVersion != desired {
+			skewed++
+		}
+	}
+
+	items := make([]*infrav3.AgentVersion, 0, len(byVersion))
+	for _, av := range byVersion {
+		sort.Strings(av.Clusters)
+		items = append(items, av)
+	}
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].Count != items[j].Count {
+			return items[i].Count > items[j].Count
+		}
+		return items[i].Version < items[j].Version
+	})
+	return items, skewed
+}
+
+// getClusterAgents returns the versions of the relay agents of the
+// clusters by cluster id
+func getClusterAgents(ctx context.Context, db bun.IDB, clusters []models.Cluster) (map[uuid.UUID]models.ClusterAgent, error) {
+	ids := make([]uuid.UUID, len(clusters))
+	for i, c := range clusters {
+		ids[i] = c.ID
+	}
+	cas, err := cdao.GetClusterAgents(ctx, db, ids)
+	if err != nil {
+		return nil, err
+	}
+	agents := make(map[uuid.UUID]models.ClusterAgent, len(cas))
+	for _, ca := range cas {
+		agents[ca.ClusterId] = ca
+	}
+	return agents, nil
+}
+
+func (s *clusterService) GetAgentVersions(ctx context.Context, opts ...query.Option) (*infrav3.AgentVersionList, error) {
+	queryOptions := commonv3.QueryOptions{}
+	for _, opt := range opts {
+		opt(&queryOptions)
+	}
+
+	proj, err := s.getProjectByName(ctx, queryOptions.Project)
+	if err != nil {
+		return nil, err
+	}
+	clusters, err := s.listProjectClusters(ctx, proj)
+	if err != nil {
+		return nil, err
+	}
+	agents, err := getClusterAgents(ctx, s.db, clusters)
+	if err != nil {
+		return nil, err
+	}
+
+	items, skewed := summarizeAgentVersions(clusters, agents, s.defaultAgentVersion())
+	return &infrav3.AgentVersionList{
+		ApiVersion:     constants.ApiVersion,
+		Kind:           constants.AgentVersionListKind,
+		Metadata:       &commonv3.ListMetadata{Count: int64(len(items))},
+		Items:          items,
+		DefaultVersion: s.defaultAgentVersion(),
+		SkewedCount:    skewed,
+	}, nil
+}
+
+// prepareAgentUpgradeResponse returns the upgrade with the progress of
+// its clusters
+func prepareAgentUpgradeResponse(u *models.AgentUpgrade, clusters []models.AgentUpgradeCluster, project string) *infrav3.AgentUpgrade {
+	upgrade := &infrav3.AgentUpgrade{
+		ApiVersion: constants.ApiVersion,
+		Kind:       constants.AgentUpgradeKind,
+		Metadata: &commonv3.Metadata{
+			Id:         u.ID.String(),
+			Name:       u.Name,
+			Project:    project,
+			CreatedAt:  timestamppb.New(u.CreatedAt),
+			ModifiedAt: timestamppb.New(u.ModifiedAt),
+		},
+		Spec: &infrav3.AgentUpgradeSpec{
+			Selector:       u.Selector,
+			Version:        u.Version,
+			MaxConcurrent:  int32(u.MaxConcurrent),
+			PauseOnFailure: u.PauseOnFailure,
+		},
+		Status: &infrav3.AgentUpgradeStatus{
+			State:       infrav3.AgentUpgradeState(infrav3.AgentUpgradeState_value[u.State]),
+			LastUpdated: timestamppb.New(u.ModifiedAt),
+		},
+	}
+	for _, uc := range clusters {
+		auc := &infrav3.AgentUpgradeCluster{
+			Name:   uc.ClusterName,
+			State:  infrav3.AgentUpgradeClusterState(infrav3.AgentUpgradeClusterState_value[uc.State]),
+			Reason: uc.Reason,
+		}
+		if !uc.StartedAt.IsZero() {
+			auc.StartedAt = timestamppb.New(uc.StartedAt)
+		}
+		if !uc.FinishedAt.IsZero() {
+			auc.FinishedAt = timestamppb.New(uc.FinishedAt)
+		}
+		upgrade.Status.Clusters = append(upgrade.Status.Clusters, auc)
+	}
+	return upgrade
+}
+
+func (s *clusterService) CreateAgentUpgrade(ctx context.Context, upgrade *infrav3.AgentUpgrade) (*infrav3.AgentUpgrade, error) {
+	if upgrade.GetMetadata().GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "name of the upgrade is required")
+	}
+	if err := validateAgentVersion(upgrade.GetSpec().GetVersion()); err != nil {
+		return nil, err
+	}
+	maxConcurrent := int(upgrade.GetSpec().GetMaxConcurrent())
+	if maxConcurrent < 0 {
+		return nil, status.Error(codes.InvalidArgument, "max concurrent can not be negative")
+	}
+	if maxConcurrent == 0 {
+		maxConcurrent = 1
+	}
+	selector, err := labels.Parse(upgrade.GetSpec().GetSelector())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid selector: %s", err)
+	}
+
+	proj, err := s.getProjectByName(ctx, upgrade.GetMetadata().GetProject())
+	if err != nil {
+		return nil, err
+	}
+	if _, err := cdao.GetAgentUpgrade(ctx, s.db, proj.ID, upgrade.Metadata.Name); err == nil {
+		return nil, status.Errorf(codes.AlreadyExists, "upgrade %s already exists", upgrade.Metadata.Name)
+	} else if err != sql.ErrNoRows {
+		return nil, err
+	}
+
+	clusters, err := s.listProjectClusters(ctx, proj)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	var ucs []models.AgentUpgradeCluster
+	for _, c := range clusters {
+		var lbls map[string]string
+		if c.Labels != nil {
+			json.Unmarshal(c.Labels, &lbls)
+		}
+		if !selector.Matches(labels.Set(lbls)) {
+			continue
+		}
+		ucs = append(ucs, models.AgentUpgradeCluster{
+			ClusterId:   c.ID,
+			ClusterName: c.Name,
+			State:       infrav3.AgentUpgradeClusterState_AgentUpgradeClusterPending.String(),
+		})
+	}
+	if len(ucs) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no clusters match the selector")
+	}
+	sort.Slice(ucs, func(i, j int) bool { return ucs[i].ClusterName < ucs[j].ClusterName })
+
+	u := &models.AgentUpgrade{
+		Name:           upgrade.Metadata.Name,
+		PartnerId:      proj.PartnerId,
+		OrganizationId: proj.OrganizationId,
+		ProjectId:      proj.ID,
+		Selector:       upgrade.GetSpec().GetSelector(),
+		Version:        upgrade.GetSpec().GetVersion(),
+		MaxConcurrent:  maxConcurrent,
+		PauseOnFailure: upgrade.GetSpec().GetPauseOnFailure(),
+		State:          infrav3.AgentUpgradeState_AgentUpgradeRunning.String(),
+		CreatedAt:      now,
+		ModifiedAt:     now,
+	}
+	err = s.db.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
+		return cdao.CreateAgentUpgrade(ctx, tx, u, ucs)
+	})
+	if err != nil {
+		return nil, err
+	}
+	_log.Infow("created relay agent upgrade", "upgrade", u.Name, "project", proj.Name, "version", u.Version, "clusters", len(ucs))
+
+	return prepareAgentUpgradeResponse(u, ucs, proj.Name), nil
+}
+
+func (s *clusterService) GetAgentUpgrade(ctx context.Context, upgrade *infrav3.AgentUpgrade) (*infrav3.AgentUpgrade, error) {
+	proj, err := s.getProjectByName(ctx, upgrade.GetMetadata().GetProject())
+	if err != nil {
+		return nil, err
+	}
+	u, err := cdao.GetAgentUpgrade(ctx, s.db, proj.ID, upgrade.GetMetadata().GetName())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "upgrade %s not found", upgrade.GetMetadata().GetName())
+		}
+		return nil, err
+	}
+	ucs, err := cdao.GetAgentUpgradeClusters(ctx, s.db, u.ID)
+	if err != nil {
+		return nil, err
+	}
+	return prepareAgentUpgradeResponse(u, ucs, proj.Name), nil
+}
+
+func (s *clusterService) ListAgentUpgrades(ctx context.Context, opts ...query.Option) (*infrav3.AgentUpgradeList, error) {
+	queryOptions := commonv3.QueryOptions{}
+	for _, opt := range opts {
+		opt(&queryOptions)
+	}
+
+	proj, err := s.getProjectByName(ctx, queryOptions.Project)
+	if err != nil {
+		return nil, err
+	}
+	us, err := cdao.ListAgentUpgrades(ctx, s.db, proj.ID)
+	if err != nil {
+		return nil, err
+	}
+	list := &infrav3.AgentUpgradeList{
+		ApiVersion: constants.ApiVersion,
+		Kind:       constants.AgentUpgradeListKind,
+		Metadata:   &commonv3.ListMetadata{Count: int64(len(us))},
+	}
+	for i := range us {
+		ucs, err := cdao.GetAgentUpgradeClusters(ctx, s.db, us[i].ID)
+		if err != nil {
+			return nil, err
+		}
+		list.Items = append(list.Items, prepareAgentUpgradeResponse(&us[i], ucs, proj.Name))
+	}
+	return list, nil
+}
+
+func (s *clusterService) ResumeAgentUpgrade(ctx context.Context, upgrade *infrav3.AgentUpgrade) (*infrav3.AgentUpgrade, error) {
+	proj, err := s.getProjectByName(ctx, upgrade.GetMetadata().GetProject())
+	if err != nil {
+		return nil, err
+	}
+	u, err := cdao.GetAgentUpgrade(ctx, s.db, proj.ID, upgrade.GetMetadata().GetName())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "upgrade %s not found", upgrade.GetMetadata().GetName())
+		}
+		return nil, err
+	}
+	if u.State != infrav3.AgentUpgradeState_AgentUpgradePaused.String() {
+		return nil, status.Errorf(codes.FailedPrecondition, "upgrade %s is not paused", u.Name)
+	}
+
+	// clusters which failed are retried
+	u.State = infrav3.AgentUpgradeState_AgentUpgradeRunning.String()
+	u.ModifiedAt = time.Now()
+	err = s.db.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
+		if err := cdao.ResetFailedAgentUpgradeClusters(ctx, tx, u.ID); err != nil {
+			return err
+		}
+		return cdao.UpdateAgentUpgradeState(ctx, tx, u)
+	})
+	if err != nil {
+		return nil, err
+	}
+	_log.Infow("resumed relay agent upgrade", "upgrade", u.Name, "project", proj.Name)
+
+	ucs, err := cdao.GetAgentUpgradeClusters(ctx, s.db, u.ID)
+	if err != nil {
+		return nil, err
+	}
+	return prepareAgentUpgradeResponse(u, ucs, proj.Name), nil
+}
+
+func (s *clusterService) ProcessAgentUpgrades(ctx context.Context, timeout time.Duration) error {
+	ids, err := cdao.GetRunningAgentUpgradeIDs(ctx, s.db)
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		if err := s.processAgentUpgrade(ctx, id, timeout); err != nil {
+			_log.Warnw("unable to process relay agent upgrade", "upgrade", id, "error", err)
+		}
+	}
+	return nil
+}
+
+// processAgentUpgrade advances the upgrade, the clusters whose relay
+// agents report the version succeed, those which do not within the
+// timeout fail and pending clusters start upgrading while fewer than
+// max concurrent are upgrading
+func (s *clusterService) processAgentUpgrade(ctx context.Context, id uuid.UUID, timeout time.Duration) error {
+	conditions := map[uuid.UUID]*infrav3.ClusterCondition{}
+	err := s.db.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
+		u, err := cdao.LockAgentUpgrade(ctx, tx, id)
+		if err != nil || u == nil {
+			return err
+		}
+		ucs, err := cdao.GetAgentUpgradeClusters(ctx, tx, u.ID)
+		if err != nil {
+			return err
+		}
+		ids := make([]uuid.UUID, len(ucs))
+		for i, uc := range ucs {
+			ids[i] = uc.ClusterId
+		}
+		cas, err := cdao.GetClusterAgents(ctx, tx, ids)
+		if err != nil {
+			return err
+		}
+		reported := make(map[uuid.UUID]string, len(cas))
+		for _, ca := range cas {
+			reported[ca.ClusterId] = ca.AgentVersion
+		}
+
+		now := time.Now()
+		upgrading, failed, pending := 0, 0, 0
+		for i := range ucs {
+			uc := &ucs[i]
+			switch uc.State {
+			case infrav3.AgentUpgradeClusterState_AgentUpgradeClusterFailed.String():
+				failed++
+				continue
+			case infrav3.AgentUpgradeClusterState_AgentUpgradeClusterPending.String():
+				pending++
+				continue
+			case infrav3.AgentUpgradeClusterState_AgentUpgradeClusterInProgress.String():
+			default:
+				continue
+			}
+			switch {
+			case reported[uc.ClusterId] == u.Version:
+				uc.State = infrav3.AgentUpgradeClusterState_AgentUpgradeClusterSucceeded.String()
+				uc.Reason = fmt.Sprintf("Relay agent upgraded to %s.", u.Version)
+				conditions[uc.ClusterId] = clstrutil.NewClusterAgentUpgrade(constants.Success, uc.Reason)
+			case now.Sub(uc.StartedAt) > timeout:
+				uc.State = infrav3.AgentUpgradeClusterState_AgentUpgradeClusterFailed.String()
+				uc.Reason = fmt.Sprintf("Relay agent did not report version %s within %s.", u.Version, timeout)
+				conditions[uc.ClusterId] = clstrutil.NewClusterAgentUpgrade(constants.Failed, uc.Reason)
+				failed++
+			default:
+				upgrading++
+				continue
+			}
+			uc.FinishedAt = now
+			if err := cdao.UpdateAgentUpgradeCluster(ctx, tx, uc); err != nil {
+				return err
+			}
+		}
+
+		state := u.State
+		if failed > 0 && u.PauseOnFailure {
+			state = infrav3.AgentUpgradeState_AgentUpgradePaused.String()
+		} else {
+			for i := range ucs {
+				uc := &ucs[i]
+				if upgrading >= u.MaxConcurrent {
+					break
+				}
+				if uc.State != infrav3.AgentUpgradeClusterState_AgentUpgradeClusterPending.String() {
+					continue
+				}
+				pending--
+				c, err := cdao.GetCluster(ctx, tx, &models.Cluster{ID: uc.ClusterId})
+				if err == sql.ErrNoRows {
+					uc.State = infrav3.AgentUpgradeClusterState_AgentUpgradeClusterFailed.String()
+					uc.Reason = "Cluster was deleted."
+					uc.FinishedAt = now
+				} else if err != nil {
+					return err
+				} else {
+					if err := s.setDesiredAgentVersion(ctx, tx, c, u.Version); err != nil {
+						return err
+					}
+					uc.State = infrav3.AgentUpgradeClusterState_AgentUpgradeClusterInProgress.String()
+					uc.Reason = fmt.Sprintf("Upgrading relay agent to %s.", u.Version)
+					uc.StartedAt = now
+					conditions[uc.ClusterId] = clstrutil.NewClusterAgentUpgrade(constants.InProgress, uc.Reason)
+					upgrading++
+				}
+				if err := cdao.UpdateAgentUpgradeCluster(ctx, tx, uc); err != nil {
+					return err
+				}
+			}
+			if pending == 0 && upgrading == 0 {
+				state = infrav3.AgentUpgradeState_AgentUpgradeCompleted.String()
+			}
+		}
+		if state == u.State {
+			return nil
+		}
+		_log.Infow("relay agent upgrade changed state", "upgrade", u.Name, "from", u.State, "to", state)
+		u.State = state
+		u.ModifiedAt = now
+		return cdao.UpdateAgentUpgradeState(ctx, tx, u)
+	})
+	if err != nil {
+		return err
+	}
+
+	for clusterID, condition := range conditions {
+		c, err := cdao.GetCluster(ctx, s.db, &models.Cluster{ID: clusterID})
+		if err == nil {
+			err = s.setClusterCondition(ctx, c, condition)
+		}
+		if err != nil {
+			// the upgrade is recorded, only the condition of the
+			// cluster is stale
+			_log.Warnw("unable to update agent upgrade condition of cluster", "cluster", clusterID, "error", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/models"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	infrav3 "github.com/paralus/paralus/proto/types/infrapb/v3"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSummarizeAgentVersions(t *testing.T) {
+	c1, c2, c3, c4 := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	clusters := []models.Cluster{{ID: c1, Name: "c1"}, {ID: c2, Name: "c2"}, {ID: c3, Name: "c3"}, {ID: c4, Name: "c4"}}
+	agents := map[uuid.UUID]models.ClusterAgent{
+		c1: {ClusterId: c1, AgentVersion: "v0.2.0"},
+		c2: {ClusterId: c2, AgentVersion: "v0.2.0"},
+		c3: {ClusterId: c3, AgentVersion: "v0.1.9", DesiredAgentVersion: "v0.1.9"},
+	}
+
+	items, skewed := summarizeAgentVersions(clusters, agents, "v0.2.1")
+	if len(items) != 3 {
+		t.Fatalf("expected 3 versions, got %v", items)
+	}
+	if items[0].Version != "v0.2.0" || items[0].Count != 2 || items[0].Clusters[0] != "c1" {
+		t.Errorf("expected v0.2.0 on 2 clusters first, got %v", items[0])
+	}
+	if items[1].Version != "" || items[1].Clusters[0] != "c4" {
+		t.Errorf("expected c4 without reported version, got %v", items[1])
+	}
+	if skewed != 2 {
+		t.Errorf("expected 2 clusters not running their desired version, got %d", skewed)
+	}
+}
+
+func TestGetAgentManifestUpToDate(t *testing.T) {
+	cs, mock, closeDB := newHealthTestClusterService(t)
+	defer closeDB()
+
+	cuuid := uuid.New().String()
+	mock.ExpectQuery(`SELECT "cluster"."id", .* FROM "cluster_clusters" AS "cluster" WHERE .id = '` + cuuid + `'. AND .trash = FALSE.`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(cuuid, "c-"+cuuid))
+	mock.ExpectExec(`INSERT INTO "cluster_agent" AS "clusteragent" .*'v0.2.0'.* ON CONFLICT \(cluster_id\) DO UPDATE`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`SELECT .* FROM "cluster_agent" AS "clusteragent" WHERE .cluster_id = '` + cuuid + `'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"cluster_id", "agent_version", "desired_agent_version"}).AddRow(cuuid, "v0.2.0", "v0.2.0"))
+
+	resp, err := cs.GetAgentManifest(context.Background(), cuuid, "v0.2.0")
+	if err != nil {
+		t.Fatal("could not get agent manifest:", err)
+	}
+	if resp.DesiredVersion != "v0.2.0" || len(resp.Manifest) != 0 {
+		t.Errorf("expected no manifest for agent running its desired version, got %v", resp)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func expectLockedAgentUpgrade(mock sqlmock.Sqlmock, uuuid string, pauseOnFailure bool) {
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT .* FROM "cluster_agent_upgrades" AS "agentupgrade" WHERE .id = '` + uuuid + `'. AND .state = 'AgentUpgradeRunning'. FOR UPDATE SKIP LOCKED`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "version", "max_concurrent", "pause_on_failure", "state"}).
+			AddRow(uuuid, "upgrade-1", "v0.2.1", 1, pauseOnFailure, "AgentUpgradeRunning"))
+}
+
+func TestProcessAgentUpgradeCompletes(t *testing.T) {
+	cs, mock, closeDB := newHealthTestClusterService(t)
+	defer closeDB()
+
+	uuuid := uuid.New().String()
+	cuuid := uuid.New().String()
+	expectLockedAgentUpgrade(mock, uuuid, true)
+	mock.ExpectQuery(`SELECT .* FROM "cluster_agent_upgrade_clusters" AS "agentupgradecluster" WHERE .upgrade_id = '` + uuuid + `'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"upgrade_id", "cluster_id", "cluster_name", "state", "started_at"}).
+			AddRow(uuuid, cuuid, "c1", "AgentUpgradeClusterInProgress", time.Now().Add(-time.Minute)))
+	mock.ExpectQuery(`SELECT .* FROM "cluster_agent" AS "clusteragent" WHERE .cluster_id IN .'` + cuuid + `'..`).
+		WillReturnRows(sqlmock.NewRows([]string{"cluster_id", "agent_version"}).AddRow(cuuid, "v0.2.1"))
+	mock.ExpectExec(`UPDATE "cluster_agent_upgrade_clusters" AS "agentupgradecluster" SET "state" = 'AgentUpgradeClusterSucceeded'`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE "cluster_agent_upgrades" AS "agentupgrade" SET "state" = 'AgentUpgradeCompleted'`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	mock.ExpectQuery(`SELECT "cluster"."id", .* FROM "cluster_clusters" AS "cluster" WHERE .id = '` + cuuid + `'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(cuuid, "c1"))
+	mock.ExpectExec(`UPDATE "cluster_clusters" AS "cluster" SET conditions = .*ClusterAgentUpgrade.*Relay agent upgraded to v0.2.1.* WHERE .id = '` + cuuid + `'.`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	if err := cs.processAgentUpgrade(context.Background(), uuid.MustParse(uuuid), 15*time.Minute); err != nil {
+		t.Fatal("could not process agent upgrade:", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestProcessAgentUpgradePausesOnFailure(t *testing.T) {
+	cs, mock, closeDB := newHealthTestClusterService(t)
+	defer closeDB()
+
+	uuuid := uuid.New().String()
+	c1uuid := uuid.New().String()
+	c2uuid := uuid.New().String()
+	expectLockedAgentUpgrade(mock, uuuid, true)
+	mock.ExpectQuery(`SELECT .* FROM "cluster_agent_upgrade_clusters" AS "agentupgradecluster" WHERE .upgrade_id = '` + uuuid + `'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"upgrade_id", "cluster_id", "cluster_name", "state", "started_at"}).
+			AddRow(uuuid, c1uuid, "c1", "AgentUpgradeClusterInProgress", time.Now().Add(-time.Hour)).
+			AddRow(uuuid, c2uuid, "c2", "AgentUpgradeClusterPending", nil))
+	mock.ExpectQuery(`SELECT .* FROM "cluster_agent" AS "clusteragent"`).
+		WillReturnRows(sqlmock.NewRows([]string{"cluster_id", "agent_version"}).AddRow(c1uuid, "v0.2.0"))
+	mock.ExpectExec(`UPDATE "cluster_agent_upgrade_clusters" AS "agentupgradecluster" SET "state" = 'AgentUpgradeClusterFailed'.* WHERE .*'` + c1uuid + `'`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE "cluster_agent_upgrades" AS "agentupgrade" SET "state" = 'AgentUpgradePaused'`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	mock.ExpectQuery(`SELECT "cluster"."id", .* FROM "cluster_clusters" AS "cluster" WHERE .id = '` + c1uuid + `'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(c1uuid, "c1"))
+	mock.ExpectExec(`UPDATE "cluster_clusters" AS "cluster" SET conditions = .*ClusterAgentUpgrade.*did not report version v0.2.1.* WHERE .id = '` + c1uuid + `'.`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	if err := cs.processAgentUpgrade(context.Background(), uuid.MustParse(uuuid), 15*time.Minute); err != nil {
+		t.Fatal("could not process agent upgrade:", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCreateAgentUpgradeInvalid(t *testing.T) {
+	cs, mock, closeDB := newHealthTestClusterService(t)
+	defer closeDB()
+
+	meta := &commonv3.Metadata{Name: "upgrade-1", Project: "project-1"}
+	tt := []struct {
+		name    string
+		upgrade *infrav3.AgentUpgrade
+	}{
+		{"no name", &infrav3.AgentUpgrade{Metadata: &commonv3.Metadata{Project: "project-1"}, Spec: &infrav3.AgentUpgradeSpec{Version: "v0.2.1"}}},
+		{"invalid version", &infrav3.AgentUpgrade{Metadata: meta, Spec: &infrav3.AgentUpgradeSpec{Version: "paralus/relay:v0.2.1"}}},
+		{"negative max concurrent", &infrav3.AgentUpgrade{Metadata: meta, Spec: &infrav3.AgentUpgradeSpec{Version: "v0.2.1", MaxConcurrent: -1}}},
+		{"invalid selector", &infrav3.AgentUpgrade{Metadata: meta, Spec: &infrav3.AgentUpgradeSpec{Version: "v0.2.1", Selector: "env in (prod"}}},
+	}
+	for _, tc := range tt {
+		if _, err := cs.CreateAgentUpgrade(context.Background(), tc.upgrade); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%s: expected invalid argument, got %v", tc.name, err)
+		}
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
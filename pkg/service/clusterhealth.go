@@ -0,0 +1,159 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	clstrutil "github.com/paralus/paralus/internal/cluster"
+	"github.com/paralus/paralus/internal/cluster/constants"
+	cdao "github.com/paralus/paralus/internal/cluster/dao"
+	"github.com/paralus/paralus/pkg/event"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	infrav3 "github.com/paralus/paralus/proto/types/infrapb/v3"
+)
+
+// clusterSeenResolution is how often a replica records that a
+// cluster is seen, the health of clusters is precise up to this
+// duration
+const clusterSeenResolution = 30 * time.Second
+
+func (s *clusterService) RecordClustersSeen(ctx context.Context, clusterIDs []string) error {
+	now := time.Now()
+	var ids []uuid.UUID
+	s.seenMu.Lock()
+	for _, cid := range clusterIDs {
+		id, err := uuid.Parse(cid)
+		if err != nil {
+			continue
+		}
+		if now.Sub(s.seen[id]) < clusterSeenResolution {
+			continue
+		}
+		s.seen[id] = now
+		ids = append(ids, id)
+	}
+	s.seenMu.Unlock()
+
+	err := cdao.RecordClustersSeen(ctx, s.db, ids, now)
+	if err != nil {
+		// record again on the next heartbeat
+		s.seenMu.Lock()
+		for _, id := range ids {
+			delete(s.seen, id)
+		}
+		s.seenMu.Unlock()
+	}
+	return err
+}
+
+// clusterHealthStatus returns the health of a cluster whose relay
+// agent was last seen the duration ago
+func clusterHealthStatus(since, unhealthyAfter, disconnectedAfter time.Duration) (commonv3.ParalusConditionStatus, string) {
+	switch {
+	case since > disconnectedAfter:
+		return constants.Disconnected, fmt.Sprintf("Relay agent not seen for %s.", since.Round(time.Second))
+	case since > unhealthyAfter:
+		return constants.Unhealthy, fmt.Sprintf("Relay agent not seen for %s.", since.Round(time.Second))
+	}
+	return constants.Healthy, "Relay agent connected."
+}
+
+// clusterConditionUpdated returns when the condition of the type was
+// last updated
+func clusterConditionUpdated(conditions []*infrav3.ClusterCondition, conditionType infrav3.ClusterConditionType) time.Time {
+	for _, c := range conditions {
+		if c.Type == conditionType && c.LastUpdated != nil {
+			return c.LastUpdated.AsTime()
+		}
+	}
+	return time.Time{}
+}
+
+func (s *clusterService) EvaluateHealth(ctx context.Context, unhealthyAfter, disconnectedAfter time.Duration) error {
+	clusters, lastSeen, err := cdao.ListClustersForHealth(ctx, s.db)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, c := range clusters {
+		var conditions []*infrav3.ClusterCondition
+		if err := json.Unmarshal(c.Conditions, &conditions); err != nil {
+			continue
+		}
+		cluster := &infrav3.Cluster{
+			Metadata: &commonv3.Metadata{
+				Id:           c.ID.String(),
+				Name:         c.Name,
+				Project:      c.ProjectId.String(),
+				Organization: c.OrganizationId.String(),
+				Partner:      c.PartnerId.String(),
+			},
+			Spec: &infrav3.ClusterSpec{
+				ClusterData: &infrav3.ClusterData{
+					ClusterStatus: &infrav3.ClusterStatus{Conditions: conditions},
+				},
+			},
+		}
+		// clusters without relay agents have no health
+		if !clstrutil.IsClusterRegisterd(cluster) {
+			continue
+		}
+
+		seen, ok := lastSeen[c.ID]
+		if !ok {
+			seen = clusterConditionUpdated(conditions, infrav3.ClusterConditionType_ClusterRegister)
+		}
+		status, reason := clusterHealthStatus(now.Sub(seen), unhealthyAfter, disconnectedAfter)
+		health := clstrutil.GetClusterHealth(cluster)
+		if (status == constants.Healthy && health == infrav3.Health_EDGE_HEALTHY) ||
+			(status == constants.Unhealthy && health == infrav3.Health_EDGE_UNHEALTHY) ||
+			(status == constants.Disconnected && health == infrav3.Health_EDGE_DISCONNECTED) {
+			continue
+		}
+
+		condition := clstrutil.NewClusterHealth(status, reason)
+		found := false
+		for i, ec := range conditions {
+			if ec.Type == condition.Type {
+				conditions[i] = condition
+				found = true
+			}
+		}
+		if !found {
+			conditions = append(conditions, condition)
+		}
+		cb, err := json.Marshal(conditions)
+		if err != nil {
+			return err
+		}
+		previous := c.Conditions
+		c.Conditions = json.RawMessage(cb)
+		updated, err := cdao.UpdateClusterConditions(ctx, s.db, &c, previous)
+		if err != nil {
+			return err
+		}
+		if !updated {
+			// changed meanwhile, possibly by another replica
+			continue
+		}
+		_log.Infow("cluster health changed", "cluster", c.Name, "id", c.ID, "from", health.String(), "to", status.String(), "reason", reason)
+
+		ev := event.Resource{
+			PartnerID:      cluster.Metadata.Partner,
+			OrganizationID: cluster.Metadata.Organization,
+			ProjectID:      cluster.Metadata.Project,
+			Name:           cluster.Metadata.Name,
+			EventType:      event.ResourceUpdateStatus,
+			ID:             cluster.Metadata.Id,
+		}
+		for _, h := range s.clusterHandlers {
+			h.OnChange(ev)
+		}
+		s.notifyCluster(ctx, cluster)
+	}
+	return nil
+}
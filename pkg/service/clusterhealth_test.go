@@ -0,0 +1,113 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/cluster/constants"
+	"github.com/paralus/paralus/pkg/common"
+	"github.com/paralus/paralus/pkg/event"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	infrav3 "github.com/paralus/paralus/proto/types/infrapb/v3"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func newHealthTestClusterService(t *testing.T) (*clusterService, sqlmock.Sqlmock, func() error) {
+	db, mock := getDB(t)
+	downloadData := &common.DownloadData{
+		ControlAddr:     "localhost:5002",
+		APIAddr:         "localhost:8000",
+		RelayAgentImage: "paralus/relay:latest",
+	}
+	cs := NewClusterService(db, downloadData, NewBootstrapService(db, getLogger(), 0), getLogger())
+	return cs.(*clusterService), mock, db.Close
+}
+
+func TestClusterHealthStatus(t *testing.T) {
+	tt := []struct {
+		since  time.Duration
+		status commonv3.ParalusConditionStatus
+	}{
+		{30 * time.Second, constants.Healthy},
+		{3 * time.Minute, constants.Unhealthy},
+		{10 * time.Minute, constants.Disconnected},
+	}
+	for _, tc := range tt {
+		status, _ := clusterHealthStatus(tc.since, 2*time.Minute, 5*time.Minute)
+		if status != tc.status {
+			t.Errorf("cluster seen %v ago: expected %v, got %v", tc.since, tc.status, status)
+		}
+	}
+}
+
+func TestRecordClustersSeen(t *testing.T) {
+	cs, mock, closeDB := newHealthTestClusterService(t)
+	defer closeDB()
+
+	cuuid := uuid.New().String()
+	mock.ExpectExec(`INSERT INTO "cluster_health" AS "clusterhealth" .* ON CONFLICT \(cluster_id\) DO UPDATE SET last_seen_at = GREATEST`).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	if err := cs.RecordClustersSeen(context.Background(), []string{cuuid, "not-a-cluster"}); err != nil {
+		t.Fatal("could not record cluster seen:", err)
+	}
+	// recorded again only after the resolution passes
+	if err := cs.RecordClustersSeen(context.Background(), []string{cuuid}); err != nil {
+		t.Fatal("could not record cluster seen:", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestEvaluateHealthDisconnected(t *testing.T) {
+	cs, mock, closeDB := newHealthTestClusterService(t)
+	defer closeDB()
+
+	var events []event.Resource
+	cs.AddEventHandler(event.HandlerFuncs{OnChangeFunc: func(r event.Resource) {
+		events = append(events, r)
+	}})
+
+	registered := time.Now().Add(-time.Hour)
+	conditions, _ := json.Marshal([]*infrav3.ClusterCondition{
+		{Type: infrav3.ClusterConditionType_ClusterRegister, Status: constants.Success, LastUpdated: timestamppb.New(registered)},
+		{Type: infrav3.ClusterConditionType_ClusterHealth, Status: constants.Healthy, LastUpdated: timestamppb.New(registered)},
+	})
+	pendingConditions, _ := json.Marshal([]*infrav3.ClusterCondition{
+		{Type: infrav3.ClusterConditionType_ClusterRegister, Status: constants.Pending},
+	})
+	healthyConditions := conditions
+
+	cuuid := uuid.New().String()
+	pcuuid := uuid.New().String()
+	hcuuid := uuid.New().String()
+	puuid := uuid.New().String()
+	mock.ExpectQuery(`SELECT "cluster"."id", .* FROM "cluster_clusters" AS "cluster" WHERE .trash = FALSE.`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "project_id", "conditions"}).
+			AddRow(cuuid, "c-disconnected", puuid, conditions).
+			AddRow(pcuuid, "c-pending", puuid, pendingConditions).
+			AddRow(hcuuid, "c-healthy", puuid, healthyConditions))
+	mock.ExpectQuery(`SELECT "clusterhealth"."cluster_id", "clusterhealth"."last_seen_at" FROM "cluster_health" AS "clusterhealth"`).
+		WillReturnRows(sqlmock.NewRows([]string{"cluster_id", "last_seen_at"}).
+			AddRow(cuuid, time.Now().Add(-10*time.Minute)).
+			AddRow(hcuuid, time.Now().Add(-10*time.Second)))
+	mock.ExpectExec(`UPDATE "cluster_clusters" AS "cluster" SET conditions = .*Disconnected.* WHERE .id = '` + cuuid + `'. AND .conditions = .*::jsonb.`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`NOTIFY "cluster:notify"`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	if err := cs.EvaluateHealth(context.Background(), 2*time.Minute, 5*time.Minute); err != nil {
+		t.Fatal("could not evaluate cluster health:", err)
+	}
+	if len(events) != 1 || events[0].ID != cuuid || events[0].EventType != event.ResourceUpdateStatus {
+		t.Errorf("expected status update event of disconnected cluster, got %v", events)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
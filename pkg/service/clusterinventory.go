@@ -0,0 +1,286 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	clstrutil "github.com/paralus/paralus/internal/cluster"
+	"github.com/paralus/paralus/internal/cluster/constants"
+	cdao "github.com/paralus/paralus/internal/cluster/dao"
+	"github.com/paralus/paralus/internal/models"
+	sentryrpc "github.com/paralus/paralus/proto/rpc/sentry"
+	infrav3 "github.com/paralus/paralus/proto/types/infrapb/v3"
+	"github.com/uptrace/bun"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxClusterNodes is the maximum number of nodes a snapshot of the
+// inventory of a cluster can have
+const maxClusterNodes = 5000
+
+// addResources adds the resources to the total
+func addResources(total *infrav3.Resources, r *infrav3.Resources) {
+	if r == nil {
+		return
+	}
+	total.CpuCount += r.CpuCount
+	total.EphemeralStorageKB += r.EphemeralStorageKB
+	total.MemoryKB += r.MemoryKB
+	total.PodsCount += r.PodsCount
+	total.GpuCount += r.GpuCount
+}
+
+// isClusterNodeReady returns true if the node is ready to run pods
+func isClusterNodeReady(node *infrav3.ClusterNode) bool {
+	if node.GetStatus().GetState() == infrav3.ClusterNodeState_ClusterNodeReady {
+		return true
+	}
+	for _, c := range node.GetStatus().GetConditions() {
+		if c.Type == "Ready" && c.Status == "True" {
+			return true
+		}
+	}
+	return false
+}
+
+// summarizeClusterNodes returns the totals of the nodes
+func summarizeClusterNodes(version string, nodes []*infrav3.ClusterNode) *infrav3.ClusterNodeSummary {
+	summary := &infrav3.ClusterNodeSummary{
+		KubernetesVersion: version,
+		NodeCount:         int64(len(nodes)),
+		Capacity:          &infrav3.Resources{},
+		Allocatable:       &infrav3.Resources{},
+		Allocated:         &infrav3.Resources{},
+	}
+	for _, n := range nodes {
+		if isClusterNodeReady(n) {
+			summary.ReadyNodeCount++
+		}
+		addResources(summary.Capacity, n.GetStatus().GetCapacity())
+		addResources(summary.Allocatable, n.GetStatus().GetAllocatable())
+		addResources(summary.Allocated, n.GetStatus().GetAllocated())
+	}
+	return summary
+}
+
+// diffClusterNodes returns the names of the nodes added and removed
+// since the previous snapshot
+func diffClusterNodes(previous []string, nodes []*infrav3.ClusterNode) (added, removed []string) {
+	current := make(map[string]bool, len(nodes))
+	for _, n := range nodes {
+		current[n.GetMetadata().GetName()] = true
+	}
+	existing := make(map[string]bool, len(previous))
+	for _, name := range previous {
+		existing[name] = true
+		if !current[name] {
+			removed = append(removed, name)
+		}
+	}
+	for _, n := range nodes {
+		if !existing[n.GetMetadata().GetName()] {
+			added = append(added, n.GetMetadata().GetName())
+		}
+	}
+	return added, removed
+}
+
+func validateClusterNodes(nodes []*infrav3.ClusterNode) error {
+	if len(nodes) > maxClusterNodes {
+		return status.Errorf(codes.InvalidArgument, "cluster can not have more than %d nodes", maxClusterNodes)
+	}
+	seen := make(map[string]bool, len(nodes))
+	for _, n := range nodes {
+		name := n.GetMetadata().GetName()
+		if name == "" {
+			return status.Error(codes.InvalidArgument, "node name is required")
+		}
+		if seen[name] {
+			return status.Errorf(codes.InvalidArgument, "node %s is reported more than once", name)
+		}
+		seen[name] = true
+	}
+	return nil
+}
+
+func (s *clusterService) UpdateClusterNodes(ctx context.Context, clusterID string, req *sentryrpc.UpdateClusterNodesRequest) (*sentryrpc.UpdateClusterNodesResponse, error) {
+	if err := validateClusterNodes(req.GetNodes()); err != nil {
+		return nil, err
+	}
+	id, err := uuid.Parse(clusterID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid cluster id %s", clusterID)
+	}
+	c, err := cdao.GetCluster(ctx, s.db, &models.Cluster{ID: id})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "cluster %s not found", clusterID)
+		}
+		return nil, err
+	}
+
+	summary := summarizeClusterNodes(req.GetKubernetesVersion(), req.GetNodes())
+	capacity, err := json.Marshal(summary.Capacity)
+	if err != nil {
+		return nil, err
+	}
+	allocatable, err := json.Marshal(summary.Allocatable)
+	if err != nil {
+		return nil, err
+	}
+	allocated, err := json.Marshal(summary.Allocated)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	nodes := make([]models.ClusterNode, len(req.GetNodes()))
+	for i, n := range req.GetNodes() {
+		nb, err := json.Marshal(n)
+		if err != nil {
+			return nil, err
+		}
+		nodes[i] = models.ClusterNode{
+			ClusterId:  c.ID,
+			Name:       n.GetMetadata().GetName(),
+			Node:       nb,
+			CreatedAt:  now,
+			ModifiedAt: now,
+		}
+	}
+
+	var added, removed []string
+	err = s.db.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
+		previous, err := cdao.GetClusterNodeNames(ctx, tx, c.ID)
+		if err != nil {
+			return err
+		}
+		added, removed = diffClusterNodes(previous, req.GetNodes())
+
+		var events []models.ClusterNodeEvent
+		for _, name := range added {
+			events = append(events, models.ClusterNodeEvent{ClusterId: c.ID, NodeName: name, Action: cdao.ClusterNodeAdded, CreatedAt: now})
+		}
+		for _, name := range removed {
+			events = append(events, models.ClusterNodeEvent{ClusterId: c.ID, NodeName: name, Action: cdao.ClusterNodeRemoved, CreatedAt: now})
+		}
+
+		if err := cdao.DeleteClusterNodes(ctx, tx, c.ID, removed); err != nil {
+			return err
+		}
+		if err := cdao.UpsertClusterNodes(ctx, tx, nodes); err != nil {
+			return err
+		}
+		if err := cdao.CreateClusterNodeEvents(ctx, tx, events); err != nil {
+			return err
+		}
+		return cdao.UpsertClusterInventory(ctx, tx, &models.ClusterInventory{
+			ClusterId:         c.ID,
+			KubernetesVersion: summary.KubernetesVersion,
+			NodeCount:         int(summary.NodeCount),
+			ReadyNodeCount:    int(summary.ReadyNodeCount),
+			Capacity:          capacity,
+			Allocatable:       allocatable,
+			Allocated:         allocated,
+			SyncedAt:          now,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(added) > 0 || len(removed) > 0 {
+		_log.Infow("cluster nodes changed", "cluster", c.Name, "id", c.ID, "added", added, "removed", removed)
+	}
+
+	reason := fmt.Sprintf("%d of %d nodes ready.", summary.ReadyNodeCount, summary.NodeCount)
+	if err := s.setClusterCondition(ctx, c, clstrutil.NewClusterNodeSync(constants.Success, reason)); err != nil {
+		// the inventory is stored, the condition is set again on the
+		// next snapshot
+		_log.Warnw("unable to update node sync condition of cluster", "cluster", c.Name, "error", err)
+	}
+
+	return &sentryrpc.UpdateClusterNodesResponse{
+		Added:   int32(len(added)),
+		Removed: int32(len(removed)),
+	}, nil
+}
+
+// setClusterCondition sets the condition of the cluster unless it
+// already has the status and reason
+func (s *clusterService) setClusterCondition(ctx context.Context, c *models.Cluster, condition *infrav3.ClusterCondition) error {
+	var conditions []*infrav3.ClusterCondition
+	if c.Conditions != nil {
+		if err := json.Unmarshal(c.Conditions, &conditions); err != nil {
+			return err
+		}
+	}
+	found := false
+	for i, ec := range conditions {
+		if ec.Type == condition.Type {
+			if ec.Status == condition.Status && ec.Reason == condition.Reason {
+				return nil
+			}
+			conditions[i] = condition
+			found = true
+		}
+	}
+	if !found {
+		conditions = append(conditions, condition)
+	}
+	cb, err := json.Marshal(conditions)
+	if err != nil {
+		return err
+	}
+	previous := c.Conditions
+	c.Conditions = json.RawMessage(cb)
+	_, err = cdao.UpdateClusterConditions(ctx, s.db, c, previous)
+	return err
+}
+
+// getClusterNodes returns the nodes last reported for the cluster
+// along with their totals, nil if they were never reported
+func (s *clusterService) getClusterNodes(ctx context.Context, clusterID uuid.UUID) ([]*infrav3.ClusterNode, *infrav3.ClusterNodeSummary, error) {
+	inv, err := cdao.GetClusterInventory(ctx, s.db, clusterID)
+	if err != nil || inv == nil {
+		return nil, nil, err
+	}
+	summary := &infrav3.ClusterNodeSummary{
+		KubernetesVersion: inv.KubernetesVersion,
+		NodeCount:         int64(inv.NodeCount),
+		ReadyNodeCount:    int64(inv.ReadyNodeCount),
+		Capacity:          &infrav3.Resources{},
+		Allocatable:       &infrav3.Resources{},
+		Allocated:         &infrav3.Resources{},
+		SyncedAt:          timestamppb.New(inv.SyncedAt),
+	}
+	if inv.Capacity != nil {
+		json.Unmarshal(inv.Capacity, summary.Capacity)
+	}
+	if inv.Allocatable != nil {
+		json.Unmarshal(inv.Allocatable, summary.Allocatable)
+	}
+	if inv.Allocated != nil {
+		json.Unmarshal(inv.Allocated, summary.Allocated)
+	}
+
+	cns, err := cdao.GetClusterNodes(ctx, s.db, clusterID)
+	if err != nil {
+		return nil, nil, err
+	}
+	nodes := make([]*infrav3.ClusterNode, 0, len(cns))
+	for _, cn := range cns {
+		var node infrav3.ClusterNode
+		if err := json.Unmarshal(cn.Node, &node); err != nil {
+			_log.Infow("unable to unmarshal node of cluster", "cluster", clusterID, "node", cn.Name, "error", err)
+			continue
+		}
+		nodes = append(nodes, &node)
+	}
+	return nodes, summary, nil
+}
@@ -0,0 +1,137 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/pkg/query"
+	sentryrpc "github.com/paralus/paralus/proto/rpc/sentry"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	infrav3 "github.com/paralus/paralus/proto/types/infrapb/v3"
+	v1 "k8s.io/api/core/v1"
+)
+
+func newClusterNode(name string, ready bool, cpus int64) *infrav3.ClusterNode {
+	state := infrav3.ClusterNodeState_ClusterNodeNotReady
+	if ready {
+		state = infrav3.ClusterNodeState_ClusterNodeReady
+	}
+	return &infrav3.ClusterNode{
+		Metadata: &commonv3.Metadata{Name: name},
+		Spec: &infrav3.ClusterNodeSpec{
+			Taints: []*v1.Taint{{Key: "dedicated", Value: "infra", Effect: v1.TaintEffectNoSchedule}},
+		},
+		Status: &infrav3.ClusterNodeStatus{
+			State:       state,
+			NodeInfo:    &v1.NodeSystemInfo{KubeletVersion: "v1.26.3"},
+			Capacity:    &infrav3.Resources{CpuCount: cpus, MemoryKB: 1024},
+			Allocatable: &infrav3.Resources{CpuCount: cpus - 1, MemoryKB: 512},
+		},
+	}
+}
+
+func TestSummarizeClusterNodes(t *testing.T) {
+	summary := summarizeClusterNodes("v1.26.3", []*infrav3.ClusterNode{
+		newClusterNode("node-1", true, 4),
+		newClusterNode("node-2", false, 8),
+	})
+	if summary.NodeCount != 2 || summary.ReadyNodeCount != 1 {
+		t.Errorf("expected 1 of 2 nodes ready, got %d of %d", summary.ReadyNodeCount, summary.NodeCount)
+	}
+	if summary.Capacity.CpuCount != 12 || summary.Allocatable.CpuCount != 10 || summary.Capacity.MemoryKB != 2048 {
+		t.Errorf("unexpected totals %v", summary)
+	}
+}
+
+func TestDiffClusterNodes(t *testing.T) {
+	added, removed := diffClusterNodes([]string{"node-1", "node-2"}, []*infrav3.ClusterNode{
+		newClusterNode("node-2", true, 4),
+		newClusterNode("node-3", true, 4),
+	})
+	if len(added) != 1 || added[0] != "node-3" {
+		t.Errorf("expected node-3 to be added, got %v", added)
+	}
+	if len(removed) != 1 || removed[0] != "node-1" {
+		t.Errorf("expected node-1 to be removed, got %v", removed)
+	}
+}
+
+func TestUpdateClusterNodesDuplicate(t *testing.T) {
+	cs, mock, closeDB := newHealthTestClusterService(t)
+	defer closeDB()
+
+	_, err := cs.UpdateClusterNodes(context.Background(), uuid.New().String(), &sentryrpc.UpdateClusterNodesRequest{
+		Nodes: []*infrav3.ClusterNode{newClusterNode("node-1", true, 4), newClusterNode("node-1", true, 4)},
+	})
+	if err == nil {
+		t.Error("expected duplicate nodes to be rejected")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestUpdateClusterNodes(t *testing.T) {
+	cs, mock, closeDB := newHealthTestClusterService(t)
+	defer closeDB()
+
+	cuuid := uuid.New().String()
+	puuid := uuid.New().String()
+	mock.ExpectQuery(`SELECT "cluster"."id", .* FROM "cluster_clusters" AS "cluster" WHERE .id = '` + cuuid + `'. AND .trash = FALSE.`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "project_id"}).AddRow(cuuid, "c-"+cuuid, puuid))
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT "clusternode"."name" FROM "cluster_nodes" AS "clusternode" WHERE .cluster_id = '` + cuuid + `'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("node-1").AddRow("node-2"))
+	mock.ExpectExec(`DELETE FROM "cluster_nodes" AS "clusternode" WHERE .cluster_id = '` + cuuid + `'. AND .name IN .'node-1'..`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`INSERT INTO "cluster_nodes" AS "clusternode" .*'node-2'.*'node-3'.* ON CONFLICT \(cluster_id, name\) DO UPDATE`).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(`INSERT INTO "cluster_node_events" .*'node-3', 'added'.*'node-1', 'removed'`).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(`INSERT INTO "cluster_inventory" AS "clusterinventory" .*'v1.26.3', 2, 1, .* ON CONFLICT \(cluster_id\) DO UPDATE`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	mock.ExpectExec(`UPDATE "cluster_clusters" AS "cluster" SET conditions = .*ClusterNodeSync.*1 of 2 nodes ready.* WHERE .id = '` + cuuid + `'.`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	resp, err := cs.UpdateClusterNodes(context.Background(), cuuid, &sentryrpc.UpdateClusterNodesRequest{
+		KubernetesVersion: "v1.26.3",
+		Nodes:             []*infrav3.ClusterNode{newClusterNode("node-2", true, 4), newClusterNode("node-3", false, 4)},
+	})
+	if err != nil {
+		t.Fatal("could not update cluster nodes:", err)
+	}
+	if resp.Added != 1 || resp.Removed != 1 {
+		t.Errorf("expected one node added and one removed, got %v", resp)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestListClustersByKubernetesVersion(t *testing.T) {
+	cs, mock, closeDB := newHealthTestClusterService(t)
+	defer closeDB()
+
+	puuid := uuid.New().String()
+	ouuid := uuid.New().String()
+	pruuid := uuid.New().String()
+	mock.ExpectQuery(`SELECT "project"."id", .* FROM "authsrv_project" AS "project" WHERE .name = 'project-1'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "organization_id", "partner_id"}).AddRow(pruuid, "project-1", ouuid, puuid))
+	mock.ExpectQuery(`SELECT "cluster"."id", .* FROM "cluster_clusters" AS "cluster" WHERE .* AND .trash = FALSE. AND .id IN \(SELECT "clusterinventory"."cluster_id" FROM "cluster_inventory" AS "clusterinventory" WHERE .ltrim\(kubernetes_version, 'v'\) ~ '\^1\\\.26\(\[\.\+-\]\|\$\)'. AND .node_count >= 3.\)`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}))
+
+	_, err := cs.List(context.Background(), query.WithOptions(&commonv3.QueryOptions{
+		Project:           "project-1",
+		KubernetesVersion: "v1.26",
+		MinNodes:          3,
+	}))
+	if err != nil {
+		t.Fatal("could not list clusters:", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,222 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	clstrutil "github.com/paralus/paralus/internal/cluster"
+	clstrconstants "github.com/paralus/paralus/internal/cluster/constants"
+	cdao "github.com/paralus/paralus/internal/cluster/dao"
+	"github.com/paralus/paralus/internal/dao"
+	"github.com/paralus/paralus/internal/models"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	infrav3 "github.com/paralus/paralus/proto/types/infrapb/v3"
+	"github.com/uptrace/bun"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// updateBootstrapAgentsCluster updates the bootstrap agents of the
+// moved or renamed cluster with its name and projects, the agents
+// keep their tokens so that the relay agent does not have to be
+// bootstrapped again
+func (s *clusterService) updateBootstrapAgentsCluster(ctx context.Context, db bun.IDB, c *models.Cluster, movedAt time.Time) error {
+	agents, err := dao.GetBootstrapAgentsForClusterID(ctx, db, c.ID.String(), c.OrganizationId)
+	if err != nil {
+		return err
+	}
+	projects, err := s.getSharedProjects(ctx, db, c)
+	if err != nil {
+		return err
+	}
+	pcs := make([]*infrav3.ProjectCluster, 0, len(projects))
+	for _, pc := range projects {
+		pcs = append(pcs, &infrav3.ProjectCluster{
+			ProjectID: pc.ProjectID.String(),
+			ClusterID: pc.ClusterID.String(),
+		})
+	}
+	shareMode := infrav3.ClusterShareMode(infrav3.ClusterShareMode_value[c.ShareMode])
+
+	for i := range agents {
+		ba := &agents[i]
+		labels := make(map[string]string)
+		if len(ba.Labels) > 0 {
+			if err := json.Unmarshal(ba.Labels, &labels); err != nil {
+				return err
+			}
+		}
+		setBootstrapAgentProjectLabels(labels, c.ProjectId.String(), shareMode, pcs)
+		labels[clstrconstants.ClusterLabelKey] = c.DisplayName
+		if !movedAt.IsZero() {
+			labels[clstrconstants.ClusterMovedAtKey] = strconv.FormatInt(movedAt.Unix(), 10)
+		}
+		lb, err := json.Marshal(labels)
+		if err != nil {
+			return err
+		}
+
+		ba.Labels = json.RawMessage(lb)
+		ba.ProjectId = c.ProjectId
+		ba.DisplayName = c.DisplayName
+		if err := dao.UpdateBootstrapAgentCluster(ctx, db, ba); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// clusterResponse returns the cluster with the projects it is shared
+// with
+func (s *clusterService) clusterResponse(ctx context.Context, c *models.Cluster) (*infrav3.Cluster, error) {
+	projects, err := s.getSharedProjects(ctx, s.db, c)
+	if err != nil {
+		return nil, err
+	}
+	clstr := &infrav3.Cluster{
+		ApiVersion: clstrconstants.ApiVersion,
+		Kind:       clstrconstants.ClusterKind,
+	}
+	return s.prepareClusterResponse(ctx, clstr, c, nil, projects, true), nil
+}
+
+// MoveCluster moves the cluster to another project of its organization.
+// Kubeconfigs issued before the move are no longer authorized for the
+// cluster and have to be downloaded again.
+func (s *clusterService) MoveCluster(ctx context.Context, meta *commonv3.Metadata, project string) (*infrav3.Cluster, error) {
+	if project == "" {
+		return nil, status.Error(codes.InvalidArgument, "project to move the cluster to is missing")
+	}
+	c, err := s.getOwnedCluster(ctx, meta)
+	if err != nil {
+		return nil, err
+	}
+	targets, err := s.getOrganizationProjects(ctx, c, []string{project})
+	if err != nil {
+		return nil, err
+	}
+	target := targets[0]
+	if target.ID == c.ProjectId {
+		return nil, status.Errorf(codes.InvalidArgument, "cluster %s is already in project %s", c.Name, target.Name)
+	}
+
+	err = s.db.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
+		current, err := cdao.GetProjectsForCluster(ctx, tx, c.ID)
+		if err != nil {
+			return err
+		}
+		shared := false
+		for _, pc := range current {
+			if pc.ProjectID == target.ID {
+				shared = true
+				break
+			}
+		}
+		if !shared {
+			err = cdao.CreateProjectCluster(ctx, tx, &models.ProjectCluster{
+				ProjectID: target.ID,
+				ClusterID: c.ID,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		err = cdao.DeleteProjectClusters(ctx, tx, c.ID, []uuid.UUID{c.ProjectId})
+		if err != nil {
+			return err
+		}
+
+		c.ProjectId = target.ID
+		if err := cdao.UpdateClusterIdentity(ctx, tx, c); err != nil {
+			return err
+		}
+		return s.updateBootstrapAgentsCluster(ctx, tx, c, time.Now())
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	clstr, err := s.clusterResponse(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	s.notifyCluster(ctx, clstr)
+
+	CreateClusterAuditEvent(ctx, s.al, AuditActionMove, c.Name, c.ID, meta.GetProject())
+	CreateClusterAuditEvent(ctx, s.al, AuditActionMove, c.Name, c.ID, target.Name)
+	return clstr, nil
+}
+
+// RenameCluster changes the name of the cluster. The id of the cluster
+// and the tokens of its relay agent are unchanged, kubeconfigs issued
+// before the rename keep working with the previous name of the cluster
+// in their context.
+func (s *clusterService) RenameCluster(ctx context.Context, meta *commonv3.Metadata, name string) (*infrav3.Cluster, error) {
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "new cluster name is missing")
+	}
+	if !clstrutil.HasValidCharacters(strings.ToLower(name)) {
+		return nil, status.Error(codes.InvalidArgument, "cluster name contains invalid characters. valid characters are `[A-Z][a-z][0-9]-`")
+	}
+	if len(name) > 63 {
+		return nil, status.Error(codes.InvalidArgument, "maximum characters allowed for cluster name is 63. please try another name")
+	}
+
+	c, err := s.getOwnedCluster(ctx, meta)
+	if err != nil {
+		return nil, err
+	}
+	if strings.ToLower(name) == c.Name {
+		return nil, status.Errorf(codes.InvalidArgument, "cluster %s already has the name", c.Name)
+	}
+	_, err = dao.GetByNamePartnerOrg(ctx, s.db, strings.ToLower(name), uuid.NullUUID{UUID: c.PartnerId, Valid: true},
+		uuid.NullUUID{UUID: c.OrganizationId, Valid: true}, &models.Cluster{})
+	if err == nil {
+		return nil, status.Errorf(codes.AlreadyExists, "cluster %s already exists", name)
+	}
+	if err != sql.ErrNoRows {
+		return nil, err
+	}
+
+	labels := make(map[string]string)
+	if len(c.Labels) > 0 {
+		if err := json.Unmarshal(c.Labels, &labels); err != nil {
+			return nil, fmt.Errorf("unable to read labels of cluster %s: %w", c.Name, err)
+		}
+	}
+	labels[clstrconstants.ClusterLabelKey] = name
+	lb, err := json.Marshal(labels)
+	if err != nil {
+		return nil, err
+	}
+
+	previous := c.Name
+	c.Name = strings.ToLower(name)
+	c.DisplayName = name
+	c.Labels = json.RawMessage(lb)
+
+	err = s.db.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
+		if err := cdao.UpdateClusterIdentity(ctx, tx, c); err != nil {
+			return err
+		}
+		return s.updateBootstrapAgentsCluster(ctx, tx, c, time.Time{})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	clstr, err := s.clusterResponse(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	s.notifyCluster(ctx, clstr)
+
+	CreateClusterAuditEvent(ctx, s.al, AuditActionRename, previous, c.ID, meta.GetProject())
+	return clstr, nil
+}
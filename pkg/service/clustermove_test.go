@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestMoveClusterSameProject(t *testing.T) {
+	cs, mock, closeDB := newHealthTestClusterService(t)
+	defer closeDB()
+
+	puuid := uuid.New().String()
+	cuuid := uuid.New().String()
+	expectOwnedCluster(mock, puuid, cuuid, "CUSTOM")
+	mock.ExpectQuery(`SELECT "project"."id", .* FROM "authsrv_project" AS "project" WHERE .name = 'project-1'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "organization_id", "partner_id"}).AddRow(puuid, "project-1", puuid, puuid))
+
+	_, err := cs.MoveCluster(context.Background(), &commonv3.Metadata{Name: "c1", Project: "project-1"}, "project-1")
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected invalid argument moving cluster to its project, got %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMoveCluster(t *testing.T) {
+	cs, mock, closeDB := newHealthTestClusterService(t)
+	defer closeDB()
+
+	puuid := uuid.New().String()
+	cuuid := uuid.New().String()
+	p2uuid := uuid.New().String()
+	auuid := uuid.New().String()
+	expectOwnedCluster(mock, puuid, cuuid, "CUSTOM")
+	mock.ExpectQuery(`SELECT "project"."id", .* FROM "authsrv_project" AS "project" WHERE .name = 'project-2'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "organization_id", "partner_id"}).AddRow(p2uuid, "project-2", puuid, puuid))
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT .* FROM "cluster_project_cluster" AS "projectcluster" WHERE .cluster_id = '` + cuuid + `'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"project_id", "cluster_id"}).AddRow(puuid, cuuid))
+	mock.ExpectExec(`INSERT INTO "cluster_project_cluster" .*'` + p2uuid + `', '` + cuuid + `'`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE "cluster_project_cluster" AS "projectcluster" SET trash = TRUE WHERE .cluster_id = '` + cuuid + `'. AND .project_id IN .'` + puuid + `'.`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE "cluster_clusters" AS "cluster" SET name = 'c1', .*project_id = '` + p2uuid + `'.* WHERE .id = '` + cuuid + `'.`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`SELECT .* FROM "sentry_bootstrap_agent" AS "ba" WHERE .name = '` + cuuid + `'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "labels"}).AddRow(auuid, cuuid, `{"paralus.dev/clusterName":"c1","project/`+puuid+`":""}`))
+	mock.ExpectQuery(`SELECT .* FROM "cluster_project_cluster" AS "projectcluster" WHERE .cluster_id = '` + cuuid + `'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"project_id", "cluster_id"}).AddRow(p2uuid, cuuid))
+	mock.ExpectExec(`UPDATE "sentry_bootstrap_agent" AS "ba" SET project_id = '` + p2uuid + `', .*"paralus.dev/movedAt":.*"paralus.dev/ownerProject":"` + p2uuid + `","project/` + p2uuid + `":"".* WHERE .id = '` + auuid + `'.`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	mock.ExpectQuery(`SELECT .* FROM "cluster_project_cluster" AS "projectcluster" WHERE .cluster_id = '` + cuuid + `'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"project_id", "cluster_id"}).AddRow(p2uuid, cuuid))
+
+	cluster, err := cs.MoveCluster(context.Background(), &commonv3.Metadata{Name: "c1", Project: "project-1"}, "project-2")
+	if err != nil {
+		t.Fatal("could not move cluster:", err)
+	}
+	if cluster.Metadata.Id != cuuid {
+		t.Errorf("expected cluster id %s to be unchanged, got %s", cuuid, cluster.Metadata.Id)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRenameClusterInvalidName(t *testing.T) {
+	cs, mock, closeDB := newHealthTestClusterService(t)
+	defer closeDB()
+
+	_, err := cs.RenameCluster(context.Background(), &commonv3.Metadata{Name: "c1", Project: "project-1"}, "c_1")
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected invalid argument for name with invalid characters, got %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRenameClusterExists(t *testing.T) {
+	cs, mock, closeDB := newHealthTestClusterService(t)
+	defer closeDB()
+
+	puuid := uuid.New().String()
+	cuuid := uuid.New().String()
+	expectOwnedCluster(mock, puuid, cuuid, "CUSTOM")
+	mock.ExpectQuery(`SELECT "cluster"."id", .* FROM "cluster_clusters" AS "cluster" WHERE .organization_id = '` + puuid + `'. AND .partner_id = '` + puuid + `'. AND .name = 'c2'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(uuid.New().String(), "c2"))
+
+	_, err := cs.RenameCluster(context.Background(), &commonv3.Metadata{Name: "c1", Project: "project-1"}, "c2")
+	if status.Code(err) != codes.AlreadyExists {
+		t.Errorf("expected already exists renaming to name of another cluster, got %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRenameCluster(t *testing.T) {
+	cs, mock, closeDB := newHealthTestClusterService(t)
+	defer closeDB()
+
+	puuid := uuid.New().String()
+	cuuid := uuid.New().String()
+	auuid := uuid.New().String()
+	expectOwnedCluster(mock, puuid, cuuid, "CUSTOM")
+	mock.ExpectQuery(`SELECT "cluster"."id", .* FROM "cluster_clusters" AS "cluster" WHERE .organization_id = '` + puuid + `'. AND .partner_id = '` + puuid + `'. AND .name = 'c2'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}))
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE "cluster_clusters" AS "cluster" SET name = 'c2', display_name = 'C2', project_id = '` + puuid + `', labels = '{"paralus.dev/clusterName":"C2"}'.* WHERE .id = '` + cuuid + `'.`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`SELECT .* FROM "sentry_bootstrap_agent" AS "ba" WHERE .name = '` + cuuid + `'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "token", "labels"}).AddRow(auuid, cuuid, "token-1", `{"paralus.dev/clusterName":"c1","project/`+puuid+`":""}`))
+	mock.ExpectQuery(`SELECT .* FROM "cluster_project_cluster" AS "projectcluster" WHERE .cluster_id = '` + cuuid + `'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"project_id", "cluster_id"}).AddRow(puuid, cuuid))
+	mock.ExpectExec(`UPDATE "sentry_bootstrap_agent" AS "ba" SET project_id = '` + puuid + `', display_name = 'C2', labels = '{"paralus.dev/clusterName":"C2",.*}', modified_at = .* WHERE .id = '` + auuid + `'.`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	mock.ExpectQuery(`SELECT .* FROM "cluster_project_cluster" AS "projectcluster" WHERE .cluster_id = '` + cuuid + `'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"project_id", "cluster_id"}).AddRow(puuid, cuuid))
+
+	cluster, err := cs.RenameCluster(context.Background(), &commonv3.Metadata{Name: "c1", Project: "project-1"}, "C2")
+	if err != nil {
+		t.Fatal("could not rename cluster:", err)
+	}
+	if cluster.Metadata.Name != "c2" || cluster.Metadata.Id != cuuid {
+		t.Errorf("expected cluster %s renamed to c2, got %s %s", cuuid, cluster.Metadata.Id, cluster.Metadata.Name)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
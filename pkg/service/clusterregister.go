@@ -0,0 +1,248 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	clstrutil "github.com/paralus/paralus/internal/cluster"
+	clstrconstants "github.com/paralus/paralus/internal/cluster/constants"
+	cdao "github.com/paralus/paralus/internal/cluster/dao"
+	"github.com/paralus/paralus/internal/cluster/util"
+	"github.com/paralus/paralus/internal/dao"
+	"github.com/paralus/paralus/internal/models"
+	"github.com/paralus/paralus/pkg/event"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	infrav3 "github.com/paralus/paralus/proto/types/infrapb/v3"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// validateProxyConfig validates the proxy configuration of a cluster
+func validateProxyConfig(pc *infrav3.ProxyConfig) error {
+	if pc == nil || !pc.Enabled {
+		return nil
+	}
+	if pc.HttpProxy == "" && pc.HttpsProxy == "" {
+		return fmt.Errorf("proxy is enabled without http or https proxy")
+	}
+	for _, p := range []string{pc.HttpProxy, pc.HttpsProxy} {
+		if p == "" {
+			continue
+		}
+		u, err := url.Parse(p)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("invalid proxy url %s", p)
+		}
+	}
+	if pc.BootstrapCA != "" {
+		if b, _ := pem.Decode([]byte(pc.BootstrapCA)); b == nil {
+			return fmt.Errorf("bootstrap CA is not PEM encoded")
+		}
+	}
+	return nil
+}
+
+// validateClusterRegistrations validates all the clusters to register
+// in the project and returns the metros of the clusters by name
+func (s *clusterService) validateClusterRegistrations(ctx context.Context, proj *models.Project, clusters []*infrav3.Cluster) (map[string]*models.Metro, error) {
+	var errs []string
+	names := make(map[string]bool)
+	metros := make(map[string]*models.Metro)
+	for i, cluster := range clusters {
+		name := cluster.GetMetadata().GetName()
+		if name == "" {
+			errs = append(errs, fmt.Sprintf("cluster %d: name is missing", i+1))
+			continue
+		}
+		invalid := func(format string, a ...interface{}) {
+			errs = append(errs, fmt.Sprintf("cluster %s: ", name)+fmt.Sprintf(format, a...))
+		}
+
+		if project := cluster.Metadata.Project; project != "" && project != proj.Name {
+			invalid("belongs to project %s", project)
+		}
+		if names[strings.ToLower(name)] {
+			invalid("is declared more than once")
+		}
+		names[strings.ToLower(name)] = true
+		if !clstrutil.HasValidCharacters(strings.ToLower(name)) {
+			invalid("name contains invalid characters. valid characters are `[A-Z][a-z][0-9]-`")
+		}
+		if len(name) > 63 {
+			invalid("maximum characters allowed for cluster name is 63")
+		}
+		if cluster.GetSpec().GetClusterType() == "" {
+			invalid("cluster type is missing")
+		} else if _, err := clstrutil.GetClusterGeneration(cluster.Spec.ClusterType); err != nil {
+			invalid("cluster generation is invalid")
+		}
+		if err := util.ValidateCustomLabels(cluster.Metadata.Labels); err != nil {
+			invalid("%s", err)
+		}
+		if err := validateProxyConfig(cluster.GetSpec().GetProxyConfig()); err != nil {
+			invalid("%s", err)
+		}
+
+		metro := cluster.GetSpec().GetMetro().GetName()
+		if metro == "" {
+			continue
+		}
+		if _, ok := metros[metro]; ok {
+			continue
+		}
+		m := &models.Metro{}
+		_, err := dao.GetByNamePartnerOrg(ctx, s.db, metro, uuid.NullUUID{UUID: proj.PartnerId, Valid: true}, uuid.NullUUID{UUID: uuid.Nil, Valid: false}, m)
+		if err == sql.ErrNoRows {
+			invalid("invalid cluster location, metro %s does not exist", metro)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		metros[metro] = m
+	}
+	if len(errs) > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid clusters: %s", strings.Join(errs, "; "))
+	}
+	return metros, nil
+}
+
+// registrationStatus sets the result of the registration of a cluster
+// as its status
+func registrationStatus(req, resp *infrav3.Cluster, conditionType string, err error) *infrav3.Cluster {
+	if err != nil {
+		req.Status = &commonv3.Status{
+			ConditionType:   conditionType,
+			ConditionStatus: commonv3.ConditionStatus_StatusFailed,
+			LastUpdated:     timestamppb.Now(),
+			Reason:          err.Error(),
+		}
+		return req
+	}
+	resp.Status = &commonv3.Status{
+		ConditionType:   conditionType,
+		ConditionStatus: commonv3.ConditionStatus_StatusOK,
+		LastUpdated:     timestamppb.Now(),
+	}
+	return resp
+}
+
+// updateRegisteredCluster updates the labels and metadata of a cluster
+// registered again
+func (s *clusterService) updateRegisteredCluster(ctx context.Context, c *models.Cluster, cluster *infrav3.Cluster, metro *models.Metro) (*infrav3.Cluster, error) {
+	current := make(map[string]string)
+	if len(c.Labels) > 0 {
+		if err := json.Unmarshal(c.Labels, &current); err != nil {
+			return nil, fmt.Errorf("unable to read labels of cluster %s: %w", c.Name, err)
+		}
+	}
+	metroName := current[clstrconstants.ClusterLocationKey]
+	if metro != nil {
+		c.MetroId = metro.ID
+		metroName = metro.Name
+	}
+	labels := clstrutil.ExtractV2ClusterLabels(cluster.Metadata.Labels, current, c.DisplayName, c.ClusterType, metroName)
+	labels[clstrconstants.ClusterLabelKey] = c.DisplayName
+	lb, err := json.Marshal(labels)
+	if err != nil {
+		return nil, err
+	}
+	c.Labels = json.RawMessage(lb)
+	if cluster.Metadata.Description != "" {
+		c.Description = cluster.Metadata.Description
+	}
+	if len(cluster.Metadata.Annotations) > 0 {
+		ab, _ := json.Marshal(cluster.Metadata.Annotations)
+		c.Annotations = json.RawMessage(ab)
+	}
+	if cluster.Spec.ProxyConfig != nil {
+		pb, _ := json.Marshal(cluster.Spec.ProxyConfig)
+		c.ProxyConfig = json.RawMessage(pb)
+	}
+	c.ModifiedAt = time.Now()
+
+	if err := cdao.UpdateCluster(ctx, s.db, c); err != nil {
+		return nil, err
+	}
+
+	clstr, err := s.clusterResponse(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	s.notifyCluster(ctx, clstr)
+
+	ev := event.Resource{
+		PartnerID:      c.PartnerId.String(),
+		OrganizationID: c.OrganizationId.String(),
+		ProjectID:      c.ProjectId.String(),
+		Name:           c.Name,
+		EventType:      event.ResourceUpdate,
+		ID:             c.ID.String(),
+	}
+	for _, h := range s.clusterHandlers {
+		h.OnChange(ev)
+	}
+
+	CreateClusterAuditEvent(ctx, s.al, AuditActionUpdate, c.Name, c.ID, cluster.Metadata.Project)
+	return clstr, nil
+}
+
+// registerCluster creates the cluster or updates it when it is already
+// registered in the project
+func (s *clusterService) registerCluster(ctx context.Context, proj *models.Project, cluster *infrav3.Cluster, metros map[string]*models.Metro) *infrav3.Cluster {
+	cluster.Metadata.Project = proj.Name
+
+	c := &models.Cluster{}
+	_, err := dao.GetByNamePartnerOrg(ctx, s.db, strings.ToLower(cluster.Metadata.Name), uuid.NullUUID{UUID: proj.PartnerId, Valid: true},
+		uuid.NullUUID{UUID: proj.OrganizationId, Valid: true}, c)
+	if err == sql.ErrNoRows {
+		resp, err := s.Create(ctx, cluster)
+		return registrationStatus(cluster, resp, "Create", err)
+	}
+	if err != nil {
+		return registrationStatus(cluster, nil, "Create", err)
+	}
+	if c.ProjectId != proj.ID {
+		return registrationStatus(cluster, nil, "Create", fmt.Errorf("cluster name is already taken in another project"))
+	}
+	resp, err := s.updateRegisteredCluster(ctx, c, cluster, metros[cluster.Spec.GetMetro().GetName()])
+	return registrationStatus(cluster, resp, "Update", err)
+}
+
+// RegisterClusters registers the clusters of the project. All the
+// clusters are validated before any of them is registered, clusters
+// already registered in the project have their labels and metadata
+// updated so that a manifest of clusters can be applied again. The
+// result of the registration of each cluster is its status.
+func (s *clusterService) RegisterClusters(ctx context.Context, project string, clusters []*infrav3.Cluster) ([]*infrav3.Cluster, error) {
+	if project == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid cluster data, project is missing")
+	}
+	if len(clusters) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no clusters to register")
+	}
+
+	var proj models.Project
+	if _, err := dao.GetByName(ctx, s.db, project, &proj); err != nil {
+		return nil, err
+	}
+
+	metros, err := s.validateClusterRegistrations(ctx, &proj, clusters)
+	if err != nil {
+		return nil, err
+	}
+
+	registered := make([]*infrav3.Cluster, 0, len(clusters))
+	for _, cluster := range clusters {
+		registered = append(registered, s.registerCluster(ctx, &proj, cluster, metros))
+	}
+	return registered, nil
+}
@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	infrav3 "github.com/paralus/paralus/proto/types/infrapb/v3"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestValidateProxyConfig(t *testing.T) {
+	tt := []struct {
+		name  string
+		pc    *infrav3.ProxyConfig
+		valid bool
+	}{
+		{"no proxy", nil, true},
+		{"disabled", &infrav3.ProxyConfig{HttpProxy: "proxy"}, true},
+		{"enabled", &infrav3.ProxyConfig{Enabled: true, HttpsProxy: "https://proxy:3128"}, true},
+		{"without proxy", &infrav3.ProxyConfig{Enabled: true, NoProxy: "10.0.0.0/8"}, false},
+		{"invalid url", &infrav3.ProxyConfig{Enabled: true, HttpProxy: "proxy:3128"}, false},
+		{"invalid bootstrap ca", &infrav3.ProxyConfig{Enabled: true, HttpProxy: "http://proxy:3128", BootstrapCA: "ca"}, false},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateProxyConfig(tc.pc)
+			if tc.valid && err != nil {
+				t.Errorf("expected valid proxy config, got %v", err)
+			}
+			if !tc.valid && err == nil {
+				t.Error("expected invalid proxy config")
+			}
+		})
+	}
+}
+
+func TestRegisterClustersInvalid(t *testing.T) {
+	cs, mock, closeDB := newHealthTestClusterService(t)
+	defer closeDB()
+
+	puuid := uuid.New().String()
+	mock.ExpectQuery(`SELECT "project"."id", .* FROM "authsrv_project" AS "project" WHERE .name = 'project-1'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "organization_id", "partner_id"}).AddRow(puuid, "project-1", puuid, puuid))
+
+	clusters := []*infrav3.Cluster{
+		{Metadata: &commonv3.Metadata{Name: "c1"}, Spec: &infrav3.ClusterSpec{ClusterType: "imported"}},
+		{Metadata: &commonv3.Metadata{Name: "C1"}, Spec: &infrav3.ClusterSpec{ClusterType: "imported"}},
+		{Metadata: &commonv3.Metadata{Name: "c2", Labels: map[string]string{"env": "-prod"}}, Spec: &infrav3.ClusterSpec{ClusterType: "imported"}},
+	}
+	_, err := cs.RegisterClusters(context.Background(), "project-1", clusters)
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected invalid argument for duplicate cluster and invalid labels, got %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRegisterClustersUpdate(t *testing.T) {
+	cs, mock, closeDB := newHealthTestClusterService(t)
+	defer closeDB()
+
+	puuid := uuid.New().String()
+	cuuid := uuid.New().String()
+	expectOwnedCluster(mock, puuid, cuuid, "CUSTOM")
+	mock.ExpectExec(`UPDATE "cluster_clusters" AS "cluster" SET .*"env":"prod".* WHERE .*'` + cuuid + `'`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`SELECT .* FROM "cluster_project_cluster" AS "projectcluster" WHERE .cluster_id = '` + cuuid + `'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"project_id", "cluster_id"}).AddRow(puuid, cuuid))
+
+	clusters := []*infrav3.Cluster{
+		{Metadata: &commonv3.Metadata{Name: "c1", Labels: map[string]string{"env": "prod"}}, Spec: &infrav3.ClusterSpec{ClusterType: "imported"}},
+	}
+	registered, err := cs.RegisterClusters(context.Background(), "project-1", clusters)
+	if err != nil {
+		t.Fatal("could not register clusters:", err)
+	}
+	if len(registered) != 1 {
+		t.Fatalf("expected 1 registered cluster, got %d", len(registered))
+	}
+	if s := registered[0].Status; s.ConditionType != "Update" || s.ConditionStatus != commonv3.ConditionStatus_StatusOK {
+		t.Errorf("expected cluster to be updated, got %s %s: %s", s.ConditionType, s.ConditionStatus, s.Reason)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRegisterClustersTakenName(t *testing.T) {
+	cs, mock, closeDB := newHealthTestClusterService(t)
+	defer closeDB()
+
+	puuid := uuid.New().String()
+	cuuid := uuid.New().String()
+	mock.ExpectQuery(`SELECT "project"."id", .* FROM "authsrv_project" AS "project" WHERE .name = 'project-1'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "organization_id", "partner_id"}).AddRow(puuid, "project-1", puuid, puuid))
+	mock.ExpectQuery(`SELECT "cluster"."id", .* FROM "cluster_clusters" AS "cluster" WHERE .organization_id = '` + puuid + `'. AND .partner_id = '` + puuid + `'. AND .name = 'c1'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "project_id"}).AddRow(cuuid, "c1", uuid.New().String()))
+
+	clusters := []*infrav3.Cluster{
+		{Metadata: &commonv3.Metadata{Name: "c1"}, Spec: &infrav3.ClusterSpec{ClusterType: "imported"}},
+	}
+	registered, err := cs.RegisterClusters(context.Background(), "project-1", clusters)
+	if err != nil {
+		t.Fatal("could not register clusters:", err)
+	}
+	if s := registered[0].Status; s.ConditionStatus != commonv3.ConditionStatus_StatusFailed {
+		t.Errorf("expected registration of cluster of another project to fail, got %s", s.ConditionStatus)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,44 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/dao"
+	"github.com/uptrace/bun"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// getClusterSelectorProject validates the cluster selector of the role
+// binding and returns the project it is scoped to, bindings of
+// organization roles apply to the matching clusters of every project.
+// Deny roles can not be bound with a cluster selector as the bindings
+// only grant kubectl access.
+func getClusterSelectorProject(ctx context.Context, db bun.IDB, scope, effect, role, project, selector string) (uuid.UUID, error) {
+	if getRoleEffect(effect) == denyEffect {
+		return uuid.Nil, fmt.Errorf("cluster selector can not be used with deny role '%v'", role)
+	}
+	if _, err := labels.Parse(selector); err != nil {
+		return uuid.Nil, fmt.Errorf("invalid cluster selector '%v' for role '%v'; %v", selector, role, err)
+	}
+
+	switch scope {
+	case "project":
+		if project == "" {
+			return uuid.Nil, fmt.Errorf("no project name provided for role '%v'", role)
+		}
+		projectId, err := dao.GetProjectId(ctx, db, project)
+		if err != nil {
+			return uuid.Nil, fmt.Errorf("unable to find project '%v'", project)
+		}
+		return projectId, nil
+	case "organization":
+		if project != "" {
+			return uuid.Nil, fmt.Errorf("project can not be provided for organization role '%v' with cluster selector", role)
+		}
+		return uuid.Nil, nil
+	default:
+		return uuid.Nil, fmt.Errorf("cluster selector can only be used with project and organization roles, not with role '%v'", role)
+	}
+}
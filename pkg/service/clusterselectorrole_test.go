@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+)
+
+func TestGetClusterSelectorProject(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	pruuid := uuid.New().String()
+	mock.ExpectQuery(`SELECT "project"."id" FROM "authsrv_project" AS "project" WHERE .name = 'project-1'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(pruuid))
+
+	tt := []struct {
+		name     string
+		scope    string
+		effect   string
+		project  string
+		selector string
+		expected string
+		err      bool
+	}{
+		{"project role", "project", "", "project-1", "env=prod", pruuid, false},
+		{"project role without project", "project", "", "", "env=prod", "", true},
+		{"organization role", "organization", "allow", "", "env=prod,region in (eu, us)", uuid.Nil.String(), false},
+		{"organization role with project", "organization", "", "project-1", "env=prod", "", true},
+		{"namespace role", "namespace", "", "project-1", "env=prod", "", true},
+		{"deny role", "organization", "deny", "", "env=prod", "", true},
+		{"invalid selector", "organization", "", "", "env in (prod", "", true},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			id, err := getClusterSelectorProject(context.Background(), db, tc.scope, tc.effect, "role", tc.project, tc.selector)
+			if tc.err {
+				if err == nil {
+					t.Errorf("expected error for %s", tc.name)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal("could not get project of cluster selector:", err)
+			}
+			if id.String() != tc.expected {
+				t.Errorf("expected project %s, got %s", tc.expected, id)
+			}
+		})
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,216 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/google/uuid"
+	clstrconstants "github.com/paralus/paralus/internal/cluster/constants"
+	cdao "github.com/paralus/paralus/internal/cluster/dao"
+	"github.com/paralus/paralus/internal/dao"
+	"github.com/paralus/paralus/internal/models"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	infrav3 "github.com/paralus/paralus/proto/types/infrapb/v3"
+	"github.com/uptrace/bun"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// getSharedProjects returns the projects the cluster is shared with,
+// every project of the organization when it is shared with all
+func (s *clusterService) getSharedProjects(ctx context.Context, db bun.IDB, c *models.Cluster) ([]models.ProjectCluster, error) {
+	if c.ShareMode != infrav3.ClusterShareMode_ALL.String() {
+		return cdao.GetProjectsForCluster(ctx, db, c.ID)
+	}
+
+	var projects []models.Project
+	_, err := dao.List(ctx, db, uuid.NullUUID{UUID: c.PartnerId, Valid: true}, uuid.NullUUID{UUID: c.OrganizationId, Valid: true}, &projects)
+	if err != nil {
+		return nil, err
+	}
+	pcs := make([]models.ProjectCluster, 0, len(projects))
+	for _, p := range projects {
+		pcs = append(pcs, models.ProjectCluster{ProjectID: p.ID, ClusterID: c.ID})
+	}
+	return pcs, nil
+}
+
+// setBootstrapAgentProjectLabels replaces the labels of the bootstrap
+// agent of the cluster with the projects the cluster is shared with,
+// kubeconfigs and kubectl authorization of the projects are looked up
+// from them
+func setBootstrapAgentProjectLabels(labels map[string]string, ownerProjectID string, shareMode infrav3.ClusterShareMode, projects []*infrav3.ProjectCluster) {
+	for key := range labels {
+		if strings.HasPrefix(key, clstrconstants.ClusterProjectPrefix) {
+			delete(labels, key)
+		}
+	}
+	delete(labels, clstrconstants.ClusterShareModeKey)
+
+	for _, project := range projects {
+		labels[clstrconstants.ClusterProjectPrefix+project.ProjectID] = ""
+	}
+	if ownerProjectID != "" {
+		labels[clstrconstants.ClusterOwnerProjectKey] = ownerProjectID
+	}
+	// projects created after the cluster was shared with all projects
+	// are not in the labels
+	if shareMode == infrav3.ClusterShareMode_ALL {
+		labels[clstrconstants.ClusterShareModeKey] = shareMode.String()
+	}
+}
+
+// getOwnedCluster returns the cluster of the organization of the
+// project, only the project owning the cluster can share it
+func (s *clusterService) getOwnedCluster(ctx context.Context, meta *commonv3.Metadata) (*models.Cluster, error) {
+	if meta.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "cluster name is missing")
+	}
+	proj, err := s.getProjectByName(ctx, meta.GetProject())
+	if err != nil {
+		return nil, err
+	}
+
+	var c models.Cluster
+	_, err = dao.GetByNamePartnerOrg(ctx, s.db, meta.GetName(), uuid.NullUUID{UUID: proj.PartnerId, Valid: true},
+		uuid.NullUUID{UUID: proj.OrganizationId, Valid: true}, &c)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "cluster %s not found", meta.GetName())
+		}
+		return nil, err
+	}
+	if c.ProjectId != proj.ID {
+		return nil, status.Errorf(codes.FailedPrecondition, "cluster %s is not owned by project %s", c.Name, proj.Name)
+	}
+	return &c, nil
+}
+
+// getOrganizationProjects returns the projects with the names, they
+// must be in the organization of the cluster
+func (s *clusterService) getOrganizationProjects(ctx context.Context, c *models.Cluster, names []string) ([]*models.Project, error) {
+	projects := make([]*models.Project, 0, len(names))
+	for _, name := range names {
+		proj, err := s.getProjectByName(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		if proj.OrganizationId != c.OrganizationId || proj.PartnerId != c.PartnerId {
+			return nil, status.Errorf(codes.InvalidArgument, "project %s is not in the organization of cluster %s", name, c.Name)
+		}
+		projects = append(projects, proj)
+	}
+	return projects, nil
+}
+
+// sharedClusterResponse updates the bootstrap agents of the shared
+// cluster and returns the cluster with its projects
+func (s *clusterService) sharedClusterResponse(ctx context.Context, c *models.Cluster, project string) (*infrav3.Cluster, error) {
+	err := s.UpdateProjectsForBootstrapAgentForCluster(ctx, &infrav3.Cluster{
+		Metadata: &commonv3.Metadata{Id: c.ID.String(), Name: c.Name},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	clstr, err := s.clusterResponse(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	CreateClusterAuditEvent(ctx, s.al, AuditActionUpdate, c.Name, c.ID, project)
+	return clstr, nil
+}
+
+func (s *clusterService) ShareCluster(ctx context.Context, meta *commonv3.Metadata, shareMode infrav3.ClusterShareMode, projects []string) (*infrav3.Cluster, error) {
+	if shareMode == infrav3.ClusterShareMode_ClusterShareModeNotSet {
+		shareMode = infrav3.ClusterShareMode_CUSTOM
+	}
+	if shareMode == infrav3.ClusterShareMode_CUSTOM && len(projects) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "projects to share the cluster with are missing")
+	}
+
+	c, err := s.getOwnedCluster(ctx, meta)
+	if err != nil {
+		return nil, err
+	}
+	shareWith, err := s.getOrganizationProjects(ctx, c, projects)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.db.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
+		current, err := cdao.GetProjectsForCluster(ctx, tx, c.ID)
+		if err != nil {
+			return err
+		}
+		shared := make(map[uuid.UUID]bool, len(current))
+		for _, pc := range current {
+			shared[pc.ProjectID] = true
+		}
+		for _, proj := range shareWith {
+			if shared[proj.ID] {
+				continue
+			}
+			shared[proj.ID] = true
+			err = cdao.CreateProjectCluster(ctx, tx, &models.ProjectCluster{
+				ProjectID: proj.ID,
+				ClusterID: c.ID,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		// sharing with more projects keeps the cluster shared with all
+		if shareMode == infrav3.ClusterShareMode_ALL && c.ShareMode != shareMode.String() {
+			c.ShareMode = shareMode.String()
+			return cdao.UpdateClusterShareMode(ctx, tx, c)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.sharedClusterResponse(ctx, c, meta.GetProject())
+}
+
+func (s *clusterService) UnshareCluster(ctx context.Context, meta *commonv3.Metadata, projects []string) (*infrav3.Cluster, error) {
+	c, err := s.getOwnedCluster(ctx, meta)
+	if err != nil {
+		return nil, err
+	}
+	if len(projects) == 0 && c.ShareMode != infrav3.ClusterShareMode_ALL.String() {
+		return nil, status.Error(codes.InvalidArgument, "projects to stop sharing the cluster with are missing")
+	}
+	unshareWith, err := s.getOrganizationProjects(ctx, c, projects)
+	if err != nil {
+		return nil, err
+	}
+	projectIDs := make([]uuid.UUID, 0, len(unshareWith))
+	for _, proj := range unshareWith {
+		if proj.ID == c.ProjectId {
+			return nil, status.Errorf(codes.InvalidArgument, "cluster %s can not be unshared with project %s owning it", c.Name, proj.Name)
+		}
+		projectIDs = append(projectIDs, proj.ID)
+	}
+
+	err = s.db.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
+		err := cdao.DeleteProjectClusters(ctx, tx, c.ID, projectIDs)
+		if err != nil {
+			return err
+		}
+		if c.ShareMode == infrav3.ClusterShareMode_ALL.String() {
+			c.ShareMode = infrav3.ClusterShareMode_CUSTOM.String()
+			return cdao.UpdateClusterShareMode(ctx, tx, c)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.sharedClusterResponse(ctx, c, meta.GetProject())
+}
@@ -0,0 +1,133 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	infrav3 "github.com/paralus/paralus/proto/types/infrapb/v3"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSetBootstrapAgentProjectLabels(t *testing.T) {
+	labels := map[string]string{
+		"paralus.dev/clusterName": "c1",
+		"project/removed":         "",
+		"paralus.dev/shareMode":   "ALL",
+	}
+	setBootstrapAgentProjectLabels(labels, "owner", infrav3.ClusterShareMode_CUSTOM, []*infrav3.ProjectCluster{
+		{ProjectID: "owner"}, {ProjectID: "shared"},
+	})
+
+	if _, ok := labels["project/removed"]; ok {
+		t.Error("expected project the cluster is no longer shared with to be removed")
+	}
+	if _, ok := labels["project/shared"]; !ok {
+		t.Error("expected project the cluster is shared with to be added")
+	}
+	if _, ok := labels["paralus.dev/shareMode"]; ok {
+		t.Error("expected share mode label to be removed for custom sharing")
+	}
+	if labels["paralus.dev/ownerProject"] != "owner" || labels["paralus.dev/clusterName"] != "c1" {
+		t.Errorf("unexpected labels %v", labels)
+	}
+
+	setBootstrapAgentProjectLabels(labels, "owner", infrav3.ClusterShareMode_ALL, nil)
+	if labels["paralus.dev/shareMode"] != "ALL" {
+		t.Errorf("expected share mode label for cluster shared with all projects, got %v", labels)
+	}
+}
+
+func expectOwnedCluster(mock sqlmock.Sqlmock, puuid, cuuid, shareMode string) {
+	mock.ExpectQuery(`SELECT "project"."id", .* FROM "authsrv_project" AS "project" WHERE .name = 'project-1'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "organization_id", "partner_id"}).AddRow(puuid, "project-1", puuid, puuid))
+	mock.ExpectQuery(`SELECT "cluster"."id", .* FROM "cluster_clusters" AS "cluster" WHERE .organization_id = '` + puuid + `'. AND .partner_id = '` + puuid + `'. AND .name = 'c1'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "project_id", "organization_id", "partner_id", "share_mode"}).AddRow(cuuid, "c1", puuid, puuid, puuid, shareMode))
+}
+
+func TestUnshareClusterOwnerProject(t *testing.T) {
+	cs, mock, closeDB := newHealthTestClusterService(t)
+	defer closeDB()
+
+	puuid := uuid.New().String()
+	cuuid := uuid.New().String()
+	expectOwnedCluster(mock, puuid, cuuid, "CUSTOM")
+	mock.ExpectQuery(`SELECT "project"."id", .* FROM "authsrv_project" AS "project" WHERE .name = 'project-1'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "organization_id", "partner_id"}).AddRow(puuid, "project-1", puuid, puuid))
+
+	_, err := cs.UnshareCluster(context.Background(), &commonv3.Metadata{Name: "c1", Project: "project-1"}, []string{"project-1"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected invalid argument unsharing with the owning project, got %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestShareClusterOtherOrganization(t *testing.T) {
+	cs, mock, closeDB := newHealthTestClusterService(t)
+	defer closeDB()
+
+	puuid := uuid.New().String()
+	cuuid := uuid.New().String()
+	ouuid := uuid.New().String()
+	expectOwnedCluster(mock, puuid, cuuid, "CUSTOM")
+	mock.ExpectQuery(`SELECT "project"."id", .* FROM "authsrv_project" AS "project" WHERE .name = 'project-2'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "organization_id", "partner_id"}).AddRow(ouuid, "project-2", ouuid, puuid))
+
+	_, err := cs.ShareCluster(context.Background(), &commonv3.Metadata{Name: "c1", Project: "project-1"}, infrav3.ClusterShareMode_CUSTOM, []string{"project-2"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected invalid argument sharing with project of another organization, got %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestShareClusterAll(t *testing.T) {
+	cs, mock, closeDB := newHealthTestClusterService(t)
+	defer closeDB()
+
+	puuid := uuid.New().String()
+	cuuid := uuid.New().String()
+	p2uuid := uuid.New().String()
+	expectOwnedCluster(mock, puuid, cuuid, "CUSTOM")
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT .* FROM "cluster_project_cluster" AS "projectcluster" WHERE .cluster_id = '` + cuuid + `'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"project_id", "cluster_id"}).AddRow(puuid, cuuid))
+	mock.ExpectExec(`UPDATE "cluster_clusters" AS "cluster" SET share_mode = 'ALL'.* WHERE .id = '` + cuuid + `'.`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	mock.ExpectQuery(`SELECT "cluster"."id", .* FROM "cluster_clusters" AS "cluster" WHERE .id = '` + cuuid + `'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "project_id", "organization_id", "partner_id", "share_mode"}).AddRow(cuuid, "c1", puuid, puuid, puuid, "ALL"))
+	mock.ExpectQuery(`SELECT .* FROM "authsrv_project" AS "project" WHERE .partner_id = '` + puuid + `'. AND .organization_id = '` + puuid + `'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(puuid, "project-1").AddRow(p2uuid, "project-2"))
+	mock.ExpectQuery(`SELECT .* FROM "sentry_bootstrap_agent_template" AS "bat"`).
+		WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("paralus-core-relay-agent"))
+	mock.ExpectQuery(`SELECT count\(\*\) FROM "sentry_bootstrap_agent_template" AS "bat"`).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectQuery(`SELECT .* FROM "sentry_bootstrap_agent" AS "ba" WHERE .*name = '` + cuuid + `'. AND .template_ref = 'paralus-core-relay-agent'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "template_ref", "labels"}).AddRow(cuuid, cuuid, "paralus-core-relay-agent", `{"paralus.dev/clusterName":"c1","project/`+puuid+`":""}`))
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT .* FROM "sentry_bootstrap_agent" AS "ba" WHERE .*name = '` + cuuid + `'. AND .template_ref = 'paralus-core-relay-agent'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "template_ref", "labels"}).AddRow(cuuid, cuuid, "paralus-core-relay-agent", `{"paralus.dev/clusterName":"c1","project/`+puuid+`":""}`))
+	mock.ExpectQuery(`UPDATE "sentry_bootstrap_agent" AS "ba" SET .*"paralus.dev/shareMode":"ALL".*"project/` + p2uuid + `":""`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(cuuid))
+	mock.ExpectCommit()
+	mock.ExpectQuery(`SELECT .* FROM "authsrv_project" AS "project" WHERE .partner_id = '` + puuid + `'. AND .organization_id = '` + puuid + `'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(puuid, "project-1").AddRow(p2uuid, "project-2"))
+
+	cluster, err := cs.ShareCluster(context.Background(), &commonv3.Metadata{Name: "c1", Project: "project-1"}, infrav3.ClusterShareMode_ALL, nil)
+	if err != nil {
+		t.Fatal("could not share cluster:", err)
+	}
+	if cluster.Spec.ShareMode != infrav3.ClusterShareMode_ALL || len(cluster.Spec.ClusterData.Projects) != 2 {
+		t.Errorf("expected cluster shared with all projects, got %v", cluster.Spec)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
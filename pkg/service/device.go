@@ -0,0 +1,399 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/dao"
+	"github.com/paralus/paralus/internal/models"
+	userrpcv3 "github.com/paralus/paralus/proto/rpc/user"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	bun "github.com/uptrace/bun"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// DeviceAccessTokenPrefix tells device access tokens apart from
+	// service account tokens in the Authorization header
+	DeviceAccessTokenPrefix  = "pda_"
+	DeviceRefreshTokenPrefix = "pdr_"
+	// DeviceCodeGrantType is the grant type of token requests polling
+	// for the approval of the device, RFC 8628
+	DeviceCodeGrantType    = "urn:ietf:params:oauth:grant-type:device_code"
+	RefreshTokenGrantType  = "refresh_token"
+	deviceCodeExpiry       = 10 * time.Minute
+	devicePollInterval     = 5 * time.Second
+	deviceAccessTokenTTL   = time.Hour
+	deviceRefreshTokenTTL  = 30 * 24 * time.Hour
+	deviceLastUsedInterval = time.Minute
+	// user codes entered by an account which do not match a device are
+	// counted, the account is held off after too many of them
+	deviceApprovalMaxFailures   = 5
+	deviceApprovalFailureWindow = deviceCodeExpiry
+
+	// user codes are typed by the user, vowels are left out so that the
+	// codes do not spell words
+	deviceUserCodeChars  = "BCDFGHJKLMNPQRSTVWXZ"
+	deviceUserCodeLength = 8
+
+	deviceStatusPending  = "pending"
+	deviceStatusApproved = "approved"
+	deviceStatusDenied   = "denied"
+)
+
+// errors of the token endpoint, the messages are the error codes of
+// RFC 8628 and RFC 6749
+var (
+	ErrAuthorizationPending = errors.New("authorization_pending")
+	ErrSlowDown             = errors.New("slow_down")
+	ErrAccessDenied         = errors.New("access_denied")
+	ErrExpiredToken         = errors.New("expired_token")
+	ErrInvalidGrant         = errors.New("invalid_grant")
+	ErrUnsupportedGrantType = errors.New("unsupported_grant_type")
+)
+
+// DeviceService is the interface for authorizing devices like the CLI
+// with the OAuth2 device authorization grant
+type DeviceService interface {
+	// start the authorization of a device
+	Authorize(context.Context, *userrpcv3.DeviceAuthorizationRequest) (*userrpcv3.DeviceAuthorizationResponse, error)
+	// approve or deny a device with the session of the user
+	Approve(context.Context, *userrpcv3.DeviceApprovalRequest) error
+	// issue tokens for an approved device or refresh them
+	Token(context.Context, *userrpcv3.DeviceTokenRequest) (*userrpcv3.DeviceTokenResponse, error)
+	// list the devices of the user
+	List(context.Context) (*userrpcv3.DeviceList, error)
+	// revoke a device of the user
+	Revoke(context.Context, *userrpcv3.Device) error
+	// authenticate a device access token
+	AuthenticateToken(context.Context, string) (*commonv3.SessionData, error)
+	// remove expired device codes and tokens
+	RemoveExpired(context.Context) error
+}
+
+// deviceService implements DeviceService
+type deviceService struct {
+	db              *bun.DB
+	verificationUri string
+	al              *zap.Logger
+}
+
+// NewDeviceService return new device service, users approve devices in
+// the console at consoleAddr
+func NewDeviceService(db *bun.DB, consoleAddr string, al *zap.Logger) DeviceService {
+	return &deviceService{db: db, verificationUri: consoleBaseUrl(consoleAddr) + "/device", al: al}
+}
+
+// consoleBaseUrl gets the URL of the console from its address
+func consoleBaseUrl(addr string) string {
+	host, port, err := net.SplitHostPort(addr)
+	if err == nil && port == "443" {
+		return "https://" + host
+	}
+	return "http://" + addr
+}
+
+func hashDeviceToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func generateDeviceToken(prefix string) (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return prefix + hex.EncodeToString(b), nil
+}
+
+func generateUserCode() (string, error) {
+	code := make([]byte, deviceUserCodeLength)
+	max := big.NewInt(int64(len(deviceUserCodeChars)))
+	for i := range code {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		code[i] = deviceUserCodeChars[n.Int64()]
+	}
+	return string(code[:4]) + "-" + string(code[4:]), nil
+}
+
+// normalizeUserCode accepts user codes typed in lower case or without
+// the dash
+func normalizeUserCode(code string) string {
+	code = strings.ToUpper(strings.NewReplacer("-", "", " ", "").Replace(code))
+	if len(code) != deviceUserCodeLength {
+		return code
+	}
+	return code[:4] + "-" + code[4:]
+}
+
+func (s *deviceService) Authorize(ctx context.Context, req *userrpcv3.DeviceAuthorizationRequest) (*userrpcv3.DeviceAuthorizationResponse, error) {
+	name := strings.TrimSpace(req.GetClientName())
+	if name == "" {
+		return nil, fmt.Errorf("client name should be set")
+	}
+	deviceCode, err := generateDeviceToken("")
+	if err != nil {
+		return nil, err
+	}
+	userCode, err := generateUserCode()
+	if err != nil {
+		return nil, err
+	}
+	device := &models.Device{
+		Name:           name,
+		Status:         deviceStatusPending,
+		UserCode:       userCode,
+		DeviceCodeHash: hashDeviceToken(deviceCode),
+		CodeExpiresAt:  time.Now().Add(deviceCodeExpiry),
+		CreatedAt:      time.Now(),
+	}
+	_, err = dao.Create(ctx, s.db, device)
+	if err != nil {
+		return nil, err
+	}
+	return &userrpcv3.DeviceAuthorizationResponse{
+		DeviceCode:              deviceCode,
+		UserCode:                userCode,
+		VerificationUri:         s.verificationUri,
+		VerificationUriComplete: s.verificationUri + "?user_code=" + url.QueryEscape(userCode),
+		ExpiresIn:               int64(deviceCodeExpiry.Seconds()),
+		Interval:                int64(devicePollInterval.Seconds()),
+	}, nil
+}
+
+func (s *deviceService) Approve(ctx context.Context, req *userrpcv3.DeviceApprovalRequest) error {
+	sd, ok := GetSessionDataFromContext(ctx)
+	if !ok {
+		return fmt.Errorf("unable to find session")
+	}
+	// devices are approved by users, a device can not approve another
+	if sd.IsServiceAccount || sd.AuthType == commonv3.AuthType_DeviceToken {
+		return fmt.Errorf("devices should be approved by users in the console")
+	}
+	accountId, err := uuid.Parse(sd.Account)
+	if err != nil {
+		return fmt.Errorf("unable to find account")
+	}
+	// user codes are short, they can not be guessed one after another
+	attempt, err := dao.GetDeviceApprovalAttempt(ctx, s.db, accountId)
+	if err != nil {
+		return err
+	}
+	if attempt.FailedAttempts >= deviceApprovalMaxFailures && time.Since(attempt.LastFailedAt) < deviceApprovalFailureWindow {
+		return fmt.Errorf("too many invalid user codes, try again later")
+	}
+	device, err := dao.GetPendingDevice(ctx, s.db, normalizeUserCode(req.GetUserCode()))
+	if err != nil || time.Now().After(device.CodeExpiresAt) {
+		if _, err := dao.RecordFailedDeviceApproval(ctx, s.db, accountId, time.Now().Add(-deviceApprovalFailureWindow)); err != nil {
+			_log.Warnw("unable to record invalid user code", "account", accountId, "error", err)
+		}
+		return fmt.Errorf("unable to find device with code '%v'", req.GetUserCode())
+	}
+
+	device.Status = deviceStatusApproved
+	action := AuditActionApprove
+	if req.GetDeny() {
+		device.Status = deviceStatusDenied
+		action = AuditActionReject
+	}
+	device.AccountId = uuid.NullUUID{UUID: accountId, Valid: true}
+	device.Username = sd.Username
+	device.OrganizationId = parseNullUUID(sd.Organization)
+	device.PartnerId = parseNullUUID(sd.Partner)
+	device.ApprovedAt = time.Now()
+	err = dao.DecidePendingDevice(ctx, s.db, device, "status", "account_id", "username", "organization_id", "partner_id", "approved_at")
+	if err != nil {
+		return err
+	}
+
+	CreateDeviceAuditEvent(ctx, s.al, action, device.Name, device.ID.String())
+	return nil
+}
+
+func parseNullUUID(v string) uuid.NullUUID {
+	id, err := uuid.Parse(v)
+	if err != nil {
+		return uuid.NullUUID{}
+	}
+	return uuid.NullUUID{UUID: id, Valid: true}
+}
+
+// issueTokens gives the device a new pair of tokens if the device code
+// or refresh token, by column, with the hash was not used yet. The
+// previous tokens can not be used anymore.
+func (s *deviceService) issueTokens(ctx context.Context, device *models.Device, column, hash string) (*userrpcv3.DeviceTokenResponse, error) {
+	accessToken, err := generateDeviceToken(DeviceAccessTokenPrefix)
+	if err != nil {
+		return nil, err
+	}
+	refreshToken, err := generateDeviceToken(DeviceRefreshTokenPrefix)
+	if err != nil {
+		return nil, err
+	}
+	device.DeviceCodeHash = ""
+	device.AccessTokenHash = hashDeviceToken(accessToken)
+	device.AccessExpiresAt = time.Now().Add(deviceAccessTokenTTL)
+	device.RefreshTokenHash = hashDeviceToken(refreshToken)
+	device.RefreshExpiresAt = time.Now().Add(deviceRefreshTokenTTL)
+	err = dao.IssueDeviceTokens(ctx, s.db, device, column, hash)
+	if err != nil {
+		_log.Infow("unable to issue device tokens", "device", device.ID, "error", err)
+		return nil, ErrInvalidGrant
+	}
+	return &userrpcv3.DeviceTokenResponse{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    int64(deviceAccessTokenTTL.Seconds()),
+	}, nil
+}
+
+func (s *deviceService) Token(ctx context.Context, req *userrpcv3.DeviceTokenRequest) (*userrpcv3.DeviceTokenResponse, error) {
+	switch req.GetGrantType() {
+	case DeviceCodeGrantType:
+		// the device code is cleared once the tokens are issued, it can
+		// not be used again
+		hash := hashDeviceToken(req.GetDeviceCode())
+		device, err := dao.GetDeviceByDeviceCode(ctx, s.db, hash)
+		if err != nil {
+			return nil, ErrInvalidGrant
+		}
+		if time.Now().After(device.CodeExpiresAt) {
+			return nil, ErrExpiredToken
+		}
+		switch device.Status {
+		case deviceStatusApproved:
+			return s.issueTokens(ctx, device, "device_code_hash", hash)
+		case deviceStatusDenied:
+			return nil, ErrAccessDenied
+		}
+		polledAt := device.LastPolledAt
+		device.LastPolledAt = time.Now()
+		if err := dao.UpdateDevice(ctx, s.db, device, "last_polled_at"); err != nil {
+			return nil, err
+		}
+		if time.Since(polledAt) < devicePollInterval {
+			return nil, ErrSlowDown
+		}
+		return nil, ErrAuthorizationPending
+	case RefreshTokenGrantType:
+		hash := hashDeviceToken(req.GetRefreshToken())
+		device, err := dao.GetDeviceByRefreshToken(ctx, s.db, hash)
+		if err != nil || device.Status != deviceStatusApproved || time.Now().After(device.RefreshExpiresAt) {
+			return nil, ErrInvalidGrant
+		}
+		return s.issueTokens(ctx, device, "refresh_token_hash", hash)
+	}
+	return nil, ErrUnsupportedGrantType
+}
+
+func toDevice(device *models.Device) *userrpcv3.Device {
+	d := &userrpcv3.Device{
+		Id:         device.ID.String(),
+		Name:       device.Name,
+		CreatedAt:  timestamppb.New(device.CreatedAt),
+		ApprovedAt: timestamppb.New(device.ApprovedAt),
+	}
+	if !device.LastUsedAt.IsZero() {
+		d.LastUsedAt = timestamppb.New(device.LastUsedAt)
+	}
+	return d
+}
+
+func (s *deviceService) List(ctx context.Context) (*userrpcv3.DeviceList, error) {
+	sd, ok := GetSessionDataFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("unable to find session")
+	}
+	accountId, err := uuid.Parse(sd.Account)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find account")
+	}
+	devices, err := dao.ListAccountDevices(ctx, s.db, accountId)
+	if err != nil {
+		return nil, err
+	}
+	list := &userrpcv3.DeviceList{}
+	for i := range devices {
+		list.Items = append(list.Items, toDevice(&devices[i]))
+	}
+	return list, nil
+}
+
+func (s *deviceService) Revoke(ctx context.Context, req *userrpcv3.Device) error {
+	sd, ok := GetSessionDataFromContext(ctx)
+	if !ok {
+		return fmt.Errorf("unable to find session")
+	}
+	accountId, err := uuid.Parse(sd.Account)
+	if err != nil {
+		return fmt.Errorf("unable to find account")
+	}
+	id, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return fmt.Errorf("unable to find device '%v'", req.GetId())
+	}
+	// users can only revoke their own devices
+	found, err := dao.RevokeAccountDevice(ctx, s.db, id, accountId)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return fmt.Errorf("unable to find device '%v'", req.GetId())
+	}
+
+	CreateDeviceAuditEvent(ctx, s.al, AuditActionDelete, req.GetName(), req.GetId())
+	return nil
+}
+
+func (s *deviceService) AuthenticateToken(ctx context.Context, token string) (*commonv3.SessionData, error) {
+	device, err := dao.GetDeviceByAccessToken(ctx, s.db, hashDeviceToken(token))
+	if err != nil {
+		return nil, fmt.Errorf("unable to find device of token")
+	}
+	if device.Status != deviceStatusApproved || !device.AccountId.Valid {
+		return nil, fmt.Errorf("device '%v' is not approved", device.Name)
+	}
+	if time.Now().After(device.AccessExpiresAt) {
+		return nil, fmt.Errorf("access token of device '%v' has expired", device.Name)
+	}
+	// last use is only recorded once a minute so that every request does
+	// not write to the database
+	if time.Since(device.LastUsedAt) > deviceLastUsedInterval {
+		device.LastUsedAt = time.Now()
+		if err := dao.UpdateDevice(ctx, s.db, device, "last_used_at"); err != nil {
+			_log.Warnw("unable to update last use of device", "device", device.ID, "error", err)
+		}
+	}
+
+	return &commonv3.SessionData{
+		Account:      device.AccountId.UUID.String(),
+		Organization: device.OrganizationId.UUID.String(),
+		Partner:      device.PartnerId.UUID.String(),
+		Username:     device.Username,
+		AuthType:     commonv3.AuthType_DeviceToken,
+		Session:      device.ID.String(),
+	}, nil
+}
+
+func (s *deviceService) RemoveExpired(ctx context.Context) error {
+	err := dao.DeleteExpiredDevices(ctx, s.db, time.Now())
+	if err != nil {
+		return err
+	}
+	return dao.DeleteDeviceApprovalAttempts(ctx, s.db, time.Now().Add(-deviceApprovalFailureWindow))
+}
@@ -0,0 +1,276 @@
+package service
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/pkg/common"
+	userrpcv3 "github.com/paralus/paralus/proto/rpc/user"
+	v3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+)
+
+func addDeviceFetchExpectation(mock sqlmock.Sqlmock, duuid, status string, codeExpiresAt, lastPolledAt time.Time) {
+	mock.ExpectQuery(`SELECT "device"."id", .* FROM "authsrv_device" AS "device"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "status", "code_expires_at", "last_polled_at"}).
+			AddRow(duuid, "laptop", status, codeExpiresAt, lastPolledAt))
+}
+
+func TestDeviceAuthorize(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	ds := NewDeviceService(db, "console.paralus.dev:443", getLogger())
+
+	mock.ExpectQuery(`INSERT INTO "authsrv_device"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uuid.New().String()))
+
+	resp, err := ds.Authorize(context.Background(), &userrpcv3.DeviceAuthorizationRequest{ClientName: "pctl on laptop"})
+	if err != nil {
+		t.Fatal("could not authorize device:", err)
+	}
+	if !regexp.MustCompile(`^[BCDFGHJKLMNPQRSTVWXZ]{4}-[BCDFGHJKLMNPQRSTVWXZ]{4}$`).MatchString(resp.UserCode) {
+		t.Errorf("invalid user code '%v'", resp.UserCode)
+	}
+	if resp.DeviceCode == "" {
+		t.Error("device code should be set")
+	}
+	if resp.VerificationUri != "https://console.paralus.dev/device" {
+		t.Errorf("invalid verification uri '%v'", resp.VerificationUri)
+	}
+	if resp.VerificationUriComplete != resp.VerificationUri+"?user_code="+resp.UserCode {
+		t.Errorf("invalid complete verification uri '%v'", resp.VerificationUriComplete)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestNormalizeUserCode(t *testing.T) {
+	for _, code := range []string{"BCDF-GHJK", "bcdf-ghjk", "BCDFGHJK", "bcdf ghjk"} {
+		if got := normalizeUserCode(code); got != "BCDF-GHJK" {
+			t.Errorf("user code '%v' normalized to '%v'", code, got)
+		}
+	}
+}
+
+func TestDeviceTokenDeviceCode(t *testing.T) {
+	tt := []struct {
+		name          string
+		status        string
+		codeExpiresAt time.Time
+		lastPolledAt  time.Time
+		update        bool
+		err           error
+	}{
+		{"pending", deviceStatusPending, time.Now().Add(time.Minute), time.Time{}, true, ErrAuthorizationPending},
+		{"polling too fast", deviceStatusPending, time.Now().Add(time.Minute), time.Now(), true, ErrSlowDown},
+		{"denied", deviceStatusDenied, time.Now().Add(time.Minute), time.Time{}, false, ErrAccessDenied},
+		{"expired", deviceStatusPending, time.Now().Add(-time.Minute), time.Time{}, false, ErrExpiredToken},
+		{"approved", deviceStatusApproved, time.Now().Add(time.Minute), time.Time{}, true, nil},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			db, mock := getDB(t)
+			defer db.Close()
+
+			ds := NewDeviceService(db, "console.paralus.dev:443", getLogger())
+
+			duuid := uuid.New().String()
+			addDeviceFetchExpectation(mock, duuid, tc.status, tc.codeExpiresAt, tc.lastPolledAt)
+			if tc.update {
+				mock.ExpectExec(`UPDATE "authsrv_device" AS "device" SET .* WHERE .*\("device"."id" = '` + duuid + `'\)`).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+			}
+
+			resp, err := ds.Token(context.Background(), &userrpcv3.DeviceTokenRequest{
+				GrantType:  DeviceCodeGrantType,
+				DeviceCode: "device-code",
+			})
+			if err != tc.err {
+				t.Fatalf("expected error '%v', got '%v'", tc.err, err)
+			}
+			if tc.err == nil {
+				if !strings.HasPrefix(resp.AccessToken, DeviceAccessTokenPrefix) || !strings.HasPrefix(resp.RefreshToken, DeviceRefreshTokenPrefix) {
+					t.Errorf("invalid tokens '%v' '%v'", resp.AccessToken, resp.RefreshToken)
+				}
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestDeviceTokenUnsupportedGrantType(t *testing.T) {
+	db, _ := getDB(t)
+	defer db.Close()
+
+	ds := NewDeviceService(db, "console.paralus.dev:443", getLogger())
+	_, err := ds.Token(context.Background(), &userrpcv3.DeviceTokenRequest{GrantType: "password"})
+	if err != ErrUnsupportedGrantType {
+		t.Fatal("expected unsupported grant type, got:", err)
+	}
+}
+
+func TestDeviceApprove(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	ds := NewDeviceService(db, "console.paralus.dev:443", getLogger())
+
+	duuid := uuid.New().String()
+	auuid := uuid.New().String()
+	mock.ExpectQuery(`SELECT "deviceapprovalattempt"."account_id", .* FROM "authsrv_deviceapprovalattempt" AS "deviceapprovalattempt" WHERE \(account_id = '` + auuid + `'\)`).
+		WillReturnRows(sqlmock.NewRows([]string{"account_id"}))
+	mock.ExpectQuery(`SELECT "device"."id", .* FROM "authsrv_device" AS "device" WHERE \(user_code = 'BCDF-GHJK'\) AND \(status = 'pending'\)`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "status", "code_expires_at"}).
+			AddRow(duuid, "laptop", deviceStatusPending, time.Now().Add(time.Minute)))
+	mock.ExpectExec(`UPDATE "authsrv_device" AS "device" SET "status" = 'approved', "account_id" = '` + auuid + `'.* WHERE \(trash = FALSE\) AND \(status = 'pending'\) AND \("device"."id" = '` + duuid + `'\)`).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	sd := &v3.SessionData{Account: auuid, Username: "user@paralus.local"}
+	ctx := context.WithValue(context.Background(), common.SessionDataKey, sd)
+	err := ds.Approve(ctx, &userrpcv3.DeviceApprovalRequest{UserCode: "bcdfghjk"})
+	if err != nil {
+		t.Fatal("could not approve device:", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDeviceApproveInvalidUserCode(t *testing.T) {
+	tt := []struct {
+		name     string
+		failures int
+		lookup   bool
+	}{
+		{"first invalid code", 0, true},
+		{"too many invalid codes", deviceApprovalMaxFailures, false},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			db, mock := getDB(t)
+			defer db.Close()
+
+			ds := NewDeviceService(db, "console.paralus.dev:443", getLogger())
+
+			auuid := uuid.New().String()
+			rows := sqlmock.NewRows([]string{"account_id", "failed_attempts", "last_failed_at"})
+			if tc.failures > 0 {
+				rows.AddRow(auuid, tc.failures, time.Now().Add(-time.Minute))
+			}
+			mock.ExpectQuery(`SELECT "deviceapprovalattempt"."account_id", .* FROM "authsrv_deviceapprovalattempt" AS "deviceapprovalattempt"`).
+				WillReturnRows(rows)
+			if tc.lookup {
+				mock.ExpectQuery(`SELECT "device"."id", .* FROM "authsrv_device" AS "device" WHERE \(user_code = 'BCDF-GHJK'\)`).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}))
+				mock.ExpectQuery(`INSERT INTO "authsrv_deviceapprovalattempt" .* ON CONFLICT \(account_id\) DO UPDATE SET failed_attempts = CASE`).
+					WillReturnRows(sqlmock.NewRows([]string{"failed_attempts"}).AddRow(1))
+			}
+
+			sd := &v3.SessionData{Account: auuid, Username: "user@paralus.local"}
+			ctx := context.WithValue(context.Background(), common.SessionDataKey, sd)
+			err := ds.Approve(ctx, &userrpcv3.DeviceApprovalRequest{UserCode: "BCDF-GHJK"})
+			if err == nil {
+				t.Fatal("approved device with invalid user code")
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestDeviceTokenUsedConcurrently(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	ds := NewDeviceService(db, "console.paralus.dev:443", getLogger())
+
+	duuid := uuid.New().String()
+	addDeviceFetchExpectation(mock, duuid, deviceStatusApproved, time.Now().Add(time.Minute), time.Time{})
+	// another poll issued the tokens first and cleared the device code
+	mock.ExpectExec(`UPDATE "authsrv_device" AS "device" SET .* WHERE \(trash = FALSE\) AND \("device_code_hash" = '` + hashDeviceToken("device-code") + `'\) AND \(status = 'approved'\) AND \("device"."id" = '` + duuid + `'\)`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	_, err := ds.Token(context.Background(), &userrpcv3.DeviceTokenRequest{
+		GrantType:  DeviceCodeGrantType,
+		DeviceCode: "device-code",
+	})
+	if err != ErrInvalidGrant {
+		t.Fatalf("expected error '%v', got '%v'", ErrInvalidGrant, err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDeviceApproveWithDeviceToken(t *testing.T) {
+	db, _ := getDB(t)
+	defer db.Close()
+
+	ds := NewDeviceService(db, "console.paralus.dev:443", getLogger())
+
+	sd := &v3.SessionData{Account: uuid.New().String(), Username: "user@paralus.local", AuthType: v3.AuthType_DeviceToken}
+	ctx := context.WithValue(context.Background(), common.SessionDataKey, sd)
+	err := ds.Approve(ctx, &userrpcv3.DeviceApprovalRequest{UserCode: "BCDF-GHJK"})
+	if err == nil {
+		t.Fatal("approved device with device token")
+	}
+}
+
+func TestDeviceAuthenticateToken(t *testing.T) {
+	tt := []struct {
+		name            string
+		status          string
+		accessExpiresAt time.Time
+		valid           bool
+	}{
+		{"valid token", deviceStatusApproved, time.Now().Add(time.Minute), true},
+		{"expired token", deviceStatusApproved, time.Now().Add(-time.Minute), false},
+		{"denied device", deviceStatusDenied, time.Now().Add(time.Minute), false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			db, mock := getDB(t)
+			defer db.Close()
+
+			ds := NewDeviceService(db, "console.paralus.dev:443", getLogger())
+
+			duuid := uuid.New().String()
+			auuid := uuid.New().String()
+			mock.ExpectQuery(`SELECT "device"."id", .* FROM "authsrv_device" AS "device" WHERE \("access_token_hash" = '` + hashDeviceToken(DeviceAccessTokenPrefix+"token") + `'\)`).
+				WillReturnRows(sqlmock.NewRows([]string{"id", "name", "status", "account_id", "username", "access_expires_at"}).
+					AddRow(duuid, "laptop", tc.status, auuid, "user@paralus.local", tc.accessExpiresAt))
+			if tc.valid {
+				mock.ExpectExec(`UPDATE "authsrv_device" AS "device" SET "last_used_at" = .* WHERE .*\("device"."id" = '` + duuid + `'\)`).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+			}
+
+			sd, err := ds.AuthenticateToken(context.Background(), DeviceAccessTokenPrefix+"token")
+			if !tc.valid {
+				if err == nil {
+					t.Fatal("authenticated invalid token")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal("could not authenticate token:", err)
+			}
+			if sd.Account != auuid || sd.Username != "user@paralus.local" || sd.AuthType != v3.AuthType_DeviceToken {
+				t.Errorf("invalid session; got '%v' '%v' '%v'", sd.Account, sd.Username, sd.AuthType)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,268 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/dao"
+	"github.com/paralus/paralus/internal/models"
+	authzv1 "github.com/paralus/paralus/proto/types/authz"
+	bun "github.com/uptrace/bun"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+const expiryNotifyTimeout = 10 * time.Second
+
+// ExpiryService is the interface for expiring time-limited group
+// memberships and role bindings
+type ExpiryService interface {
+	// remove all expired group memberships and role bindings
+	RemoveExpired(context.Context) error
+	// notify about group memberships and role bindings expiring within the duration
+	NotifyExpiring(context.Context, time.Duration) error
+}
+
+// expiryService implements ExpiryService
+type expiryService struct {
+	db        *bun.DB
+	azc       AuthzService
+	al        *zap.Logger
+	notifyURL string
+	hc        *http.Client
+}
+
+// NewExpiryService return new expiry service, upcoming expiries are
+// posted to the notify webhook when one is given
+func NewExpiryService(db *bun.DB, azc AuthzService, al *zap.Logger, notifyURL string) ExpiryService {
+	return &expiryService{db: db, azc: azc, al: al, notifyURL: notifyURL, hc: &http.Client{Timeout: expiryNotifyTimeout}}
+}
+
+// bindingPolicy returns the authz policy created for the role binding
+func bindingPolicy(rb models.RoleBinding) *authzv1.Policy {
+	p := &authzv1.Policy{Ns: "*", Proj: "*", Org: "*", Obj: rb.Role, Eft: rb.Effect}
+	if rb.Group != "" {
+		p.Sub = "g:" + rb.Group
+	} else {
+		p.Sub = "u:" + rb.Account
+	}
+	switch strings.ToLower(rb.Scope) {
+	case "organization":
+		p.Org = rb.Organization
+	case "project":
+		p.Org = rb.Organization
+		p.Proj = rb.Project
+	case "namespace":
+		p.Org = rb.Organization
+		p.Proj = rb.Project
+		p.Ns = rb.Namespace
+	}
+	return p
+}
+
+func groupAccountNames(ga models.GroupAccount) (string, string) {
+	var user, group string
+	if ga.Account != nil {
+		if email, ok := ga.Account.Traits["email"].(string); ok {
+			user = email
+		}
+	}
+	if ga.Group != nil {
+		group = ga.Group.Name
+	}
+	return user, group
+}
+
+func (s *expiryService) removeGroupAccount(ctx context.Context, ga models.GroupAccount) error {
+	user, group := groupAccountNames(ga)
+	err := dao.Delete(ctx, s.db, ga.ID, &models.GroupAccount{})
+	if err != nil {
+		return fmt.Errorf("unable to remove user '%v' from group '%v'; %v", user, group, err)
+	}
+	_, err = s.azc.DeleteUserGroups(ctx, &authzv1.UserGroup{User: "u:" + user, Grp: "g:" + group})
+	if err != nil {
+		return fmt.Errorf("unable to delete group-user relation from authz; %v", err)
+	}
+	CreateMembershipExpiryAuditEvent(s.al, user, group, ga.ExpiresAt)
+	return nil
+}
+
+func (s *expiryService) removeRoleBinding(ctx context.Context, rb models.RoleBinding) error {
+	err := dao.DeleteRoleBinding(ctx, s.db, rb)
+	if err != nil {
+		return fmt.Errorf("unable to remove role binding of role '%v'; %v", rb.Role, err)
+	}
+	if rb.ClusterSelector == "" {
+		inUse, err := s.policyInUse(ctx, rb)
+		if err != nil {
+			return fmt.Errorf("unable to check role binding policy; %v", err)
+		}
+		if !inUse {
+			_, err = s.azc.DeletePolicies(ctx, bindingPolicy(rb))
+			if err != nil {
+				return fmt.Errorf("unable to delete role binding from authz; %v", err)
+			}
+		}
+	}
+	CreateRoleBindingExpiryAuditEvent(s.al, rb)
+	return nil
+}
+
+// policyInUse checks if another role binding in effect maps to the same
+// authz policy as the role binding, the policy is then kept
+func (s *expiryService) policyInUse(ctx context.Context, rb models.RoleBinding) (bool, error) {
+	rbs, err := dao.GetOtherActiveRoleBindings(ctx, s.db, rb)
+	if err != nil {
+		return false, err
+	}
+	p := bindingPolicy(rb)
+	for _, other := range rbs {
+		if proto.Equal(bindingPolicy(other), p) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (s *expiryService) remove(ctx context.Context, gas []models.GroupAccount, rbs []models.RoleBinding) error {
+	for _, ga := range gas {
+		if err := s.removeGroupAccount(ctx, ga); err != nil {
+			return err
+		}
+	}
+	for _, rb := range rbs {
+		if err := s.removeRoleBinding(ctx, rb); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *expiryService) RemoveExpired(ctx context.Context) error {
+	now := time.Now()
+	gas, err := dao.GetExpiredGroupAccounts(ctx, s.db, now)
+	if err != nil {
+		return err
+	}
+	rbs, err := dao.GetExpiredRoleBindings(ctx, s.db, now)
+	if err != nil {
+		return err
+	}
+	return s.remove(ctx, gas, rbs)
+}
+
+// expiryNotification is the payload posted to the notify webhook, the
+// recipients are the user and the admins of the organization, who own
+// its groups
+type expiryNotification struct {
+	Organization string    `json:"organization"`
+	User         string    `json:"user,omitempty"`
+	Group        string    `json:"group,omitempty"`
+	Role         string    `json:"role,omitempty"`
+	Project      string    `json:"project,omitempty"`
+	Namespace    string    `json:"namespace,omitempty"`
+	ExpiresAt    time.Time `json:"expiresAt"`
+	Recipients   []string  `json:"recipients"`
+}
+
+// notify posts the upcoming expiry to the notify webhook
+func (s *expiryService) notify(ctx context.Context, orgID uuid.UUID, n *expiryNotification) error {
+	if s.notifyURL == "" {
+		return nil
+	}
+	if n.Organization == "" {
+		org, err := dao.GetOrganizationName(ctx, s.db, orgID)
+		if err != nil {
+			return err
+		}
+		n.Organization = org
+	}
+	admins, err := dao.GetOrganizationAdmins(ctx, s.db, orgID)
+	if err != nil {
+		return err
+	}
+	if n.User != "" {
+		n.Recipients = append(n.Recipients, n.User)
+	}
+	for _, admin := range admins {
+		if admin != n.User {
+			n.Recipients = append(n.Recipients, admin)
+		}
+	}
+	body, err := json.Marshal(n)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.notifyURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := s.hc.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("notify webhook returned %s", resp.Status)
+	}
+	return nil
+}
+
+func (s *expiryService) NotifyExpiring(ctx context.Context, within time.Duration) error {
+	before := time.Now().Add(within)
+	gas, err := dao.GetExpiringGroupAccounts(ctx, s.db, before)
+	if err != nil {
+		return err
+	}
+	for _, ga := range gas {
+		user, group := groupAccountNames(ga)
+		var orgID uuid.UUID
+		if ga.Group != nil {
+			orgID = ga.Group.OrganizationId
+		}
+		err = s.notify(ctx, orgID, &expiryNotification{User: user, Group: group, ExpiresAt: ga.ExpiresAt})
+		if err != nil {
+			// notified again on the next run
+			_log.Warnw("unable to notify expiring membership", "user", user, "group", group, "error", err)
+			continue
+		}
+		CreateMembershipExpiringAuditEvent(s.al, user, group, ga.ExpiresAt)
+		err = dao.SetGroupAccountNotified(ctx, s.db, ga.ID)
+		if err != nil {
+			return err
+		}
+	}
+
+	rbs, err := dao.GetExpiringRoleBindings(ctx, s.db, before)
+	if err != nil {
+		return err
+	}
+	for _, rb := range rbs {
+		err = s.notify(ctx, rb.OrganizationID, &expiryNotification{
+			Organization: rb.Organization,
+			User:         rb.Account,
+			Group:        rb.Group,
+			Role:         rb.Role,
+			Project:      rb.Project,
+			Namespace:    rb.Namespace,
+			ExpiresAt:    rb.ExpiresAt,
+		})
+		if err != nil {
+			_log.Warnw("unable to notify expiring role binding", "role", rb.Role, "error", err)
+			continue
+		}
+		CreateRoleBindingExpiringAuditEvent(s.al, rb)
+		err = dao.SetRoleBindingNotified(ctx, s.db, rb)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
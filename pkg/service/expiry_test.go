@@ -0,0 +1,204 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+)
+
+var roleBindingTableNames = []string{
+	"authsrv_accountresourcerole",
+	"authsrv_projectaccountresourcerole",
+	"authsrv_projectaccountnamespacerole",
+	"authsrv_grouprole",
+	"authsrv_projectgrouprole",
+	"authsrv_projectgroupnamespacerole",
+	"authsrv_accountclusterselectorrole",
+	"authsrv_groupclusterselectorrole",
+}
+
+func TestRemoveExpired(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	mazc := mockAuthzClient{}
+	es := NewExpiryService(db, &mazc, getLogger(), "")
+
+	gauuid := uuid.New().String()
+	uuuid := uuid.New().String()
+	guuid := uuid.New().String()
+	rbuuid := uuid.New().String()
+	expired := time.Now().Add(-time.Hour)
+
+	mock.ExpectQuery(`SELECT "groupaccount"."id", .* FROM "authsrv_groupaccount" AS "groupaccount" LEFT JOIN "identities" AS "account" .* LEFT JOIN "authsrv_group" AS "group" .* WHERE .groupaccount.trash = FALSE. AND .groupaccount.expires_at IS NOT NULL. AND .groupaccount.expires_at <= `).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "account_id", "group_id", "expires_at", "account__id", "account__traits", "group__id", "group__name"}).
+			AddRow(gauuid, uuuid, guuid, expired, uuuid, []byte(`{"email":"johndoe@provider.com"}`), guuid, "group-"+guuid))
+	for _, t := range roleBindingTableNames {
+		rows := sqlmock.NewRows([]string{"id", "expires_at", "role", "effect", "scope", "organization", "project", "namespace", "group"})
+		if t == "authsrv_projectgroupnamespacerole" {
+			rows.AddRow(rbuuid, expired, "NAMESPACE_ADMIN", "allow", "namespace", "org", "project", "ns", "group-"+guuid)
+		}
+		mock.ExpectQuery(`SELECT b.id, b.expires_at, .* FROM ` + t + ` AS b .* WHERE .b.trash = FALSE. AND .b.expires_at IS NOT NULL. AND .b.expires_at <= `).
+			WillReturnRows(rows)
+	}
+	mock.ExpectExec(`UPDATE "authsrv_groupaccount" AS "groupaccount" SET trash = TRUE WHERE .id  = '` + gauuid + `'.`).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(`UPDATE "authsrv_projectgroupnamespacerole" SET trash = TRUE, modified_at = .* WHERE .id = '` + rbuuid + `'.`).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	for _, t := range []string{"authsrv_grouprole", "authsrv_projectgrouprole", "authsrv_projectgroupnamespacerole"} {
+		mock.ExpectQuery(`SELECT b.id, b.expires_at, .* FROM ` + t + ` AS b .* WHERE .b.trash = FALSE. AND .b.id != '` + rbuuid + `'. AND .r.name = 'NAMESPACE_ADMIN'. AND .b.expires_at IS NULL OR b.expires_at > .* AND .g.name = 'group-` + guuid + `'.`).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	}
+
+	err := es.RemoveExpired(context.Background())
+	if err != nil {
+		t.Fatal("could not remove expired bindings:", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+	performBasicAuthzChecks(t, mazc, 0, 1, 0, 1, 0, 0)
+	if mazc.dug[0].User != "u:johndoe@provider.com" || mazc.dug[0].Grp != "g:group-"+guuid {
+		t.Errorf("invalid group membership removed from authz; got '%v' -> '%v'", mazc.dug[0].User, mazc.dug[0].Grp)
+	}
+	p := mazc.dp[0]
+	if p.Sub != "g:group-"+guuid || p.Ns != "ns" || p.Proj != "project" || p.Org != "org" || p.Obj != "NAMESPACE_ADMIN" || p.Eft != "allow" {
+		t.Errorf("invalid policy removed from authz; got %v", p)
+	}
+}
+
+func TestRemoveExpiredSharedPolicy(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	mazc := mockAuthzClient{}
+	es := NewExpiryService(db, &mazc, getLogger(), "")
+
+	rbuuid := uuid.New().String()
+	orbuuid := uuid.New().String()
+	mock.ExpectQuery(`SELECT "groupaccount"."id", .* AND .groupaccount.expires_at <= `).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	for _, t := range roleBindingTableNames {
+		rows := sqlmock.NewRows([]string{"id", "expires_at", "role", "effect", "scope", "organization", "account"})
+		if t == "authsrv_accountresourcerole" {
+			rows.AddRow(rbuuid, time.Now().Add(-time.Minute), "ADMIN", "allow", "organization", "org", "johndoe@provider.com")
+		}
+		mock.ExpectQuery(`SELECT b.id, b.expires_at, .* FROM ` + t + ` AS b .* AND .b.expires_at <= `).
+			WillReturnRows(rows)
+	}
+	mock.ExpectExec(`UPDATE "authsrv_accountresourcerole" SET trash = TRUE, .* WHERE .id = '` + rbuuid + `'.`).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	// the same role is bound to the user without expiry
+	for _, t := range []string{"authsrv_accountresourcerole", "authsrv_projectaccountresourcerole", "authsrv_projectaccountnamespacerole"} {
+		rows := sqlmock.NewRows([]string{"id", "expires_at", "role", "effect", "scope", "organization", "account"})
+		if t == "authsrv_projectaccountresourcerole" {
+			rows.AddRow(orbuuid, nil, "ADMIN", "allow", "organization", "org", "johndoe@provider.com")
+		}
+		mock.ExpectQuery(`SELECT b.id, b.expires_at, .* FROM ` + t + ` AS b .* AND .b.id != '` + rbuuid + `'. AND .r.name = 'ADMIN'. .* AND .i.traits ->> 'email' = 'johndoe@provider.com'.`).
+			WillReturnRows(rows)
+	}
+
+	err := es.RemoveExpired(context.Background())
+	if err != nil {
+		t.Fatal("could not remove expired bindings:", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+	// the policy is still needed by the other binding
+	performBasicAuthzChecks(t, mazc, 0, 0, 0, 0, 0, 0)
+}
+
+func TestNotifyExpiring(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	mazc := mockAuthzClient{}
+	es := NewExpiryService(db, &mazc, getLogger(), "")
+
+	gauuid := uuid.New().String()
+	uuuid := uuid.New().String()
+	guuid := uuid.New().String()
+	rbuuid := uuid.New().String()
+	expiring := time.Now().Add(time.Hour)
+
+	mock.ExpectQuery(`SELECT "groupaccount"."id", .* AND .groupaccount.expiry_notified = FALSE.`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "account_id", "group_id", "expires_at", "account__id", "account__traits", "group__id", "group__name"}).
+			AddRow(gauuid, uuuid, guuid, expiring, uuuid, []byte(`{"email":"johndoe@provider.com"}`), guuid, "group-"+guuid))
+	mock.ExpectExec(`UPDATE "authsrv_groupaccount" AS "groupaccount" SET expiry_notified = TRUE WHERE .id = '` + gauuid + `'.`).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	for _, t := range roleBindingTableNames {
+		rows := sqlmock.NewRows([]string{"id", "expires_at", "role", "effect", "scope", "organization", "project", "account"})
+		if t == "authsrv_projectaccountresourcerole" {
+			rows.AddRow(rbuuid, expiring, "PROJECT_ADMIN", "allow", "project", "org", "project", "johndoe@provider.com")
+		}
+		mock.ExpectQuery(`SELECT b.id, b.expires_at, .* FROM ` + t + ` AS b .* AND .b.expiry_notified = FALSE.`).
+			WillReturnRows(rows)
+	}
+	mock.ExpectExec(`UPDATE "authsrv_projectaccountresourcerole" SET expiry_notified = TRUE WHERE .id = '` + rbuuid + `'.`).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	err := es.NotifyExpiring(context.Background(), 24*time.Hour)
+	if err != nil {
+		t.Fatal("could not notify expiring bindings:", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+	// notifying does not change any authz state
+	performBasicAuthzChecks(t, mazc, 0, 0, 0, 0, 0, 0)
+}
+
+func TestNotifyExpiringWebhook(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	var notification expiryNotification
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&notification)
+	}))
+	defer ts.Close()
+
+	mazc := mockAuthzClient{}
+	es := NewExpiryService(db, &mazc, getLogger(), ts.URL)
+
+	gauuid := uuid.New().String()
+	uuuid := uuid.New().String()
+	guuid := uuid.New().String()
+	ouuid := uuid.New().String()
+	expiring := time.Now().Add(time.Hour)
+
+	mock.ExpectQuery(`SELECT "groupaccount"."id", .* AND .groupaccount.expiry_notified = FALSE.`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "account_id", "group_id", "expires_at", "account__id", "account__traits", "group__id", "group__name", "group__organization_id"}).
+			AddRow(gauuid, uuuid, guuid, expiring, uuuid, []byte(`{"email":"johndoe@provider.com"}`), guuid, "contractors", ouuid))
+	mock.ExpectQuery(`SELECT "organization"."name" FROM "authsrv_organization" AS "organization" WHERE .id = '` + ouuid + `'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("org"))
+	mock.ExpectQuery(`SELECT DISTINCT identities.traits ->> 'email' FROM identities JOIN sentry_account_permission AS sap .* WHERE .sap.organization_id = '` + ouuid + `'. AND .sap.role_name = 'ADMIN'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"email"}).AddRow("admin@provider.com"))
+	mock.ExpectExec(`UPDATE "authsrv_groupaccount" AS "groupaccount" SET expiry_notified = TRUE WHERE .id = '` + gauuid + `'.`).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	for _, t := range roleBindingTableNames {
+		mock.ExpectQuery(`SELECT b.id, b.expires_at, .* FROM ` + t + ` AS b .* AND .b.expiry_notified = FALSE.`).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	}
+
+	err := es.NotifyExpiring(context.Background(), 24*time.Hour)
+	if err != nil {
+		t.Fatal("could not notify expiring bindings:", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+	if notification.Organization != "org" || notification.User != "johndoe@provider.com" || notification.Group != "contractors" {
+		t.Errorf("invalid expiry notification; got %+v", notification)
+	}
+	if len(notification.Recipients) != 2 || notification.Recipients[0] != "johndoe@provider.com" || notification.Recipients[1] != "admin@provider.com" {
+		t.Errorf("expected the user and the organization admin to be notified; got %v", notification.Recipients)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"fmt"
 	"regexp"
+	"sort"
 	"strings"
 	"time"
 
@@ -25,6 +26,9 @@ import (
 const (
 	groupKind     = "Group"
 	groupListKind = "GroupList"
+	// maxGroupNestingDepth limits how deep groups can be nested, casbin
+	// only follows g2 links up to 10 levels
+	maxGroupNestingDepth = 5
 )
 
 // GroupService is the interface for group operations
@@ -41,6 +45,8 @@ type GroupService interface {
 	Delete(context.Context, *userv3.Group) (*userv3.Group, error)
 	// list groups
 	List(context.Context, ...query.Option) (*userv3.GroupList, error)
+	// get users of group including the ones in nested groups
+	GetEffectiveMembers(context.Context, *userv3.Group) (*userv3.Group, error)
 }
 
 // groupService implements GroupService
@@ -86,6 +92,15 @@ func (s *groupService) deleteGroupRoleRelaitons(ctx context.Context, db bun.IDB,
 		ids = append(ids, r.RoleId)
 	}
 
+	gcsr := []models.GroupClusterSelectorRole{}
+	err = dao.DeleteXR(ctx, db, "group_id", groupId, &gcsr)
+	if err != nil {
+		return &userv3.Group{}, nil, err
+	}
+	for _, r := range gcsr {
+		ids = append(ids, r.RoleId)
+	}
+
 	_, err = s.azc.DeletePolicies(ctx, &authzv1.Policy{Sub: "g:" + group.GetMetadata().GetName()})
 	if err != nil {
 		return &userv3.Group{}, nil, fmt.Errorf("unable to delete group-role relations from authz; %v", err)
@@ -101,6 +116,7 @@ func (s *groupService) createGroupRoleRelations(ctx context.Context, db bun.IDB,
 	var pgrs []models.ProjectGroupRole
 	var pgnr []models.ProjectGroupNamespaceRole
 	var grs []models.GroupRole
+	var gcsrs []models.GroupClusterSelectorRole
 	var ps []*authzv1.Policy
 	var rids []uuid.UUID
 	regexc := regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
@@ -114,18 +130,51 @@ func (s *groupService) createGroupRoleRelations(ctx context.Context, db bun.IDB,
 		var roleId uuid.UUID
 		var roleName string
 		var scope string
+		var effect string
 		if rle, ok := entity.(*models.Role); ok {
 			roleId = rle.ID
 			roleName = rle.Name
 			rids = append(rids, rle.ID)
 			scope = strings.ToLower(rle.Scope)
+			effect = rle.Effect
 		} else {
 			return &userv3.Group{}, nil, fmt.Errorf("unable to find role '%v'", role)
 		}
 
+		expiresAt, err := getExpiry(pnr.GetExpiresAt())
+		if err != nil {
+			return &userv3.Group{}, nil, err
+		}
+
 		project := pnr.GetProject()
 		org := group.GetMetadata().GetOrganization()
 
+		// role bindings scoped by a cluster selector only grant kubectl
+		// access to the matching clusters, there is no authz policy
+		if selector := pnr.GetClusterSelector(); selector != "" {
+			if org == "" {
+				return &userv3.Group{}, nil, fmt.Errorf("no org name provided for role '%v'", roleName)
+			}
+			projectId, err := getClusterSelectorProject(ctx, db, scope, effect, roleName, project, selector)
+			if err != nil {
+				return &userv3.Group{}, nil, err
+			}
+			gcsrs = append(gcsrs, models.GroupClusterSelectorRole{
+				CreatedAt:       time.Now(),
+				ModifiedAt:      time.Now(),
+				Trash:           false,
+				PartnerId:       ids.Partner,
+				OrganizationId:  ids.Organization,
+				RoleId:          roleId,
+				GroupId:         ids.Id,
+				ProjectId:       projectId,
+				ClusterSelector: selector,
+				Active:          true,
+				ExpiresAt:       expiresAt,
+			})
+			continue
+		}
+
 		switch scope {
 		case "system":
 			gr := models.GroupRole{
@@ -135,6 +184,7 @@ func (s *groupService) createGroupRoleRelations(ctx context.Context, db bun.IDB,
 				OrganizationId: ids.Organization,
 				GroupId:        ids.Id,
 				Active:         true,
+				ExpiresAt:      expiresAt,
 			}
 			grs = append(grs, gr)
 			ps = append(ps, &authzv1.Policy{
@@ -143,6 +193,7 @@ func (s *groupService) createGroupRoleRelations(ctx context.Context, db bun.IDB,
 				Proj: "*",
 				Org:  "*",
 				Obj:  role,
+				Eft:  effect,
 			})
 		case "organization":
 			if org == "" {
@@ -155,6 +206,7 @@ func (s *groupService) createGroupRoleRelations(ctx context.Context, db bun.IDB,
 				OrganizationId: ids.Organization,
 				GroupId:        ids.Id,
 				Active:         true,
+				ExpiresAt:      expiresAt,
 			}
 			grs = append(grs, gr)
 			ps = append(ps, &authzv1.Policy{
@@ -163,6 +215,7 @@ func (s *groupService) createGroupRoleRelations(ctx context.Context, db bun.IDB,
 				Proj: "*",
 				Org:  org,
 				Obj:  role,
+				Eft:  effect,
 			})
 		case "project":
 			if org == "" {
@@ -184,6 +237,7 @@ func (s *groupService) createGroupRoleRelations(ctx context.Context, db bun.IDB,
 				GroupId:        ids.Id,
 				ProjectId:      projectId,
 				Active:         true,
+				ExpiresAt:      expiresAt,
 			}
 			pgrs = append(pgrs, pgr)
 
@@ -193,6 +247,7 @@ func (s *groupService) createGroupRoleRelations(ctx context.Context, db bun.IDB,
 				Proj: project,
 				Org:  org,
 				Obj:  role,
+				Eft:  effect,
 			})
 		case "namespace":
 			if org == "" {
@@ -226,6 +281,7 @@ func (s *groupService) createGroupRoleRelations(ctx context.Context, db bun.IDB,
 				ProjectId:      projectId,
 				Namespace:      namespace,
 				Active:         true,
+				ExpiresAt:      expiresAt,
 			}
 			pgnr = append(pgnr, pgnrObj)
 
@@ -235,6 +291,7 @@ func (s *groupService) createGroupRoleRelations(ctx context.Context, db bun.IDB,
 				Proj: project,
 				Org:  org,
 				Obj:  role,
+				Eft:  effect,
 			})
 		default:
 			if err != nil {
@@ -260,6 +317,12 @@ func (s *groupService) createGroupRoleRelations(ctx context.Context, db bun.IDB,
 			return &userv3.Group{}, nil, err
 		}
 	}
+	if len(gcsrs) > 0 {
+		_, err := dao.Create(ctx, db, &gcsrs)
+		if err != nil {
+			return &userv3.Group{}, nil, err
+		}
+	}
 
 	if len(ps) > 0 {
 		success, err := s.azc.CreatePolicies(ctx, &authzv1.Policies{Policies: ps})
@@ -295,12 +358,21 @@ func (s *groupService) createGroupAccountRelations(ctx context.Context, db bun.I
 	var grpaccs []models.GroupAccount
 	var ugs []*authzv1.UserGroup
 	var uids []uuid.UUID
+	for account := range group.GetSpec().GetMembershipExpiry() {
+		if !utils.Contains(group.GetSpec().GetUsers(), account) {
+			return &userv3.Group{}, nil, fmt.Errorf("membership expiry set for '%v' who is not a member", account)
+		}
+	}
 	for _, account := range utils.Unique(group.GetSpec().GetUsers()) {
 		// FIXME: do combined lookup
 		entity, err := dao.GetUserIdByEmail(ctx, db, account, &models.KratosIdentities{})
 		if err != nil {
 			return &userv3.Group{}, nil, fmt.Errorf("unable to find user '%v'", account)
 		}
+		expiresAt, err := getExpiry(group.GetSpec().GetMembershipExpiry()[account])
+		if err != nil {
+			return &userv3.Group{}, nil, fmt.Errorf("invalid membership of user '%v'; %v", account, err)
+		}
 		if acc, ok := entity.(*models.KratosIdentities); ok {
 			grp := models.GroupAccount{
 				CreatedAt:  time.Now(),
@@ -309,6 +381,7 @@ func (s *groupService) createGroupAccountRelations(ctx context.Context, db bun.I
 				AccountId:  acc.ID,
 				GroupId:    groupId,
 				Active:     true,
+				ExpiresAt:  expiresAt,
 			}
 			uids = append(uids, acc.ID)
 			grpaccs = append(grpaccs, grp)
@@ -334,6 +407,109 @@ func (s *groupService) createGroupAccountRelations(ctx context.Context, db bun.I
 	return group, uids, nil
 }
 
+// groupNesting walks the group hierarchy starting from id using next
+// and returns the number of levels found along with the visited groups
+// in breadth first order, starting with id
+func groupNesting(ctx context.Context, db bun.IDB, id uuid.UUID, next func(context.Context, bun.IDB, uuid.UUID) ([]models.Group, error)) (int, []uuid.UUID, error) {
+	depth := 0
+	seen := map[uuid.UUID]bool{id: true}
+	visited := []uuid.UUID{id}
+	level := []uuid.UUID{id}
+	for len(level) > 0 && depth <= maxGroupNestingDepth {
+		var nextLevel []uuid.UUID
+		for _, gid := range level {
+			groups, err := next(ctx, db, gid)
+			if err != nil {
+				return 0, nil, err
+			}
+			for _, g := range groups {
+				if !seen[g.ID] {
+					seen[g.ID] = true
+					visited = append(visited, g.ID)
+					nextLevel = append(nextLevel, g.ID)
+				}
+			}
+		}
+		if len(nextLevel) > 0 {
+			depth++
+		}
+		level = nextLevel
+	}
+	return depth, visited, nil
+}
+
+func (s *groupService) deleteGroupMemberRelations(ctx context.Context, db bun.IDB, groupId uuid.UUID) error {
+	// g2 links of member groups are removed along with the user links
+	// in deleteGroupAccountRelations
+	err := dao.DeleteX(ctx, db, "group_id", groupId, &models.GroupGroup{})
+	if err != nil {
+		return fmt.Errorf("unable to remove member groups from group; %v", err)
+	}
+	return nil
+}
+
+// Update the groups nested in each group
+func (s *groupService) createGroupMemberRelations(ctx context.Context, db bun.IDB, groupId uuid.UUID, group *userv3.Group, partnerId, organizationId uuid.UUID) (*userv3.Group, error) {
+	members := utils.Unique(group.GetSpec().GetGroups())
+	if len(members) == 0 {
+		return group, nil
+	}
+	up, ancestors, err := groupNesting(ctx, db, groupId, dao.GetParentGroups)
+	if err != nil {
+		return &userv3.Group{}, err
+	}
+
+	var ggs []models.GroupGroup
+	var ugs []*authzv1.UserGroup
+	for _, member := range members {
+		if member == group.GetMetadata().GetName() {
+			return &userv3.Group{}, fmt.Errorf("group '%v' cannot be a member of itself", member)
+		}
+		entity, err := dao.GetIdByNamePartnerOrg(ctx, db, member, uuid.NullUUID{UUID: partnerId, Valid: true}, uuid.NullUUID{UUID: organizationId, Valid: true}, &models.Group{})
+		if err != nil {
+			return &userv3.Group{}, fmt.Errorf("unable to find group '%v'", member)
+		}
+		grp, ok := entity.(*models.Group)
+		if !ok {
+			return &userv3.Group{}, fmt.Errorf("unable to find group '%v'", member)
+		}
+		for _, a := range ancestors {
+			if a == grp.ID {
+				return &userv3.Group{}, fmt.Errorf("adding group '%v' to '%v' would create a cycle", member, group.GetMetadata().GetName())
+			}
+		}
+		down, _, err := groupNesting(ctx, db, grp.ID, dao.GetMemberGroups)
+		if err != nil {
+			return &userv3.Group{}, err
+		}
+		if up+down+1 > maxGroupNestingDepth {
+			return &userv3.Group{}, fmt.Errorf("adding group '%v' to '%v' exceeds max nesting depth of %d", member, group.GetMetadata().GetName(), maxGroupNestingDepth)
+		}
+		ggs = append(ggs, models.GroupGroup{
+			CreatedAt:     time.Now(),
+			ModifiedAt:    time.Now(),
+			Trash:         false,
+			GroupId:       groupId,
+			MemberGroupId: grp.ID,
+		})
+		ugs = append(ugs, &authzv1.UserGroup{
+			Grp:  "g:" + group.GetMetadata().GetName(),
+			User: "g:" + member,
+		})
+	}
+	_, err = dao.Create(ctx, db, &ggs)
+	if err != nil {
+		return &userv3.Group{}, err
+	}
+
+	_, err = s.azc.CreateUserGroups(ctx, &authzv1.UserGroups{UserGroups: ugs})
+	if err != nil {
+		return &userv3.Group{}, fmt.Errorf("unable to create mapping in authz; %v", err)
+	}
+
+	return group, nil
+}
+
 // TODO: move this to utils, make it accept two strings (names)
 func (s *groupService) getPartnerOrganization(ctx context.Context, db bun.IDB, group *userv3.Group) (uuid.UUID, uuid.UUID, error) {
 	partner := group.GetMetadata().GetPartner()
@@ -391,6 +567,12 @@ func (s *groupService) Create(ctx context.Context, group *userv3.Group) (*userv3
 			return &userv3.Group{}, err
 		}
 
+		group, err = s.createGroupMemberRelations(ctx, tx, grp.ID, group, partnerId, organizationId)
+		if err != nil {
+			tx.Rollback()
+			return &userv3.Group{}, err
+		}
+
 		group, rolesAfter, err := s.createGroupRoleRelations(ctx, tx, group, parsedIds{Id: grp.ID, Partner: partnerId, Organization: organizationId})
 		if err != nil {
 			tx.Rollback()
@@ -434,6 +616,15 @@ func (s *groupService) toV3Group(ctx context.Context, db bun.IDB, group *userv3.
 		userNames = append(userNames, u.Traits["email"].(string))
 	}
 
+	members, err := dao.GetMemberGroups(ctx, db, grp.ID)
+	if err != nil {
+		return &userv3.Group{}, err
+	}
+	groupNames := []string{}
+	for _, m := range members {
+		groupNames = append(groupNames, m.Name)
+	}
+
 	roles, err := dao.GetGroupRoles(ctx, db, grp.ID)
 	if err != nil {
 		return &userv3.Group{}, err
@@ -441,6 +632,7 @@ func (s *groupService) toV3Group(ctx context.Context, db bun.IDB, group *userv3.
 	group.Spec = &userv3.GroupSpec{
 		Type:                  grp.Type,
 		Users:                 userNames,
+		Groups:                groupNames,
 		ProjectNamespaceRoles: roles,
 	}
 	return group, nil
@@ -517,6 +709,16 @@ func (s *groupService) Update(ctx context.Context, group *userv3.Group) (*userv3
 			tx.Rollback()
 			return &userv3.Group{}, err
 		}
+		err = s.deleteGroupMemberRelations(ctx, tx, grp.ID)
+		if err != nil {
+			tx.Rollback()
+			return &userv3.Group{}, err
+		}
+		group, err = s.createGroupMemberRelations(ctx, tx, grp.ID, group, partnerId, organizationId)
+		if err != nil {
+			tx.Rollback()
+			return &userv3.Group{}, err
+		}
 		group, rolesBefore, err := s.deleteGroupRoleRelaitons(ctx, tx, grp.ID, group)
 		if err != nil {
 			tx.Rollback()
@@ -544,6 +746,8 @@ func (s *groupService) Update(ctx context.Context, group *userv3.Group) (*userv3
 		group.Spec = &userv3.GroupSpec{
 			Type:                  grp.Type,
 			Users:                 group.Spec.Users, // TODO: update from db resp or no update?
+			Groups:                group.Spec.Groups,
+			MembershipExpiry:      group.Spec.MembershipExpiry,
 			ProjectNamespaceRoles: group.Spec.ProjectNamespaceRoles,
 		}
 
@@ -564,6 +768,14 @@ func (s *groupService) Delete(ctx context.Context, group *userv3.Group) (*userv3
 		return &userv3.Group{}, err
 	}
 	if grp, ok := entity.(*models.Group); ok {
+		// service accounts should always have an owner
+		owns, err := dao.GroupOwnsServiceAccounts(ctx, s.db, grp.ID)
+		if err != nil {
+			return &userv3.Group{}, err
+		}
+		if owns {
+			return &userv3.Group{}, fmt.Errorf("group '%v' owns service accounts, assign them to another group first", name)
+		}
 
 		tx, err := s.db.BeginTx(ctx, &sql.TxOptions{})
 		if err != nil {
@@ -580,6 +792,22 @@ func (s *groupService) Delete(ctx context.Context, group *userv3.Group) (*userv3
 			tx.Rollback()
 			return &userv3.Group{}, err
 		}
+		err = s.deleteGroupMemberRelations(ctx, tx, grp.ID)
+		if err != nil {
+			tx.Rollback()
+			return &userv3.Group{}, err
+		}
+		// remove the group from any group it is nested in
+		err = dao.DeleteX(ctx, tx, "member_group_id", grp.ID, &models.GroupGroup{})
+		if err != nil {
+			tx.Rollback()
+			return &userv3.Group{}, err
+		}
+		_, err = s.azc.DeleteUserGroups(ctx, &authzv1.UserGroup{User: "g:" + grp.Name})
+		if err != nil {
+			tx.Rollback()
+			return &userv3.Group{}, fmt.Errorf("unable to delete group-group relations from authz; %v", err)
+		}
 		err = dao.Delete(ctx, tx, grp.ID, grp)
 		if err != nil {
 			tx.Rollback()
@@ -599,6 +827,45 @@ func (s *groupService) Delete(ctx context.Context, group *userv3.Group) (*userv3
 	return &userv3.Group{}, fmt.Errorf("unable to delete group")
 }
 
+func (s *groupService) GetEffectiveMembers(ctx context.Context, group *userv3.Group) (*userv3.Group, error) {
+	name := group.GetMetadata().GetName()
+	partnerId, organizationId, err := s.getPartnerOrganization(ctx, s.db, group)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get partner and org id")
+	}
+	entity, err := dao.GetByNamePartnerOrg(ctx, s.db, name, uuid.NullUUID{UUID: partnerId, Valid: true}, uuid.NullUUID{UUID: organizationId, Valid: true}, &models.Group{})
+	if err != nil {
+		return &userv3.Group{}, err
+	}
+	grp, ok := entity.(*models.Group)
+	if !ok {
+		return &userv3.Group{}, fmt.Errorf("unable to find group '%v'", name)
+	}
+
+	group, err = s.toV3Group(ctx, s.db, group, grp)
+	if err != nil {
+		return &userv3.Group{}, err
+	}
+	_, nested, err := groupNesting(ctx, s.db, grp.ID, dao.GetMemberGroups)
+	if err != nil {
+		return &userv3.Group{}, err
+	}
+	effective := []string{}
+	for _, gid := range nested {
+		users, err := dao.GetUsers(ctx, s.db, gid)
+		if err != nil {
+			return &userv3.Group{}, err
+		}
+		for _, u := range users {
+			effective = append(effective, u.Traits["email"].(string))
+		}
+	}
+	effective = utils.Unique(effective)
+	sort.Strings(effective)
+	group.Spec.EffectiveUsers = effective
+	return group, nil
+}
+
 func (s *groupService) List(ctx context.Context, opts ...query.Option) (*userv3.GroupList, error) {
 	var groups []*userv3.Group
 	groupList := &userv3.GroupList{
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/google/uuid"
@@ -11,6 +12,7 @@ import (
 	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
 	v3 "github.com/paralus/paralus/proto/types/commonpb/v3"
 	userv3 "github.com/paralus/paralus/proto/types/userpb/v3"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func performGroupBasicChecks(t *testing.T, group *userv3.Group, guuid string) {
@@ -110,6 +112,111 @@ func TestCreateGroupNoUsersNoRoles(t *testing.T) {
 	performGroupBasicAuthzChecks(t, mazc, guuid, []string{}, []*userv3.ProjectNamespaceRole{})
 }
 
+func TestCreateGroupWithNestedGroup(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	mazc := mockAuthzClient{}
+	gs := NewGroupService(db, &mazc, getLogger())
+
+	guuid := uuid.New().String()
+	muuid := uuid.New().String()
+
+	puuid, ouuid := addParterOrgFetchExpectation(mock)
+	mock.ExpectQuery(`SELECT "group"."id" FROM "authsrv_group" AS "group" WHERE .organization_id = '` + ouuid + `'. AND .partner_id = '` + puuid + `'. AND .name = 'group-` + guuid + `'.`).
+		WillReturnError(fmt.Errorf("no data available"))
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO "authsrv_group"`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(guuid))
+	addGroupParentFetchExpectation(mock, guuid)
+	mock.ExpectQuery(`SELECT "group"."id" FROM "authsrv_group" AS "group" WHERE .organization_id = '` + ouuid + `'. AND .partner_id = '` + puuid + `'. AND .name = 'group-` + muuid + `'.`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(muuid))
+	addGroupMemberFetchExpectation(mock, muuid)
+	mock.ExpectQuery(`INSERT INTO "authsrv_groupgroup"`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uuid.New().String()))
+	mock.ExpectCommit()
+
+	group := &userv3.Group{
+		Metadata: &v3.Metadata{Partner: "partner-" + puuid, Organization: "org-" + ouuid, Name: "group-" + guuid},
+		Spec:     &userv3.GroupSpec{Groups: []string{"group-" + muuid}},
+	}
+	group, err := gs.Create(context.Background(), group)
+	if err != nil {
+		t.Fatal("could not create group:", err)
+	}
+	performGroupBasicChecks(t, group, guuid)
+	performBasicAuthzChecks(t, mazc, 0, 0, 1, 0, 0, 0)
+	ug := mazc.cug[0].UserGroups[0]
+	if ug.User != "g:group-"+muuid || ug.Grp != "g:group-"+guuid {
+		t.Errorf("invalid group link sent to authz; got '%v' -> '%v'", ug.User, ug.Grp)
+	}
+}
+
+func TestCreateGroupNestedCycle(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	mazc := mockAuthzClient{}
+	gs := NewGroupService(db, &mazc, getLogger())
+
+	guuid := uuid.New().String()
+	muuid := uuid.New().String()
+
+	puuid, ouuid := addParterOrgFetchExpectation(mock)
+	mock.ExpectQuery(`SELECT "group"."id" FROM "authsrv_group" AS "group" WHERE .organization_id = '` + ouuid + `'. AND .partner_id = '` + puuid + `'. AND .name = 'group-` + guuid + `'.`).
+		WillReturnError(fmt.Errorf("no data available"))
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO "authsrv_group"`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(guuid))
+	// the group being created is already nested in the member group
+	addGroupParentFetchExpectation(mock, guuid, muuid)
+	addGroupParentFetchExpectation(mock, muuid)
+	mock.ExpectQuery(`SELECT "group"."id" FROM "authsrv_group" AS "group" WHERE .organization_id = '` + ouuid + `'. AND .partner_id = '` + puuid + `'. AND .name = 'group-` + muuid + `'.`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(muuid))
+	mock.ExpectRollback()
+
+	group := &userv3.Group{
+		Metadata: &v3.Metadata{Partner: "partner-" + puuid, Organization: "org-" + ouuid, Name: "group-" + guuid},
+		Spec:     &userv3.GroupSpec{Groups: []string{"group-" + muuid}},
+	}
+	_, err := gs.Create(context.Background(), group)
+	if err == nil {
+		t.Fatal("should not be able to create a group membership cycle")
+	}
+	performBasicAuthzChecks(t, mazc, 0, 0, 0, 0, 0, 0)
+}
+
+func TestCreateGroupNestedSelf(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	mazc := mockAuthzClient{}
+	gs := NewGroupService(db, &mazc, getLogger())
+
+	guuid := uuid.New().String()
+
+	puuid, ouuid := addParterOrgFetchExpectation(mock)
+	mock.ExpectQuery(`SELECT "group"."id" FROM "authsrv_group" AS "group" WHERE .organization_id = '` + ouuid + `'. AND .partner_id = '` + puuid + `'. AND .name = 'group-` + guuid + `'.`).
+		WillReturnError(fmt.Errorf("no data available"))
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO "authsrv_group"`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(guuid))
+	addGroupParentFetchExpectation(mock, guuid)
+	mock.ExpectRollback()
+
+	group := &userv3.Group{
+		Metadata: &v3.Metadata{Partner: "partner-" + puuid, Organization: "org-" + ouuid, Name: "group-" + guuid},
+		Spec:     &userv3.GroupSpec{Groups: []string{"group-" + guuid}},
+	}
+	_, err := gs.Create(context.Background(), group)
+	if err == nil {
+		t.Fatal("should not be able to add group to itself")
+	}
+}
+
 func TestCreateGroupDuplicate(t *testing.T) {
 	db, mock := getDB(t)
 	defer db.Close()
@@ -194,6 +301,61 @@ func TestCreateGroupWithUsersNoRoles(t *testing.T) {
 	}
 }
 
+func TestCreateGroupMembershipExpiry(t *testing.T) {
+	tt := []struct {
+		name      string
+		expiresAt time.Time
+		valid     bool
+	}{
+		{"future expiry", time.Now().Add(time.Hour), true},
+		{"past expiry", time.Now().Add(-time.Hour), false},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			db, mock := getDB(t)
+			defer db.Close()
+
+			mazc := mockAuthzClient{}
+			gs := NewGroupService(db, &mazc, getLogger())
+
+			guuid := uuid.New().String()
+
+			puuid, ouuid := addParterOrgFetchExpectation(mock)
+			addUnavailableExpectation(mock, "group", puuid, ouuid, guuid)
+
+			mock.ExpectBegin()
+			mock.ExpectQuery(`INSERT INTO "authsrv_group"`).
+				WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(guuid))
+			user := "user-" + addUserFetchExpectation(mock)
+			if tc.valid {
+				mock.ExpectQuery(`INSERT INTO "authsrv_groupaccount" .* VALUES .*'` + tc.expiresAt.UTC().Format("2006-01-02 15:04:05")).
+					WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uuid.New().String()))
+				mock.ExpectCommit()
+			} else {
+				mock.ExpectRollback()
+			}
+
+			group := &userv3.Group{
+				Metadata: &v3.Metadata{Partner: "partner-" + puuid, Organization: "org-" + ouuid, Name: "group-" + guuid},
+				Spec: &userv3.GroupSpec{
+					Users:            []string{user},
+					MembershipExpiry: map[string]*timestamppb.Timestamp{user: timestamppb.New(tc.expiresAt)},
+				},
+			}
+			_, err := gs.Create(context.Background(), group)
+			if tc.valid && err != nil {
+				t.Fatal("could not create group:", err)
+			}
+			if !tc.valid && err == nil {
+				t.Fatal("should not be able to create group with expired membership")
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
 func TestCreateGroupNoUsersWithRoles(t *testing.T) {
 	tt := []struct {
 		name       string
@@ -386,6 +548,8 @@ func TestUpdateGroupWithUsersWithRoles(t *testing.T) {
 			}
 			mock.ExpectQuery(`INSERT INTO "authsrv_groupaccount"`).
 				WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uuid.New().String()))
+			mock.ExpectExec(`UPDATE "authsrv_groupgroup" AS "groupgroup" SET trash = TRUE WHERE ..group_id. = '` + guuid + `'`).
+				WillReturnResult(sqlmock.NewResult(1, 1))
 
 			addGroupRoleMappingsUpdateExpectation(mock, guuid)
 			mock.ExpectQuery(`SELECT "resourcerole"."id".* FROM "authsrv_resourcerole" AS "resourcerole"`).
@@ -435,9 +599,15 @@ func TestGroupDelete(t *testing.T) {
 
 	puuid, ouuid := addParterOrgFetchExpectation(mock)
 	guuid := addFetchExpectation(mock, "group")
+	mock.ExpectQuery(`SELECT EXISTS \(SELECT .* FROM "authsrv_serviceaccount" AS "serviceaccount" WHERE \(owner_group_id = '` + guuid + `'\)`).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
 	mock.ExpectBegin()
 	addGroupRoleMappingsUpdateExpectation(mock, guuid)
 	addGroupUserMappingsUpdateExpectation(mock, guuid)
+	mock.ExpectExec(`UPDATE "authsrv_groupgroup" AS "groupgroup" SET trash = TRUE WHERE ..group_id. = '` + guuid + `'`).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(`UPDATE "authsrv_groupgroup" AS "groupgroup" SET trash = TRUE WHERE ..member_group_id. = '` + guuid + `'`).
+		WillReturnResult(sqlmock.NewResult(1, 1))
 	addDeleteExpectation(mock, "group", guuid)
 	mock.ExpectCommit()
 
@@ -450,6 +620,30 @@ func TestGroupDelete(t *testing.T) {
 	}
 }
 
+func TestGroupDeleteOwnerOfServiceAccounts(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	mazc := mockAuthzClient{}
+	gs := NewGroupService(db, &mazc, getLogger())
+
+	puuid, ouuid := addParterOrgFetchExpectation(mock)
+	guuid := addFetchExpectation(mock, "group")
+	mock.ExpectQuery(`SELECT EXISTS \(SELECT .* FROM "authsrv_serviceaccount" AS "serviceaccount" WHERE \(owner_group_id = '` + guuid + `'\)`).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+
+	group := &userv3.Group{
+		Metadata: &v3.Metadata{Partner: "partner-" + puuid, Organization: "org-" + ouuid, Name: "group-" + guuid},
+	}
+	_, err := gs.Delete(context.Background(), group)
+	if err == nil {
+		t.Fatal("group owning service accounts should not be deleted")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
 func TestGroupDeleteNonExist(t *testing.T) {
 	db, mock := getDB(t)
 	defer db.Close()
@@ -486,6 +680,7 @@ func TestGroupGetByName(t *testing.T) {
 	addFetchByNameExpectation(mock, "group", guuid)
 	mock.ExpectQuery(`SELECT "identities"."id".* FROM "identities" JOIN authsrv_groupaccount ON identities.id=authsrv_groupaccount.account_id WHERE .authsrv_groupaccount.group_id = '` + guuid + `'`).
 		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id", "traits"}).AddRow(uuuid, []byte(`{"email":"johndoe@provider.com"}`)))
+	addGroupMemberFetchExpectation(mock, guuid)
 	addGroupRoleMappingsFetchExpectation(mock, guuid, puuid)
 
 	group := &userv3.Group{
@@ -526,6 +721,7 @@ func TestGroupGetById(t *testing.T) {
 		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(guuid, "group-"+guuid))
 	mock.ExpectQuery(`SELECT "identities"."id".* FROM "identities" JOIN authsrv_groupaccount ON identities.id=authsrv_groupaccount.account_id WHERE .authsrv_groupaccount.group_id = '` + guuid + `'`).
 		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id", "traits"}).AddRow(uuuid, []byte(`{"email":"johndoe@provider.com"}`)))
+	addGroupMemberFetchExpectation(mock, guuid)
 
 	mock.ExpectQuery(`SELECT authsrv_resourcerole.name as role, authsrv_group.name as group FROM "authsrv_grouprole" JOIN authsrv_resourcerole ON authsrv_resourcerole.id=authsrv_grouprole.role_id JOIN authsrv_group ON authsrv_group.id=authsrv_grouprole.group_id WHERE .authsrv_grouprole.group_id = '` + guuid + `'`).
 		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"role"}).AddRow("role-" + ruuid))
@@ -533,6 +729,8 @@ func TestGroupGetById(t *testing.T) {
 		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"role", "project"}).AddRow("role-"+ruuid, "project-"+pruuid))
 	mock.ExpectQuery(`SELECT authsrv_resourcerole.name as role, authsrv_project.name as project, namespace, authsrv_group.name as group FROM "authsrv_projectgroupnamespacerole" JOIN authsrv_resourcerole ON authsrv_resourcerole.id=authsrv_projectgroupnamespacerole.role_id JOIN authsrv_project ON authsrv_project.id=authsrv_projectgroupnamespacerole.project_id JOIN authsrv_group ON authsrv_group.id=authsrv_projectgroupnamespacerole.group_id WHERE`).
 		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"role", "project", "namespace"}).AddRow("role-"+ruuid, "project-"+pruuid, "ns"))
+	mock.ExpectQuery(`SELECT authsrv_resourcerole.name as role, authsrv_project.name as project, cluster_selector, authsrv_group.name as group FROM "authsrv_groupclusterselectorrole"`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"role", "project", "cluster_selector"}))
 
 	group := &userv3.Group{
 		Metadata: &v3.Metadata{Partner: "partner-" + puuid, Organization: "org-" + ouuid, Id: guuid},
@@ -554,6 +752,48 @@ func TestGroupGetById(t *testing.T) {
 	}
 }
 
+func TestGroupGetEffectiveMembers(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	mazc := mockAuthzClient{}
+	gs := NewGroupService(db, &mazc, getLogger())
+
+	guuid := uuid.New().String()
+	muuid := uuid.New().String()
+
+	puuid, ouuid := addParterOrgFetchExpectation(mock)
+	addFetchByNameExpectation(mock, "group", guuid)
+	mock.ExpectQuery(`SELECT "identities"."id".* FROM "identities" JOIN authsrv_groupaccount ON identities.id=authsrv_groupaccount.account_id WHERE .authsrv_groupaccount.group_id = '` + guuid + `'`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id", "traits"}).AddRow(uuid.New().String(), []byte(`{"email":"johndoe@provider.com"}`)))
+	addGroupMemberFetchExpectation(mock, guuid, muuid)
+	addGroupRoleMappingsFetchExpectation(mock, guuid, puuid)
+	addGroupMemberFetchExpectation(mock, guuid, muuid)
+	addGroupMemberFetchExpectation(mock, muuid)
+	mock.ExpectQuery(`SELECT "identities"."id".* FROM "identities" JOIN authsrv_groupaccount ON identities.id=authsrv_groupaccount.account_id WHERE .authsrv_groupaccount.group_id = '` + guuid + `'`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id", "traits"}).AddRow(uuid.New().String(), []byte(`{"email":"johndoe@provider.com"}`)))
+	mock.ExpectQuery(`SELECT "identities"."id".* FROM "identities" JOIN authsrv_groupaccount ON identities.id=authsrv_groupaccount.account_id WHERE .authsrv_groupaccount.group_id = '` + muuid + `'`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id", "traits"}).
+		AddRow(uuid.New().String(), []byte(`{"email":"janedoe@provider.com"}`)).
+		AddRow(uuid.New().String(), []byte(`{"email":"johndoe@provider.com"}`)))
+
+	group := &userv3.Group{
+		Metadata: &v3.Metadata{Partner: "partner-" + puuid, Organization: "org-" + ouuid, Name: "group-" + guuid},
+	}
+	group, err := gs.GetEffectiveMembers(context.Background(), group)
+	if err != nil {
+		t.Fatal("could not get effective members:", err)
+	}
+	performGroupBasicChecks(t, group, guuid)
+	if len(group.GetSpec().GetGroups()) != 1 || group.GetSpec().GetGroups()[0] != "group-"+muuid {
+		t.Errorf("invalid member groups returned; got %v", group.GetSpec().GetGroups())
+	}
+	users := group.GetSpec().GetEffectiveUsers()
+	if len(users) != 2 || users[0] != "janedoe@provider.com" || users[1] != "johndoe@provider.com" {
+		t.Errorf("invalid effective users returned; got %v", users)
+	}
+}
+
 func TestGroupList(t *testing.T) {
 	db, mock := getDB(t)
 	defer db.Close()
@@ -573,10 +813,12 @@ func TestGroupList(t *testing.T) {
 
 	mock.ExpectQuery(`SELECT "identities"."id".* FROM "identities" JOIN authsrv_groupaccount ON identities.id=authsrv_groupaccount.account_id WHERE .authsrv_groupaccount.group_id = '` + guuid1 + `'`).
 		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id", "traits"}).AddRow(uuuid, []byte(`{"email":"johndoe@provider.com"}`)))
+	addGroupMemberFetchExpectation(mock, guuid1)
 	addGroupRoleMappingsFetchExpectation(mock, guuid1, pruuid)
 
 	mock.ExpectQuery(`SELECT "identities"."id".* FROM "identities" JOIN authsrv_groupaccount ON identities.id=authsrv_groupaccount.account_id WHERE .authsrv_groupaccount.group_id = '` + guuid2 + `'`).
 		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id", "traits"}).AddRow(uuuid, []byte(`{"email":"johndoe@provider.com"}`)))
+	addGroupMemberFetchExpectation(mock, guuid2)
 	addGroupRoleMappingsFetchExpectation(mock, guuid2, pruuid)
 
 	qo := &commonv3.QueryOptions{}
@@ -614,10 +856,12 @@ func TestGroupListFiltered(t *testing.T) {
 
 	mock.ExpectQuery(`SELECT "identities"."id".* FROM "identities" JOIN authsrv_groupaccount ON identities.id=authsrv_groupaccount.account_id WHERE .authsrv_groupaccount.group_id = '` + guuid1 + `'`).
 		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id", "traits"}).AddRow(uuuid, []byte(`{"email":"johndoe@provider.com"}`)))
+	addGroupMemberFetchExpectation(mock, guuid1)
 	addGroupRoleMappingsFetchExpectation(mock, guuid1, pruuid)
 
 	mock.ExpectQuery(`SELECT "identities"."id".* FROM "identities" JOIN authsrv_groupaccount ON identities.id=authsrv_groupaccount.account_id WHERE .authsrv_groupaccount.group_id = '` + guuid2 + `'`).
 		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id", "traits"}).AddRow(uuuid, []byte(`{"email":"johndoe@provider.com"}`)))
+	addGroupMemberFetchExpectation(mock, guuid2)
 	addGroupRoleMappingsFetchExpectation(mock, guuid2, pruuid)
 
 	qo := &commonv3.QueryOptions{Q: "filter-query", Limit: 50, Offset: 20, OrderBy: "email", Order: "asc"}
@@ -0,0 +1,260 @@
+package service
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	// jwksCacheDuration is how long the signing keys of an issuer are
+	// used before fetching them again
+	jwksCacheDuration = 5 * time.Minute
+	// jwksRefreshInterval limits how often the signing keys are fetched
+	// again for tokens signed by an unknown key
+	jwksRefreshInterval = 30 * time.Second
+	jwksFetchTimeout    = 10 * time.Second
+)
+
+// jsonWebKey is a public key of a JWKS, RFC 7517
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Crv string `json:"crv"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+type jwks struct {
+	keys      map[string]interface{}
+	fetchedAt time.Time
+}
+
+type jwksURI struct {
+	uri          string
+	discoveredAt time.Time
+}
+
+// jwksCache caches the JWKS URIs and signing keys of OIDC issuers. The
+// lock only guards the maps, requests are made without holding it and
+// concurrent requests for the same issuer or URI are made once.
+type jwksCache struct {
+	hc    *http.Client
+	group singleflight.Group
+	mu    sync.Mutex
+	uris  map[string]*jwksURI
+	sets  map[string]*jwks
+}
+
+func newJWKSCache() *jwksCache {
+	return &jwksCache{
+		hc:   &http.Client{Timeout: jwksFetchTimeout},
+		uris: map[string]*jwksURI{},
+		sets: map[string]*jwks{},
+	}
+}
+
+func (c *jwksCache) get(ctx context.Context, uri string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := c.hc.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d from %s", resp.StatusCode, uri)
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+// discover gets the JWKS URI from the OpenID configuration of the
+// issuer. The issuer must come from a workload identity policy, never
+// from the token being verified.
+func (c *jwksCache) discover(ctx context.Context, issuer string) (string, error) {
+	c.mu.Lock()
+	cached, ok := c.uris[issuer]
+	c.mu.Unlock()
+	if ok && time.Since(cached.discoveredAt) < jwksCacheDuration {
+		return cached.uri, nil
+	}
+
+	v, err, _ := c.group.Do("discover:"+issuer, func() (interface{}, error) {
+		// another request may have discovered it in the meantime
+		c.mu.Lock()
+		cached, ok := c.uris[issuer]
+		c.mu.Unlock()
+		if ok && time.Since(cached.discoveredAt) < jwksCacheDuration {
+			return cached.uri, nil
+		}
+
+		var config struct {
+			JwksUri string `json:"jwks_uri"`
+		}
+		err := c.get(ctx, strings.TrimSuffix(issuer, "/")+"/.well-known/openid-configuration", &config)
+		if err != nil {
+			return "", fmt.Errorf("unable to discover keys of issuer '%v'; %v", issuer, err)
+		}
+		if config.JwksUri == "" {
+			return "", fmt.Errorf("issuer '%v' has no jwks_uri", issuer)
+		}
+		c.mu.Lock()
+		c.uris[issuer] = &jwksURI{uri: config.JwksUri, discoveredAt: time.Now()}
+		c.mu.Unlock()
+		return config.JwksUri, nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return v.(string), nil
+}
+
+func (c *jwksCache) fetch(ctx context.Context, uri string) (*jwks, error) {
+	var set struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := c.get(ctx, uri, &set); err != nil {
+		return nil, fmt.Errorf("unable to fetch keys from '%v'; %v", uri, err)
+	}
+	keys := map[string]interface{}{}
+	for _, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		// keys of unsupported types are skipped, the issuer may publish
+		// keys we do not need
+		if key, err := parseJWK(k); err == nil {
+			keys[k.Kid] = key
+		}
+	}
+	return &jwks{keys: keys, fetchedAt: time.Now()}, nil
+}
+
+// key gets the signing key with the given id, the keys are fetched
+// again when they are stale or the key is unknown
+func (c *jwksCache) key(ctx context.Context, uri string, kid string) (interface{}, error) {
+	c.mu.Lock()
+	set, ok := c.sets[uri]
+	c.mu.Unlock()
+
+	if ok {
+		if key, found := set.lookup(kid); found && time.Since(set.fetchedAt) < jwksCacheDuration {
+			return key, nil
+		}
+	}
+	if !ok || time.Since(set.fetchedAt) > jwksRefreshInterval {
+		v, err, _ := c.group.Do("keys:"+uri, func() (interface{}, error) {
+			// another request may have fetched them in the meantime
+			c.mu.Lock()
+			latest, found := c.sets[uri]
+			c.mu.Unlock()
+			if found && latest != set && time.Since(latest.fetchedAt) < jwksRefreshInterval {
+				return latest, nil
+			}
+
+			fresh, err := c.fetch(ctx, uri)
+			if err != nil {
+				return nil, err
+			}
+			c.mu.Lock()
+			c.sets[uri] = fresh
+			c.mu.Unlock()
+			return fresh, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		set = v.(*jwks)
+	}
+	if key, found := set.lookup(kid); found {
+		return key, nil
+	}
+	return nil, fmt.Errorf("unable to find signing key '%v'", kid)
+}
+
+// lookup gets the key with the given id, tokens without key id can only
+// be verified when there is one key
+func (s *jwks) lookup(kid string) (interface{}, bool) {
+	if kid == "" && len(s.keys) == 1 {
+		for _, key := range s.keys {
+			return key, true
+		}
+	}
+	key, ok := s.keys[kid]
+	return key, ok
+}
+
+func decodeJWKField(v string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(strings.TrimRight(v, "="))
+}
+
+// parseJWK parses RSA, EC and Ed25519 public keys
+func parseJWK(k jsonWebKey) (interface{}, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeJWKField(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeJWKField(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve '%v'", k.Crv)
+		}
+		x, err := decodeJWKField(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeJWKField(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		key := &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+		if !curve.IsOnCurve(key.X, key.Y) {
+			return nil, fmt.Errorf("invalid key '%v'", k.Kid)
+		}
+		return key, nil
+	case "OKP":
+		if k.Crv != "Ed25519" {
+			return nil, fmt.Errorf("unsupported curve '%v'", k.Crv)
+		}
+		x, err := decodeJWKField(k.X)
+		if err != nil {
+			return nil, err
+		}
+		if len(x) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("invalid key '%v'", k.Kid)
+		}
+		return ed25519.PublicKey(x), nil
+	}
+	return nil, fmt.Errorf("unsupported key type '%v'", k.Kty)
+}
@@ -0,0 +1,251 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/dao"
+	"github.com/paralus/paralus/internal/models"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	systemv3 "github.com/paralus/paralus/proto/types/systempb/v3"
+	bun "github.com/uptrace/bun"
+	"go.uber.org/zap"
+)
+
+// sessionActivityResolution is how often the activity of a session is
+// recorded, idle logout is precise up to this duration
+const sessionActivityResolution = time.Minute
+
+// LockoutService is the interface for enforcing the lockout and idle
+// logout settings of organizations
+type LockoutService interface {
+	// record a failed login of the user, locking the user out after too many failures
+	RecordLoginFailure(context.Context, string) error
+	// record a successful login of the account
+	RecordLoginSuccess(context.Context, string) error
+	// check if the account is locked out
+	IsLocked(context.Context, string) (bool, error)
+	// check if the session has been idle for longer than allowed, records activity otherwise
+	IsIdle(context.Context, string, *commonv3.SessionData) (bool, error)
+	// record activity of an api key or device session, these are not logged out when idle
+	RecordActivity(context.Context, string, *commonv3.SessionData) error
+	// unlock the user
+	Unlock(context.Context, string) error
+}
+
+// lockoutService implements LockoutService
+type lockoutService struct {
+	db *bun.DB
+	al *zap.Logger
+}
+
+// NewLockoutService return new lockout service
+func NewLockoutService(db *bun.DB, al *zap.Logger) LockoutService {
+	return &lockoutService{db: db, al: al}
+}
+
+func (s *lockoutService) getOrganizationSettings(ctx context.Context, orgID string) (*systemv3.OrganizationSettings, error) {
+	org, err := getOrganization(ctx, s.db, orgID)
+	if err != nil {
+		return nil, err
+	}
+	return organizationSettings(org)
+}
+
+// getOrganization returns the organization with the given id, an
+// unknown organization is returned empty
+func getOrganization(ctx context.Context, db bun.IDB, orgID string) (*models.Organization, error) {
+	var org models.Organization
+	oid, err := uuid.Parse(orgID)
+	if err != nil {
+		return &org, nil
+	}
+	_, err = dao.GetByID(ctx, db, oid, &org)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, err
+	}
+	return &org, nil
+}
+
+func organizationSettings(org *models.Organization) (*systemv3.OrganizationSettings, error) {
+	settings := &systemv3.OrganizationSettings{}
+	if org.Settings != nil {
+		err := json.Unmarshal(org.Settings, settings)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return settings, nil
+}
+
+func identityOrganization(identity *models.KratosIdentities) string {
+	if org, ok := identity.MetadataPublic["Organization"].(string); ok {
+		return org
+	}
+	return ""
+}
+
+func (s *lockoutService) RecordLoginFailure(ctx context.Context, username string) error {
+	identity := &models.KratosIdentities{}
+	_, err := dao.GetUserByEmail(ctx, s.db, username, identity)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			// not revealing whether the user exists
+			_log.Infow("login failure for unknown user", "username", username)
+			return nil
+		}
+		return fmt.Errorf("unable to record login failure; %v", err)
+	}
+	CreateUserLoginFailureAuditEvent(s.al, username)
+
+	settings, err := s.getOrganizationSettings(ctx, identityOrganization(identity))
+	if err != nil {
+		return fmt.Errorf("unable to record login failure; %v", err)
+	}
+	lockout := settings.GetLockout()
+	if !lockout.GetEnabled() || lockout.GetAttempts() <= 0 {
+		return nil
+	}
+
+	current, err := dao.GetAccountLockout(ctx, s.db, identity.ID)
+	if err != nil {
+		return fmt.Errorf("unable to record login failure; %v", err)
+	}
+	if current.LockedUntil.After(time.Now()) {
+		return nil
+	}
+
+	period := time.Duration(lockout.GetPeriodMin()) * time.Minute
+	res, err := dao.RecordFailedLogin(ctx, s.db, identity.ID, time.Now().Add(-period))
+	if err != nil {
+		return fmt.Errorf("unable to record login failure; %v", err)
+	}
+	if res.FailedAttempts < int(lockout.GetAttempts()) {
+		return nil
+	}
+
+	until := time.Now().Add(period)
+	err = dao.LockAccount(ctx, s.db, identity.ID, until)
+	if err != nil {
+		return fmt.Errorf("unable to lock user '%v'; %v", username, err)
+	}
+	CreateUserLockedAuditEvent(s.al, username, res.FailedAttempts, until)
+	return nil
+}
+
+func (s *lockoutService) RecordLoginSuccess(ctx context.Context, accountID string) error {
+	aid, err := uuid.Parse(accountID)
+	if err != nil {
+		return err
+	}
+	return dao.ResetFailedLogins(ctx, s.db, aid)
+}
+
+func (s *lockoutService) IsLocked(ctx context.Context, accountID string) (bool, error) {
+	aid, err := uuid.Parse(accountID)
+	if err != nil {
+		return false, err
+	}
+	lockout, err := dao.GetAccountLockout(ctx, s.db, aid)
+	if err != nil {
+		return false, err
+	}
+	return lockout.LockedUntil.After(time.Now()), nil
+}
+
+func (s *lockoutService) IsIdle(ctx context.Context, sessionID string, sd *commonv3.SessionData) (bool, error) {
+	sid, err := uuid.Parse(sessionID)
+	if err != nil {
+		return false, err
+	}
+	aid, err := uuid.Parse(sd.GetAccount())
+	if err != nil {
+		return false, err
+	}
+	activity, err := dao.GetSessionActivity(ctx, s.db, sid)
+	if err != nil {
+		return false, err
+	}
+	if activity.Terminated {
+		return true, nil
+	}
+
+	now := time.Now()
+	if !activity.LastActiveAt.IsZero() {
+		settings, err := s.getOrganizationSettings(ctx, sd.GetOrganization())
+		if err != nil {
+			return false, err
+		}
+		idle := time.Duration(settings.GetIdleLogoutMin()) * time.Minute
+		if idle > 0 && now.Sub(activity.LastActiveAt) > idle {
+			err = dao.TerminateSession(ctx, s.db, sid)
+			if err != nil {
+				return false, err
+			}
+			CreateSessionIdleAuditEvent(s.al, sd, activity.LastActiveAt)
+			return true, nil
+		}
+		if now.Sub(activity.LastActiveAt) < sessionActivityResolution {
+			return false, nil
+		}
+	}
+	err = dao.TouchSession(ctx, s.db, &models.SessionActivity{
+		SessionId:    sid,
+		AccountId:    aid,
+		LastActiveAt: now,
+		Kind:         SessionTypeConsole,
+		ClientIp:     sd.GetClientIp(),
+		ClientUa:     sd.GetClientUa(),
+	})
+	if err != nil {
+		return false, err
+	}
+	return false, nil
+}
+
+func (s *lockoutService) RecordActivity(ctx context.Context, kind string, sd *commonv3.SessionData) error {
+	sid, err := uuid.Parse(sd.GetSession())
+	if err != nil {
+		return err
+	}
+	aid, err := uuid.Parse(sd.GetAccount())
+	if err != nil {
+		return err
+	}
+	activity, err := dao.GetSessionActivity(ctx, s.db, sid)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	if now.Sub(activity.LastActiveAt) < sessionActivityResolution && activity.ClientIp == sd.GetClientIp() {
+		return nil
+	}
+	return dao.TouchSession(ctx, s.db, &models.SessionActivity{
+		SessionId:    sid,
+		AccountId:    aid,
+		LastActiveAt: now,
+		Kind:         kind,
+		ClientIp:     sd.GetClientIp(),
+		ClientUa:     sd.GetClientUa(),
+	})
+}
+
+func (s *lockoutService) Unlock(ctx context.Context, username string) error {
+	identity := &models.KratosIdentities{}
+	_, err := dao.GetUserIdByEmail(ctx, s.db, username, identity)
+	if err != nil {
+		return fmt.Errorf("unable to find user '%v'", username)
+	}
+	unlocked, err := dao.UnlockAccount(ctx, s.db, identity.ID)
+	if err != nil {
+		return fmt.Errorf("unable to unlock user '%v'; %v", username, err)
+	}
+	if unlocked {
+		CreateUserUnlockedAuditEvent(ctx, s.al, username)
+	}
+	return nil
+}
@@ -0,0 +1,185 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+)
+
+func addOrganizationSettingsFetchExpectation(mock sqlmock.Sqlmock, ouuid string) {
+	mock.ExpectQuery(`SELECT "organization"."id", .* FROM "authsrv_organization" AS "organization" WHERE .*id = '` + ouuid + `'`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "settings"}).
+			AddRow(ouuid, "org-"+ouuid, []byte(`{"lockout":{"enabled":true,"period_min":15,"attempts":5},"idleLogoutMin":60}`)))
+}
+
+func TestRecordLoginFailureLocks(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	ls := NewLockoutService(db, getLogger())
+
+	uuuid := uuid.New().String()
+	ouuid := uuid.New().String()
+	mock.ExpectQuery(`SELECT "identities"."id", .* FROM "identities" WHERE .traits ->> 'email' = 'johndoe@provider.com'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "traits", "metadata_public"}).
+			AddRow(uuuid, []byte(`{"email":"johndoe@provider.com"}`), []byte(`{"Organization":"`+ouuid+`"}`)))
+	addOrganizationSettingsFetchExpectation(mock, ouuid)
+	mock.ExpectQuery(`SELECT "accountlockout"."account_id", .* FROM "authsrv_accountlockout" AS "accountlockout" WHERE .account_id = '` + uuuid + `'.`).
+		WillReturnError(sql.ErrNoRows)
+	mock.ExpectQuery(`INSERT INTO "authsrv_accountlockout" AS "accountlockout" .* ON CONFLICT \(account_id\) DO UPDATE SET failed_attempts = CASE .* RETURNING`).
+		WillReturnRows(sqlmock.NewRows([]string{"account_id", "failed_attempts"}).AddRow(uuuid, 5))
+	mock.ExpectExec(`UPDATE "authsrv_accountlockout" AS "accountlockout" SET locked_until = .* WHERE .account_id = '` + uuuid + `'.`).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	err := ls.RecordLoginFailure(context.Background(), "johndoe@provider.com")
+	if err != nil {
+		t.Fatal("could not record login failure:", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRecordLoginFailureBelowAttempts(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	ls := NewLockoutService(db, getLogger())
+
+	uuuid := uuid.New().String()
+	ouuid := uuid.New().String()
+	mock.ExpectQuery(`SELECT "identities"."id", .* FROM "identities" WHERE .traits ->> 'email' = 'johndoe@provider.com'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "traits", "metadata_public"}).
+			AddRow(uuuid, []byte(`{"email":"johndoe@provider.com"}`), []byte(`{"Organization":"`+ouuid+`"}`)))
+	addOrganizationSettingsFetchExpectation(mock, ouuid)
+	mock.ExpectQuery(`SELECT "accountlockout"."account_id", .* FROM "authsrv_accountlockout" AS "accountlockout" WHERE .account_id = '` + uuuid + `'.`).
+		WillReturnError(sql.ErrNoRows)
+	mock.ExpectQuery(`INSERT INTO "authsrv_accountlockout" AS "accountlockout" .* ON CONFLICT \(account_id\) DO UPDATE`).
+		WillReturnRows(sqlmock.NewRows([]string{"account_id", "failed_attempts"}).AddRow(uuuid, 2))
+
+	err := ls.RecordLoginFailure(context.Background(), "johndoe@provider.com")
+	if err != nil {
+		t.Fatal("could not record login failure:", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRecordLoginFailureUnknownUser(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	ls := NewLockoutService(db, getLogger())
+
+	mock.ExpectQuery(`SELECT "identities"."id", .* FROM "identities" WHERE .traits ->> 'email' = 'nobody@provider.com'.`).
+		WillReturnError(sql.ErrNoRows)
+
+	err := ls.RecordLoginFailure(context.Background(), "nobody@provider.com")
+	if err != nil {
+		t.Fatal("login failure of unknown user should not fail:", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestIsLocked(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	ls := NewLockoutService(db, getLogger())
+
+	uuuid := uuid.New().String()
+	mock.ExpectQuery(`SELECT "accountlockout"."account_id", .* FROM "authsrv_accountlockout" AS "accountlockout" WHERE .account_id = '` + uuuid + `'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"account_id", "failed_attempts", "locked_until"}).AddRow(uuuid, 5, time.Now().Add(10*time.Minute)))
+
+	locked, err := ls.IsLocked(context.Background(), uuuid)
+	if err != nil {
+		t.Fatal("could not check lockout:", err)
+	}
+	if !locked {
+		t.Error("account should be locked")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestIsIdleRecordsActivity(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	ls := NewLockoutService(db, getLogger())
+
+	suuid := uuid.New().String()
+	uuuid := uuid.New().String()
+	ouuid := uuid.New().String()
+	mock.ExpectQuery(`SELECT "sessionactivity"."session_id", .* FROM "authsrv_sessionactivity" AS "sessionactivity" WHERE .session_id = '` + suuid + `'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"session_id", "account_id", "last_active_at"}).AddRow(suuid, uuuid, time.Now().Add(-10*time.Minute)))
+	addOrganizationSettingsFetchExpectation(mock, ouuid)
+	mock.ExpectExec(`INSERT INTO "authsrv_sessionactivity" AS "sessionactivity" .* ON CONFLICT \(session_id\) DO UPDATE SET last_active_at = EXCLUDED.last_active_at`).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	idle, err := ls.IsIdle(context.Background(), suuid, &commonv3.SessionData{Account: uuuid, Organization: ouuid, Username: "johndoe@provider.com"})
+	if err != nil {
+		t.Fatal("could not check idle session:", err)
+	}
+	if idle {
+		t.Error("session should not be idle")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestIsIdleTerminates(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	ls := NewLockoutService(db, getLogger())
+
+	suuid := uuid.New().String()
+	uuuid := uuid.New().String()
+	ouuid := uuid.New().String()
+	mock.ExpectQuery(`SELECT "sessionactivity"."session_id", .* FROM "authsrv_sessionactivity" AS "sessionactivity" WHERE .session_id = '` + suuid + `'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"session_id", "account_id", "last_active_at"}).AddRow(suuid, uuuid, time.Now().Add(-2*time.Hour)))
+	addOrganizationSettingsFetchExpectation(mock, ouuid)
+	mock.ExpectExec(`UPDATE "authsrv_sessionactivity" AS "sessionactivity" SET terminated = TRUE WHERE .session_id = '` + suuid + `'.`).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	idle, err := ls.IsIdle(context.Background(), suuid, &commonv3.SessionData{Account: uuuid, Organization: ouuid, Username: "johndoe@provider.com"})
+	if err != nil {
+		t.Fatal("could not check idle session:", err)
+	}
+	if !idle {
+		t.Error("session should be idle")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestUnlockUser(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	ls := NewLockoutService(db, getLogger())
+
+	uuuid := addUserIdFetchExpectation(mock)
+	mock.ExpectExec(`DELETE FROM "authsrv_accountlockout" AS "accountlockout" WHERE .account_id = '` + uuuid + `'.`).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	err := ls.Unlock(context.Background(), "user-"+uuuid)
+	if err != nil {
+		t.Fatal("could not unlock user:", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
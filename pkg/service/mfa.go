@@ -0,0 +1,151 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/dao"
+	"github.com/paralus/paralus/internal/models"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	systemv3 "github.com/paralus/paralus/proto/types/systempb/v3"
+	bun "github.com/uptrace/bun"
+)
+
+// defaultStepUpMaxAge is how recently the second factor should have been
+// authenticated for sensitive actions when the policy does not say
+const defaultStepUpMaxAge = 15 * time.Minute
+
+// MFARequirement is the multi-factor authentication required of a user
+type MFARequirement struct {
+	// Required is set when the user should authenticate with a second factor
+	Required bool
+	// StepUpMaxAge is how recently the second factor should have been
+	// authenticated for sensitive actions
+	StepUpMaxAge time.Duration
+	// EnrollBy is the end of the enrollment grace period, zero when
+	// there is no grace period
+	EnrollBy time.Time
+}
+
+// MFAService is the interface for the multi-factor authentication
+// policy of organizations
+type MFAService interface {
+	// get the multi-factor authentication required of the user
+	GetRequirement(context.Context, *commonv3.SessionData) (*MFARequirement, error)
+}
+
+// mfaService implements MFAService
+type mfaService struct {
+	db *bun.DB
+}
+
+// NewMFAService return new multi-factor authentication service
+func NewMFAService(db *bun.DB) MFAService {
+	return &mfaService{db: db}
+}
+
+// isEnforced checks if the organization or its partner has enabled
+// multi-factor authentication
+func (s *mfaService) isEnforced(ctx context.Context, org *models.Organization) (bool, error) {
+	if org.IsTOTPEnabled {
+		return true, nil
+	}
+	if org.PartnerId == uuid.Nil {
+		return false, nil
+	}
+	var partner models.Partner
+	_, err := dao.GetByID(ctx, s.db, org.PartnerId, &partner)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, err
+	}
+	return partner.IsTOTPEnabled, nil
+}
+
+// appliesTo checks if the policy covers the user, a policy without
+// groups and roles covers every user
+func (s *mfaService) appliesTo(ctx context.Context, policy *systemv3.MFAPolicy, sd *commonv3.SessionData, aid uuid.UUID) (bool, error) {
+	if len(policy.GetGroups()) == 0 && len(policy.GetRoles()) == 0 {
+		return true, nil
+	}
+	for _, g := range policy.GetGroups() {
+		for _, sg := range sd.GetGroups() {
+			if g == sg {
+				return true, nil
+			}
+		}
+	}
+	if len(policy.GetRoles()) == 0 {
+		return false, nil
+	}
+	return dao.AccountHasRoles(ctx, s.db, aid, policy.GetRoles())
+}
+
+func (s *mfaService) GetRequirement(ctx context.Context, sd *commonv3.SessionData) (*MFARequirement, error) {
+	requirement := &MFARequirement{}
+	aid, err := uuid.Parse(sd.GetAccount())
+	if err != nil {
+		return requirement, nil
+	}
+	org, err := getOrganization(ctx, s.db, sd.GetOrganization())
+	if err != nil {
+		return nil, fmt.Errorf("unable to get mfa policy; %v", err)
+	}
+	enforced, err := s.isEnforced(ctx, org)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get mfa policy; %v", err)
+	}
+	if !enforced {
+		return requirement, nil
+	}
+	settings, err := organizationSettings(org)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get mfa policy; %v", err)
+	}
+	policy := settings.GetMfa()
+	applies, err := s.appliesTo(ctx, policy, sd, aid)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get mfa policy; %v", err)
+	}
+	if !applies {
+		return requirement, nil
+	}
+	// service accounts can not authenticate a second factor, like api
+	// keys they can not be used for actions which need a step up
+	if sd.GetIsServiceAccount() {
+		requirement.Required = true
+		return requirement, nil
+	}
+
+	enrollment, err := dao.GetMFAEnrollment(ctx, s.db, aid)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get mfa enrollment; %v", err)
+	}
+	// users of identity providers trusted to enforce multi-factor
+	// authentication authenticate the second factor with them, other
+	// SSO users enroll one like local users
+	if enrollment.IdpMFA {
+		return requirement, nil
+	}
+
+	requirement.Required = true
+	requirement.StepUpMaxAge = time.Duration(policy.GetStepUpMaxAgeMin()) * time.Minute
+	if requirement.StepUpMaxAge <= 0 {
+		requirement.StepUpMaxAge = defaultStepUpMaxAge
+	}
+	if !enrollment.Enrolled && policy.GetGracePeriodDays() > 0 {
+		// the grace period starts when the user is created or when the
+		// policy is enforced, whichever is later
+		start := enrollment.CreatedAt
+		if since := policy.GetEnforcedSince(); since != nil && since.AsTime().After(start) {
+			start = since.AsTime()
+		}
+		requirement.EnrollBy = start.AddDate(0, 0, int(policy.GetGracePeriodDays()))
+	}
+	return requirement, nil
+}
@@ -0,0 +1,173 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+)
+
+func addMFAOrganizationFetchExpectation(mock sqlmock.Sqlmock, ouuid string, totp bool, settings string) {
+	mock.ExpectQuery(`SELECT "organization"."id", .* FROM "authsrv_organization" AS "organization" WHERE .*id = '` + ouuid + `'`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "is_totp_enabled", "settings"}).
+			AddRow(ouuid, "org-"+ouuid, totp, []byte(settings)))
+}
+
+func addMFAEnrollmentFetchExpectation(mock sqlmock.Sqlmock, uuuid string, createdAt time.Time, enrolled, idpMFA bool) {
+	mock.ExpectQuery(`SELECT "identities"."created_at", EXISTS \(.*'totp', 'webauthn'.*\) AS enrolled, EXISTS \(.* JOIN authsrv_oidc_provider AS op .*'oidc'.*op.mfa_enforced = TRUE.*\) AS idp_mfa FROM "identities" WHERE .identities.id = '` + uuuid + `'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"created_at", "enrolled", "idp_mfa"}).AddRow(createdAt, enrolled, idpMFA))
+}
+
+func TestMFARequirementNotEnforced(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	ms := NewMFAService(db)
+
+	uuuid := uuid.New().String()
+	ouuid := uuid.New().String()
+	addMFAOrganizationFetchExpectation(mock, ouuid, false, `{}`)
+
+	requirement, err := ms.GetRequirement(context.Background(), &commonv3.SessionData{Account: uuuid, Organization: ouuid})
+	if err != nil {
+		t.Fatal("could not get mfa requirement:", err)
+	}
+	if requirement.Required {
+		t.Error("mfa should not be required")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMFARequirementGracePeriod(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	ms := NewMFAService(db)
+
+	uuuid := uuid.New().String()
+	ouuid := uuid.New().String()
+	enforcedSince := time.Now().Add(-24 * time.Hour).UTC().Truncate(time.Second)
+	addMFAOrganizationFetchExpectation(mock, ouuid, true, `{"mfa":{"gracePeriodDays":7,"enforcedSince":{"seconds":`+
+		strconv.FormatInt(enforcedSince.Unix(), 10)+`}}}`)
+	addMFAEnrollmentFetchExpectation(mock, uuuid, time.Now().Add(-30*24*time.Hour), false, false)
+
+	requirement, err := ms.GetRequirement(context.Background(), &commonv3.SessionData{Account: uuuid, Organization: ouuid})
+	if err != nil {
+		t.Fatal("could not get mfa requirement:", err)
+	}
+	if !requirement.Required {
+		t.Fatal("mfa should be required")
+	}
+	if !requirement.EnrollBy.Equal(enforcedSince.AddDate(0, 0, 7)) {
+		t.Errorf("grace period should end 7 days after enforcement; got %v", requirement.EnrollBy)
+	}
+	if requirement.StepUpMaxAge != defaultStepUpMaxAge {
+		t.Errorf("step up max age should default to %v; got %v", defaultStepUpMaxAge, requirement.StepUpMaxAge)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMFARequirementEnrolled(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	ms := NewMFAService(db)
+
+	uuuid := uuid.New().String()
+	ouuid := uuid.New().String()
+	addMFAOrganizationFetchExpectation(mock, ouuid, true, `{"mfa":{"gracePeriodDays":7,"stepUpMaxAgeMin":5}}`)
+	addMFAEnrollmentFetchExpectation(mock, uuuid, time.Now(), true, false)
+
+	requirement, err := ms.GetRequirement(context.Background(), &commonv3.SessionData{Account: uuuid, Organization: ouuid})
+	if err != nil {
+		t.Fatal("could not get mfa requirement:", err)
+	}
+	if !requirement.Required || !requirement.EnrollBy.IsZero() {
+		t.Errorf("enrolled users should have no grace period; got %v", requirement)
+	}
+	if requirement.StepUpMaxAge != 5*time.Minute {
+		t.Errorf("step up max age should be 5m; got %v", requirement.StepUpMaxAge)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMFARequirementIdpMFAUser(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	ms := NewMFAService(db)
+
+	uuuid := uuid.New().String()
+	ouuid := uuid.New().String()
+	addMFAOrganizationFetchExpectation(mock, ouuid, true, `{}`)
+	addMFAEnrollmentFetchExpectation(mock, uuuid, time.Now(), false, true)
+
+	requirement, err := ms.GetRequirement(context.Background(), &commonv3.SessionData{Account: uuuid, Organization: ouuid})
+	if err != nil {
+		t.Fatal("could not get mfa requirement:", err)
+	}
+	if requirement.Required {
+		t.Error("mfa should not be required of users of identity providers enforcing it")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMFARequirementSSOUser(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	ms := NewMFAService(db)
+
+	uuuid := uuid.New().String()
+	ouuid := uuid.New().String()
+	addMFAOrganizationFetchExpectation(mock, ouuid, true, `{}`)
+	// the identity provider of the user does not enforce mfa
+	addMFAEnrollmentFetchExpectation(mock, uuuid, time.Now(), false, false)
+
+	requirement, err := ms.GetRequirement(context.Background(), &commonv3.SessionData{Account: uuuid, Organization: ouuid})
+	if err != nil {
+		t.Fatal("could not get mfa requirement:", err)
+	}
+	if !requirement.Required {
+		t.Error("mfa should be required of sso users of identity providers not enforcing it")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMFARequirementRoleOverride(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	ms := NewMFAService(db)
+
+	uuuid := uuid.New().String()
+	ouuid := uuid.New().String()
+	addMFAOrganizationFetchExpectation(mock, ouuid, true, `{"mfa":{"groups":["admins"],"roles":["ADMIN"]}}`)
+	mock.ExpectQuery(`SELECT EXISTS \(SELECT .* FROM sentry_account_permission WHERE .account_id = '` + uuuid + `'. AND .role_name IN \('ADMIN'\).\)`).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+
+	requirement, err := ms.GetRequirement(context.Background(), &commonv3.SessionData{Account: uuuid, Organization: ouuid, Groups: []string{"developers"}})
+	if err != nil {
+		t.Fatal("could not get mfa requirement:", err)
+	}
+	if requirement.Required {
+		t.Error("mfa should only be required of admins")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
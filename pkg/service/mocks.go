@@ -13,10 +13,13 @@ type ApUpdate struct {
 	traits map[string]interface{}
 }
 type mockAuthProvider struct {
-	c []map[string]interface{}
-	u []ApUpdate
-	r []string
-	d []string
+	c  []map[string]interface{}
+	u  []ApUpdate
+	r  []string
+	d  []string
+	s  []providers.Session
+	rs []string
+	st []bool
 }
 
 func (m *mockAuthProvider) Create(ctx context.Context, pass string, traits map[string]interface{}, metadata providers.IdentityPublicMetadata) (string, error) {
@@ -40,6 +43,25 @@ func (m *mockAuthProvider) GetPublicMetadata(context.Context, string) (*provider
 	return &providers.IdentityPublicMetadata{}, nil
 }
 
+func (m *mockAuthProvider) ListSessions(ctx context.Context, id string) ([]providers.Session, error) {
+	return m.s, nil
+}
+
+func (m *mockAuthProvider) RevokeSession(ctx context.Context, id string) error {
+	m.rs = append(m.rs, id)
+	return nil
+}
+
+func (m *mockAuthProvider) RevokeSessions(ctx context.Context, id string) error {
+	m.rs = append(m.rs, id)
+	return nil
+}
+
+func (m *mockAuthProvider) SetState(ctx context.Context, id string, active bool) error {
+	m.st = append(m.st, active)
+	return nil
+}
+
 type mockAuthzClient struct {
 	cp   []*types.Policies
 	dp   []*types.Policy
@@ -47,11 +69,23 @@ type mockAuthzClient struct {
 	dug  []*types.UserGroup
 	crpm []*types.RolePermissionMappingList
 	drpm []*types.FilteredRolePermissionMapping
+	ex   []*types.ExplainRequest
 }
 
 func (c *mockAuthzClient) Enforce(ctx context.Context, in *types.EnforceRequest) (*types.BoolReply, error) {
 	return &types.BoolReply{Res: true}, nil
 }
+func (c *mockAuthzClient) EnforceEx(ctx context.Context, in *types.EnforceRequest) (*types.EnforceExReply, error) {
+	return &types.EnforceExReply{Res: true}, nil
+}
+func (c *mockAuthzClient) Explain(ctx context.Context, in *types.ExplainRequest) (*types.ExplainReply, error) {
+	c.ex = append(c.ex, in)
+	return &types.ExplainReply{
+		Res:     true,
+		Matched: []*types.Policy{{Sub: in.Params[0], Ns: "*", Proj: "*", Org: in.Params[3], Obj: "ADMIN", Eft: "allow"}},
+		Roles:   []*types.RoleMatch{{Role: "ADMIN", Url: in.Params[4], Method: in.Params[5]}},
+	}, nil
+}
 func (c *mockAuthzClient) ListPolicies(ctx context.Context, in *types.Policy) (*types.Policies, error) {
 	return &types.Policies{}, nil
 }
@@ -155,6 +155,7 @@ func (s *oidcProvider) Create(ctx context.Context, provider *systemv3.OIDCProvid
 		TokenURL:        tknUrl,
 		RequestedClaims: provider.Spec.GetRequestedClaims().AsMap(),
 		Predefined:      provider.Spec.GetPredefined(),
+		MFAEnforced:     provider.Spec.GetMfaEnforced(),
 	}
 	_, err = dao.Create(ctx, s.db, entity)
 	if err != nil {
@@ -181,6 +182,7 @@ func (s *oidcProvider) Create(ctx context.Context, provider *systemv3.OIDCProvid
 			TokenUrl:        entity.TokenURL,
 			RequestedClaims: rclaims,
 			Predefined:      entity.Predefined,
+			MfaEnforced:     entity.MFAEnforced,
 			CallbackUrl:     generateCallbackUrl(entity.Name, s.kratosUrl),
 		},
 	}
@@ -223,6 +225,7 @@ func (s *oidcProvider) GetByID(ctx context.Context, provider *systemv3.OIDCProvi
 			TokenUrl:        entity.TokenURL,
 			RequestedClaims: rclaims,
 			Predefined:      entity.Predefined,
+			MfaEnforced:     entity.MFAEnforced,
 			CallbackUrl:     generateCallbackUrl(entity.Name, s.kratosUrl),
 		},
 	}
@@ -270,6 +273,7 @@ func (s *oidcProvider) GetByName(ctx context.Context, provider *systemv3.OIDCPro
 			TokenUrl:        entity.TokenURL,
 			RequestedClaims: rclaims,
 			Predefined:      entity.Predefined,
+			MfaEnforced:     entity.MFAEnforced,
 			CallbackUrl:     generateCallbackUrl(entity.Name, s.kratosUrl),
 		},
 	}
@@ -309,6 +313,7 @@ func (s *oidcProvider) List(ctx context.Context) (*systemv3.OIDCProviderList, er
 				TokenUrl:        entity.TokenURL,
 				RequestedClaims: rclaims,
 				Predefined:      entity.Predefined,
+				MfaEnforced:     entity.MFAEnforced,
 				CallbackUrl:     generateCallbackUrl(entity.Name, s.kratosUrl),
 			},
 		}
@@ -386,6 +391,7 @@ func (s *oidcProvider) Update(ctx context.Context, provider *systemv3.OIDCProvid
 		TokenURL:        tknUrl,
 		RequestedClaims: provider.Spec.GetRequestedClaims().AsMap(),
 		Predefined:      provider.Spec.GetPredefined(),
+		MFAEnforced:     provider.Spec.GetMfaEnforced(),
 	}
 	_, err = dao.Update(ctx, s.db, existingP.Id, entity)
 	if err != nil {
@@ -414,6 +420,7 @@ func (s *oidcProvider) Update(ctx context.Context, provider *systemv3.OIDCProvid
 			TokenUrl:        entity.TokenURL,
 			RequestedClaims: rclaims,
 			Predefined:      entity.Predefined,
+			MfaEnforced:     entity.MFAEnforced,
 			CallbackUrl:     generateCallbackUrl(provider.GetMetadata().GetName(), s.kratosUrl),
 		},
 	}
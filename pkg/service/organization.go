@@ -65,6 +65,9 @@ func (s *organizationService) Create(ctx context.Context, org *systemv3.Organiza
 		},
 		IdleLogoutMin: 60,
 	}
+	if org.GetSpec().GetIsTotpEnabled() {
+		org.Spec.Settings.Mfa = &systemv3.MFAPolicy{EnforcedSince: timestamppb.Now()}
+	}
 	sb, err := json.MarshalIndent(org.GetSpec().GetSettings(), "", "\t")
 	if err != nil {
 		return &systemv3.Organization{}, err
@@ -202,6 +205,15 @@ func (s *organizationService) Update(ctx context.Context, organization *systemv3
 		settingsAfter := organization.GetSpec().GetSettings()
 		settingsBefore := systemv3.OrganizationSettings{}
 		_ = json.Unmarshal(org.Settings, &settingsBefore) // ignore any unmarshelling issues
+		if err := validateInactivityPolicy(settingsAfter.GetInactivity()); err != nil {
+			return &systemv3.Organization{}, err
+		}
+		if err := validateRateLimits(settingsAfter.GetRateLimits()); err != nil {
+			return &systemv3.Organization{}, err
+		}
+		if settingsAfter != nil {
+			settingsAfter.Mfa = mfaPolicyAfterUpdate(settingsBefore.GetMfa(), settingsAfter.GetMfa(), org.IsTOTPEnabled, organization.GetSpec().GetIsTotpEnabled())
+		}
 
 		sb, err := json.MarshalIndent(settingsAfter, "", "\t")
 		if err != nil {
@@ -371,3 +383,38 @@ func prepareOrganizationResponse(organization *systemv3.Organization, org *model
 
 	return organization, nil
 }
+
+// validateInactivityPolicy checks that users are deleted only after
+// they have been suspended
+func validateInactivityPolicy(policy *systemv3.InactivityPolicy) error {
+	if policy.GetSuspendAfterDays() < 0 || policy.GetDeleteAfterDays() < 0 || policy.GetWarnBeforeDays() < 0 {
+		return fmt.Errorf("inactivity days can not be negative")
+	}
+	if policy.GetDeleteAfterDays() > 0 && policy.GetDeleteAfterDays() <= policy.GetSuspendAfterDays() {
+		return fmt.Errorf("inactive users should be deleted after they are suspended")
+	}
+	if policy.GetDeleteAfterDays() > 0 && policy.GetSuspendAfterDays() == 0 {
+		return fmt.Errorf("inactive users should be suspended before they are deleted")
+	}
+	return nil
+}
+
+// mfaPolicyAfterUpdate keeps the time multi-factor authentication was
+// enforced, which starts the enrollment grace period, out of the hands
+// of the client
+func mfaPolicyAfterUpdate(before, after *systemv3.MFAPolicy, enabledBefore, enabledAfter bool) *systemv3.MFAPolicy {
+	enforcedSince := before.GetEnforcedSince()
+	if !enabledAfter {
+		enforcedSince = nil
+	} else if !enabledBefore || enforcedSince == nil {
+		enforcedSince = timestamppb.Now()
+	}
+	if after == nil {
+		if enforcedSince == nil {
+			return nil
+		}
+		after = &systemv3.MFAPolicy{}
+	}
+	after.EnforcedSince = enforcedSince
+	return after
+}
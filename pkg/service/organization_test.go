@@ -181,3 +181,46 @@ func TestOrganizationUpdate(t *testing.T) {
 		t.Fatal("could not update organization:", err)
 	}
 }
+
+func TestValidateInactivityPolicy(t *testing.T) {
+	tt := []struct {
+		name   string
+		policy *systemv3.InactivityPolicy
+		valid  bool
+	}{
+		{"no policy", nil, true},
+		{"suspend only", &systemv3.InactivityPolicy{SuspendAfterDays: 30, WarnBeforeDays: 7}, true},
+		{"suspend and delete", &systemv3.InactivityPolicy{SuspendAfterDays: 30, DeleteAfterDays: 90}, true},
+		{"delete without suspend", &systemv3.InactivityPolicy{DeleteAfterDays: 90}, false},
+		{"delete before suspend", &systemv3.InactivityPolicy{SuspendAfterDays: 90, DeleteAfterDays: 30}, false},
+		{"negative days", &systemv3.InactivityPolicy{SuspendAfterDays: -1}, false},
+	}
+	for _, tc := range tt {
+		err := validateInactivityPolicy(tc.policy)
+		if (err == nil) != tc.valid {
+			t.Errorf("%s: expected valid %v, got error %v", tc.name, tc.valid, err)
+		}
+	}
+}
+
+func TestValidateRateLimits(t *testing.T) {
+	tt := []struct {
+		name   string
+		limits []*systemv3.RateLimit
+		valid  bool
+	}{
+		{"no limits", nil, true},
+		{"kubeconfig limit", []*systemv3.RateLimit{{Class: RateLimitClassKubeconfig, RequestsPerSecond: 0.1, Burst: 5}}, true},
+		{"no limit", []*systemv3.RateLimit{{Class: RateLimitClassDefault}}, true},
+		{"unknown class", []*systemv3.RateLimit{{Class: "clusters", RequestsPerSecond: 1, Burst: 1}}, false},
+		{"duplicate class", []*systemv3.RateLimit{{Class: RateLimitClassLogin, RequestsPerSecond: 1, Burst: 1}, {Class: RateLimitClassLogin, RequestsPerSecond: 2, Burst: 1}}, false},
+		{"negative rate", []*systemv3.RateLimit{{Class: RateLimitClassDefault, RequestsPerSecond: -1, Burst: 1}}, false},
+		{"no burst", []*systemv3.RateLimit{{Class: RateLimitClassDefault, RequestsPerSecond: 1}}, false},
+	}
+	for _, tc := range tt {
+		err := validateRateLimits(tc.limits)
+		if (err == nil) != tc.valid {
+			t.Errorf("%s: expected valid %v, got error %v", tc.name, tc.valid, err)
+		}
+	}
+}
@@ -472,6 +472,9 @@ func (s *projectService) createGroupRoleRelations(ctx context.Context, db bun.ID
 	var ps []*authzv1.Policy
 	for _, pnr := range projectNamespaceRoles {
 		role := pnr.GetRole()
+		if pnr.GetClusterSelector() != "" {
+			return &systemv3.Project{}, fmt.Errorf("role '%v' with cluster selector can only be assigned to users and groups", role)
+		}
 		entity, err := dao.GetByName(ctx, db, role, &models.Role{})
 		if err != nil {
 			return &systemv3.Project{}, fmt.Errorf("unable to find role '%v'", role)
@@ -479,10 +482,12 @@ func (s *projectService) createGroupRoleRelations(ctx context.Context, db bun.ID
 		var roleId uuid.UUID
 		var scope string
 		var roleName string
+		var effect string
 		if rle, ok := entity.(*models.Role); ok {
 			roleId = rle.ID
 			scope = rle.Scope
 			roleName = rle.Name
+			effect = rle.Effect
 		} else {
 			return &systemv3.Project{}, fmt.Errorf("unable to find role '%v'", role)
 		}
@@ -525,6 +530,7 @@ func (s *projectService) createGroupRoleRelations(ctx context.Context, db bun.ID
 				Proj: project.Metadata.Name,
 				Org:  org,
 				Obj:  role,
+				Eft:  effect,
 			})
 		case "namespace":
 			if org == "" {
@@ -552,6 +558,7 @@ func (s *projectService) createGroupRoleRelations(ctx context.Context, db bun.ID
 				Proj: project.Metadata.Name,
 				Org:  org,
 				Obj:  role,
+				Eft:  effect,
 			})
 		default:
 			if err != nil {
@@ -661,6 +668,7 @@ func (s *projectService) createProjectAccountRelations(ctx context.Context, db b
 						Org:  project.Metadata.Organization,
 						Ns:   "*",
 						Obj:  role.Name,
+						Eft:  role.Effect,
 					})
 				case "namespace":
 					panrObj := models.ProjectAccountNamespaceRole{
@@ -683,6 +691,7 @@ func (s *projectService) createProjectAccountRelations(ctx context.Context, db b
 						Org:  project.Metadata.Organization,
 						Ns:   ur.GetNamespace(),
 						Obj:  role.Name,
+						Eft:  role.Effect,
 					})
 				default:
 					if err != nil {
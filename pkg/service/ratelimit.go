@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	systemv3 "github.com/paralus/paralus/proto/types/systempb/v3"
+	bun "github.com/uptrace/bun"
+)
+
+// classes of API methods which are rate limited separately
+const (
+	RateLimitClassDefault    = "default"
+	RateLimitClassKubeconfig = "kubeconfig"
+	RateLimitClassLogin      = "login"
+)
+
+// RateLimitService is the interface for the rate limits organizations
+// set for their users
+type RateLimitService interface {
+	// get the rate limits of the organization overriding the defaults
+	GetOrganizationLimits(context.Context, string) ([]*systemv3.RateLimit, error)
+}
+
+type rateLimitService struct {
+	db *bun.DB
+}
+
+// NewRateLimitService return new rate limit service
+func NewRateLimitService(db *bun.DB) RateLimitService {
+	return &rateLimitService{db: db}
+}
+
+func (s *rateLimitService) GetOrganizationLimits(ctx context.Context, orgID string) ([]*systemv3.RateLimit, error) {
+	org, err := getOrganization(ctx, s.db, orgID)
+	if err != nil {
+		return nil, err
+	}
+	settings, err := organizationSettings(org)
+	if err != nil {
+		return nil, err
+	}
+	return settings.GetRateLimits(), nil
+}
+
+// validateRateLimits checks that each known class of API methods is
+// limited at most once
+func validateRateLimits(limits []*systemv3.RateLimit) error {
+	seen := map[string]bool{}
+	for _, l := range limits {
+		switch l.GetClass() {
+		case RateLimitClassDefault, RateLimitClassKubeconfig, RateLimitClassLogin:
+		default:
+			return fmt.Errorf("unknown rate limit class %q", l.GetClass())
+		}
+		if seen[l.GetClass()] {
+			return fmt.Errorf("rate limit class %q is limited more than once", l.GetClass())
+		}
+		seen[l.GetClass()] = true
+		if l.GetRequestsPerSecond() < 0 || l.GetBurst() < 0 {
+			return fmt.Errorf("rate limit of class %q can not be negative", l.GetClass())
+		}
+		if l.GetRequestsPerSecond() > 0 && l.GetBurst() == 0 {
+			return fmt.Errorf("rate limit of class %q should allow a burst of at least one request", l.GetClass())
+		}
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+package service
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	cdao "github.com/paralus/paralus/internal/cluster/dao"
+	"github.com/paralus/paralus/internal/dao"
+	"github.com/paralus/paralus/internal/models"
+	infrav3 "github.com/paralus/paralus/proto/types/infrapb/v3"
+	"github.com/paralus/paralus/proto/types/sentry"
+	"github.com/spf13/viper"
+)
+
+const earthRadiusKm = 6371.0
+
+// relaySelectionLimit returns the number of relays closest to a cluster
+// its relay agent is configured with, all the relays are configured
+// when it is not set
+func relaySelectionLimit() int {
+	return viper.GetInt("RELAY_SELECTION_LIMIT")
+}
+
+// parseCoordinates parses the latitude and longitude of a location
+func parseCoordinates(latitude, longitude string) (float64, float64, bool) {
+	lat, err := strconv.ParseFloat(strings.TrimSpace(latitude), 64)
+	if err != nil || lat < -90 || lat > 90 {
+		return 0, 0, false
+	}
+	long, err := strconv.ParseFloat(strings.TrimSpace(longitude), 64)
+	if err != nil || long < -180 || long > 180 {
+		return 0, 0, false
+	}
+	return lat, long, true
+}
+
+// distanceKm returns the great-circle distance between two coordinates
+func distanceKm(lat1, long1, lat2, long2 float64) float64 {
+	rad := func(deg float64) float64 { return deg * math.Pi / 180 }
+	dlat := rad(lat2 - lat1)
+	dlong := rad(long2 - long1)
+	a := math.Sin(dlat/2)*math.Sin(dlat/2) +
+		math.Cos(rad(lat1))*math.Cos(rad(lat2))*math.Sin(dlong/2)*math.Sin(dlong/2)
+	return 2 * earthRadiusKm * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
+// orderRelayTemplates orders the relay templates by proximity to the
+// metro. Templates with coordinates come first by distance, then the
+// templates in the country of the metro and then the others in their
+// order. A positive limit keeps only the closest templates. Templates
+// are returned as is when the metro has no coordinates.
+func orderRelayTemplates(templates []*sentry.BootstrapAgentTemplate, metro *infrav3.Metro, limit int) []*sentry.BootstrapAgentTemplate {
+	lat, long, ok := parseCoordinates(metro.GetLatitude(), metro.GetLongitude())
+	if !ok {
+		return templates
+	}
+
+	type rankedTemplate struct {
+		template *sentry.BootstrapAgentTemplate
+		tier     int
+		distance float64
+	}
+	ranked := make([]rankedTemplate, 0, len(templates))
+	for _, t := range templates {
+		loc := t.GetSpec().GetLocation()
+		r := rankedTemplate{template: t, tier: 2}
+		if tlat, tlong, ok := parseCoordinates(loc.GetLatitude(), loc.GetLongitude()); ok {
+			r.tier = 0
+			r.distance = distanceKm(lat, long, tlat, tlong)
+		} else if loc.GetCountry() != "" && (strings.EqualFold(loc.GetCountry(), metro.Country) || strings.EqualFold(loc.GetCountry(), metro.CountryCode)) {
+			r.tier = 1
+		}
+		ranked = append(ranked, r)
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		if ranked[i].tier != ranked[j].tier {
+			return ranked[i].tier < ranked[j].tier
+		}
+		return ranked[i].distance < ranked[j].distance
+	})
+
+	if limit > 0 && len(ranked) > limit {
+		ranked = ranked[:limit]
+	}
+	ordered := make([]*sentry.BootstrapAgentTemplate, 0, len(ranked))
+	for _, r := range ranked {
+		ordered = append(ordered, r.template)
+	}
+	return ordered
+}
+
+// clusterMetro returns the metro of the cluster with its coordinates,
+// nil when the cluster has no metro
+func (s *clusterService) clusterMetro(ctx context.Context, cluster *infrav3.Cluster) *infrav3.Metro {
+	metro := cluster.GetSpec().GetMetro()
+	if _, _, ok := parseCoordinates(metro.GetLatitude(), metro.GetLongitude()); ok {
+		return metro
+	}
+
+	id, err := uuid.Parse(cluster.GetMetadata().GetId())
+	if err != nil {
+		return nil
+	}
+	c, err := cdao.GetCluster(ctx, s.db, &models.Cluster{ID: id})
+	if err != nil || c.MetroId == uuid.Nil {
+		return nil
+	}
+	entity, err := dao.GetByID(ctx, s.db, c.MetroId, &models.Metro{})
+	if err != nil {
+		_log.Infow("unable to fetch metro of cluster", "cluster", c.Name, "error", err)
+		return nil
+	}
+	m := entity.(*models.Metro)
+	return &infrav3.Metro{
+		Name:        m.Name,
+		City:        m.City,
+		State:       m.State,
+		Country:     m.Country,
+		Latitude:    m.Latitude,
+		Longitude:   m.Longitude,
+		CountryCode: m.CountryCode,
+		StateCode:   m.StateCode,
+	}
+}
+
+// selectRelayTemplates returns the relay templates the relay agent of
+// the cluster connects to, closest to the metro of the cluster first.
+// A positive limit keeps only the closest templates.
+func (s *clusterService) selectRelayTemplates(ctx context.Context, cluster *infrav3.Cluster, templates []*sentry.BootstrapAgentTemplate, limit int) []*sentry.BootstrapAgentTemplate {
+	if len(templates) < 2 {
+		return templates
+	}
+	return orderRelayTemplates(templates, s.clusterMetro(ctx, cluster), limit)
+}
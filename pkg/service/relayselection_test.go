@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	infrav3 "github.com/paralus/paralus/proto/types/infrapb/v3"
+	"github.com/paralus/paralus/proto/types/sentry"
+	"github.com/spf13/viper"
+)
+
+func relayTemplate(name string, location *sentry.BootstrapTemplateLocation) *sentry.BootstrapAgentTemplate {
+	return &sentry.BootstrapAgentTemplate{
+		Metadata: &commonv3.Metadata{Name: name},
+		Spec:     &sentry.BootstrapAgentTemplateSpec{Location: location},
+	}
+}
+
+func templateNames(templates []*sentry.BootstrapAgentTemplate) []string {
+	names := make([]string, 0, len(templates))
+	for _, t := range templates {
+		names = append(names, t.Metadata.Name)
+	}
+	return names
+}
+
+func TestDistanceKm(t *testing.T) {
+	// new york to london
+	d := distanceKm(40.7128, -74.0060, 51.5074, -0.1278)
+	if d < 5500 || d > 5600 {
+		t.Errorf("expected about 5570 km from new york to london, got %f", d)
+	}
+}
+
+func TestOrderRelayTemplates(t *testing.T) {
+	templates := []*sentry.BootstrapAgentTemplate{
+		relayTemplate("relay-default", nil),
+		relayTemplate("relay-sydney", &sentry.BootstrapTemplateLocation{Latitude: "-33.8688", Longitude: "151.2093"}),
+		relayTemplate("relay-germany", &sentry.BootstrapTemplateLocation{Country: "Germany"}),
+		relayTemplate("relay-london", &sentry.BootstrapTemplateLocation{Latitude: "51.5074", Longitude: "-0.1278"}),
+		relayTemplate("relay-virginia", &sentry.BootstrapTemplateLocation{Latitude: "38.9", Longitude: "-77.0"}),
+	}
+	frankfurt := &infrav3.Metro{Name: "fra", Latitude: "50.1109", Longitude: "8.6821", Country: "Germany"}
+
+	tt := []struct {
+		name     string
+		metro    *infrav3.Metro
+		limit    int
+		expected []string
+	}{
+		{"no metro", nil, 2, []string{"relay-default", "relay-sydney", "relay-germany", "relay-london", "relay-virginia"}},
+		{"metro without coordinates", &infrav3.Metro{Name: "fra"}, 0, []string{"relay-default", "relay-sydney", "relay-germany", "relay-london", "relay-virginia"}},
+		{"closest first", frankfurt, 0, []string{"relay-london", "relay-virginia", "relay-sydney", "relay-germany", "relay-default"}},
+		{"limit", frankfurt, 2, []string{"relay-london", "relay-virginia"}},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			names := templateNames(orderRelayTemplates(templates, tc.metro, tc.limit))
+			if len(names) != len(tc.expected) {
+				t.Fatalf("expected %v, got %v", tc.expected, names)
+			}
+			for i := range names {
+				if names[i] != tc.expected[i] {
+					t.Fatalf("expected %v, got %v", tc.expected, names)
+				}
+			}
+		})
+	}
+}
+
+func TestGetRelaysConfigForClusterSkipsMissingAgents(t *testing.T) {
+	cs, mock, closeDB := newHealthTestClusterService(t)
+	defer closeDB()
+	// templates are selected and counted concurrently
+	mock.MatchExpectationsInOrder(false)
+
+	viper.Set("RELAY_SELECTION_LIMIT", 1)
+	defer viper.Set("RELAY_SELECTION_LIMIT", 0)
+
+	templateColumns := []string{"name", "hosts", "token", "location"}
+	mock.ExpectQuery(`SELECT "bat"."name", .* FROM "sentry_bootstrap_agent_template" AS "bat"`).
+		WillReturnRows(sqlmock.NewRows(templateColumns).
+			AddRow("relay-london", `[{"host":"london.relay","type":"HostTypeExternal"}]`, "token-london", `{"latitude":"51.5074","longitude":"-0.1278"}`).
+			AddRow("relay-virginia", `[{"host":"virginia.relay","type":"HostTypeExternal"}]`, "token-virginia", `{"latitude":"38.9","longitude":"-77.0"}`))
+	mock.ExpectQuery(`SELECT count\(\*\) FROM "sentry_bootstrap_agent_template" AS "bat"`).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+
+	cuuid := uuid.New().String()
+	// the cluster has no agent for the closest relay, which was added
+	// after the agents of the cluster were created
+	mock.ExpectQuery(`SELECT "ba"."id", .* FROM "sentry_bootstrap_agent" AS "ba" WHERE .* AND .template_ref = 'relay-london'.`).
+		WillReturnError(sql.ErrNoRows)
+	mock.ExpectQuery(`SELECT "ba"."id", .* FROM "sentry_bootstrap_agent" AS "ba" WHERE .* AND .template_ref = 'relay-virginia'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "template_ref", "token"}).AddRow(uuid.New().String(), cuuid, "relay-virginia", "agent-token"))
+
+	cluster := &infrav3.Cluster{
+		Metadata: &commonv3.Metadata{Id: cuuid, Name: "c1"},
+		Spec:     &infrav3.ClusterSpec{Metro: &infrav3.Metro{Name: "fra", Latitude: "50.1109", Longitude: "8.6821"}},
+	}
+	relays, err := cs.GetRelaysConfigForCluster(context.Background(), cluster)
+	if err != nil {
+		t.Fatal("could not get relays of cluster:", err)
+	}
+	if len(relays) != 1 || relays[0].Name != "relay-virginia" || relays[0].Token != "agent-token" {
+		t.Errorf("expected the closest relay with an agent, got %+v", relays)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
@@ -4,16 +4,19 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	cdao "github.com/paralus/paralus/internal/cluster/dao"
 	"github.com/paralus/paralus/internal/dao"
 	"github.com/paralus/paralus/internal/models"
 	"github.com/paralus/paralus/pkg/utils"
 	authzv1 "github.com/paralus/paralus/proto/types/authz"
 	v3 "github.com/paralus/paralus/proto/types/commonpb/v3"
 	rolev3 "github.com/paralus/paralus/proto/types/rolepb/v3"
+	"github.com/paralus/paralus/proto/types/sentry"
 	bun "github.com/uptrace/bun"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -38,6 +41,10 @@ type RoleService interface {
 	Delete(context.Context, *rolev3.Role) (*rolev3.Role, error)
 	// list roles
 	List(context.Context, *rolev3.Role) (*rolev3.RoleList, error)
+	// get role with the permissions of included roles
+	GetEffectivePermissions(context.Context, *rolev3.Role) (*rolev3.Role, error)
+	// explain authorization decision
+	ExplainAuthorization(context.Context, *rolev3.AuthorizationExplainRequest) (*rolev3.AuthorizationExplanation, error)
 }
 
 // roleService implements RoleService
@@ -72,6 +79,10 @@ func (s *roleService) deleteRolePermissionMapping(ctx context.Context, db bun.ID
 	if err != nil {
 		return &rolev3.Role{}, err
 	}
+	err = dao.DeleteX(ctx, db, "resource_role_id", rleId, &models.ResourceRoleInclude{})
+	if err != nil {
+		return &rolev3.Role{}, err
+	}
 
 	drpm := authzv1.FilteredRolePermissionMapping{Role: role.GetMetadata().GetName()}
 	success, err := s.azc.DeleteRolePermissionMappings(ctx, &drpm)
@@ -85,7 +96,7 @@ func (s *roleService) deleteRolePermissionMapping(ctx context.Context, db bun.ID
 	return role, nil
 }
 
-func (s *roleService) createRolePermissionMapping(ctx context.Context, db bun.IDB, role *rolev3.Role, ids parsedIds) (*rolev3.Role, error) {
+func (s *roleService) createRolePermissionMapping(ctx context.Context, db bun.IDB, role *rolev3.Role, ids parsedIds, includes []models.Role) (*rolev3.Role, error) {
 	perms := role.GetSpec().GetRolepermissions()
 
 	var items []models.ResourceRolePermission
@@ -108,11 +119,34 @@ func (s *roleService) createRolePermissionMapping(ctx context.Context, db bun.ID
 		if err != nil {
 			return role, err
 		}
+	}
+
+	var incs []models.ResourceRoleInclude
+	for _, inc := range includes {
+		incs = append(incs, models.ResourceRoleInclude{
+			CreatedAt:      time.Now(),
+			ModifiedAt:     time.Now(),
+			ResourceRoleId: ids.Id,
+			IncludedRoleId: inc.ID,
+		})
+	}
+	if len(incs) > 0 {
+		_, err := dao.Create(ctx, db, &incs)
+		if err != nil {
+			return role, err
+		}
+		// casbin mappings are made for the flattened permissions
+		perms, err = getRoleEffectivePermissions(ctx, db, ids.Id)
+		if err != nil {
+			return role, err
+		}
+	}
 
+	if len(perms) > 0 {
 		crpm := authzv1.RolePermissionMappingList{
 			RolePermissionMappingList: []*authzv1.RolePermissionMapping{{
 				Role:       role.GetMetadata().GetName(),
-				Permission: role.Spec.Rolepermissions,
+				Permission: perms,
 			}},
 		}
 		success, err := s.azc.CreateRolePermissionMappings(ctx, &crpm)
@@ -123,6 +157,126 @@ func (s *roleService) createRolePermissionMapping(ctx context.Context, db bun.ID
 	return role, nil
 }
 
+// getIncludedRoles looks up the roles to be included in the role and
+// returns them along with their effective permissions. Included roles
+// should have the same effect and must not include the role itself.
+func (s *roleService) getIncludedRoles(ctx context.Context, db bun.IDB, role *rolev3.Role, partnerId, organizationId, roleId uuid.UUID, effect string) ([]models.Role, []string, error) {
+	var includes []models.Role
+	var perms []string
+	for _, name := range utils.Unique(role.GetSpec().GetIncludedRoles()) {
+		entity, err := dao.GetByNamePartnerOrg(ctx, db, name, uuid.NullUUID{UUID: partnerId, Valid: true}, uuid.NullUUID{UUID: organizationId, Valid: true}, &models.Role{})
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to find included role '%v'", name)
+		}
+		inc, ok := entity.(*models.Role)
+		if !ok {
+			return nil, nil, fmt.Errorf("unable to find included role '%v'", name)
+		}
+		if getRoleEffect(inc.Effect) != effect {
+			return nil, nil, fmt.Errorf("included role '%v' should have the same effect as role '%v'", name, role.GetMetadata().GetName())
+		}
+		if roleId != uuid.Nil {
+			cycle, err := isRoleIncludedBy(ctx, db, roleId, inc.ID)
+			if err != nil {
+				return nil, nil, err
+			}
+			if cycle {
+				return nil, nil, fmt.Errorf("including role '%v' in '%v' creates a cycle", name, role.GetMetadata().GetName())
+			}
+		}
+		ps, err := getRoleEffectivePermissions(ctx, db, inc.ID)
+		if err != nil {
+			return nil, nil, err
+		}
+		includes = append(includes, *inc)
+		perms = append(perms, ps...)
+	}
+	return includes, perms, nil
+}
+
+// isRoleIncludedBy checks if the role is reachable from the other
+// role through included roles, the other role itself included
+func isRoleIncludedBy(ctx context.Context, db bun.IDB, roleId, by uuid.UUID) (bool, error) {
+	seen := map[uuid.UUID]bool{}
+	queue := []uuid.UUID{by}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		if id == roleId {
+			return true, nil
+		}
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		incs, err := dao.GetIncludedRoles(ctx, db, id)
+		if err != nil {
+			return false, err
+		}
+		for _, inc := range incs {
+			queue = append(queue, inc.ID)
+		}
+	}
+	return false, nil
+}
+
+func getRoleEffectivePermissions(ctx context.Context, db bun.IDB, id uuid.UUID) ([]string, error) {
+	entities, err := dao.GetRoleEffectivePermissions(ctx, db, id)
+	if err != nil {
+		return nil, err
+	}
+	permissions := []string{}
+	for _, p := range entities {
+		permissions = append(permissions, p.Name)
+	}
+	return permissions, nil
+}
+
+// refreshIncludingRoleMappings recreates the casbin mappings of the
+// roles which include the role, directly or not, so that changes of a
+// base role propagate to the composite roles
+func (s *roleService) refreshIncludingRoleMappings(ctx context.Context, db bun.IDB, id uuid.UUID) error {
+	seen := map[uuid.UUID]bool{id: true}
+	queue := []uuid.UUID{id}
+	for len(queue) > 0 {
+		rles, err := dao.GetIncludingRoles(ctx, db, queue[0])
+		if err != nil {
+			return err
+		}
+		queue = queue[1:]
+		for _, rle := range rles {
+			if seen[rle.ID] {
+				continue
+			}
+			seen[rle.ID] = true
+			queue = append(queue, rle.ID)
+
+			perms, err := getRoleEffectivePermissions(ctx, db, rle.ID)
+			if err != nil {
+				return err
+			}
+			_, err = s.azc.DeleteRolePermissionMappings(ctx, &authzv1.FilteredRolePermissionMapping{Role: rle.Name})
+			if err != nil {
+				return fmt.Errorf("unable to delete mapping from authz; %v", err)
+			}
+			if len(perms) == 0 {
+				continue
+			}
+			crpm := authzv1.RolePermissionMappingList{
+				RolePermissionMappingList: []*authzv1.RolePermissionMapping{{
+					Role:       rle.Name,
+					Permission: perms,
+				}},
+			}
+			success, err := s.azc.CreateRolePermissionMappings(ctx, &crpm)
+			if err != nil || !success.Res {
+				return fmt.Errorf("unable to create mapping in authz; %v", err)
+			}
+		}
+	}
+	return nil
+}
+
 func (s *roleService) Create(ctx context.Context, role *rolev3.Role) (*rolev3.Role, error) {
 	partnerId, organizationId, err := s.getPartnerOrganization(ctx, s.db, role)
 	if err != nil {
@@ -138,19 +292,36 @@ func (s *roleService) Create(ctx context.Context, role *rolev3.Role) (*rolev3.Ro
 		return nil, fmt.Errorf("unknown scope '%v'", scope)
 	}
 
-	//validate namespaced permissions for dynamic role of scope namespace, either one of the permissions should be present as part of namespaced roles
-	if scope == "namespace" {
-		if !utils.Contains(role.Spec.Rolepermissions, namespaceR) && !utils.Contains(role.Spec.Rolepermissions, namespaceW) {
-			return nil, fmt.Errorf("insufficient permissions, either '%v' / '%v' should be present ", namespaceR, namespaceW)
-		}
+	effect := getRoleEffect(role.GetSpec().GetEffect())
+	if effect != allowEffect && effect != denyEffect {
+		return nil, fmt.Errorf("unknown effect '%v'", role.GetSpec().GetEffect())
 	}
 
-	//validate basic mandatory permissions that should be part of all custom roles
-	if len(role.Spec.Rolepermissions) > 0 &&
-		!utils.Contains(role.Spec.Rolepermissions, opsAll) &&
-		(!utils.Contains(role.Spec.Rolepermissions, partnerR) ||
-			!utils.Contains(role.Spec.Rolepermissions, organizationR)) {
-		return nil, fmt.Errorf("invalid role permissions, '%v', '%v' should be present ", partnerR, organizationR)
+	includes, inherited, err := s.getIncludedRoles(ctx, s.db, role, partnerId, organizationId, uuid.Nil, effect)
+	if err != nil {
+		return nil, err
+	}
+	perms := append(inherited, role.GetSpec().GetRolepermissions()...)
+
+	// deny roles only carry the permissions to be denied, so the
+	// mandatory permissions for allow roles do not apply to them
+	if effect == allowEffect {
+		//validate namespaced permissions for dynamic role of scope namespace, either one of the permissions should be present as part of namespaced roles
+		if scope == "namespace" {
+			if !utils.Contains(perms, namespaceR) && !utils.Contains(perms, namespaceW) {
+				return nil, fmt.Errorf("insufficient permissions, either '%v' / '%v' should be present ", namespaceR, namespaceW)
+			}
+		}
+
+		//validate basic mandatory permissions that should be part of all custom roles
+		if len(perms) > 0 &&
+			!utils.Contains(perms, opsAll) &&
+			(!utils.Contains(perms, partnerR) ||
+				!utils.Contains(perms, organizationR)) {
+			return nil, fmt.Errorf("invalid role permissions, '%v', '%v' should be present ", partnerR, organizationR)
+		}
+	} else if len(perms) == 0 {
+		return nil, fmt.Errorf("deny role '%v' should have at least one permission", role.GetMetadata().GetName())
 	}
 
 	// Only allow internal call (eg: initialize) to set builtin flag
@@ -171,7 +342,9 @@ func (s *roleService) Create(ctx context.Context, role *rolev3.Role) (*rolev3.Ro
 		IsGlobal:       role.GetSpec().GetIsGlobal(),
 		Builtin:        builtin,
 		Scope:          strings.ToLower(scope),
+		Effect:         effect,
 	}
+	role.Spec.Effect = effect
 
 	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{})
 	if err != nil {
@@ -186,7 +359,7 @@ func (s *roleService) Create(ctx context.Context, role *rolev3.Role) (*rolev3.Ro
 
 	//update v3 spec
 	if createdRole, ok := entity.(*models.Role); ok {
-		role, err = s.createRolePermissionMapping(ctx, tx, role, parsedIds{Id: createdRole.ID, Partner: partnerId, Organization: organizationId})
+		role, err = s.createRolePermissionMapping(ctx, tx, role, parsedIds{Id: createdRole.ID, Partner: partnerId, Organization: organizationId}, includes)
 		if err != nil {
 			tx.Rollback()
 			return &rolev3.Role{}, err
@@ -265,29 +438,47 @@ func (s *roleService) Update(ctx context.Context, role *rolev3.Role) (*rolev3.Ro
 		return role, fmt.Errorf("unable to find role '%v'", name)
 	}
 
-	//validate namespaced permissions for dynamic role of scope namespace, either one of the permissions should be present as part of namespaced roles
-	if role.GetSpec().GetScope() == "namespace" {
-		if !utils.Contains(role.Spec.Rolepermissions, namespaceR) && !utils.Contains(role.Spec.Rolepermissions, namespaceW) {
-			return nil, fmt.Errorf("insufficient permissions, either '%v' / '%v' should be present ", namespaceR, namespaceW)
-		}
-	}
-
-	//validate basic mandatory permissions that should be part of all custom roles
-	if !utils.Contains(role.Spec.Rolepermissions, opsAll) &&
-		(!utils.Contains(role.Spec.Rolepermissions, partnerR) ||
-			!utils.Contains(role.Spec.Rolepermissions, organizationR)) {
-		return nil, fmt.Errorf("invalid role permissions, '%v', '%v' should be present ", partnerR, organizationR)
-	}
-
 	if rle, ok := entity.(*models.Role); ok {
 		if rle.Builtin {
 			return role, fmt.Errorf("builtin role '%v' cannot be updated", name)
 		}
+		// policies binding the role carry its effect, hence it
+		// cannot be changed once the role is created
+		effect := getRoleEffect(rle.Effect)
+		if role.GetSpec().GetEffect() != "" && getRoleEffect(role.GetSpec().GetEffect()) != effect {
+			return role, fmt.Errorf("effect of role '%v' cannot be changed", name)
+		}
+
+		includes, inherited, err := s.getIncludedRoles(ctx, s.db, role, partnerId, organizationId, rle.ID, effect)
+		if err != nil {
+			return role, err
+		}
+		perms := append(inherited, role.GetSpec().GetRolepermissions()...)
+
+		if effect == allowEffect {
+			//validate namespaced permissions for dynamic role of scope namespace, either one of the permissions should be present as part of namespaced roles
+			if role.GetSpec().GetScope() == "namespace" {
+				if !utils.Contains(perms, namespaceR) && !utils.Contains(perms, namespaceW) {
+					return nil, fmt.Errorf("insufficient permissions, either '%v' / '%v' should be present ", namespaceR, namespaceW)
+				}
+			}
+
+			//validate basic mandatory permissions that should be part of all custom roles
+			if !utils.Contains(perms, opsAll) &&
+				(!utils.Contains(perms, partnerR) ||
+					!utils.Contains(perms, organizationR)) {
+				return nil, fmt.Errorf("invalid role permissions, '%v', '%v' should be present ", partnerR, organizationR)
+			}
+		} else if len(perms) == 0 {
+			return nil, fmt.Errorf("deny role '%v' should have at least one permission", name)
+		}
+
 		//update role details
 		rle.Name = role.Metadata.Name
 		rle.Description = role.Metadata.Description
 		rle.Scope = role.Spec.Scope
 		rle.IsGlobal = role.Spec.IsGlobal
+		rle.Effect = effect
 		rle.ModifiedAt = time.Now()
 
 		tx, err := s.db.BeginTx(ctx, &sql.TxOptions{})
@@ -307,7 +498,13 @@ func (s *roleService) Update(ctx context.Context, role *rolev3.Role) (*rolev3.Ro
 			return &rolev3.Role{}, err
 		}
 
-		role, err = s.createRolePermissionMapping(ctx, tx, role, parsedIds{Id: rle.ID, Partner: partnerId, Organization: organizationId})
+		role, err = s.createRolePermissionMapping(ctx, tx, role, parsedIds{Id: rle.ID, Partner: partnerId, Organization: organizationId}, includes)
+		if err != nil {
+			tx.Rollback()
+			return &rolev3.Role{}, err
+		}
+
+		err = s.refreshIncludingRoleMappings(ctx, tx, rle.ID)
 		if err != nil {
 			tx.Rollback()
 			return &rolev3.Role{}, err
@@ -315,8 +512,10 @@ func (s *roleService) Update(ctx context.Context, role *rolev3.Role) (*rolev3.Ro
 
 		//update spec and status
 		role.Spec = &rolev3.RoleSpec{
-			IsGlobal: rle.IsGlobal,
-			Scope:    rle.Scope,
+			IsGlobal:      rle.IsGlobal,
+			Scope:         rle.Scope,
+			Effect:        effect,
+			IncludedRoles: role.GetSpec().GetIncludedRoles(),
 		}
 
 		err = tx.Commit()
@@ -347,6 +546,13 @@ func (s *roleService) Delete(ctx context.Context, role *rolev3.Role) (*rolev3.Ro
 		if rle.Builtin {
 			return role, fmt.Errorf("builtin role '%v' cannot be deleted", name)
 		}
+		including, err := dao.GetIncludingRoles(ctx, s.db, rle.ID)
+		if err != nil {
+			return &rolev3.Role{}, err
+		}
+		if len(including) > 0 {
+			return role, fmt.Errorf("role '%v' is included by role '%v'", name, including[0].Name)
+		}
 
 		tx, err := s.db.BeginTx(ctx, &sql.TxOptions{})
 		if err != nil {
@@ -402,15 +608,60 @@ func (s *roleService) toV3Role(ctx context.Context, db bun.IDB, role *rolev3.Rol
 		permissions = append(permissions, p.Name)
 	}
 
+	includes, err := dao.GetIncludedRoles(ctx, db, rle.ID)
+	if err != nil {
+		return role, err
+	}
+	includedRoles := []string{}
+	for _, inc := range includes {
+		includedRoles = append(includedRoles, inc.Name)
+	}
+
 	role.Spec = &rolev3.RoleSpec{
 		IsGlobal:        rle.IsGlobal,
 		Scope:           rle.Scope,
 		Rolepermissions: permissions,
 		Builtin:         rle.Builtin,
+		Effect:          getRoleEffect(rle.Effect),
+		IncludedRoles:   includedRoles,
 	}
 	return role, nil
 }
 
+func (s *roleService) GetEffectivePermissions(ctx context.Context, role *rolev3.Role) (*rolev3.Role, error) {
+	name := role.GetMetadata().GetName()
+	partnerId, organizationId, err := s.getPartnerOrganization(ctx, s.db, role)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get partner and org id")
+	}
+	entity, err := dao.GetByNamePartnerOrg(ctx, s.db, name, uuid.NullUUID{UUID: partnerId, Valid: true}, uuid.NullUUID{UUID: organizationId, Valid: true}, &models.Role{})
+	if err != nil {
+		return &rolev3.Role{}, err
+	}
+	rle, ok := entity.(*models.Role)
+	if !ok {
+		return nil, fmt.Errorf("unable to find role")
+	}
+	role, err = s.toV3Role(ctx, s.db, role, rle)
+	if err != nil {
+		return &rolev3.Role{}, err
+	}
+	role.Spec.EffectivePermissions, err = getRoleEffectivePermissions(ctx, s.db, rle.ID)
+	if err != nil {
+		return &rolev3.Role{}, err
+	}
+	return role, nil
+}
+
+// getRoleEffect returns the normalized effect of a role, roles
+// without an explicit effect are allow roles.
+func getRoleEffect(effect string) string {
+	if effect == "" {
+		return allowEffect
+	}
+	return strings.ToLower(effect)
+}
+
 func (s *roleService) List(ctx context.Context, role *rolev3.Role) (*rolev3.RoleList, error) {
 	var roles []*rolev3.Role
 	roleList := &rolev3.RoleList{
@@ -456,3 +707,217 @@ func (s *roleService) List(ctx context.Context, role *rolev3.Role) (*rolev3.Role
 	}
 	return roleList, nil
 }
+
+// ExplainAuthorization explains why a request of a user or group is
+// allowed or denied. When a proposed role is given, it is evaluated in
+// place of the stored role without persisting anything.
+func (s *roleService) ExplainAuthorization(ctx context.Context, req *rolev3.AuthorizationExplainRequest) (*rolev3.AuthorizationExplanation, error) {
+	spec := req.GetSpec()
+	if (spec.GetUser() == "") == (spec.GetGroup() == "") {
+		return nil, fmt.Errorf("either user or group should be specified")
+	}
+	if spec.GetUrl() == "" || spec.GetMethod() == "" {
+		return nil, fmt.Errorf("url and method should be specified")
+	}
+	partnerId, organizationId, err := getPartnerOrganization(ctx, s.db, req.GetMetadata().GetPartner(), req.GetMetadata().GetOrganization())
+	if err != nil {
+		return nil, fmt.Errorf("unable to get partner and org id")
+	}
+
+	// same params as authContext.authorize
+	sub := "u:" + spec.GetUser()
+	if spec.GetGroup() != "" {
+		sub = "g:" + spec.GetGroup()
+	}
+	proj := spec.GetProject()
+	if proj == "" {
+		proj = "*"
+	}
+	er := &authzv1.ExplainRequest{
+		Params: []string{sub, "*", proj, req.GetMetadata().GetOrganization(), spec.GetUrl(), spec.GetMethod()},
+	}
+
+	if role := spec.GetProposedRole(); role != nil {
+		name := role.GetMetadata().GetName()
+		entity, err := dao.GetByNamePartnerOrg(ctx, s.db, name, uuid.NullUUID{UUID: partnerId, Valid: true}, uuid.NullUUID{UUID: organizationId, Valid: true}, &models.Role{})
+		if err != nil {
+			return nil, fmt.Errorf("unable to find role '%v'", name)
+		}
+		// the proposed role keeps the effect of the role unless given
+		effect := getRoleEffect(role.GetSpec().GetEffect())
+		if rle, ok := entity.(*models.Role); ok && role.GetSpec().GetEffect() == "" {
+			effect = getRoleEffect(rle.Effect)
+		}
+		if effect != allowEffect && effect != denyEffect {
+			return nil, fmt.Errorf("unknown effect '%v'", role.GetSpec().GetEffect())
+		}
+		er.ProposedRole = name
+		er.ProposedEffect = effect
+		er.ProposedPermissions = role.GetSpec().GetRolepermissions()
+	}
+
+	reply, err := s.azc.Explain(ctx, er)
+	if err != nil {
+		return nil, err
+	}
+
+	explanation := &rolev3.AuthorizationExplanation{
+		Allowed: reply.GetRes(),
+		Groups:  reply.GetGroups(),
+		WhatIf:  er.ProposedRole != "",
+		Reason:  "no role bound to the subject grants the action",
+	}
+	var allowedBy, deniedBy *authzv1.Policy
+	for _, p := range reply.GetMatched() {
+		explanation.Policies = append(explanation.Policies, &rolev3.ExplainedPolicy{
+			Subject:      p.GetSub(),
+			Namespace:    p.GetNs(),
+			Project:      p.GetProj(),
+			Organization: p.GetOrg(),
+			Role:         p.GetObj(),
+			Effect:       p.GetEft(),
+		})
+		if p.GetEft() == denyEffect && deniedBy == nil {
+			deniedBy = p
+		} else if p.GetEft() == allowEffect && allowedBy == nil {
+			allowedBy = p
+		}
+	}
+	for _, r := range reply.GetRoles() {
+		explanation.RolePermissions = append(explanation.RolePermissions, &rolev3.ExplainedRolePermission{
+			Role:   r.GetRole(),
+			Url:    r.GetUrl(),
+			Method: r.GetMethod(),
+		})
+	}
+	switch {
+	case deniedBy != nil:
+		explanation.Reason = fmt.Sprintf("denied by role '%s' bound to '%s'", deniedBy.GetObj(), deniedBy.GetSub())
+	case allowedBy != nil:
+		explanation.Reason = fmt.Sprintf("allowed by role '%s' bound to '%s'", allowedBy.GetObj(), allowedBy.GetSub())
+	}
+
+	if spec.GetCluster() != "" {
+		explanation.KubectlPermissions, err = s.getKubectlPermissions(ctx, spec, partnerId, organizationId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return explanation, nil
+}
+
+// getKubectlPermissions returns the kubectl permissions of the user or
+// group in the projects of the cluster, looked up the same way as when
+// authorizing kubectl access to the cluster.
+func (s *roleService) getKubectlPermissions(ctx context.Context, spec *rolev3.AuthorizationExplainSpec, partnerId, organizationId uuid.UUID) ([]*rolev3.KubectlProjectPermissions, error) {
+	entity, err := dao.GetByNamePartnerOrg(ctx, s.db, spec.GetCluster(), uuid.NullUUID{UUID: partnerId, Valid: true}, uuid.NullUUID{UUID: organizationId, Valid: true}, &models.Cluster{})
+	if err != nil {
+		return nil, fmt.Errorf("unable to find cluster '%v'", spec.GetCluster())
+	}
+	cluster, ok := entity.(*models.Cluster)
+	if !ok {
+		return nil, fmt.Errorf("unable to find cluster '%v'", spec.GetCluster())
+	}
+	pcs, err := cdao.GetProjectsForCluster(ctx, s.db, cluster.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// project id to name, empty for organization wide permissions
+	projects := map[string]string{"": ""}
+	for _, pc := range pcs {
+		name, err := dao.GetProjectName(ctx, s.db, pc.ProjectID)
+		if err != nil {
+			return nil, err
+		}
+		projects[pc.ProjectID.String()] = name
+	}
+
+	kubectlPermissions := []string{
+		sentry.KubectlFullAccessPermission,
+		sentry.KubectlNamespaceReadPermission,
+		sentry.KubectlNamespaceWritePermission,
+		sentry.KubectlClusterReadPermission,
+		sentry.KubectlClusterWritePermission,
+	}
+	permissions := make(map[string][]string)
+	add := func(projectId, permission string) {
+		name, ok := projects[projectId]
+		if !ok || !utils.Contains(kubectlPermissions, permission) || utils.Contains(permissions[name], permission) {
+			return
+		}
+		permissions[name] = append(permissions[name], permission)
+	}
+
+	groups := []string{}
+	if spec.GetGroup() != "" {
+		entity, err := dao.GetByNamePartnerOrg(ctx, s.db, spec.GetGroup(), uuid.NullUUID{UUID: partnerId, Valid: true}, uuid.NullUUID{UUID: organizationId, Valid: true}, &models.Group{})
+		if err != nil {
+			return nil, fmt.Errorf("unable to find group '%v'", spec.GetGroup())
+		}
+		grp, ok := entity.(*models.Group)
+		if !ok {
+			return nil, fmt.Errorf("unable to find group '%v'", spec.GetGroup())
+		}
+		// permissions of groups the group is nested in apply as well
+		grps, err := dao.WithParentGroups(ctx, s.db, []models.Group{*grp})
+		if err != nil {
+			return nil, err
+		}
+		for _, g := range grps {
+			groups = append(groups, g.Name)
+		}
+	} else {
+		entity, err := dao.GetUserIdByEmail(ctx, s.db, spec.GetUser(), &models.KratosIdentities{})
+		if err != nil {
+			return nil, fmt.Errorf("unable to find user '%v'", spec.GetUser())
+		}
+		acc, ok := entity.(*models.KratosIdentities)
+		if !ok {
+			return nil, fmt.Errorf("unable to find user '%v'", spec.GetUser())
+		}
+		sso, err := dao.IsSSOAccount(ctx, s.db, acc.ID)
+		if err != nil {
+			return nil, err
+		}
+		// sso users only get permissions through their groups
+		if !sso {
+			aps, err := dao.GetAccountPermissions(ctx, s.db, acc.ID, organizationId, partnerId)
+			if err != nil {
+				return nil, err
+			}
+			for _, ap := range aps {
+				projectId := ""
+				if ap.ProjectId != uuid.Nil {
+					projectId = ap.ProjectId.String()
+				}
+				add(projectId, ap.PermissionName)
+			}
+		} else {
+			gas, err := dao.GetEffectiveGroups(ctx, s.db, acc.ID)
+			if err != nil {
+				return nil, err
+			}
+			for _, ga := range gas {
+				groups = append(groups, ga.Name)
+			}
+		}
+	}
+	if len(groups) > 0 {
+		gps, err := dao.GetGroupPermissions(ctx, s.db, groups, organizationId, partnerId)
+		if err != nil {
+			return nil, err
+		}
+		for _, gp := range gps {
+			add(gp.ProjecttId, gp.PermissionName)
+		}
+	}
+
+	result := []*rolev3.KubectlProjectPermissions{}
+	for project, perms := range permissions {
+		result = append(result, &rolev3.KubectlProjectPermissions{Project: project, Permissions: perms})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Project < result[j].Project })
+	return result, nil
+}
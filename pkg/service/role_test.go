@@ -187,6 +187,65 @@ func TestCreateRoleWithPermissions(t *testing.T) {
 
 }
 
+func TestCreateDenyRole(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	mazc := mockAuthzClient{}
+	rs := NewRoleService(db, &mazc, getLogger())
+
+	ruuid := uuid.New().String()
+
+	puuid, ouuid := addParterOrgFetchExpectation(mock)
+	mock.ExpectQuery(`SELECT "resourcerole"."id" FROM "authsrv_resourcerole" AS "resourcerole" WHERE .organization_id = '` + ouuid + `'. AND .partner_id = '` + puuid + `'. AND .name = 'role-` + ruuid + `'.`).
+		WillReturnError(fmt.Errorf("no data available"))
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO "authsrv_resourcerole".* 'organization', 'deny'`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(ruuid))
+	mock.ExpectQuery(`SELECT "resourcepermission"."id" FROM "authsrv_resourcepermission" AS "resourcepermission" WHERE .name = 'organization.write'.`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uuid.New().String()))
+	mock.ExpectQuery(`INSERT INTO "authsrv_resourcerolepermission"`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uuid.New().String()))
+	mock.ExpectCommit()
+
+	role := &rolev3.Role{
+		Metadata: &v3.Metadata{Partner: "partner-" + puuid, Organization: "org-" + ouuid, Name: "role-" + ruuid},
+		Spec:     &rolev3.RoleSpec{IsGlobal: true, Scope: "organization", Effect: "deny", Rolepermissions: []string{"organization.write"}},
+	}
+	role, err := rs.Create(context.Background(), role)
+	if err != nil {
+		t.Fatal("could not create role:", err)
+	}
+	performRoleBasicChecks(t, role, ruuid)
+	if role.GetSpec().GetEffect() != "deny" {
+		t.Errorf("incorrect effect; expected 'deny', got '%v'", role.GetSpec().GetEffect())
+	}
+}
+
+func TestCreateRoleUnknownEffect(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	mazc := mockAuthzClient{}
+	rs := NewRoleService(db, &mazc, getLogger())
+
+	ruuid := uuid.New().String()
+
+	puuid, ouuid := addParterOrgFetchExpectation(mock)
+	mock.ExpectQuery(`SELECT "resourcerole"."id" FROM "authsrv_resourcerole" AS "resourcerole" WHERE .organization_id = '` + ouuid + `'. AND .partner_id = '` + puuid + `'. AND .name = 'role-` + ruuid + `'.`).
+		WillReturnError(fmt.Errorf("no data available"))
+
+	role := &rolev3.Role{
+		Metadata: &v3.Metadata{Partner: "partner-" + puuid, Organization: "org-" + ouuid, Name: "role-" + ruuid},
+		Spec:     &rolev3.RoleSpec{IsGlobal: true, Scope: "organization", Effect: "maybe", Rolepermissions: []string{"organization.write"}},
+	}
+	_, err := rs.Create(context.Background(), role)
+	if err == nil {
+		t.Fatal("should not be able to create role with unknown effect")
+	}
+}
+
 func TestCreateRoleDuplicate(t *testing.T) {
 	db, mock := getDB(t)
 	defer db.Close()
@@ -230,10 +289,12 @@ func TestUpdateRole(t *testing.T) {
 		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id", "name", "organization_id", "partner_id"}).AddRow(ruuid, "role-"+ruuid, ouuid, puuid))
 
 	mock.ExpectBegin()
-	mock.ExpectExec(`UPDATE "authsrv_resourcerole" AS "resourcerole" SET "name" = 'role-` + ruuid + `', .*"organization_id" = '` + ouuid + `', "partner_id" = '` + puuid + `', "is_global" = TRUE, "builtin" = FALSE, "scope" = 'system' WHERE .id  = '` + ruuid + `'.`).
+	mock.ExpectExec(`UPDATE "authsrv_resourcerole" AS "resourcerole" SET "name" = 'role-` + ruuid + `', .*"organization_id" = '` + ouuid + `', "partner_id" = '` + puuid + `', "is_global" = TRUE, "builtin" = FALSE, "scope" = 'system', "effect" = 'allow' WHERE .id  = '` + ruuid + `'.`).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectExec(`UPDATE "authsrv_resourcerolepermission" AS "resourcerolepermission" SET trash = TRUE WHERE ."resource_role_id" = '` + ruuid + `'.`).
 		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(`UPDATE "authsrv_resourceroleinclude" AS "resourceroleinclude" SET trash = TRUE WHERE ."resource_role_id" = '` + ruuid + `'.`).
+		WillReturnResult(sqlmock.NewResult(1, 1))
 
 	mock.ExpectQuery(`SELECT "resourcepermission"."id" FROM "authsrv_resourcepermission" AS "resourcepermission" WHERE .name = 'partner.read'.`).
 		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("partner.read"))
@@ -241,6 +302,8 @@ func TestUpdateRole(t *testing.T) {
 		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("organization.read"))
 	mock.ExpectQuery(`INSERT INTO "authsrv_resourcerolepermission"`).
 		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(ruuid))
+	mock.ExpectQuery(`SELECT "resourcerole"."id", .* FROM "authsrv_resourcerole" AS "resourcerole" JOIN authsrv_resourceroleinclude AS ri ON ri.resource_role_id = "resourcerole".id WHERE .ri.included_role_id = '` + ruuid + `'.`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id", "name"}))
 	mock.ExpectCommit()
 
 	role := &rolev3.Role{
@@ -254,6 +317,52 @@ func TestUpdateRole(t *testing.T) {
 	performRoleBasicChecks(t, role, ruuid)
 }
 
+func TestUpdateDenyRoleWithoutEffect(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	mazc := mockAuthzClient{}
+	rs := NewRoleService(db, &mazc, getLogger())
+
+	ruuid := uuid.New().String()
+
+	puuid, ouuid := addParterOrgFetchExpectation(mock)
+	mock.ExpectQuery(`SELECT "resourcerole"."id", "resourcerole"."name", .*FROM "authsrv_resourcerole" AS "resourcerole" WHERE .organization_id = '` + ouuid + `'. AND .partner_id = '` + puuid + `'. AND .name = 'role-` + ruuid + `'.`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id", "name", "organization_id", "partner_id", "effect"}).AddRow(ruuid, "role-"+ruuid, ouuid, puuid, "deny"))
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE "authsrv_resourcerole" AS "resourcerole" SET "name" = 'role-` + ruuid + `', .*"scope" = 'organization', "effect" = 'deny' WHERE .id  = '` + ruuid + `'.`).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(`UPDATE "authsrv_resourcerolepermission" AS "resourcerolepermission" SET trash = TRUE WHERE ."resource_role_id" = '` + ruuid + `'.`).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(`UPDATE "authsrv_resourceroleinclude" AS "resourceroleinclude" SET trash = TRUE WHERE ."resource_role_id" = '` + ruuid + `'.`).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery(`SELECT "resourcepermission"."id" FROM "authsrv_resourcepermission" AS "resourcepermission" WHERE .name = 'organization.write'.`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uuid.New().String()))
+	mock.ExpectQuery(`INSERT INTO "authsrv_resourcerolepermission"`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uuid.New().String()))
+	mock.ExpectQuery(`SELECT "resourcerole"."id", .* FROM "authsrv_resourcerole" AS "resourcerole" JOIN authsrv_resourceroleinclude AS ri ON ri.resource_role_id = "resourcerole".id WHERE .ri.included_role_id = '` + ruuid + `'.`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id", "name"}))
+	mock.ExpectCommit()
+
+	// the effect is left out, the role stays a deny role
+	role := &rolev3.Role{
+		Metadata: &v3.Metadata{Partner: "partner-" + puuid, Organization: "org-" + ouuid, Name: "role-" + ruuid},
+		Spec:     &rolev3.RoleSpec{IsGlobal: true, Scope: "organization", Rolepermissions: []string{"organization.write"}},
+	}
+	role, err := rs.Update(context.Background(), role)
+	if err != nil {
+		t.Fatal("could not update role:", err)
+	}
+	performRoleBasicChecks(t, role, ruuid)
+	if role.GetSpec().GetEffect() != "deny" {
+		t.Errorf("incorrect effect; expected 'deny', got '%v'", role.GetSpec().GetEffect())
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
 func TestUpdateRoleBuiltin(t *testing.T) {
 	db, mock := getDB(t)
 	defer db.Close()
@@ -276,6 +385,28 @@ func TestUpdateRoleBuiltin(t *testing.T) {
 	}
 }
 
+func TestUpdateRoleEffectChange(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	mazc := mockAuthzClient{}
+	rs := NewRoleService(db, &mazc, getLogger())
+
+	ruuid := uuid.New().String()
+	puuid, ouuid := addParterOrgFetchExpectation(mock)
+	mock.ExpectQuery(`SELECT "resourcerole"."id", "resourcerole"."name", .*FROM "authsrv_resourcerole" AS "resourcerole" WHERE .organization_id = '` + ouuid + `'. AND .partner_id = '` + puuid + `'. AND .name = 'role-` + ruuid + `'.`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id", "name", "organization_id", "partner_id", "effect"}).AddRow(ruuid, "role-"+ruuid, ouuid, puuid, "allow"))
+
+	role := &rolev3.Role{
+		Metadata: &v3.Metadata{Partner: "partner-" + puuid, Organization: "org-" + ouuid, Name: "role-" + ruuid},
+		Spec:     &rolev3.RoleSpec{IsGlobal: true, Scope: "organization", Effect: "deny", Rolepermissions: []string{"organization.write"}},
+	}
+	_, err := rs.Update(context.Background(), role)
+	if err == nil {
+		t.Fatal("effect of a role should not be changed")
+	}
+}
+
 func TestRoleDelete(t *testing.T) {
 	db, mock := getDB(t)
 	defer db.Close()
@@ -288,9 +419,13 @@ func TestRoleDelete(t *testing.T) {
 	puuid, ouuid := addParterOrgFetchExpectation(mock)
 	mock.ExpectQuery(`SELECT "resourcerole"."id", "resourcerole"."name", .* FROM "authsrv_resourcerole" AS "resourcerole" WHERE`).
 		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(ruuid, "role-"+ruuid))
+	mock.ExpectQuery(`SELECT "resourcerole"."id", .* FROM "authsrv_resourcerole" AS "resourcerole" JOIN authsrv_resourceroleinclude AS ri ON ri.resource_role_id = "resourcerole".id WHERE .ri.included_role_id = '` + ruuid + `'.`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id", "name"}))
 	mock.ExpectBegin()
 	mock.ExpectExec(`UPDATE "authsrv_resourcerolepermission" AS "resourcerolepermission" SET trash = TRUE WHERE ."resource_role_id" = '` + ruuid + `'.`).
 		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(`UPDATE "authsrv_resourceroleinclude" AS "resourceroleinclude" SET trash = TRUE WHERE ."resource_role_id" = '` + ruuid + `'.`).
+		WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectExec(`UPDATE "authsrv_resourcerole" AS "resourcerole" SET trash = TRUE WHERE .id = '` + ruuid + `'.`).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectCommit()
@@ -348,6 +483,8 @@ func TestRoleGetByName(t *testing.T) {
 		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(ruuid, "role-"+ruuid))
 	mock.ExpectQuery(`SELECT authsrv_resourcepermission.name as name FROM "authsrv_resourcepermission" JOIN authsrv_resourcerolepermission ON authsrv_resourcerolepermission.resource_permission_id=authsrv_resourcepermission.id WHERE .authsrv_resourcerolepermission.resource_role_id = '` + ruuid + `'`).
 		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(rruuid, "resourcerole-"+rruuid))
+	mock.ExpectQuery(`SELECT "resourcerole"."id", .* FROM "authsrv_resourcerole" AS "resourcerole" JOIN authsrv_resourceroleinclude AS ri ON ri.included_role_id = "resourcerole".id WHERE .ri.resource_role_id = '` + ruuid + `'.`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id", "name"}))
 
 	role := &rolev3.Role{
 		Metadata: &v3.Metadata{Partner: "partner-" + puuid, Organization: "org-" + ouuid, Name: "role-" + ruuid},
@@ -375,6 +512,8 @@ func TestRoleGetById(t *testing.T) {
 		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(ruuid, "role-"+ruuid))
 	mock.ExpectQuery(`SELECT authsrv_resourcepermission.name as name FROM "authsrv_resourcepermission" JOIN authsrv_resourcerolepermission ON authsrv_resourcerolepermission.resource_permission_id=authsrv_resourcepermission.id WHERE .authsrv_resourcerolepermission.resource_role_id = '` + ruuid + `'`).
 		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(rruuid, "resourcerole-"+rruuid))
+	mock.ExpectQuery(`SELECT "resourcerole"."id", .* FROM "authsrv_resourcerole" AS "resourcerole" JOIN authsrv_resourceroleinclude AS ri ON ri.included_role_id = "resourcerole".id WHERE .ri.resource_role_id = '` + ruuid + `'.`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id", "name"}))
 
 	role := &rolev3.Role{
 		Metadata: &v3.Metadata{Partner: "partner-" + puuid, Organization: "org-" + ouuid, Id: ruuid},
@@ -408,9 +547,13 @@ func TestRoleList(t *testing.T) {
 	mock.ExpectQuery(`SELECT authsrv_resourcepermission.name as name FROM "authsrv_resourcepermission" JOIN authsrv_resourcerolepermission ON authsrv_resourcerolepermission.resource_permission_id=authsrv_resourcepermission.id WHERE .authsrv_resourcerolepermission.resource_role_id = '` + ruuid1 + `'.`).
 		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"name"}).
 		AddRow("ops_star.all"))
+	mock.ExpectQuery(`SELECT "resourcerole"."id", .* FROM "authsrv_resourcerole" AS "resourcerole" JOIN authsrv_resourceroleinclude AS ri ON ri.included_role_id = "resourcerole".id WHERE .ri.resource_role_id = '` + ruuid1 + `'.`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id", "name"}))
 	mock.ExpectQuery(`SELECT authsrv_resourcepermission.name as name FROM "authsrv_resourcepermission" JOIN authsrv_resourcerolepermission ON authsrv_resourcerolepermission.resource_permission_id=authsrv_resourcepermission.id WHERE .authsrv_resourcerolepermission.resource_role_id = '` + ruuid2 + `'.`).
 		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"name"}).
 		AddRow("ops_star.all"))
+	mock.ExpectQuery(`SELECT "resourcerole"."id", .* FROM "authsrv_resourcerole" AS "resourcerole" JOIN authsrv_resourceroleinclude AS ri ON ri.included_role_id = "resourcerole".id WHERE .ri.resource_role_id = '` + ruuid2 + `'.`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id", "name"}))
 
 	role := &rolev3.Role{
 		Metadata: &v3.Metadata{Partner: "partner-" + puuid, Organization: "org-" + ouuid},
@@ -426,3 +569,261 @@ func TestRoleList(t *testing.T) {
 		t.Errorf("incorrect role names returned when listing")
 	}
 }
+
+func TestCreateRoleWithIncludedRole(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	mazc := mockAuthzClient{}
+	rs := NewRoleService(db, &mazc, getLogger())
+
+	ruuid := uuid.New().String()
+	buuid := uuid.New().String()
+
+	puuid, ouuid := addParterOrgFetchExpectation(mock)
+	mock.ExpectQuery(`SELECT "resourcerole"."id" FROM "authsrv_resourcerole" AS "resourcerole" WHERE .organization_id = '` + ouuid + `'. AND .partner_id = '` + puuid + `'. AND .name = 'role-` + ruuid + `'.`).
+		WillReturnError(fmt.Errorf("no data available"))
+	mock.ExpectQuery(`SELECT "resourcerole"."id", "resourcerole"."name", .*FROM "authsrv_resourcerole" AS "resourcerole" WHERE .organization_id = '` + ouuid + `'. AND .partner_id = '` + puuid + `'. AND .name = 'PROJECT_READ_ONLY'.`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id", "name", "effect"}).AddRow(buuid, "PROJECT_READ_ONLY", "allow"))
+	mock.ExpectQuery(`SELECT authsrv_resourcepermission.name as name FROM "authsrv_resourcepermission" JOIN authsrv_resourcerole_effective_permission .* WHERE .authsrv_resourcerole_effective_permission.role_id = '` + buuid + `'.`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("partner.read").AddRow("organization.read").AddRow("project.read"))
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`INSERT INTO "authsrv_resourcerole"`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(ruuid))
+	mock.ExpectQuery(`SELECT "resourcepermission"."id" FROM "authsrv_resourcepermission" AS "resourcepermission" WHERE .name = 'project.write'.`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uuid.New().String()))
+	mock.ExpectQuery(`INSERT INTO "authsrv_resourcerolepermission"`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uuid.New().String()))
+	mock.ExpectQuery(`INSERT INTO "authsrv_resourceroleinclude" .*'` + ruuid + `', '` + buuid + `'`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uuid.New().String()))
+	mock.ExpectQuery(`SELECT authsrv_resourcepermission.name as name FROM "authsrv_resourcepermission" JOIN authsrv_resourcerole_effective_permission .* WHERE .authsrv_resourcerole_effective_permission.role_id = '` + ruuid + `'.`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("partner.read").AddRow("organization.read").AddRow("project.read").AddRow("project.write"))
+	mock.ExpectCommit()
+
+	role := &rolev3.Role{
+		Metadata: &v3.Metadata{Partner: "partner-" + puuid, Organization: "org-" + ouuid, Name: "role-" + ruuid},
+		Spec:     &rolev3.RoleSpec{Scope: "project", Rolepermissions: []string{"project.write"}, IncludedRoles: []string{"PROJECT_READ_ONLY"}},
+	}
+	role, err := rs.Create(context.Background(), role)
+	if err != nil {
+		t.Fatal("could not create role:", err)
+	}
+	performRoleBasicChecks(t, role, ruuid)
+	if len(mazc.crpm) != 1 {
+		t.Fatal("invalid number of role permission mappings created", len(mazc.crpm))
+	}
+	if perms := mazc.crpm[0].RolePermissionMappingList[0].Permission; len(perms) != 4 {
+		t.Error("mapping should have the permissions of the included role", perms)
+	}
+}
+
+func TestCreateRoleIncludedRoleEffectMismatch(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	mazc := mockAuthzClient{}
+	rs := NewRoleService(db, &mazc, getLogger())
+
+	ruuid := uuid.New().String()
+	buuid := uuid.New().String()
+
+	puuid, ouuid := addParterOrgFetchExpectation(mock)
+	mock.ExpectQuery(`SELECT "resourcerole"."id" FROM "authsrv_resourcerole" AS "resourcerole" WHERE .organization_id = '` + ouuid + `'. AND .partner_id = '` + puuid + `'. AND .name = 'role-` + ruuid + `'.`).
+		WillReturnError(fmt.Errorf("no data available"))
+	mock.ExpectQuery(`SELECT "resourcerole"."id", "resourcerole"."name", .*FROM "authsrv_resourcerole" AS "resourcerole" WHERE .*name = 'base-` + buuid + `'.`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id", "name", "effect"}).AddRow(buuid, "base-"+buuid, "deny"))
+
+	role := &rolev3.Role{
+		Metadata: &v3.Metadata{Partner: "partner-" + puuid, Organization: "org-" + ouuid, Name: "role-" + ruuid},
+		Spec:     &rolev3.RoleSpec{Scope: "project", Rolepermissions: []string{"partner.read", "organization.read"}, IncludedRoles: []string{"base-" + buuid}},
+	}
+	_, err := rs.Create(context.Background(), role)
+	if err == nil {
+		t.Fatal("allow role should not include a deny role")
+	}
+}
+
+func TestUpdateRoleIncludeCycle(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	mazc := mockAuthzClient{}
+	rs := NewRoleService(db, &mazc, getLogger())
+
+	ruuid := uuid.New().String()
+	buuid := uuid.New().String()
+
+	puuid, ouuid := addParterOrgFetchExpectation(mock)
+	mock.ExpectQuery(`SELECT "resourcerole"."id", "resourcerole"."name", .*FROM "authsrv_resourcerole" AS "resourcerole" WHERE .*name = 'role-` + ruuid + `'.`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id", "name", "effect"}).AddRow(ruuid, "role-"+ruuid, "allow"))
+	mock.ExpectQuery(`SELECT "resourcerole"."id", "resourcerole"."name", .*FROM "authsrv_resourcerole" AS "resourcerole" WHERE .*name = 'base-` + buuid + `'.`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id", "name", "effect"}).AddRow(buuid, "base-"+buuid, "allow"))
+	// base role already includes the role being updated
+	mock.ExpectQuery(`SELECT "resourcerole"."id", .* JOIN authsrv_resourceroleinclude AS ri ON ri.included_role_id = "resourcerole".id WHERE .ri.resource_role_id = '` + buuid + `'.`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(ruuid, "role-"+ruuid))
+
+	role := &rolev3.Role{
+		Metadata: &v3.Metadata{Partner: "partner-" + puuid, Organization: "org-" + ouuid, Name: "role-" + ruuid},
+		Spec:     &rolev3.RoleSpec{Scope: "organization", Rolepermissions: []string{"partner.read", "organization.read"}, IncludedRoles: []string{"base-" + buuid}},
+	}
+	_, err := rs.Update(context.Background(), role)
+	if err == nil {
+		t.Fatal("role include cycle should not be allowed")
+	}
+}
+
+func TestRoleDeleteIncluded(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	mazc := mockAuthzClient{}
+	rs := NewRoleService(db, &mazc, getLogger())
+
+	ruuid := uuid.New().String()
+	cuuid := uuid.New().String()
+
+	puuid, ouuid := addParterOrgFetchExpectation(mock)
+	mock.ExpectQuery(`SELECT "resourcerole"."id", "resourcerole"."name", .* FROM "authsrv_resourcerole" AS "resourcerole" WHERE`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(ruuid, "role-"+ruuid))
+	mock.ExpectQuery(`SELECT "resourcerole"."id", .* JOIN authsrv_resourceroleinclude AS ri ON ri.resource_role_id = "resourcerole".id WHERE .ri.included_role_id = '` + ruuid + `'.`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(cuuid, "composite-"+cuuid))
+
+	role := &rolev3.Role{
+		Metadata: &v3.Metadata{Partner: "partner-" + puuid, Organization: "org-" + ouuid, Name: "role-" + ruuid},
+	}
+	_, err := rs.Delete(context.Background(), role)
+	if err == nil {
+		t.Fatal("role included by other roles should not be deleted")
+	}
+}
+
+func TestRoleGetEffectivePermissions(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	mazc := mockAuthzClient{}
+	rs := NewRoleService(db, &mazc, getLogger())
+
+	ruuid := uuid.New().String()
+	buuid := uuid.New().String()
+
+	puuid, ouuid := addParterOrgFetchExpectation(mock)
+	mock.ExpectQuery(`SELECT "resourcerole"."id", "resourcerole"."name", .* FROM "authsrv_resourcerole" AS "resourcerole" WHERE .*name = 'role-` + ruuid + `'`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(ruuid, "role-"+ruuid))
+	mock.ExpectQuery(`SELECT authsrv_resourcepermission.name as name FROM "authsrv_resourcepermission" JOIN authsrv_resourcerolepermission .* WHERE .authsrv_resourcerolepermission.resource_role_id = '` + ruuid + `'`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("project.write"))
+	mock.ExpectQuery(`SELECT "resourcerole"."id", .* JOIN authsrv_resourceroleinclude AS ri ON ri.included_role_id = "resourcerole".id WHERE .ri.resource_role_id = '` + ruuid + `'.`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(buuid, "PROJECT_READ_ONLY"))
+	mock.ExpectQuery(`SELECT authsrv_resourcepermission.name as name FROM "authsrv_resourcepermission" JOIN authsrv_resourcerole_effective_permission .* WHERE .authsrv_resourcerole_effective_permission.role_id = '` + ruuid + `'.`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("project.read").AddRow("project.write"))
+
+	role := &rolev3.Role{
+		Metadata: &v3.Metadata{Partner: "partner-" + puuid, Organization: "org-" + ouuid, Name: "role-" + ruuid},
+	}
+	role, err := rs.GetEffectivePermissions(context.Background(), role)
+	if err != nil {
+		t.Fatal("could not get role effective permissions:", err)
+	}
+	performRoleBasicChecks(t, role, ruuid)
+	if len(role.Spec.IncludedRoles) != 1 || role.Spec.IncludedRoles[0] != "PROJECT_READ_ONLY" {
+		t.Error("invalid included roles", role.Spec.IncludedRoles)
+	}
+	if len(role.Spec.EffectivePermissions) != 2 || len(role.Spec.Rolepermissions) != 1 {
+		t.Error("invalid effective permissions", role.Spec.EffectivePermissions)
+	}
+}
+
+func TestExplainAuthorization(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	mazc := mockAuthzClient{}
+	rs := NewRoleService(db, &mazc, getLogger())
+
+	puuid, ouuid := addParterOrgFetchExpectation(mock)
+
+	req := &rolev3.AuthorizationExplainRequest{
+		Metadata: &v3.Metadata{Partner: "partner-" + puuid, Organization: "org-" + ouuid},
+		Spec:     &rolev3.AuthorizationExplainSpec{User: "user@example.com", Url: "/auth/v3/users", Method: "GET"},
+	}
+	exp, err := rs.ExplainAuthorization(context.Background(), req)
+	if err != nil {
+		t.Fatal("could not explain authorization:", err)
+	}
+	if len(mazc.ex) != 1 {
+		t.Fatal("invalid number of explain calls", len(mazc.ex))
+	}
+	params := mazc.ex[0].Params
+	if params[0] != "u:user@example.com" || params[2] != "*" || params[3] != "org-"+ouuid || params[4] != "/auth/v3/users" {
+		t.Error("invalid explain params", params)
+	}
+	if mazc.ex[0].ProposedRole != "" || exp.WhatIf {
+		t.Error("explain should not evaluate a proposed role")
+	}
+	if !exp.Allowed || len(exp.Policies) != 1 || len(exp.RolePermissions) != 1 {
+		t.Error("invalid explanation", exp)
+	}
+	if exp.Reason != "allowed by role 'ADMIN' bound to 'u:user@example.com'" {
+		t.Error("invalid reason", exp.Reason)
+	}
+}
+
+func TestExplainAuthorizationProposedRole(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	mazc := mockAuthzClient{}
+	rs := NewRoleService(db, &mazc, getLogger())
+
+	ruuid := uuid.New().String()
+	puuid, ouuid := addParterOrgFetchExpectation(mock)
+	mock.ExpectQuery(`SELECT "resourcerole"."id", "resourcerole"."name", .*FROM "authsrv_resourcerole" AS "resourcerole" WHERE .organization_id = '` + ouuid + `'. AND .partner_id = '` + puuid + `'. AND .name = 'role-` + ruuid + `'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "effect"}).AddRow(ruuid, "role-"+ruuid, "deny"))
+
+	req := &rolev3.AuthorizationExplainRequest{
+		Metadata: &v3.Metadata{Partner: "partner-" + puuid, Organization: "org-" + ouuid},
+		Spec: &rolev3.AuthorizationExplainSpec{
+			Group:   "group-" + ruuid,
+			Project: "project-" + ruuid,
+			Url:     "/auth/v3/users",
+			Method:  "GET",
+			ProposedRole: &rolev3.Role{
+				Metadata: &v3.Metadata{Name: "role-" + ruuid},
+				// the proposed role keeps the effect of the role
+				Spec: &rolev3.RoleSpec{Rolepermissions: []string{"user.read"}},
+			},
+		},
+	}
+	exp, err := rs.ExplainAuthorization(context.Background(), req)
+	if err != nil {
+		t.Fatal("could not explain authorization:", err)
+	}
+	ex := mazc.ex[0]
+	if ex.Params[0] != "g:group-"+ruuid || ex.Params[2] != "project-"+ruuid {
+		t.Error("invalid explain params", ex.Params)
+	}
+	if ex.ProposedRole != "role-"+ruuid || ex.ProposedEffect != "deny" || len(ex.ProposedPermissions) != 1 {
+		t.Error("invalid proposed role", ex)
+	}
+	if !exp.WhatIf {
+		t.Error("explanation should be marked as what if")
+	}
+}
+
+func TestExplainAuthorizationNoSubject(t *testing.T) {
+	db, _ := getDB(t)
+	defer db.Close()
+
+	mazc := mockAuthzClient{}
+	rs := NewRoleService(db, &mazc, getLogger())
+
+	req := &rolev3.AuthorizationExplainRequest{
+		Metadata: &v3.Metadata{Partner: "partner", Organization: "org"},
+		Spec:     &rolev3.AuthorizationExplainSpec{User: "user@example.com", Group: "group", Url: "/auth/v3/users", Method: "GET"},
+	}
+	_, err := rs.ExplainAuthorization(context.Background(), req)
+	if err == nil {
+		t.Fatal("explain should need exactly one of user or group")
+	}
+}
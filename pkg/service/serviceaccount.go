@@ -0,0 +1,565 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/dao"
+	"github.com/paralus/paralus/internal/models"
+	"github.com/paralus/paralus/pkg/crypto"
+	"github.com/paralus/paralus/pkg/query"
+	userrpcv3 "github.com/paralus/paralus/proto/rpc/user"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	userv3 "github.com/paralus/paralus/proto/types/userpb/v3"
+	bun "github.com/uptrace/bun"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	serviceAccountKind     = "ServiceAccount"
+	serviceAccountListKind = "ServiceAccountList"
+
+	// ServiceAccountTokenAudience is the audience of the JWTs signed by
+	// service accounts
+	ServiceAccountTokenAudience = "paralus"
+	// maxServiceAccountTokenLifetime limits how long a signed JWT can
+	// be used for
+	maxServiceAccountTokenLifetime = time.Hour
+)
+
+// ServiceAccountService is the interface for service account operations
+type ServiceAccountService interface {
+	// create service account
+	Create(context.Context, *userv3.ServiceAccount) (*userv3.ServiceAccount, error)
+	// get service account by name
+	GetByName(context.Context, *userv3.ServiceAccount) (*userv3.ServiceAccount, error)
+	// update service account
+	Update(context.Context, *userv3.ServiceAccount) (*userv3.ServiceAccount, error)
+	// delete service account along with its api keys
+	Delete(context.Context, *userv3.ServiceAccount) error
+	// list service accounts
+	List(context.Context, ...query.Option) (*userv3.ServiceAccountList, error)
+	// create api key of service account, the secret is only returned here
+	CreateApiKey(context.Context, *userrpcv3.ServiceAccountApiKeyRequest) (*userrpcv3.ServiceAccountApiKey, error)
+	// list api keys of service account
+	ListApiKeys(context.Context, *userrpcv3.ServiceAccountApiKeyRequest) (*userrpcv3.UserListApiKeysResponse, error)
+	// delete api key of service account
+	DeleteApiKey(context.Context, *userrpcv3.ServiceAccountApiKeyRequest) error
+	// authenticate a JWT signed by a service account, returns the
+	// session of the service account
+	AuthenticateToken(context.Context, string) (*commonv3.SessionData, error)
+}
+
+// serviceAccountService implements ServiceAccountService
+type serviceAccountService struct {
+	db  *bun.DB
+	azc AuthzService
+	al  *zap.Logger
+}
+
+// NewServiceAccountService return new service account service
+func NewServiceAccountService(db *bun.DB, azc AuthzService, al *zap.Logger) ServiceAccountService {
+	return &serviceAccountService{db: db, azc: azc, al: al}
+}
+
+// serviceAccountUsername returns the username of the service account,
+// it can not be mistaken for the email of a user
+func serviceAccountUsername(name, org string) string {
+	return fmt.Sprintf("%s@%s.serviceaccount.paralus.local", name, org)
+}
+
+// parseServiceAccountPublicKey parses the PEM encoded RSA, ECDSA or
+// Ed25519 public key of a service account
+func parseServiceAccountPublicKey(key string) (interface{}, error) {
+	if k, err := jwt.ParseRSAPublicKeyFromPEM([]byte(key)); err == nil {
+		return k, nil
+	}
+	if k, err := jwt.ParseECPublicKeyFromPEM([]byte(key)); err == nil {
+		return k, nil
+	}
+	if k, err := jwt.ParseEdPublicKeyFromPEM([]byte(key)); err == nil {
+		return k, nil
+	}
+	return nil, fmt.Errorf("public key should be a PEM encoded RSA, ECDSA or Ed25519 public key")
+}
+
+func (s *serviceAccountService) getPartnerOrganization(ctx context.Context, partner, org string) (uuid.UUID, uuid.UUID, error) {
+	partnerId, err := dao.GetPartnerId(ctx, s.db, partner)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("unable to find partner '%v'", partner)
+	}
+	orgId, err := dao.GetOrganizationId(ctx, s.db, org)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, fmt.Errorf("unable to find organization '%v'", org)
+	}
+	return partnerId, orgId, nil
+}
+
+func (s *serviceAccountService) get(ctx context.Context, name string, partnerId, orgId uuid.UUID) (*models.ServiceAccount, error) {
+	var sa models.ServiceAccount
+	_, err := dao.GetByNamePartnerOrg(ctx, s.db, name,
+		uuid.NullUUID{UUID: partnerId, Valid: true},
+		uuid.NullUUID{UUID: orgId, Valid: true},
+		&sa,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find service account '%v'", name)
+	}
+	return &sa, nil
+}
+
+// getOwnerGroup gets the id of the group which owns the service account
+func (s *serviceAccountService) getOwnerGroup(ctx context.Context, name string, partnerId, orgId uuid.UUID) (uuid.UUID, error) {
+	if name == "" {
+		return uuid.Nil, fmt.Errorf("service account should be owned by a group")
+	}
+	entity, err := dao.GetIdByNamePartnerOrg(ctx, s.db, name,
+		uuid.NullUUID{UUID: partnerId, Valid: true},
+		uuid.NullUUID{UUID: orgId, Valid: true},
+		&models.Group{},
+	)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("unable to find group '%v'", name)
+	}
+	return entity.(*models.Group).ID, nil
+}
+
+// validateRoles checks the roles of the service account, service
+// accounts can not hold system roles and those scoped to a project can
+// only hold roles in it
+func (s *serviceAccountService) validateRoles(ctx context.Context, sa *userv3.ServiceAccount) error {
+	project := sa.GetMetadata().GetProject()
+	for _, pnr := range sa.GetSpec().GetProjectNamespaceRoles() {
+		var role models.Role
+		_, err := dao.GetByName(ctx, s.db, pnr.GetRole(), &role)
+		if err != nil {
+			return fmt.Errorf("unable to find role '%v'", pnr.GetRole())
+		}
+		scope := strings.ToLower(role.Scope)
+		if scope == "system" {
+			return fmt.Errorf("service accounts can not be assigned system role '%v'", role.Name)
+		}
+		if project == "" {
+			continue
+		}
+		if scope == "organization" || pnr.GetProject() != project {
+			return fmt.Errorf("service account scoped to project '%v' can only be assigned roles in the project", project)
+		}
+	}
+	return nil
+}
+
+func (s *serviceAccountService) toServiceAccount(ctx context.Context, sa *models.ServiceAccount, partner, org string) (*userv3.ServiceAccount, error) {
+	var group models.Group
+	_, err := dao.GetNameById(ctx, s.db, sa.OwnerGroupId, &group)
+	if err != nil {
+		return nil, err
+	}
+	project := ""
+	if sa.ProjectId.Valid {
+		project, err = dao.GetProjectName(ctx, s.db, sa.ProjectId.UUID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	roles, err := dao.GetUserRoles(ctx, s.db, sa.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &userv3.ServiceAccount{
+		ApiVersion: apiVersion,
+		Kind:       serviceAccountKind,
+		Metadata: &commonv3.Metadata{
+			Name:         sa.Name,
+			Description:  sa.Description,
+			Id:           sa.ID.String(),
+			Partner:      partner,
+			Organization: org,
+			Project:      project,
+			CreatedAt:    timestamppb.New(sa.CreatedAt),
+			ModifiedAt:   timestamppb.New(sa.ModifiedAt),
+		},
+		Spec: &userv3.ServiceAccountSpec{
+			OwnerGroup:            group.Name,
+			ProjectNamespaceRoles: roles,
+			PublicKey:             sa.PublicKey,
+		},
+		Status: &userv3.ServiceAccountStatus{
+			Username: sa.Username,
+		},
+	}, nil
+}
+
+func (s *serviceAccountService) Create(ctx context.Context, sa *userv3.ServiceAccount) (*userv3.ServiceAccount, error) {
+	name := sa.GetMetadata().GetName()
+	if name == "" {
+		return nil, fmt.Errorf("empty name for service account")
+	}
+	org := sa.GetMetadata().GetOrganization()
+	partnerId, orgId, err := s.getPartnerOrganization(ctx, sa.GetMetadata().GetPartner(), org)
+	if err != nil {
+		return nil, err
+	}
+	if existing, _ := s.get(ctx, name, partnerId, orgId); existing != nil {
+		return nil, fmt.Errorf("service account '%v' already exists", name)
+	}
+	ownerId, err := s.getOwnerGroup(ctx, sa.GetSpec().GetOwnerGroup(), partnerId, orgId)
+	if err != nil {
+		return nil, err
+	}
+	projectId := uuid.NullUUID{}
+	if project := sa.GetMetadata().GetProject(); project != "" {
+		pid, err := dao.GetProjectId(ctx, s.db, project)
+		if err != nil {
+			return nil, fmt.Errorf("unable to find project '%v'", project)
+		}
+		projectId = uuid.NullUUID{UUID: pid, Valid: true}
+	}
+	if key := sa.GetSpec().GetPublicKey(); key != "" {
+		if _, err := parseServiceAccountPublicKey(key); err != nil {
+			return nil, err
+		}
+	}
+	err = s.validateRoles(ctx, sa)
+	if err != nil {
+		return nil, err
+	}
+
+	msa := &models.ServiceAccount{
+		Name:           name,
+		Username:       serviceAccountUsername(name, org),
+		Description:    sa.GetMetadata().GetDescription(),
+		OrganizationId: orgId,
+		PartnerId:      partnerId,
+		ProjectId:      projectId,
+		OwnerGroupId:   ownerId,
+		PublicKey:      sa.GetSpec().GetPublicKey(),
+		CreatedAt:      time.Now(),
+		ModifiedAt:     time.Now(),
+	}
+
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+	_, err = dao.Create(ctx, tx, msa)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	_, err = createAccountRoleRelations(ctx, tx, s.azc, "u:"+msa.Username, org, sa.GetSpec().GetProjectNamespaceRoles(), parsedIds{Id: msa.ID, Partner: partnerId, Organization: orgId})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	err = tx.Commit()
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	CreateServiceAccountAuditEvent(ctx, s.al, AuditActionCreate, name, sa.GetSpec().GetOwnerGroup(), sa.GetMetadata().GetProject())
+	return s.toServiceAccount(ctx, msa, sa.GetMetadata().GetPartner(), org)
+}
+
+func (s *serviceAccountService) GetByName(ctx context.Context, sa *userv3.ServiceAccount) (*userv3.ServiceAccount, error) {
+	partnerId, orgId, err := s.getPartnerOrganization(ctx, sa.GetMetadata().GetPartner(), sa.GetMetadata().GetOrganization())
+	if err != nil {
+		return nil, err
+	}
+	msa, err := s.get(ctx, sa.GetMetadata().GetName(), partnerId, orgId)
+	if err != nil {
+		return nil, err
+	}
+	return s.toServiceAccount(ctx, msa, sa.GetMetadata().GetPartner(), sa.GetMetadata().GetOrganization())
+}
+
+// Update changes the owner, public key and roles of the service
+// account, the project it is scoped to can not be changed
+func (s *serviceAccountService) Update(ctx context.Context, sa *userv3.ServiceAccount) (*userv3.ServiceAccount, error) {
+	org := sa.GetMetadata().GetOrganization()
+	partnerId, orgId, err := s.getPartnerOrganization(ctx, sa.GetMetadata().GetPartner(), org)
+	if err != nil {
+		return nil, err
+	}
+	msa, err := s.get(ctx, sa.GetMetadata().GetName(), partnerId, orgId)
+	if err != nil {
+		return nil, err
+	}
+	project := ""
+	if msa.ProjectId.Valid {
+		project, err = dao.GetProjectName(ctx, s.db, msa.ProjectId.UUID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if sa.GetMetadata().GetProject() != project {
+		return nil, fmt.Errorf("project of service account '%v' can not be changed", msa.Name)
+	}
+	ownerId, err := s.getOwnerGroup(ctx, sa.GetSpec().GetOwnerGroup(), partnerId, orgId)
+	if err != nil {
+		return nil, err
+	}
+	if key := sa.GetSpec().GetPublicKey(); key != "" {
+		if _, err := parseServiceAccountPublicKey(key); err != nil {
+			return nil, err
+		}
+	}
+	err = s.validateRoles(ctx, sa)
+	if err != nil {
+		return nil, err
+	}
+
+	msa.Description = sa.GetMetadata().GetDescription()
+	msa.OwnerGroupId = ownerId
+	msa.PublicKey = sa.GetSpec().GetPublicKey()
+	msa.ModifiedAt = time.Now()
+
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return nil, err
+	}
+	_, err = dao.Update(ctx, tx, msa.ID, msa)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	_, err = deleteAccountRoleRelations(ctx, tx, s.azc, msa.ID, "u:"+msa.Username)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	_, err = createAccountRoleRelations(ctx, tx, s.azc, "u:"+msa.Username, org, sa.GetSpec().GetProjectNamespaceRoles(), parsedIds{Id: msa.ID, Partner: partnerId, Organization: orgId})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	err = tx.Commit()
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	CreateServiceAccountAuditEvent(ctx, s.al, AuditActionUpdate, msa.Name, sa.GetSpec().GetOwnerGroup(), project)
+	return s.toServiceAccount(ctx, msa, sa.GetMetadata().GetPartner(), org)
+}
+
+func (s *serviceAccountService) Delete(ctx context.Context, sa *userv3.ServiceAccount) error {
+	partnerId, orgId, err := s.getPartnerOrganization(ctx, sa.GetMetadata().GetPartner(), sa.GetMetadata().GetOrganization())
+	if err != nil {
+		return err
+	}
+	msa, err := s.get(ctx, sa.GetMetadata().GetName(), partnerId, orgId)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return err
+	}
+	_, err = deleteAccountRoleRelations(ctx, tx, s.azc, msa.ID, "u:"+msa.Username)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	err = dao.DeleteX(ctx, tx, "account_id", msa.ID, &models.ApiKey{})
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	err = dao.Delete(ctx, tx, msa.ID, &models.ServiceAccount{})
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	err = tx.Commit()
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	CreateServiceAccountAuditEvent(ctx, s.al, AuditActionDelete, msa.Name, "", sa.GetMetadata().GetProject())
+	return nil
+}
+
+func (s *serviceAccountService) List(ctx context.Context, opts ...query.Option) (*userv3.ServiceAccountList, error) {
+	saList := &userv3.ServiceAccountList{
+		ApiVersion: apiVersion,
+		Kind:       serviceAccountListKind,
+		Metadata: &commonv3.ListMetadata{
+			Count: 0,
+		},
+	}
+
+	queryOptions := commonv3.QueryOptions{}
+	for _, opt := range opts {
+		opt(&queryOptions)
+	}
+
+	partnerId, orgId, err := s.getPartnerOrganization(ctx, queryOptions.Partner, queryOptions.Organization)
+	if err != nil {
+		return saList, err
+	}
+	projectId := uuid.NullUUID{}
+	if queryOptions.Project != "" {
+		pid, err := dao.GetProjectId(ctx, s.db, queryOptions.Project)
+		if err != nil {
+			return saList, fmt.Errorf("unable to find project '%v'", queryOptions.Project)
+		}
+		projectId = uuid.NullUUID{UUID: pid, Valid: true}
+	}
+	var sas []models.ServiceAccount
+	_, err = dao.ListFiltered(ctx, s.db,
+		uuid.NullUUID{UUID: partnerId, Valid: true}, uuid.NullUUID{UUID: orgId, Valid: true},
+		projectId,
+		&sas,
+		queryOptions.Q,
+		queryOptions.OrderBy,
+		queryOptions.Order,
+		int(queryOptions.Limit),
+		int(queryOptions.Offset),
+	)
+	if err != nil {
+		return saList, err
+	}
+	for i := range sas {
+		entry, err := s.toServiceAccount(ctx, &sas[i], queryOptions.Partner, queryOptions.Organization)
+		if err != nil {
+			return saList, err
+		}
+		saList.Items = append(saList.Items, entry)
+	}
+	saList.Metadata.Count = int64(len(saList.Items))
+	return saList, nil
+}
+
+func (s *serviceAccountService) CreateApiKey(ctx context.Context, req *userrpcv3.ServiceAccountApiKeyRequest) (*userrpcv3.ServiceAccountApiKey, error) {
+	partnerId, orgId, err := s.getPartnerOrganization(ctx, req.GetPartner(), req.GetOrganization())
+	if err != nil {
+		return nil, err
+	}
+	msa, err := s.get(ctx, req.GetName(), partnerId, orgId)
+	if err != nil {
+		return nil, err
+	}
+	apikey := &models.ApiKey{
+		Name:           msa.Username,
+		CreatedAt:      time.Now(),
+		ModifiedAt:     time.Now(),
+		AccountID:      msa.ID,
+		OrganizationID: orgId,
+		PartnerID:      partnerId,
+		Key:            crypto.GenerateSha1Key(),
+		Secret:         crypto.GenerateSha256Secret(),
+	}
+	_, err = dao.Create(ctx, s.db, apikey)
+	if err != nil {
+		return nil, err
+	}
+
+	CreateServiceAccountApiKeyAuditEvent(ctx, s.al, AuditActionCreate, msa.Name, apikey.Key)
+	return &userrpcv3.ServiceAccountApiKey{
+		Key:       apikey.Key,
+		Secret:    apikey.Secret,
+		CreatedAt: timestamppb.New(apikey.CreatedAt),
+	}, nil
+}
+
+func (s *serviceAccountService) ListApiKeys(ctx context.Context, req *userrpcv3.ServiceAccountApiKeyRequest) (*userrpcv3.UserListApiKeysResponse, error) {
+	partnerId, orgId, err := s.getPartnerOrganization(ctx, req.GetPartner(), req.GetOrganization())
+	if err != nil {
+		return nil, err
+	}
+	msa, err := s.get(ctx, req.GetName(), partnerId, orgId)
+	if err != nil {
+		return nil, err
+	}
+	var apikeys []models.ApiKey
+	_, err = dao.GetX(ctx, s.db, "account_id", msa.ID, &apikeys)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, err
+	}
+	resp := &userrpcv3.UserListApiKeysResponse{
+		Items: make([]*userrpcv3.ApiKeyResponse, 0),
+	}
+	for _, apikey := range apikeys {
+		resp.Items = append(resp.Items, &userrpcv3.ApiKeyResponse{
+			Name:       apikey.Name,
+			CreatedAt:  timestamppb.New(apikey.CreatedAt),
+			ModifiedAt: timestamppb.New(apikey.ModifiedAt),
+			Key:        apikey.Key,
+		})
+	}
+	return resp, nil
+}
+
+func (s *serviceAccountService) DeleteApiKey(ctx context.Context, req *userrpcv3.ServiceAccountApiKeyRequest) error {
+	partnerId, orgId, err := s.getPartnerOrganization(ctx, req.GetPartner(), req.GetOrganization())
+	if err != nil {
+		return err
+	}
+	msa, err := s.get(ctx, req.GetName(), partnerId, orgId)
+	if err != nil {
+		return err
+	}
+	res, err := s.db.NewUpdate().Model(&models.ApiKey{}).
+		Set("trash = ?", true).
+		Where("account_id = ?", msa.ID).
+		Where("key = ?", req.GetKey()).
+		Where("trash = ?", false).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return fmt.Errorf("unable to find api key '%v'", req.GetKey())
+	}
+
+	CreateServiceAccountApiKeyAuditEvent(ctx, s.al, AuditActionDelete, msa.Name, req.GetKey())
+	return nil
+}
+
+func (s *serviceAccountService) AuthenticateToken(ctx context.Context, token string) (*commonv3.SessionData, error) {
+	var msa *models.ServiceAccount
+	claims := &jwt.RegisteredClaims{}
+	_, err := jwt.ParseWithClaims(token, claims, func(t *jwt.Token) (interface{}, error) {
+		var err error
+		msa, err = dao.GetServiceAccountByUsername(ctx, s.db, claims.Subject)
+		if err != nil {
+			return nil, fmt.Errorf("unable to find service account '%v'", claims.Subject)
+		}
+		if msa.PublicKey == "" {
+			return nil, fmt.Errorf("service account '%v' has no public key", msa.Name)
+		}
+		return parseServiceAccountPublicKey(msa.PublicKey)
+	}, jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "ES256", "ES384", "ES512", "EdDSA"}))
+	if err != nil {
+		return nil, err
+	}
+	if !claims.VerifyAudience(ServiceAccountTokenAudience, true) {
+		return nil, fmt.Errorf("token audience should be '%v'", ServiceAccountTokenAudience)
+	}
+	// long lived tokens are no better than api keys
+	if claims.ExpiresAt == nil || claims.IssuedAt == nil {
+		return nil, fmt.Errorf("token should have an issue and expiry time")
+	}
+	if claims.ExpiresAt.Sub(claims.IssuedAt.Time) > maxServiceAccountTokenLifetime {
+		return nil, fmt.Errorf("token should expire within %v of being issued", maxServiceAccountTokenLifetime)
+	}
+
+	return &commonv3.SessionData{
+		Account:          msa.ID.String(),
+		Organization:     msa.OrganizationId.String(),
+		Partner:          msa.PartnerId.String(),
+		Username:         msa.Username,
+		IsServiceAccount: true,
+		AuthType:         commonv3.AuthType_ServiceAccountToken,
+	}, nil
+}
@@ -0,0 +1,183 @@
+package service
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/google/uuid"
+	v3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	userv3 "github.com/paralus/paralus/proto/types/userpb/v3"
+)
+
+func addServiceAccountFetchExpectation(mock sqlmock.Sqlmock, sauuid, ouuid, puuid, publicKey string) {
+	mock.ExpectQuery(`SELECT "serviceaccount"."id", .* FROM "authsrv_serviceaccount" AS "serviceaccount"`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id", "name", "username", "organization_id", "partner_id", "public_key"}).
+		AddRow(sauuid, "sa-"+sauuid, serviceAccountUsername("sa-"+sauuid, "org-"+ouuid), ouuid, puuid, publicKey))
+}
+
+func getServiceAccountKey(t *testing.T) (*ecdsa.PrivateKey, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal("could not generate key:", err)
+	}
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatal("could not marshal public key:", err)
+	}
+	return key, string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
+
+func TestServiceAccountCreateWithoutOwnerGroup(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	mazc := mockAuthzClient{}
+	ss := NewServiceAccountService(db, &mazc, getLogger())
+
+	sauuid := uuid.New().String()
+	puuid, ouuid := addParterOrgFetchExpectation(mock)
+	mock.ExpectQuery(`SELECT "serviceaccount"."id", .* FROM "authsrv_serviceaccount" AS "serviceaccount"`).
+		WillReturnError(fmt.Errorf("no data available"))
+
+	sa := &userv3.ServiceAccount{
+		Metadata: &v3.Metadata{Partner: "partner-" + puuid, Organization: "org-" + ouuid, Name: "sa-" + sauuid},
+		Spec:     &userv3.ServiceAccountSpec{},
+	}
+	_, err := ss.Create(context.Background(), sa)
+	if err == nil {
+		t.Fatal("created service account without owner group")
+	}
+	performBasicAuthzChecks(t, mazc, 0, 0, 0, 0, 0, 0)
+}
+
+func TestServiceAccountCreateSystemRole(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	mazc := mockAuthzClient{}
+	ss := NewServiceAccountService(db, &mazc, getLogger())
+
+	sauuid := uuid.New().String()
+	puuid, ouuid := addParterOrgFetchExpectation(mock)
+	mock.ExpectQuery(`SELECT "serviceaccount"."id", .* FROM "authsrv_serviceaccount" AS "serviceaccount"`).
+		WillReturnError(fmt.Errorf("no data available"))
+	guuid := uuid.New().String()
+	mock.ExpectQuery(`SELECT "group"."id" FROM "authsrv_group" AS "group" WHERE .*name = 'group-` + guuid + `'`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(guuid))
+	ruuid := uuid.New().String()
+	mock.ExpectQuery(`SELECT "resourcerole"."id", .* FROM "authsrv_resourcerole" AS "resourcerole" WHERE .*name = 'role-` + ruuid + `'`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id", "name", "scope"}).AddRow(ruuid, "role-"+ruuid, "SYSTEM"))
+
+	sa := &userv3.ServiceAccount{
+		Metadata: &v3.Metadata{Partner: "partner-" + puuid, Organization: "org-" + ouuid, Name: "sa-" + sauuid},
+		Spec: &userv3.ServiceAccountSpec{
+			OwnerGroup:            "group-" + guuid,
+			ProjectNamespaceRoles: []*userv3.ProjectNamespaceRole{{Role: "role-" + ruuid}},
+		},
+	}
+	_, err := ss.Create(context.Background(), sa)
+	if err == nil {
+		t.Fatal("created service account with system role")
+	}
+	performBasicAuthzChecks(t, mazc, 0, 0, 0, 0, 0, 0)
+}
+
+func TestServiceAccountDelete(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	mazc := mockAuthzClient{}
+	ss := NewServiceAccountService(db, &mazc, getLogger())
+
+	sauuid := uuid.New().String()
+	puuid, ouuid := addParterOrgFetchExpectation(mock)
+	addServiceAccountFetchExpectation(mock, sauuid, ouuid, puuid, "")
+	mock.ExpectBegin()
+	_ = addUserRoleMappingsUpdateExpectation(mock, sauuid)
+	mock.ExpectExec(`UPDATE "authsrv_apikey" AS "apikey" SET trash = TRUE WHERE \("account_id" = '` + sauuid + `'\)`).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	addDeleteExpectation(mock, "serviceaccount", sauuid)
+	mock.ExpectCommit()
+
+	sa := &userv3.ServiceAccount{
+		Metadata: &v3.Metadata{Partner: "partner-" + puuid, Organization: "org-" + ouuid, Name: "sa-" + sauuid},
+	}
+	err := ss.Delete(context.Background(), sa)
+	if err != nil {
+		t.Fatal("could not delete service account:", err)
+	}
+	performBasicAuthzChecks(t, mazc, 0, 1, 0, 0, 0, 0)
+	if mazc.dp[0].Sub != "u:"+serviceAccountUsername("sa-"+sauuid, "org-"+ouuid) {
+		t.Errorf("invalid sub in policy sent to authz; got '%v'", mazc.dp[0].Sub)
+	}
+}
+
+func TestServiceAccountAuthenticateToken(t *testing.T) {
+	key, publicKey := getServiceAccountKey(t)
+	otherKey, _ := getServiceAccountKey(t)
+
+	tt := []struct {
+		name     string
+		key      *ecdsa.PrivateKey
+		audience string
+		lifetime time.Duration
+		valid    bool
+	}{
+		{"valid token", key, ServiceAccountTokenAudience, 5 * time.Minute, true},
+		{"signed by other key", otherKey, ServiceAccountTokenAudience, 5 * time.Minute, false},
+		{"other audience", key, "kubernetes", 5 * time.Minute, false},
+		{"long lived token", key, ServiceAccountTokenAudience, 24 * time.Hour, false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			db, mock := getDB(t)
+			defer db.Close()
+
+			ss := NewServiceAccountService(db, &mockAuthzClient{}, getLogger())
+
+			sauuid := uuid.New().String()
+			puuid := uuid.New().String()
+			ouuid := uuid.New().String()
+			username := serviceAccountUsername("sa-"+sauuid, "org-"+ouuid)
+			addServiceAccountFetchExpectation(mock, sauuid, ouuid, puuid, publicKey)
+
+			now := time.Now()
+			token, err := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.RegisteredClaims{
+				Subject:   username,
+				Audience:  jwt.ClaimStrings{tc.audience},
+				IssuedAt:  jwt.NewNumericDate(now),
+				ExpiresAt: jwt.NewNumericDate(now.Add(tc.lifetime)),
+			}).SignedString(tc.key)
+			if err != nil {
+				t.Fatal("could not sign token:", err)
+			}
+
+			sd, err := ss.AuthenticateToken(context.Background(), token)
+			if !tc.valid {
+				if err == nil {
+					t.Fatal("authenticated invalid token")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal("could not authenticate token:", err)
+			}
+			if !sd.IsServiceAccount || sd.AuthType != v3.AuthType_ServiceAccountToken {
+				t.Error("session should be of a service account")
+			}
+			if sd.Account != sauuid || sd.Username != username {
+				t.Errorf("invalid session account; got '%v' '%v'", sd.Account, sd.Username)
+			}
+		})
+	}
+}
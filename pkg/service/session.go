@@ -0,0 +1,364 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/dao"
+	"github.com/paralus/paralus/internal/models"
+	providers "github.com/paralus/paralus/internal/provider/kratos"
+	userrpcv3 "github.com/paralus/paralus/proto/rpc/user"
+	authzv1 "github.com/paralus/paralus/proto/types/authz"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	sentry "github.com/paralus/paralus/proto/types/sentry"
+	bun "github.com/uptrace/bun"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// types of sessions users can access Paralus with
+const (
+	SessionTypeConsole    = "console"
+	SessionTypeApiKey     = "apikey"
+	SessionTypeDevice     = "device"
+	SessionTypeKubeconfig = "kubeconfig"
+)
+
+// ErrSessionPermissionDenied is returned when the sessions of another
+// user are managed without the permission to manage users
+var ErrSessionPermissionDenied = errors.New("not authorized to manage sessions of user")
+
+// SessionService is the interface for managing the sessions of users
+type SessionService interface {
+	// list sessions of the user
+	List(context.Context, *userrpcv3.UserSessionRequest) (*userrpcv3.UserSessionList, error)
+	// revoke a session of the user
+	Revoke(context.Context, *userrpcv3.UserSessionRequest) error
+	// revoke all sessions and the kubectl access of the user
+	RevokeAll(context.Context, *userrpcv3.UserSessionRequest) error
+	// record a kubeconfig issued to the account, valid for the given duration
+	RecordKubeconfig(context.Context, string, time.Duration) error
+	// remove activity of expired sessions
+	RemoveExpired(context.Context) error
+}
+
+// sessionService implements SessionService
+type sessionService struct {
+	db  *bun.DB
+	ap  providers.AuthProvider
+	azc AuthzService
+	krs KubeconfigRevocationService
+	al  *zap.Logger
+}
+
+// NewSessionService return new session service
+func NewSessionService(db *bun.DB, ap providers.AuthProvider, azc AuthzService, krs KubeconfigRevocationService, al *zap.Logger) SessionService {
+	return &sessionService{db: db, ap: ap, azc: azc, krs: krs, al: al}
+}
+
+// getUser gets the user whose sessions are managed, users manage their
+// own sessions while managing the sessions of other users of the
+// organization needs the permission of the url
+func (s *sessionService) getUser(ctx context.Context, username, method, url string) (*commonv3.SessionData, *models.KratosIdentities, error) {
+	sd, ok := GetSessionDataFromContext(ctx)
+	if !ok {
+		return nil, nil, fmt.Errorf("unable to find session")
+	}
+	identity := &models.KratosIdentities{}
+	_, err := dao.GetUserByEmail(ctx, s.db, username, identity)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to find user '%v'", username)
+	}
+	if sd.Username == username {
+		return sd, identity, nil
+	}
+
+	if identityOrganization(identity) != sd.Organization {
+		return nil, nil, fmt.Errorf("unable to find user '%v'", username)
+	}
+	res, err := s.azc.Enforce(ctx, &authzv1.EnforceRequest{
+		Params: []string{"u:" + sd.Username, "*", "*", "*", url, method},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if !res.Res {
+		return nil, nil, ErrSessionPermissionDenied
+	}
+	return sd, identity, nil
+}
+
+func setLastSeen(session *userrpcv3.UserSession, activity models.SessionActivity) {
+	if activity.LastActiveAt.IsZero() {
+		return
+	}
+	session.LastSeenAt = timestamppb.New(activity.LastActiveAt)
+	session.LastSeenIp = activity.ClientIp
+	session.LastSeenUserAgent = activity.ClientUa
+}
+
+func (s *sessionService) List(ctx context.Context, req *userrpcv3.UserSessionRequest) (*userrpcv3.UserSessionList, error) {
+	sd, identity, err := s.getUser(ctx, req.GetUsername(), http.MethodGet, fmt.Sprintf("/auth/v3/user/%s/sessions", req.GetUsername()))
+	if err != nil {
+		return nil, err
+	}
+
+	activities, err := dao.ListSessionActivity(ctx, s.db, identity.ID)
+	if err != nil {
+		return nil, err
+	}
+	activity := map[uuid.UUID]models.SessionActivity{}
+	for _, a := range activities {
+		activity[a.SessionId] = a
+	}
+
+	list := &userrpcv3.UserSessionList{Items: []*userrpcv3.UserSession{}}
+	consoleSessions, err := s.ap.ListSessions(ctx, identity.ID.String())
+	if err != nil {
+		return nil, err
+	}
+	for _, cs := range consoleSessions {
+		session := &userrpcv3.UserSession{
+			Id:                cs.Id,
+			Type:              SessionTypeConsole,
+			CreatedAt:         timestamppb.New(cs.AuthenticatedAt),
+			ExpiresAt:         timestamppb.New(cs.ExpiresAt),
+			LastSeenAt:        timestamppb.New(cs.AuthenticatedAt),
+			LastSeenIp:        cs.IpAddress,
+			LastSeenUserAgent: cs.UserAgent,
+			Current:           cs.Id == sd.Session,
+		}
+		if sid, err := uuid.Parse(cs.Id); err == nil {
+			// sessions logged out for being idle are still active in
+			// kratos
+			if activity[sid].Terminated {
+				continue
+			}
+			setLastSeen(session, activity[sid])
+		}
+		list.Items = append(list.Items, session)
+	}
+
+	var apikeys []models.ApiKey
+	_, err = dao.GetX(ctx, s.db, "account_id", identity.ID, &apikeys)
+	if err != nil {
+		return nil, err
+	}
+	for _, apikey := range apikeys {
+		if !apikey.ExpiresAt.IsZero() && apikey.ExpiresAt.Before(time.Now()) {
+			continue
+		}
+		session := &userrpcv3.UserSession{
+			Id:        apikey.ID.String(),
+			Type:      SessionTypeApiKey,
+			Name:      apikey.Key,
+			CreatedAt: timestamppb.New(apikey.CreatedAt),
+			Current:   apikey.ID.String() == sd.Session,
+		}
+		if !apikey.ExpiresAt.IsZero() {
+			session.ExpiresAt = timestamppb.New(apikey.ExpiresAt)
+		}
+		setLastSeen(session, activity[apikey.ID])
+		list.Items = append(list.Items, session)
+	}
+
+	devices, err := dao.ListAccountDevices(ctx, s.db, identity.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, device := range devices {
+		if device.RefreshExpiresAt.IsZero() || device.RefreshExpiresAt.Before(time.Now()) {
+			continue
+		}
+		session := &userrpcv3.UserSession{
+			Id:        device.ID.String(),
+			Type:      SessionTypeDevice,
+			Name:      device.Name,
+			CreatedAt: timestamppb.New(device.ApprovedAt),
+			ExpiresAt: timestamppb.New(device.RefreshExpiresAt),
+			Current:   device.ID.String() == sd.Session,
+		}
+		setLastSeen(session, activity[device.ID])
+		list.Items = append(list.Items, session)
+	}
+
+	// kubeconfigs issued before the kubeconfigs of the user were revoked
+	// can not be used anymore
+	var revokedAt time.Time
+	if orgId, err := uuid.Parse(identityOrganization(identity)); err == nil {
+		if kr, err := dao.GetKubeconfigRevocation(ctx, s.db, orgId, identity.ID, false); err == nil {
+			revokedAt = kr.RevokedAt
+		}
+	}
+	for _, a := range activities {
+		if a.Kind != SessionTypeKubeconfig || a.Terminated || a.CreatedAt.Before(revokedAt) || a.ExpiresAt.Before(time.Now()) {
+			continue
+		}
+		list.Items = append(list.Items, &userrpcv3.UserSession{
+			Id:                a.SessionId.String(),
+			Type:              SessionTypeKubeconfig,
+			CreatedAt:         timestamppb.New(a.CreatedAt),
+			ExpiresAt:         timestamppb.New(a.ExpiresAt),
+			LastSeenAt:        timestamppb.New(a.LastActiveAt),
+			LastSeenIp:        a.ClientIp,
+			LastSeenUserAgent: a.ClientUa,
+		})
+	}
+	return list, nil
+}
+
+// revokeKubeconfigs revokes every kubeconfig issued to the user
+func (s *sessionService) revokeKubeconfigs(ctx context.Context, sd *commonv3.SessionData, identity *models.KratosIdentities) error {
+	org, partner := sd.Organization, sd.Partner
+	if o := identityOrganization(identity); o != "" {
+		org = o
+	}
+	if p, ok := identity.MetadataPublic["Partner"].(string); ok && p != "" {
+		partner = p
+	}
+	if _, err := uuid.Parse(org); err != nil {
+		return fmt.Errorf("unable to find organization of user")
+	}
+	if _, err := uuid.Parse(partner); err != nil {
+		return fmt.Errorf("unable to find partner of user")
+	}
+	err := s.krs.Patch(ctx, &sentry.KubeconfigRevocation{
+		OrganizationID: org,
+		PartnerID:      partner,
+		AccountID:      identity.ID.String(),
+		RevokedAt:      timestamppb.New(time.Now()),
+	})
+	if err != nil {
+		return err
+	}
+	return dao.TerminateAccountSessions(ctx, s.db, identity.ID, SessionTypeKubeconfig)
+}
+
+func (s *sessionService) Revoke(ctx context.Context, req *userrpcv3.UserSessionRequest) error {
+	sd, identity, err := s.getUser(ctx, req.GetUsername(), http.MethodDelete, fmt.Sprintf("/auth/v3/user/%s/session/%s/%s", req.GetUsername(), req.GetType(), req.GetId()))
+	if err != nil {
+		return err
+	}
+	id, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return fmt.Errorf("unable to find session '%v'", req.GetId())
+	}
+
+	found := false
+	switch req.GetType() {
+	case SessionTypeConsole:
+		// kratos revokes any session by id, the session should be one
+		// of the user
+		sessions, err := s.ap.ListSessions(ctx, identity.ID.String())
+		if err != nil {
+			return err
+		}
+		for _, session := range sessions {
+			if session.Id == id.String() {
+				found = true
+			}
+		}
+		if !found {
+			break
+		}
+		if err := s.ap.RevokeSession(ctx, id.String()); err != nil {
+			return err
+		}
+		if err := dao.TerminateSession(ctx, s.db, id); err != nil {
+			return err
+		}
+	case SessionTypeApiKey:
+		found, err = dao.RevokeAccountApiKey(ctx, s.db, id, identity.ID)
+		if err != nil {
+			return err
+		}
+	case SessionTypeDevice:
+		found, err = dao.RevokeAccountDevice(ctx, s.db, id, identity.ID)
+		if err != nil {
+			return err
+		}
+	case SessionTypeKubeconfig:
+		// kubeconfigs are revoked by the time they were issued before,
+		// one kubeconfig can not be revoked alone
+		activity, err := dao.GetSessionActivity(ctx, s.db, id)
+		if err != nil {
+			return err
+		}
+		if activity.AccountId != identity.ID || activity.Kind != SessionTypeKubeconfig {
+			break
+		}
+		found = true
+		if err := s.revokeKubeconfigs(ctx, sd, identity); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("invalid session type '%v'", req.GetType())
+	}
+	if !found {
+		return fmt.Errorf("unable to find session '%v'", req.GetId())
+	}
+
+	CreateSessionRevokeAuditEvent(ctx, s.al, req.GetUsername(), req.GetType(), req.GetId())
+	return nil
+}
+
+func (s *sessionService) RevokeAll(ctx context.Context, req *userrpcv3.UserSessionRequest) error {
+	sd, identity, err := s.getUser(ctx, req.GetUsername(), http.MethodDelete, fmt.Sprintf("/auth/v3/user/%s/sessions", req.GetUsername()))
+	if err != nil {
+		return err
+	}
+
+	err = s.ap.RevokeSessions(ctx, identity.ID.String())
+	if err != nil {
+		return err
+	}
+	err = dao.DeleteX(ctx, s.db, "account_id", identity.ID, &models.ApiKey{})
+	if err != nil {
+		return err
+	}
+	err = dao.DeleteX(ctx, s.db, "account_id", identity.ID, &models.Device{})
+	if err != nil {
+		return err
+	}
+	err = s.revokeKubeconfigs(ctx, sd, identity)
+	if err != nil {
+		return err
+	}
+	err = dao.TerminateAccountSessions(ctx, s.db, identity.ID, "")
+	if err != nil {
+		return err
+	}
+
+	CreateSessionRevokeAuditEvent(ctx, s.al, req.GetUsername(), "", "")
+	return nil
+}
+
+func (s *sessionService) RecordKubeconfig(ctx context.Context, accountID string, validity time.Duration) error {
+	aid, err := uuid.Parse(accountID)
+	if err != nil {
+		return err
+	}
+	sd, ok := GetSessionDataFromContext(ctx)
+	if !ok {
+		return fmt.Errorf("unable to find session")
+	}
+	now := time.Now()
+	return dao.TouchSession(ctx, s.db, &models.SessionActivity{
+		SessionId:    uuid.New(),
+		AccountId:    aid,
+		LastActiveAt: now,
+		Kind:         SessionTypeKubeconfig,
+		ClientIp:     sd.GetClientIp(),
+		ClientUa:     sd.GetClientUa(),
+		CreatedAt:    now,
+		ExpiresAt:    now.Add(validity),
+	})
+}
+
+func (s *sessionService) RemoveExpired(ctx context.Context) error {
+	return dao.DeleteExpiredSessionActivity(ctx, s.db, time.Now())
+}
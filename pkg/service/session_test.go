@@ -0,0 +1,186 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	providers "github.com/paralus/paralus/internal/provider/kratos"
+	"github.com/paralus/paralus/pkg/common"
+	userrpcv3 "github.com/paralus/paralus/proto/rpc/user"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	"github.com/paralus/paralus/proto/types/sentry"
+)
+
+type mockKubeconfigRevocationService struct {
+	p []*sentry.KubeconfigRevocation
+}
+
+func (m *mockKubeconfigRevocationService) Get(ctx context.Context, orgID string, accountID string, isSSOUser bool) (*sentry.KubeconfigRevocation, error) {
+	return nil, sql.ErrNoRows
+}
+
+func (m *mockKubeconfigRevocationService) Patch(ctx context.Context, kr *sentry.KubeconfigRevocation) error {
+	m.p = append(m.p, kr)
+	return nil
+}
+
+func addSessionUserFetchExpectation(mock sqlmock.Sqlmock, uuuid, puuid, ouuid string) {
+	mock.ExpectQuery(`SELECT "identities"."id", .* FROM "identities" WHERE .traits ->> 'email' = 'johndoe@provider.com'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "traits", "metadata_public"}).
+			AddRow(uuuid, []byte(`{"email":"johndoe@provider.com"}`), []byte(`{"Partner":"`+puuid+`","Organization":"`+ouuid+`"}`)))
+}
+
+func TestSessionList(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	csuuid := uuid.New().String()
+	now := time.Now()
+	ap := &mockAuthProvider{s: []providers.Session{
+		{Id: csuuid, AuthenticatedAt: now.Add(-time.Hour), ExpiresAt: now.Add(time.Hour), IpAddress: "10.0.0.1", UserAgent: "firefox"},
+	}}
+	ss := NewSessionService(db, ap, &mockAuthzClient{}, &mockKubeconfigRevocationService{}, getLogger())
+
+	uuuid := uuid.New().String()
+	puuid := uuid.New().String()
+	ouuid := uuid.New().String()
+	akuuid := uuid.New().String()
+	expakuuid := uuid.New().String()
+	duuid := uuid.New().String()
+	kcuuid := uuid.New().String()
+	addSessionUserFetchExpectation(mock, uuuid, puuid, ouuid)
+	mock.ExpectQuery(`SELECT "sessionactivity"."session_id", .* FROM "authsrv_sessionactivity" AS "sessionactivity" WHERE .account_id = '` + uuuid + `'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"session_id", "account_id", "last_active_at", "terminated", "kind", "client_ip", "client_ua", "created_at", "expires_at"}).
+			AddRow(csuuid, uuuid, now, false, SessionTypeConsole, "10.0.0.2", "chrome", nil, nil).
+			AddRow(akuuid, uuuid, now, false, SessionTypeApiKey, "10.0.0.3", "curl", nil, nil).
+			AddRow(kcuuid, uuuid, now, false, SessionTypeKubeconfig, "10.0.0.4", "firefox", now.Add(-time.Hour), now.Add(time.Hour)))
+	mock.ExpectQuery(`SELECT "apikey"."id", .* FROM "authsrv_apikey" AS "apikey" WHERE .*account_id = '` + uuuid + `'`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "key", "account_id", "expires_at"}).
+			AddRow(akuuid, "key-1", uuuid, nil).
+			AddRow(expakuuid, "key-2", uuuid, now.Add(-time.Hour)))
+	mock.ExpectQuery(`SELECT "device"."id", .* FROM "authsrv_device" AS "device" WHERE .account_id = '` + uuuid + `'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "status", "account_id", "approved_at", "refresh_expires_at"}).
+			AddRow(duuid, "laptop", "approved", uuuid, now.Add(-time.Hour), now.Add(time.Hour)))
+	mock.ExpectQuery(`SELECT "kr"."id", .* FROM "sentry_kubeconfig_revocation" AS "kr"`).
+		WillReturnError(sql.ErrNoRows)
+
+	ctx := context.WithValue(context.Background(), common.SessionDataKey, &commonv3.SessionData{
+		Username: "johndoe@provider.com", Partner: puuid, Organization: ouuid, Session: csuuid,
+	})
+	list, err := ss.List(ctx, &userrpcv3.UserSessionRequest{Username: "johndoe@provider.com"})
+	if err != nil {
+		t.Fatal("could not list sessions:", err)
+	}
+	if len(list.Items) != 4 {
+		t.Fatalf("expected 4 sessions, got %d", len(list.Items))
+	}
+	expected := []struct{ id, typ, ip string }{
+		{csuuid, SessionTypeConsole, "10.0.0.2"},
+		{akuuid, SessionTypeApiKey, "10.0.0.3"},
+		{duuid, SessionTypeDevice, ""},
+		{kcuuid, SessionTypeKubeconfig, "10.0.0.4"},
+	}
+	for i, e := range expected {
+		session := list.Items[i]
+		if session.Id != e.id || session.Type != e.typ || session.LastSeenIp != e.ip {
+			t.Errorf("expected %v session %v last seen from '%v', got %v session %v last seen from '%v'", e.typ, e.id, e.ip, session.Type, session.Id, session.LastSeenIp)
+		}
+	}
+	if !list.Items[0].Current {
+		t.Error("console session the request is authenticated with should be current")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSessionRevokeAll(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	ap := &mockAuthProvider{}
+	krs := &mockKubeconfigRevocationService{}
+	ss := NewSessionService(db, ap, &mockAuthzClient{}, krs, getLogger())
+
+	uuuid := uuid.New().String()
+	puuid := uuid.New().String()
+	ouuid := uuid.New().String()
+	addSessionUserFetchExpectation(mock, uuuid, puuid, ouuid)
+	mock.ExpectExec(`UPDATE "authsrv_apikey" AS "apikey" SET trash = TRUE WHERE ."account_id" = '` + uuuid + `'.`).
+		WillReturnResult(sqlmock.NewResult(1, 2))
+	mock.ExpectExec(`UPDATE "authsrv_device" AS "device" SET trash = TRUE WHERE ."account_id" = '` + uuuid + `'.`).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(`UPDATE "authsrv_sessionactivity" AS "sessionactivity" SET terminated = TRUE WHERE .account_id = '` + uuuid + `'. AND .kind = 'kubeconfig'.`).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec(`UPDATE "authsrv_sessionactivity" AS "sessionactivity" SET terminated = TRUE WHERE .account_id = '` + uuuid + `'.$`).
+		WillReturnResult(sqlmock.NewResult(1, 3))
+
+	ctx := context.WithValue(context.Background(), common.SessionDataKey, &commonv3.SessionData{
+		Username: "johndoe@provider.com", Partner: puuid, Organization: ouuid,
+	})
+	err := ss.RevokeAll(ctx, &userrpcv3.UserSessionRequest{Username: "johndoe@provider.com"})
+	if err != nil {
+		t.Fatal("could not revoke sessions:", err)
+	}
+	if len(ap.rs) != 1 || ap.rs[0] != uuuid {
+		t.Errorf("console sessions of the user should be revoked, revoked %v", ap.rs)
+	}
+	if len(krs.p) != 1 || krs.p[0].AccountID != uuuid || krs.p[0].OrganizationID != ouuid {
+		t.Errorf("kubeconfigs of the user should be revoked, revoked %v", krs.p)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSessionRevokeApiKeyOfOtherUser(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	ss := NewSessionService(db, &mockAuthProvider{}, &mockAuthzClient{}, &mockKubeconfigRevocationService{}, getLogger())
+
+	uuuid := uuid.New().String()
+	puuid := uuid.New().String()
+	ouuid := uuid.New().String()
+	akuuid := uuid.New().String()
+	addSessionUserFetchExpectation(mock, uuuid, puuid, ouuid)
+	mock.ExpectExec(`UPDATE "authsrv_apikey" AS "apikey" SET trash = TRUE WHERE .id = '` + akuuid + `'. AND .account_id = '` + uuuid + `'.`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	ctx := context.WithValue(context.Background(), common.SessionDataKey, &commonv3.SessionData{
+		Username: "johndoe@provider.com", Partner: puuid, Organization: ouuid,
+	})
+	err := ss.Revoke(ctx, &userrpcv3.UserSessionRequest{Username: "johndoe@provider.com", Type: SessionTypeApiKey, Id: akuuid})
+	if err == nil {
+		t.Fatal("revoked api key which is not of the user")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSessionListOtherOrganization(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	ss := NewSessionService(db, &mockAuthProvider{}, &mockAuthzClient{}, &mockKubeconfigRevocationService{}, getLogger())
+
+	uuuid := uuid.New().String()
+	puuid := uuid.New().String()
+	addSessionUserFetchExpectation(mock, uuuid, puuid, uuid.New().String())
+
+	ctx := context.WithValue(context.Background(), common.SessionDataKey, &commonv3.SessionData{
+		Username: "admin@provider.com", Partner: puuid, Organization: uuid.New().String(),
+	})
+	_, err := ss.List(ctx, &userrpcv3.UserSessionRequest{Username: "johndoe@provider.com"})
+	if err == nil {
+		t.Fatal("listed sessions of user of other organization")
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
@@ -160,6 +160,13 @@ func addUserFetchExpectation(mock sqlmock.Sqlmock) string {
 	return uid
 }
 
+func addUserIdFetchExpectation(mock sqlmock.Sqlmock) string {
+	uid := uuid.New().String()
+	mock.ExpectQuery(`SELECT "identities"."id" FROM "identities" WHERE .traits ->> 'email' = 'user-` + uid + `'.`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uid))
+	return uid
+}
+
 func addUserFullFetchExpectation(mock sqlmock.Sqlmock) string {
 	uid := uuid.New().String()
 	mock.ExpectQuery(`SELECT "identities"."id", "identities"."schema_id", "identities"."traits", "identities"."created_at", "identities"."updated_at", "identities"."state", "identities"."state_changed_at", "identities"."nid", "identities"."metadata_public", "identity_credential"."id" AS "identity_credential__id", "identity_credential"."identity_id" AS "identity_credential__identity_id", "identity_credential"."identity_credential_type_id" AS "identity_credential__identity_credential_type_id", "identity_credential__identity_credential_type"."id" AS "identity_credential__identity_credential_type__id", "identity_credential__identity_credential_type"."name" AS "identity_credential__identity_credential_type__name" FROM "identities" LEFT JOIN "identity_credentials" AS "identity_credential" ON ."identity_credential"."identity_id" = "identities"."id". LEFT JOIN "identity_credential_types" AS "identity_credential__identity_credential_type" ON ."identity_credential__identity_credential_type"."id" = "identity_credential"."identity_credential_type_id". WHERE .traits ->> 'email' = 'user-` + uid + `'.`).
@@ -190,6 +197,8 @@ func addUserRoleMappingsFetchExpectation(mock sqlmock.Sqlmock, user string, proj
 		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"role", "project"}).AddRow("role-"+uid, "project-"+project))
 	mock.ExpectQuery(`SELECT authsrv_resourcerole.name as role, authsrv_project.name as project, namespace FROM "authsrv_projectaccountnamespacerole" JOIN authsrv_resourcerole ON authsrv_resourcerole.id=authsrv_projectaccountnamespacerole.role_id JOIN authsrv_project ON authsrv_project.id=authsrv_projectaccountnamespacerole.project_id WHERE .authsrv_projectaccountnamespacerole.account_id = '` + user + `'`).
 		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"role", "project", "namespace"}).AddRow("role-"+uid, "project-"+project, "ns"))
+	mock.ExpectQuery(`SELECT authsrv_resourcerole.name as role, authsrv_project.name as project, cluster_selector FROM "authsrv_accountclusterselectorrole" .* WHERE .authsrv_accountclusterselectorrole.account_id = '` + user + `'`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"role", "project", "cluster_selector"}))
 }
 
 func addUserRoleMappingsUpdateExpectation(mock sqlmock.Sqlmock, uuuid string) string {
@@ -200,9 +209,29 @@ func addUserRoleMappingsUpdateExpectation(mock sqlmock.Sqlmock, uuuid string) st
 		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uid))
 	mock.ExpectQuery(`UPDATE "authsrv_projectaccountnamespacerole" AS "projectaccountnamespacerole" SET trash = TRUE WHERE ."account_id" = '` + uuuid + `'. AND .trash = false. RETURNING *`).
 		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uid))
+	mock.ExpectQuery(`UPDATE "authsrv_accountclusterselectorrole" AS "accountclusterselectorrole" SET trash = TRUE WHERE ."account_id" = '` + uuuid + `'. AND .trash = false. RETURNING *`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id"}))
 	return uid
 }
 
+func addGroupMemberFetchExpectation(mock sqlmock.Sqlmock, guuid string, members ...string) {
+	rows := sqlmock.NewRows([]string{"id", "name"})
+	for _, m := range members {
+		rows.AddRow(m, "group-"+m)
+	}
+	mock.ExpectQuery(`SELECT "group"."id", "group"."name", .* FROM "authsrv_group" AS "group" JOIN authsrv_groupgroup ON authsrv_groupgroup.member_group_id="group".id WHERE .authsrv_groupgroup.group_id = '` + guuid + `'`).
+		WithArgs().WillReturnRows(rows)
+}
+
+func addGroupParentFetchExpectation(mock sqlmock.Sqlmock, guuid string, parents ...string) {
+	rows := sqlmock.NewRows([]string{"id", "name"})
+	for _, p := range parents {
+		rows.AddRow(p, "group-"+p)
+	}
+	mock.ExpectQuery(`SELECT "group"."id", "group"."name", .* FROM "authsrv_group" AS "group" JOIN authsrv_groupgroup ON authsrv_groupgroup.group_id="group".id WHERE .authsrv_groupgroup.member_group_id = '` + guuid + `'`).
+		WithArgs().WillReturnRows(rows)
+}
+
 func addGroupRoleMappingsFetchExpectation(mock sqlmock.Sqlmock, group string, project string) {
 	uid := uuid.New().String()
 	mock.ExpectQuery(`SELECT authsrv_resourcerole.name as role, authsrv_group.name as group FROM "authsrv_grouprole" JOIN authsrv_resourcerole ON authsrv_resourcerole.id=authsrv_grouprole.role_id JOIN authsrv_group ON authsrv_group.id=authsrv_grouprole.group_id WHERE`).
@@ -211,6 +240,8 @@ func addGroupRoleMappingsFetchExpectation(mock sqlmock.Sqlmock, group string, pr
 		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"role", "project"}).AddRow("role-"+uid, "project-"+project))
 	mock.ExpectQuery(`SELECT authsrv_resourcerole.name as role, authsrv_project.name as project, namespace, authsrv_group.name as group FROM "authsrv_projectgroupnamespacerole"`).
 		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"role", "project", "namespace"}).AddRow("role-"+uid, "project-"+project, "ns"))
+	mock.ExpectQuery(`SELECT authsrv_resourcerole.name as role, authsrv_project.name as project, cluster_selector, authsrv_group.name as group FROM "authsrv_groupclusterselectorrole"`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"role", "project", "cluster_selector"}))
 }
 
 func addGroupRoleMappingsUpdateExpectation(mock sqlmock.Sqlmock, group string) string {
@@ -221,6 +252,8 @@ func addGroupRoleMappingsUpdateExpectation(mock sqlmock.Sqlmock, group string) s
 		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uid))
 	mock.ExpectQuery(`UPDATE "authsrv_projectgroupnamespacerole" AS "projectgroupnamespacerole" SET trash = TRUE WHERE ."group_id" = '` + group + `'. AND .trash = false. RETURNING *`).
 		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uid))
+	mock.ExpectQuery(`UPDATE "authsrv_groupclusterselectorrole" AS "groupclusterselectorrole" SET trash = TRUE WHERE ."group_id" = '` + group + `'. AND .trash = false. RETURNING *`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id"}))
 	return uid
 }
 
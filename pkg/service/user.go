@@ -23,6 +23,7 @@ import (
 	authzv1 "github.com/paralus/paralus/proto/types/authz"
 	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
 	v3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	systemv3 "github.com/paralus/paralus/proto/types/systempb/v3"
 	userv3 "github.com/paralus/paralus/proto/types/userpb/v3"
 )
 
@@ -49,6 +50,8 @@ type UserService interface {
 	Delete(context.Context, *userv3.User) (*userrpcv3.UserDeleteApiKeysResponse, error)
 	// list users
 	List(context.Context, ...query.Option) (*userv3.UserList, error)
+	// list users who have not enrolled a second factor
+	ListWithoutMFA(context.Context, ...query.Option) (*userv3.UserList, error)
 	// retrieve the cli config for the logged in user
 	RetrieveCliConfig(ctx context.Context, req *userrpcv3.ApiKeyRequest) (*common.CliConfigDownloadData, error)
 	// Update UserGroup casbin for OIdC/Idp users
@@ -57,6 +60,12 @@ type UserService interface {
 	ForgotPassword(context.Context, *userrpcv3.UserForgotPasswordRequest) (*userrpcv3.UserForgotPasswordResponse, error)
 	// Generate auditLog event
 	CreateLoginAuditLog(context.Context, *userrpcv3.UserLoginAuditRequest) (*userrpcv3.UserLoginAuditResponse, error)
+	// suspend user keeping its groups and roles
+	Suspend(context.Context, *userv3.User) error
+	// reactivate suspended user
+	Reactivate(context.Context, *userv3.User) error
+	// suspend and delete users who have not logged in for longer than their organization allows
+	ProcessInactive(context.Context) error
 }
 
 type userService struct {
@@ -121,11 +130,20 @@ func getUserTraits(traits map[string]interface{}) userTraits {
 
 // Map roles to accounts
 func (s *userService) createUserRoleRelations(ctx context.Context, db bun.IDB, user *userv3.User, ids parsedIds) (*userv3.User, []uuid.UUID, error) {
-	projectNamespaceRoles := user.GetSpec().GetProjectNamespaceRoles()
+	rids, err := createAccountRoleRelations(ctx, db, s.azc, "u:"+user.GetMetadata().GetName(), user.GetMetadata().GetOrganization(), user.GetSpec().GetProjectNamespaceRoles(), ids)
+	if err != nil {
+		return &userv3.User{}, nil, err
+	}
+	return user, rids, nil
+}
 
+// createAccountRoleRelations maps roles to the account which is known
+// by sub in authz
+func createAccountRoleRelations(ctx context.Context, db bun.IDB, azc AuthzService, sub string, org string, projectNamespaceRoles []*userv3.ProjectNamespaceRole, ids parsedIds) ([]uuid.UUID, error) {
 	var pars []models.ProjectAccountResourcerole
 	var panr []models.ProjectAccountNamespaceRole
 	var ars []models.AccountResourcerole
+	var acsrs []models.AccountClusterSelectorRole
 	var ps []*authzv1.Policy
 	var rids []uuid.UUID
 	for _, pnr := range projectNamespaceRoles {
@@ -135,26 +153,58 @@ func (s *userService) createUserRoleRelations(ctx context.Context, db bun.IDB, u
 		}
 		role := pnr.GetRole()
 		if role == "" {
-			return &userv3.User{}, nil, fmt.Errorf("cannot use empty role")
+			return nil, fmt.Errorf("cannot use empty role")
 		}
 		entity, err := dao.GetByName(ctx, db, role, &models.Role{})
 		if err != nil {
-			return &userv3.User{}, nil, fmt.Errorf("unable to find role '%v'", role)
+			return nil, fmt.Errorf("unable to find role '%v'", role)
 		}
 		var roleId uuid.UUID
 		var roleName string
 		var scope string
+		var effect string
 		if rle, ok := entity.(*models.Role); ok {
 			roleId = rle.ID
 			roleName = rle.Name
 			rids = append(rids, rle.ID)
 			scope = strings.ToLower(rle.Scope)
+			effect = rle.Effect
 		} else {
-			return &userv3.User{}, nil, fmt.Errorf("unable to find role '%v'", role)
+			return nil, fmt.Errorf("unable to find role '%v'", role)
+		}
+
+		expiresAt, err := getExpiry(pnr.GetExpiresAt())
+		if err != nil {
+			return nil, err
 		}
 
 		project := pnr.GetProject()
-		org := user.GetMetadata().GetOrganization()
+
+		// role bindings scoped by a cluster selector only grant kubectl
+		// access to the matching clusters, there is no authz policy
+		if selector := pnr.GetClusterSelector(); selector != "" {
+			if org == "" {
+				return nil, fmt.Errorf("no org name provided for role '%v'", roleName)
+			}
+			projectId, err := getClusterSelectorProject(ctx, db, scope, effect, roleName, project, selector)
+			if err != nil {
+				return nil, err
+			}
+			acsrs = append(acsrs, models.AccountClusterSelectorRole{
+				CreatedAt:       time.Now(),
+				ModifiedAt:      time.Now(),
+				Trash:           false,
+				PartnerId:       ids.Partner,
+				OrganizationId:  ids.Organization,
+				RoleId:          roleId,
+				AccountId:       ids.Id,
+				ProjectId:       projectId,
+				ClusterSelector: selector,
+				Active:          true,
+				ExpiresAt:       expiresAt,
+			})
+			continue
+		}
 
 		switch scope {
 		case "system":
@@ -168,19 +218,21 @@ func (s *userService) createUserRoleRelations(ctx context.Context, db bun.IDB, u
 				OrganizationId: ids.Organization, // Not really used
 				AccountId:      ids.Id,
 				Active:         true,
+				ExpiresAt:      expiresAt,
 			}
 			ars = append(ars, ar)
 
 			ps = append(ps, &authzv1.Policy{
-				Sub:  "u:" + user.GetMetadata().GetName(),
+				Sub:  sub,
 				Ns:   "*",
 				Proj: "*",
 				Org:  "*",
 				Obj:  role,
+				Eft:  effect,
 			})
 		case "organization":
 			if org == "" {
-				return &userv3.User{}, nil, fmt.Errorf("no org name provided for role '%v'", roleName)
+				return nil, fmt.Errorf("no org name provided for role '%v'", roleName)
 			}
 
 			ar := models.AccountResourcerole{
@@ -193,26 +245,28 @@ func (s *userService) createUserRoleRelations(ctx context.Context, db bun.IDB, u
 				OrganizationId: ids.Organization,
 				AccountId:      ids.Id,
 				Active:         true,
+				ExpiresAt:      expiresAt,
 			}
 			ars = append(ars, ar)
 
 			ps = append(ps, &authzv1.Policy{
-				Sub:  "u:" + user.GetMetadata().GetName(),
+				Sub:  sub,
 				Ns:   "*",
 				Proj: "*",
 				Org:  org,
 				Obj:  role,
+				Eft:  effect,
 			})
 		case "project":
 			if org == "" {
-				return &userv3.User{}, nil, fmt.Errorf("no org name provided for role '%v'", roleName)
+				return nil, fmt.Errorf("no org name provided for role '%v'", roleName)
 			}
 			if project == "" {
-				return &userv3.User{}, nil, fmt.Errorf("no project name provided for role '%v'", roleName)
+				return nil, fmt.Errorf("no project name provided for role '%v'", roleName)
 			}
 			projectId, err := dao.GetProjectId(ctx, db, project)
 			if err != nil {
-				return user, nil, fmt.Errorf("unable to find project '%v'", project)
+				return nil, fmt.Errorf("unable to find project '%v'", project)
 			}
 
 			par := models.ProjectAccountResourcerole{
@@ -226,26 +280,28 @@ func (s *userService) createUserRoleRelations(ctx context.Context, db bun.IDB, u
 				AccountId:      ids.Id,
 				ProjectId:      projectId,
 				Active:         true,
+				ExpiresAt:      expiresAt,
 			}
 			pars = append(pars, par)
 
 			ps = append(ps, &authzv1.Policy{
-				Sub:  "u:" + user.GetMetadata().GetName(),
+				Sub:  sub,
 				Ns:   "*",
 				Proj: project,
 				Org:  org,
 				Obj:  role,
+				Eft:  effect,
 			})
 		case "namespace":
 			if org == "" {
-				return &userv3.User{}, nil, fmt.Errorf("no org name provided for role '%v'", roleName)
+				return nil, fmt.Errorf("no org name provided for role '%v'", roleName)
 			}
 			if project == "" {
-				return &userv3.User{}, nil, fmt.Errorf("no project name provided for role '%v'", roleName)
+				return nil, fmt.Errorf("no project name provided for role '%v'", roleName)
 			}
 			projectId, err := dao.GetProjectId(ctx, db, project)
 			if err != nil {
-				return user, nil, fmt.Errorf("unable to find project '%v'", project)
+				return nil, fmt.Errorf("unable to find project '%v'", project)
 			}
 
 			namespace := pnr.GetNamespace()
@@ -260,49 +316,57 @@ func (s *userService) createUserRoleRelations(ctx context.Context, db bun.IDB, u
 				ProjectId:      projectId,
 				Namespace:      namespace,
 				Active:         true,
+				ExpiresAt:      expiresAt,
 			}
 			panr = append(panr, panrObj)
 
 			ps = append(ps, &authzv1.Policy{
-				Sub:  "u:" + user.GetMetadata().GetName(),
+				Sub:  sub,
 				Ns:   namespace,
 				Proj: project,
 				Org:  org,
 				Obj:  role,
+				Eft:  effect,
 			})
 		default:
 			if err != nil {
-				return user, nil, fmt.Errorf("unknown scope for role")
+				return nil, fmt.Errorf("unknown scope for role")
 			}
 		}
 	}
 	if len(pars) > 0 {
 		_, err := dao.Create(ctx, db, &pars)
 		if err != nil {
-			return &userv3.User{}, nil, err
+			return nil, err
 		}
 	}
 	if len(panr) > 0 {
 		_, err := dao.Create(ctx, db, &panr)
 		if err != nil {
-			return &userv3.User{}, nil, err
+			return nil, err
 		}
 	}
 	if len(ars) > 0 {
 		_, err := dao.Create(ctx, db, &ars)
 		if err != nil {
-			return &userv3.User{}, nil, err
+			return nil, err
+		}
+	}
+	if len(acsrs) > 0 {
+		_, err := dao.Create(ctx, db, &acsrs)
+		if err != nil {
+			return nil, err
 		}
 	}
 
 	if len(ps) > 0 {
-		success, err := s.azc.CreatePolicies(ctx, &authzv1.Policies{Policies: ps})
+		success, err := azc.CreatePolicies(ctx, &authzv1.Policies{Policies: ps})
 		if err != nil || !success.Res {
-			return &userv3.User{}, nil, fmt.Errorf("unable to create mapping in authz; %v", err)
+			return nil, fmt.Errorf("unable to create mapping in authz; %v", err)
 		}
 	}
 
-	return user, rids, nil
+	return rids, nil
 }
 
 // Update the groups mapped to each user(account)
@@ -523,6 +587,7 @@ func (s *userService) identitiesModelToUser(ctx context.Context, db bun.IDB, use
 		Groups:                groupNames,
 		IdpGroups:             idpGroups,
 		ProjectNamespaceRoles: roles,
+		Suspended:             usr.State == dao.KratosInactiveState,
 	}
 
 	return user, nil
@@ -667,10 +732,16 @@ func (s *userService) GetUserInfo(ctx context.Context, user *userv3.User) (*user
 }
 
 func (s *userService) deleteUserRoleRelations(ctx context.Context, db bun.IDB, userId uuid.UUID, user *userv3.User) ([]uuid.UUID, error) {
+	return deleteAccountRoleRelations(ctx, db, s.azc, userId, "u:"+user.GetMetadata().GetName())
+}
+
+// deleteAccountRoleRelations removes the roles of the account which is
+// known by sub in authz
+func deleteAccountRoleRelations(ctx context.Context, db bun.IDB, azc AuthzService, accountId uuid.UUID, sub string) ([]uuid.UUID, error) {
 	ids := []uuid.UUID{}
 
 	ar := []models.AccountResourcerole{}
-	err := dao.DeleteXR(ctx, db, "account_id", userId, &ar)
+	err := dao.DeleteXR(ctx, db, "account_id", accountId, &ar)
 	if err != nil {
 		return nil, err
 	}
@@ -679,7 +750,7 @@ func (s *userService) deleteUserRoleRelations(ctx context.Context, db bun.IDB, u
 	}
 
 	par := []models.ProjectAccountResourcerole{}
-	err = dao.DeleteXR(ctx, db, "account_id", userId, &par)
+	err = dao.DeleteXR(ctx, db, "account_id", accountId, &par)
 	if err != nil {
 		return nil, err
 	}
@@ -688,7 +759,7 @@ func (s *userService) deleteUserRoleRelations(ctx context.Context, db bun.IDB, u
 	}
 
 	panr := []models.ProjectAccountNamespaceRole{}
-	err = dao.DeleteXR(ctx, db, "account_id", userId, &panr)
+	err = dao.DeleteXR(ctx, db, "account_id", accountId, &panr)
 	if err != nil {
 		return nil, err
 	}
@@ -696,7 +767,16 @@ func (s *userService) deleteUserRoleRelations(ctx context.Context, db bun.IDB, u
 		ids = append(ids, r.RoleId)
 	}
 
-	_, err = s.azc.DeletePolicies(ctx, &authzv1.Policy{Sub: "u:" + user.GetMetadata().GetName()})
+	acsr := []models.AccountClusterSelectorRole{}
+	err = dao.DeleteXR(ctx, db, "account_id", accountId, &acsr)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range acsr {
+		ids = append(ids, r.RoleId)
+	}
+
+	_, err = azc.DeletePolicies(ctx, &authzv1.Policy{Sub: sub})
 	if err != nil {
 		return nil, fmt.Errorf("unable to delete user-role relations from authz; %v", err)
 	}
@@ -815,41 +895,238 @@ func (s *userService) Delete(ctx context.Context, user *userv3.User) (*userrpcv3
 	}
 
 	if usr, ok := entity.(*models.KratosIdentities); ok {
-
-		tx, err := s.db.BeginTx(ctx, &sql.TxOptions{})
+		err = s.delete(ctx, usr.ID, user)
 		if err != nil {
 			return &userrpcv3.UserDeleteApiKeysResponse{}, err
 		}
+		return &userrpcv3.UserDeleteApiKeysResponse{}, nil
+	}
+	return &userrpcv3.UserDeleteApiKeysResponse{}, fmt.Errorf("unable to delete user '%v'", user.Metadata.Name)
 
-		rolesBefore, err := s.deleteUserRoleRelations(ctx, tx, usr.ID, user)
+}
+
+func (s *userService) delete(ctx context.Context, userId uuid.UUID, user *userv3.User) error {
+	tx, err := s.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return err
+	}
+
+	rolesBefore, err := s.deleteUserRoleRelations(ctx, tx, userId, user)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	user, groupsBefore, err := s.deleteGroupAccountRelations(ctx, tx, userId, user)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("unable to delete user; %v", err)
+	}
+
+	err = dao.DeleteAccountInactivity(ctx, tx, userId)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	err = s.ap.Delete(ctx, userId.String())
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		tx.Rollback()
+		_log.Warn("unable to commit changes", err)
+	}
+
+	CreateUserAuditEvent(ctx, s.al, s.db, AuditActionDelete, user.GetMetadata().GetName(), userId, rolesBefore, []uuid.UUID{}, groupsBefore, []uuid.UUID{})
+	return nil
+}
+
+func (s *userService) Suspend(ctx context.Context, user *userv3.User) error {
+	name := user.GetMetadata().GetName()
+	usr := &models.KratosIdentities{}
+	_, err := dao.GetUserByEmail(ctx, s.db, name, usr)
+	if err != nil {
+		return fmt.Errorf("no user found with name '%v'", name)
+	}
+	sd, ok := GetSessionDataFromContext(ctx)
+	if !ok {
+		return fmt.Errorf("unable to suspend user without auth")
+	}
+	if sd.Username == name {
+		return fmt.Errorf("you cannot suspend your own account")
+	}
+	return s.suspend(ctx, usr.ID, name, sd)
+}
+
+// suspend deactivates the identity of the user and logs it out, its api
+// keys and kubeconfigs are refused while it is suspended
+func (s *userService) suspend(ctx context.Context, userId uuid.UUID, name string, sd *commonv3.SessionData) error {
+	err := s.ap.SetState(ctx, userId.String(), false)
+	if err != nil {
+		return fmt.Errorf("unable to suspend user '%v'; %v", name, err)
+	}
+	err = s.ap.RevokeSessions(ctx, userId.String())
+	if err != nil {
+		return fmt.Errorf("unable to logout user '%v'; %v", name, err)
+	}
+	err = dao.TerminateAccountSessions(ctx, s.db, userId, SessionTypeConsole)
+	if err != nil {
+		return err
+	}
+	CreateUserSuspendAuditEvent(s.al, sd, name, true)
+	return nil
+}
+
+func (s *userService) Reactivate(ctx context.Context, user *userv3.User) error {
+	name := user.GetMetadata().GetName()
+	usr := &models.KratosIdentities{}
+	_, err := dao.GetUserByEmail(ctx, s.db, name, usr)
+	if err != nil {
+		return fmt.Errorf("no user found with name '%v'", name)
+	}
+	sd, ok := GetSessionDataFromContext(ctx)
+	if !ok {
+		return fmt.Errorf("unable to reactivate user without auth")
+	}
+	err = s.ap.SetState(ctx, usr.ID.String(), true)
+	if err != nil {
+		return fmt.Errorf("unable to reactivate user '%v'; %v", name, err)
+	}
+	// warnings about inactivity before the user was reactivated no
+	// longer apply
+	err = dao.DeleteAccountInactivity(ctx, s.db, usr.ID)
+	if err != nil {
+		return err
+	}
+	CreateUserSuspendAuditEvent(s.al, sd, name, false)
+	return nil
+}
+
+// lastActive returns when the user last logged in, was created or was
+// reactivated, whichever is latest
+func (s *userService) lastActive(ctx context.Context, usr *models.KratosIdentities) (time.Time, error) {
+	since := usr.CreatedAt
+	if usr.State != dao.KratosInactiveState && usr.StateChangedAt.After(since) {
+		since = usr.StateChangedAt
+	}
+	authTime, err := dao.GetUserLastAuthTime(ctx, s.db, usr.ID)
+	if err != nil {
+		return since, err
+	}
+	if authTime.After(since) {
+		since = authTime
+	}
+	return since, nil
+}
+
+func (s *userService) ProcessInactive(ctx context.Context) error {
+	var orgs []models.Organization
+	_, err := dao.ListAll(ctx, s.db, &orgs)
+	if err != nil {
+		return err
+	}
+	for _, org := range orgs {
+		if org.Trash {
+			continue
+		}
+		settings, err := organizationSettings(&org)
 		if err != nil {
-			tx.Rollback()
-			return &userrpcv3.UserDeleteApiKeysResponse{}, err
+			_log.Warnw("unable to read settings of organization", "organization", org.Name, "error", err)
+			continue
 		}
-
-		user, groupsBefore, err := s.deleteGroupAccountRelations(ctx, tx, usr.ID, user)
+		policy := settings.GetInactivity()
+		if policy.GetSuspendAfterDays() <= 0 {
+			continue
+		}
+		err = s.processInactive(ctx, org.ID, policy)
 		if err != nil {
-			tx.Rollback()
-			return &userrpcv3.UserDeleteApiKeysResponse{}, fmt.Errorf("unable to delete user; %v", err)
+			return fmt.Errorf("unable to process inactive users of organization '%v'; %v", org.Name, err)
 		}
+	}
+	return nil
+}
+
+// processInactive suspends the users of the organization who have not
+// logged in for long and deletes the suspended ones who have not logged
+// in for even longer, users are warned ahead of both
+func (s *userService) processInactive(ctx context.Context, orgId uuid.UUID, policy *systemv3.InactivityPolicy) error {
+	const day = 24 * time.Hour
+	suspendAfter := time.Duration(policy.GetSuspendAfterDays()) * day
+	deleteAfter := time.Duration(policy.GetDeleteAfterDays()) * day
+	warnBefore := time.Duration(policy.GetWarnBeforeDays()) * day
 
-		err = s.ap.Delete(ctx, usr.ID.String())
+	usrs, err := dao.ListOrganizationIdentities(ctx, s.db, orgId)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	for i := range usrs {
+		usr := &usrs[i]
+		breakGlass, err := dao.IsBreakGlassAccount(ctx, s.db, usr.ID)
 		if err != nil {
-			tx.Rollback()
-			return &userrpcv3.UserDeleteApiKeysResponse{}, err
+			return err
 		}
-
-		err = tx.Commit()
+		if breakGlass {
+			continue
+		}
+		since, err := s.lastActive(ctx, usr)
 		if err != nil {
-			tx.Rollback()
-			_log.Warn("unable to commit changes", err)
+			return err
+		}
+		inactivity, err := dao.GetAccountInactivity(ctx, s.db, usr.ID)
+		if err != nil {
+			return err
 		}
+		name := getUserTraits(usr.Traits).Email
 
-		CreateUserAuditEvent(ctx, s.al, s.db, AuditActionDelete, user.GetMetadata().GetName(), usr.ID, rolesBefore, []uuid.UUID{}, groupsBefore, []uuid.UUID{})
-		return &userrpcv3.UserDeleteApiKeysResponse{}, nil
-	}
-	return &userrpcv3.UserDeleteApiKeysResponse{}, fmt.Errorf("unable to delete user '%v'", user.Metadata.Name)
+		if usr.State == dao.KratosInactiveState {
+			if deleteAfter <= 0 {
+				continue
+			}
+			deleteAt := since.Add(deleteAfter)
+			if !now.Before(deleteAt) {
+				ctx := context.WithValue(ctx, common.SessionDataKey, expiryActor)
+				err = s.delete(ctx, usr.ID, &userv3.User{Metadata: &v3.Metadata{Name: name}})
+				if err != nil {
+					return err
+				}
+				continue
+			}
+			if warnBefore > 0 && now.Add(warnBefore).After(deleteAt) && inactivity.DeleteWarnedAt.Before(since) {
+				CreateUserInactiveAuditEvent(s.al, name, true, since, deleteAt)
+				inactivity.DeleteWarnedAt = now
+				inactivity.ModifiedAt = now
+				err = dao.UpdateAccountInactivity(ctx, s.db, &inactivity)
+				if err != nil {
+					return err
+				}
+			}
+			continue
+		}
 
+		suspendAt := since.Add(suspendAfter)
+		if !now.Before(suspendAt) {
+			err = s.suspend(ctx, usr.ID, name, expiryActor)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		if warnBefore > 0 && now.Add(warnBefore).After(suspendAt) && inactivity.SuspendWarnedAt.Before(since) {
+			CreateUserInactiveAuditEvent(s.al, name, false, since, suspendAt)
+			inactivity.SuspendWarnedAt = now
+			inactivity.ModifiedAt = now
+			err = dao.UpdateAccountInactivity(ctx, s.db, &inactivity)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
 
 func (s *userService) List(ctx context.Context, opts ...query.Option) (*userv3.UserList, error) {
@@ -968,6 +1245,41 @@ func (s *userService) List(ctx context.Context, opts ...query.Option) (*userv3.U
 	return userList, nil
 }
 
+func (s *userService) ListWithoutMFA(ctx context.Context, opts ...query.Option) (*userv3.UserList, error) {
+	queryOptions := v3.QueryOptions{}
+	for _, opt := range opts {
+		opt(&queryOptions)
+	}
+
+	_, orgId, err := getPartnerOrganization(ctx, s.db, queryOptions.Partner, queryOptions.Organization)
+	if err != nil {
+		return &userv3.UserList{}, fmt.Errorf("unable to find partner and org")
+	}
+
+	usrs, count, err := dao.ListUsersWithoutMFA(ctx, s.db, orgId, int(queryOptions.Limit), int(queryOptions.Offset))
+	if err != nil {
+		return &userv3.UserList{}, err
+	}
+
+	users := []*userv3.User{}
+	for _, usr := range usrs {
+		user, err := s.identitiesModelToUser(ctx, s.db, &userv3.User{}, &usr)
+		if err != nil {
+			return &userv3.UserList{}, err
+		}
+		users = append(users, user)
+	}
+
+	return &userv3.UserList{
+		ApiVersion: apiVersion,
+		Kind:       userListKind,
+		Metadata: &v3.ListMetadata{
+			Count: int64(count),
+		},
+		Items: users,
+	}, nil
+}
+
 func (s *userService) RetrieveCliConfig(ctx context.Context, req *userrpcv3.ApiKeyRequest) (*common.CliConfigDownloadData, error) {
 	// get the default project associated to this account
 	ap, err := dao.GetDefaultAccountProject(ctx, s.db, uuid.MustParse(req.Id))
@@ -133,6 +133,42 @@ func TestCreateUserWithRole(t *testing.T) {
 	}
 }
 
+func TestCreateUserWithClusterSelectorRole(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	ap := &mockAuthProvider{}
+	mazc := mockAuthzClient{}
+	us := NewUserService(ap, db, &mazc, nil, common.CliConfigDownloadData{}, getLogger(), true)
+
+	uuuid := uuid.New().String()
+	puuid, ouuid := addParterOrgFetchExpectation(mock)
+	mock.ExpectBegin()
+	ruuid := addResourceRoleFetchExpectation(mock, "project")
+	pruuid := addFetchIdExpectation(mock, "project")
+	mock.ExpectQuery(`INSERT INTO "authsrv_accountclusterselectorrole" .*'env=prod'`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uuid.New().String()))
+	mock.ExpectCommit()
+
+	project := "project-" + pruuid
+	selector := "env=prod"
+	user := &userv3.User{
+		Metadata: &v3.Metadata{Partner: "partner-" + puuid, Organization: "org-" + ouuid, Name: "user-" + uuuid},
+		Spec: &userv3.UserSpec{ProjectNamespaceRoles: []*userv3.ProjectNamespaceRole{
+			{Role: idname(ruuid, "role"), Project: &project, ClusterSelector: &selector},
+		}},
+	}
+	_, err := us.Create(context.Background(), user)
+	if err != nil {
+		t.Fatal("could not create user:", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+	// bindings scoped by cluster selectors have no authz policies
+	performBasicAuthzChecks(t, mazc, 0, 0, 0, 0, 0, 0)
+}
+
 func TestUpdateUser(t *testing.T) {
 	db, mock := getDB(t)
 	defer db.Close()
@@ -365,6 +401,8 @@ func TestUserGetByName(t *testing.T) {
 		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"role", "project"}).AddRow("role-"+ruuid, "project-"+puuid))
 	mock.ExpectQuery(`SELECT authsrv_resourcerole.name as role, authsrv_project.name as project, namespace, authsrv_group.name as group FROM "authsrv_projectgroupnamespacerole"`).
 		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"role", "project"}).AddRow("role-"+ruuid, "project-"+puuid))
+	mock.ExpectQuery(`SELECT authsrv_resourcerole.name as role, authsrv_project.name as project, cluster_selector, authsrv_group.name as group FROM "authsrv_groupclusterselectorrole"`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"role", "project", "cluster_selector"}))
 
 	mock.ExpectQuery(`SELECT authsrv_resourcerole.name as role FROM "authsrv_accountresourcerole" JOIN authsrv_resourcerole ON authsrv_resourcerole.id=authsrv_accountresourcerole.role_id WHERE .authsrv_accountresourcerole.account_id = '` + uuuid + `'`).
 		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"role"}).AddRow("role-" + ruuid))
@@ -373,6 +411,8 @@ func TestUserGetByName(t *testing.T) {
 		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"role", "project"}).AddRow("role-"+ruuid, "project-"+pruuid))
 	mock.ExpectQuery(`SELECT authsrv_resourcerole.name as role, authsrv_project.name as project, namespace FROM "authsrv_projectaccountnamespacerole" JOIN authsrv_resourcerole ON authsrv_resourcerole.id=authsrv_projectaccountnamespacerole.role_id JOIN authsrv_project ON authsrv_project.id=authsrv_projectaccountnamespacerole.project_id WHERE .authsrv_projectaccountnamespacerole.account_id = '` + uuuid + `'`).
 		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"role", "project", "namespace"}).AddRow("role-"+ruuid, "project-"+pruuid, "ns"))
+	mock.ExpectQuery(`SELECT authsrv_resourcerole.name as role, authsrv_project.name as project, cluster_selector FROM "authsrv_accountclusterselectorrole" .* WHERE .authsrv_accountclusterselectorrole.account_id = '` + uuuid + `'`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"role", "project", "cluster_selector"}))
 	mock.ExpectQuery(`select .* from sessions where .*`).
 		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"max"}).
 		AddRow(authenticated))
@@ -428,12 +468,16 @@ func TestUserGetInfo(t *testing.T) {
 		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"role", "project"}).AddRow("role-"+ruuid, "project-"+pruuid))
 	mock.ExpectQuery(`SELECT authsrv_resourcerole.name as role, authsrv_project.name as project, namespace, authsrv_group.name as group FROM "authsrv_projectgroupnamespacerole"`).
 		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"role", "project"}).AddRow("role-"+ruuid, "project-"+pruuid))
+	mock.ExpectQuery(`SELECT authsrv_resourcerole.name as role, authsrv_project.name as project, cluster_selector, authsrv_group.name as group FROM "authsrv_groupclusterselectorrole"`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"role", "project", "cluster_selector"}))
 	mock.ExpectQuery(`SELECT authsrv_resourcerole.name as role FROM "authsrv_accountresourcerole" JOIN authsrv_resourcerole ON authsrv_resourcerole.id=authsrv_accountresourcerole.role_id WHERE .authsrv_accountresourcerole.account_id = '` + uuuid + `'`).
 		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"role"}).AddRow("role-" + ruuid))
 	mock.ExpectQuery(`SELECT distinct authsrv_resourcerole.name as role, authsrv_project.name as project FROM "authsrv_projectaccountresourcerole" JOIN authsrv_resourcerole ON authsrv_resourcerole.id=authsrv_projectaccountresourcerole.role_id JOIN authsrv_project ON authsrv_project.id=authsrv_projectaccountresourcerole.project_id WHERE .authsrv_projectaccountresourcerole.account_id = '` + uuuid + `'`).
 		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"role", "project"}).AddRow("role-"+ruuid, "project-"+pruuid))
 	mock.ExpectQuery(`SELECT authsrv_resourcerole.name as role, authsrv_project.name as project, namespace FROM "authsrv_projectaccountnamespacerole" JOIN authsrv_resourcerole ON authsrv_resourcerole.id=authsrv_projectaccountnamespacerole.role_id JOIN authsrv_project ON authsrv_project.id=authsrv_projectaccountnamespacerole.project_id WHERE .authsrv_projectaccountnamespacerole.account_id = '` + uuuid + `'`).
 		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"role", "project", "namespace"}).AddRow("role-"+ruuid, "project-"+pruuid, "ns"))
+	mock.ExpectQuery(`SELECT authsrv_resourcerole.name as role, authsrv_project.name as project, cluster_selector FROM "authsrv_accountclusterselectorrole" .* WHERE .authsrv_accountclusterselectorrole.account_id = '` + uuuid + `'`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"role", "project", "cluster_selector"}))
 	mock.ExpectQuery(`SELECT "resourcerole"."id", "resourcerole"."scope" FROM "authsrv_resourcerole" AS "resourcerole" WHERE .name = 'role-` + ruuid + `'. AND .trash = FALSE.`).
 		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id", "scope", "name"}).AddRow(ruuid, fakescope, "role-"+ruuid))
 	mock.ExpectQuery(`SELECT authsrv_resourcepermission.name as name FROM "authsrv_resourcepermission" JOIN authsrv_resourcerolepermission ON authsrv_resourcerolepermission.resource_permission_id=authsrv_resourcepermission.id WHERE .authsrv_resourcerolepermission.resource_role_id = '` + ruuid + `'. AND .authsrv_resourcepermission.trash = FALSE. AND .authsrv_resourcerolepermission.trash = FALSE.`).
@@ -674,6 +718,8 @@ func TestUserDelete(t *testing.T) {
 	_ = addUserRoleMappingsUpdateExpectation(mock, uuuid)
 	// User delete is via kratos
 	addUserGroupMappingsUpdateExpectation(mock, uuuid)
+	mock.ExpectExec(`DELETE FROM "authsrv_accountinactivity" AS "accountinactivity" WHERE .account_id = '` + uuuid + `'.`).
+		WillReturnResult(sqlmock.NewResult(0, 0))
 	mock.ExpectCommit()
 
 	user := &userv3.User{
@@ -867,3 +913,109 @@ func TestCreateLoginAuditLog(t *testing.T) {
 	}
 
 }
+
+func TestUserSuspend(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	ap := &mockAuthProvider{}
+	mazc := mockAuthzClient{}
+	us := NewUserService(ap, db, &mazc, nil, common.CliConfigDownloadData{}, getLogger(), true)
+
+	uuuid := uuid.New().String()
+	mock.ExpectQuery(`SELECT "identities"."id", .* FROM "identities" WHERE .traits ->> 'email' = 'user-` + uuuid + `'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "traits", "state"}).AddRow(uuuid, []byte(`{"email":"user-`+uuuid+`"}`), "active"))
+	mock.ExpectExec(`UPDATE "authsrv_sessionactivity" AS "sessionactivity" SET terminated = TRUE WHERE .account_id = '` + uuuid + `'. AND .kind = 'console'.`).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	user := &userv3.User{Metadata: &v3.Metadata{Name: "user-" + uuuid}}
+	ctx := context.WithValue(context.Background(), common.SessionDataKey, &v3.SessionData{Username: "not-user-" + uuuid})
+	err := us.Suspend(ctx, user)
+	if err != nil {
+		t.Fatal("could not suspend user:", err)
+	}
+	if len(ap.st) != 1 || ap.st[0] {
+		t.Errorf("identity of user should be deactivated, got %v", ap.st)
+	}
+	if len(ap.rs) != 1 || ap.rs[0] != uuuid {
+		t.Errorf("sessions of user should be revoked, revoked %v", ap.rs)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestUserSuspendSelf(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	ap := &mockAuthProvider{}
+	mazc := mockAuthzClient{}
+	us := NewUserService(ap, db, &mazc, nil, common.CliConfigDownloadData{}, getLogger(), true)
+
+	uuuid := uuid.New().String()
+	mock.ExpectQuery(`SELECT "identities"."id", .* FROM "identities" WHERE .traits ->> 'email' = 'user-` + uuuid + `'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "traits", "state"}).AddRow(uuuid, []byte(`{"email":"user-`+uuuid+`"}`), "active"))
+
+	user := &userv3.User{Metadata: &v3.Metadata{Name: "user-" + uuuid}}
+	ctx := context.WithValue(context.Background(), common.SessionDataKey, &v3.SessionData{Username: "user-" + uuuid})
+	err := us.Suspend(ctx, user)
+	if err == nil {
+		t.Fatal("user able to suspend their own account")
+	}
+	if len(ap.st) != 0 {
+		t.Errorf("identity of user should not be deactivated, got %v", ap.st)
+	}
+}
+
+func addInactivityCheckExpectation(mock sqlmock.Sqlmock, uuuid string, lastLogin time.Time) {
+	mock.ExpectQuery(`SELECT EXISTS \(SELECT .* FROM "authsrv_breakglassaccount" AS "breakglassaccount" WHERE .account_id = '` + uuuid + `'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+	mock.ExpectQuery(`select max\(authenticated_at\) from sessions where identity_id = '` + uuuid + `'`).
+		WillReturnRows(sqlmock.NewRows([]string{"max"}).AddRow(lastLogin))
+	mock.ExpectQuery(`SELECT "accountinactivity"."account_id", .* FROM "authsrv_accountinactivity" AS "accountinactivity" WHERE .account_id = '` + uuuid + `'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"account_id"}))
+}
+
+func TestUserProcessInactive(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	ap := &mockAuthProvider{}
+	mazc := mockAuthzClient{}
+	us := NewUserService(ap, db, &mazc, nil, common.CliConfigDownloadData{}, getLogger(), true)
+
+	ouuid := uuid.New().String()
+	inactiveuuid := uuid.New().String()
+	idleuuid := uuid.New().String()
+	activeuuid := uuid.New().String()
+	created := time.Now().Add(-365 * 24 * time.Hour)
+	mock.ExpectQuery(`SELECT "organization"."id", .* FROM "authsrv_organization" AS "organization"`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "settings", "trash"}).
+			AddRow(ouuid, "org-"+ouuid, []byte(`{"inactivity":{"suspendAfterDays":30,"deleteAfterDays":90,"warnBeforeDays":7}}`), false).
+			AddRow(uuid.New().String(), "org-without-policy", []byte(`{"idleLogoutMin":60}`), false))
+	mock.ExpectQuery(`SELECT "identities"."id", .* FROM "identities" WHERE .identities.metadata_public ->> 'Organization' = '` + ouuid + `'.`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "traits", "state", "created_at", "state_changed_at"}).
+			AddRow(inactiveuuid, []byte(`{"email":"inactive@provider.com"}`), "active", created, created).
+			AddRow(idleuuid, []byte(`{"email":"idle@provider.com"}`), "active", created, created).
+			AddRow(activeuuid, []byte(`{"email":"active@provider.com"}`), "active", created, created))
+
+	addInactivityCheckExpectation(mock, inactiveuuid, time.Now().Add(-40*24*time.Hour))
+	mock.ExpectExec(`UPDATE "authsrv_sessionactivity" AS "sessionactivity" SET terminated = TRUE WHERE .account_id = '` + inactiveuuid + `'. AND .kind = 'console'.`).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	addInactivityCheckExpectation(mock, idleuuid, time.Now().Add(-25*24*time.Hour))
+	mock.ExpectExec(`INSERT INTO "authsrv_accountinactivity" AS "accountinactivity" .* VALUES \('` + idleuuid + `', '.*', DEFAULT, .* ON CONFLICT \(account_id\) DO UPDATE`).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	addInactivityCheckExpectation(mock, activeuuid, time.Now().Add(-time.Hour))
+
+	err := us.ProcessInactive(context.Background())
+	if err != nil {
+		t.Fatal("could not process inactive users:", err)
+	}
+	if len(ap.st) != 1 || ap.st[0] || len(ap.rs) != 1 || ap.rs[0] != inactiveuuid {
+		t.Errorf("only the user inactive for longer than allowed should be suspended; deactivated %v, logged out %v", ap.st, ap.rs)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
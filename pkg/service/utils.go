@@ -2,12 +2,15 @@ package service
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/paralus/paralus/internal/dao"
 	"github.com/paralus/paralus/pkg/common"
 	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
 	"github.com/uptrace/bun"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func getPartnerOrganization(ctx context.Context, db bun.IDB, partner, org string) (uuid.UUID, uuid.UUID, error) {
@@ -33,3 +36,16 @@ func IsInternalRequest(ctx context.Context) bool {
 	b, ok := v.(bool)
 	return ok && b
 }
+
+// getExpiry returns the expiry time of a group membership or role
+// binding, zero time is returned when it does not expire
+func getExpiry(ts *timestamppb.Timestamp) (time.Time, error) {
+	if ts == nil {
+		return time.Time{}, nil
+	}
+	t := ts.AsTime()
+	if !t.After(time.Now()) {
+		return time.Time{}, fmt.Errorf("expiry time %v is in the past", t.Format(time.RFC3339))
+	}
+	return t, nil
+}
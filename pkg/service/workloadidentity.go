@@ -0,0 +1,455 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/google/uuid"
+	"github.com/paralus/paralus/internal/dao"
+	"github.com/paralus/paralus/internal/models"
+	"github.com/paralus/paralus/pkg/crypto"
+	"github.com/paralus/paralus/pkg/query"
+	userrpcv3 "github.com/paralus/paralus/proto/rpc/user"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	userv3 "github.com/paralus/paralus/proto/types/userpb/v3"
+	bun "github.com/uptrace/bun"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	workloadIdentityPolicyKind     = "WorkloadIdentityPolicy"
+	workloadIdentityPolicyListKind = "WorkloadIdentityPolicyList"
+
+	// defaultWorkloadIdentityTTL is how long exchanged credentials are
+	// valid for when the policy does not say
+	defaultWorkloadIdentityTTL = 15 * time.Minute
+	maxWorkloadIdentityTTL     = time.Hour
+)
+
+// ErrWorkloadIdentityDenied is returned when an OIDC token can not be
+// exchanged, the cause is only recorded in the audit trail
+var ErrWorkloadIdentityDenied = errors.New("oidc token is not trusted")
+
+// WorkloadIdentityService is the interface for exchanging OIDC tokens of
+// external issuers for Paralus credentials
+type WorkloadIdentityService interface {
+	// create workload identity policy
+	Create(context.Context, *userv3.WorkloadIdentityPolicy) (*userv3.WorkloadIdentityPolicy, error)
+	// get workload identity policy by name
+	GetByName(context.Context, *userv3.WorkloadIdentityPolicy) (*userv3.WorkloadIdentityPolicy, error)
+	// update workload identity policy
+	Update(context.Context, *userv3.WorkloadIdentityPolicy) (*userv3.WorkloadIdentityPolicy, error)
+	// delete workload identity policy
+	Delete(context.Context, *userv3.WorkloadIdentityPolicy) error
+	// list workload identity policies of a project
+	List(context.Context, ...query.Option) (*userv3.WorkloadIdentityPolicyList, error)
+	// authenticate an OIDC token with the policy, returns the session of
+	// the service account and how long its credentials are valid for
+	Authenticate(context.Context, *userrpcv3.WorkloadIdentityTokenRequest, *commonv3.SessionData) (*commonv3.SessionData, time.Duration, error)
+	// exchange an OIDC token for a short-lived api key
+	ExchangeToken(context.Context, *userrpcv3.WorkloadIdentityTokenRequest, *commonv3.SessionData) (*userrpcv3.WorkloadIdentityCredentials, error)
+	// remove expired api keys
+	RemoveExpiredCredentials(context.Context) error
+}
+
+// workloadIdentityService implements WorkloadIdentityService
+type workloadIdentityService struct {
+	db   *bun.DB
+	al   *zap.Logger
+	jwks *jwksCache
+}
+
+// NewWorkloadIdentityService return new workload identity service
+func NewWorkloadIdentityService(db *bun.DB, al *zap.Logger) WorkloadIdentityService {
+	return &workloadIdentityService{db: db, al: al, jwks: newJWKSCache()}
+}
+
+// validateIssuerURL checks the URL of the issuer or its keys, keys are
+// only fetched over https unless served locally
+func validateIssuerURL(name, v string) error {
+	u, err := url.Parse(v)
+	if err != nil || u.Host == "" {
+		return fmt.Errorf("%s should be a URL", name)
+	}
+	if u.Scheme == "https" {
+		return nil
+	}
+	host := u.Hostname()
+	if ip := net.ParseIP(host); u.Scheme == "http" && (host == "localhost" || (ip != nil && ip.IsLoopback())) {
+		return nil
+	}
+	return fmt.Errorf("%s should be a https URL", name)
+}
+
+func validateWorkloadIdentityPolicy(policy *userv3.WorkloadIdentityPolicy) error {
+	spec := policy.GetSpec()
+	if err := validateIssuerURL("issuer", spec.GetIssuer()); err != nil {
+		return err
+	}
+	if spec.GetJwksUri() != "" {
+		if err := validateIssuerURL("jwks uri", spec.GetJwksUri()); err != nil {
+			return err
+		}
+	}
+	if spec.GetAudience() == "" {
+		return fmt.Errorf("audience of the oidc tokens should be set")
+	}
+	// issuers like GitHub Actions issue tokens to every repository, the
+	// claims decide which of them are trusted
+	if len(spec.GetClaims()) == 0 {
+		return fmt.Errorf("claims of the oidc tokens should be set")
+	}
+	if spec.GetTtlSeconds() < 0 || time.Duration(spec.GetTtlSeconds())*time.Second > maxWorkloadIdentityTTL {
+		return fmt.Errorf("ttl should not exceed %v", maxWorkloadIdentityTTL)
+	}
+	return nil
+}
+
+// matchClaim checks the claim of the token against the value of the
+// policy, values ending with * match by prefix
+func matchClaim(got interface{}, want string) bool {
+	var v string
+	switch c := got.(type) {
+	case string:
+		v = c
+	case bool, float64:
+		v = fmt.Sprint(c)
+	default:
+		return false
+	}
+	if strings.HasSuffix(want, "*") {
+		return strings.HasPrefix(v, strings.TrimSuffix(want, "*"))
+	}
+	return v == want
+}
+
+func (s *workloadIdentityService) getIds(ctx context.Context, partner, org, project string) (uuid.UUID, uuid.UUID, uuid.UUID, error) {
+	partnerId, err := dao.GetPartnerId(ctx, s.db, partner)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, uuid.Nil, fmt.Errorf("unable to find partner '%v'", partner)
+	}
+	orgId, err := dao.GetOrganizationId(ctx, s.db, org)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, uuid.Nil, fmt.Errorf("unable to find organization '%v'", org)
+	}
+	projectId, err := dao.GetProjectId(ctx, s.db, project)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, uuid.Nil, fmt.Errorf("unable to find project '%v'", project)
+	}
+	return partnerId, orgId, projectId, nil
+}
+
+// getServiceAccount gets the service account the policy exchanges
+// tokens for, it should be scoped to the project of the policy so that
+// project admins can not federate roles outside their project
+func (s *workloadIdentityService) getServiceAccount(ctx context.Context, name string, partnerId, orgId, projectId uuid.UUID) (*models.ServiceAccount, error) {
+	if name == "" {
+		return nil, fmt.Errorf("service account of the policy should be set")
+	}
+	var sa models.ServiceAccount
+	_, err := dao.GetByNamePartnerOrg(ctx, s.db, name,
+		uuid.NullUUID{UUID: partnerId, Valid: true},
+		uuid.NullUUID{UUID: orgId, Valid: true},
+		&sa,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find service account '%v'", name)
+	}
+	if !sa.ProjectId.Valid || sa.ProjectId.UUID != projectId {
+		return nil, fmt.Errorf("service account '%v' should be scoped to the project of the policy", name)
+	}
+	return &sa, nil
+}
+
+func (s *workloadIdentityService) toPolicy(policy *models.WorkloadIdentityPolicy, serviceAccount, partner, org, project string) *userv3.WorkloadIdentityPolicy {
+	return &userv3.WorkloadIdentityPolicy{
+		ApiVersion: apiVersion,
+		Kind:       workloadIdentityPolicyKind,
+		Metadata: &commonv3.Metadata{
+			Name:         policy.Name,
+			Description:  policy.Description,
+			Id:           policy.ID.String(),
+			Partner:      partner,
+			Organization: org,
+			Project:      project,
+			CreatedAt:    timestamppb.New(policy.CreatedAt),
+			ModifiedAt:   timestamppb.New(policy.ModifiedAt),
+		},
+		Spec: &userv3.WorkloadIdentityPolicySpec{
+			Issuer:         policy.Issuer,
+			JwksUri:        policy.JwksUri,
+			Audience:       policy.Audience,
+			Claims:         policy.Claims,
+			ServiceAccount: serviceAccount,
+			TtlSeconds:     policy.TTLSeconds,
+		},
+	}
+}
+
+func (s *workloadIdentityService) Create(ctx context.Context, policy *userv3.WorkloadIdentityPolicy) (*userv3.WorkloadIdentityPolicy, error) {
+	md := policy.GetMetadata()
+	if md.GetName() == "" {
+		return nil, fmt.Errorf("empty name for workload identity policy")
+	}
+	partnerId, orgId, projectId, err := s.getIds(ctx, md.GetPartner(), md.GetOrganization(), md.GetProject())
+	if err != nil {
+		return nil, err
+	}
+	if existing, _ := dao.GetWorkloadIdentityPolicy(ctx, s.db, md.GetName(), projectId); existing != nil {
+		return nil, fmt.Errorf("workload identity policy '%v' already exists", md.GetName())
+	}
+	if err := validateWorkloadIdentityPolicy(policy); err != nil {
+		return nil, err
+	}
+	sa, err := s.getServiceAccount(ctx, policy.GetSpec().GetServiceAccount(), partnerId, orgId, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	spec := policy.GetSpec()
+	mp := &models.WorkloadIdentityPolicy{
+		Name:             md.GetName(),
+		Description:      md.GetDescription(),
+		OrganizationId:   orgId,
+		PartnerId:        partnerId,
+		ProjectId:        projectId,
+		Issuer:           spec.GetIssuer(),
+		JwksUri:          spec.GetJwksUri(),
+		Audience:         spec.GetAudience(),
+		Claims:           spec.GetClaims(),
+		ServiceAccountId: sa.ID,
+		TTLSeconds:       spec.GetTtlSeconds(),
+		CreatedAt:        time.Now(),
+		ModifiedAt:       time.Now(),
+	}
+	_, err = dao.Create(ctx, s.db, mp)
+	if err != nil {
+		return nil, err
+	}
+
+	CreateWorkloadIdentityPolicyAuditEvent(ctx, s.al, AuditActionCreate, mp.Name, sa.Name, md.GetProject())
+	return s.toPolicy(mp, sa.Name, md.GetPartner(), md.GetOrganization(), md.GetProject()), nil
+}
+
+func (s *workloadIdentityService) GetByName(ctx context.Context, policy *userv3.WorkloadIdentityPolicy) (*userv3.WorkloadIdentityPolicy, error) {
+	md := policy.GetMetadata()
+	_, _, projectId, err := s.getIds(ctx, md.GetPartner(), md.GetOrganization(), md.GetProject())
+	if err != nil {
+		return nil, err
+	}
+	mp, err := dao.GetWorkloadIdentityPolicy(ctx, s.db, md.GetName(), projectId)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find workload identity policy '%v'", md.GetName())
+	}
+	var sa models.ServiceAccount
+	_, err = dao.GetNameById(ctx, s.db, mp.ServiceAccountId, &sa)
+	if err != nil {
+		return nil, err
+	}
+	return s.toPolicy(mp, sa.Name, md.GetPartner(), md.GetOrganization(), md.GetProject()), nil
+}
+
+func (s *workloadIdentityService) Update(ctx context.Context, policy *userv3.WorkloadIdentityPolicy) (*userv3.WorkloadIdentityPolicy, error) {
+	md := policy.GetMetadata()
+	partnerId, orgId, projectId, err := s.getIds(ctx, md.GetPartner(), md.GetOrganization(), md.GetProject())
+	if err != nil {
+		return nil, err
+	}
+	mp, err := dao.GetWorkloadIdentityPolicy(ctx, s.db, md.GetName(), projectId)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find workload identity policy '%v'", md.GetName())
+	}
+	if err := validateWorkloadIdentityPolicy(policy); err != nil {
+		return nil, err
+	}
+	sa, err := s.getServiceAccount(ctx, policy.GetSpec().GetServiceAccount(), partnerId, orgId, projectId)
+	if err != nil {
+		return nil, err
+	}
+
+	spec := policy.GetSpec()
+	mp.Description = md.GetDescription()
+	mp.Issuer = spec.GetIssuer()
+	mp.JwksUri = spec.GetJwksUri()
+	mp.Audience = spec.GetAudience()
+	mp.Claims = spec.GetClaims()
+	mp.ServiceAccountId = sa.ID
+	mp.TTLSeconds = spec.GetTtlSeconds()
+	mp.ModifiedAt = time.Now()
+	_, err = dao.Update(ctx, s.db, mp.ID, mp)
+	if err != nil {
+		return nil, err
+	}
+
+	CreateWorkloadIdentityPolicyAuditEvent(ctx, s.al, AuditActionUpdate, mp.Name, sa.Name, md.GetProject())
+	return s.toPolicy(mp, sa.Name, md.GetPartner(), md.GetOrganization(), md.GetProject()), nil
+}
+
+func (s *workloadIdentityService) Delete(ctx context.Context, policy *userv3.WorkloadIdentityPolicy) error {
+	md := policy.GetMetadata()
+	_, _, projectId, err := s.getIds(ctx, md.GetPartner(), md.GetOrganization(), md.GetProject())
+	if err != nil {
+		return err
+	}
+	mp, err := dao.GetWorkloadIdentityPolicy(ctx, s.db, md.GetName(), projectId)
+	if err != nil {
+		return fmt.Errorf("unable to find workload identity policy '%v'", md.GetName())
+	}
+	err = dao.Delete(ctx, s.db, mp.ID, &models.WorkloadIdentityPolicy{})
+	if err != nil {
+		return err
+	}
+
+	CreateWorkloadIdentityPolicyAuditEvent(ctx, s.al, AuditActionDelete, mp.Name, "", md.GetProject())
+	return nil
+}
+
+func (s *workloadIdentityService) List(ctx context.Context, opts ...query.Option) (*userv3.WorkloadIdentityPolicyList, error) {
+	policyList := &userv3.WorkloadIdentityPolicyList{
+		ApiVersion: apiVersion,
+		Kind:       workloadIdentityPolicyListKind,
+		Metadata: &commonv3.ListMetadata{
+			Count: 0,
+		},
+	}
+
+	queryOptions := commonv3.QueryOptions{}
+	for _, opt := range opts {
+		opt(&queryOptions)
+	}
+
+	_, _, projectId, err := s.getIds(ctx, queryOptions.Partner, queryOptions.Organization, queryOptions.Project)
+	if err != nil {
+		return policyList, err
+	}
+	policies, err := dao.ListWorkloadIdentityPolicies(ctx, s.db, projectId)
+	if err != nil {
+		return policyList, err
+	}
+	for i := range policies {
+		var sa models.ServiceAccount
+		_, err = dao.GetNameById(ctx, s.db, policies[i].ServiceAccountId, &sa)
+		if err != nil {
+			return policyList, err
+		}
+		policyList.Items = append(policyList.Items, s.toPolicy(&policies[i], sa.Name, queryOptions.Partner, queryOptions.Organization, queryOptions.Project))
+	}
+	policyList.Metadata.Count = int64(len(policyList.Items))
+	return policyList, nil
+}
+
+// verify checks the signature, issuer, audience and claims of the OIDC
+// token against the policy
+func (s *workloadIdentityService) verify(ctx context.Context, policy *models.WorkloadIdentityPolicy, token string) (jwt.MapClaims, error) {
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(token, claims, func(t *jwt.Token) (interface{}, error) {
+		// keys are not fetched for tokens of other issuers
+		if !claims.VerifyIssuer(policy.Issuer, true) {
+			return nil, fmt.Errorf("token should be issued by '%v'", policy.Issuer)
+		}
+		uri := policy.JwksUri
+		if uri == "" {
+			var err error
+			uri, err = s.jwks.discover(ctx, policy.Issuer)
+			if err != nil {
+				return nil, err
+			}
+		}
+		kid, _ := t.Header["kid"].(string)
+		return s.jwks.key(ctx, uri, kid)
+	}, jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "ES256", "ES384", "ES512", "EdDSA"}))
+	if err != nil {
+		return claims, err
+	}
+	if !claims.VerifyAudience(policy.Audience, true) {
+		return claims, fmt.Errorf("token should be issued for '%v'", policy.Audience)
+	}
+	if !claims.VerifyExpiresAt(time.Now().Unix(), true) {
+		return claims, fmt.Errorf("token should have an expiry time")
+	}
+	for name, want := range policy.Claims {
+		if !matchClaim(claims[name], want) {
+			return claims, fmt.Errorf("claim '%v' of the token does not match", name)
+		}
+	}
+	return claims, nil
+}
+
+// Authenticate is called without a session, sd has the client details
+// of the request
+func (s *workloadIdentityService) Authenticate(ctx context.Context, req *userrpcv3.WorkloadIdentityTokenRequest, sd *commonv3.SessionData) (*commonv3.SessionData, time.Duration, error) {
+	partnerId, orgId, projectId, err := s.getIds(ctx, req.GetPartner(), req.GetOrganization(), req.GetProject())
+	if err != nil {
+		return nil, 0, err
+	}
+	policy, err := dao.GetWorkloadIdentityPolicy(ctx, s.db, req.GetPolicy(), projectId)
+	if err != nil {
+		return nil, 0, fmt.Errorf("unable to find workload identity policy '%v'", req.GetPolicy())
+	}
+
+	sd.Partner = partnerId.String()
+	sd.Organization = orgId.String()
+	claims, err := s.verify(ctx, policy, req.GetToken())
+	if err != nil {
+		subject, _ := claims["sub"].(string)
+		sd.Username = subject
+		CreateWorkloadIdentityExchangeAuditEvent(s.al, sd, policy.Name, subject, req.GetProject(), err.Error())
+		return nil, 0, ErrWorkloadIdentityDenied
+	}
+	subject, _ := claims["sub"].(string)
+	sa, err := dao.GetServiceAccount(ctx, s.db, policy.ServiceAccountId)
+	if err != nil {
+		CreateWorkloadIdentityExchangeAuditEvent(s.al, sd, policy.Name, subject, req.GetProject(), "service account of the policy is deleted")
+		return nil, 0, ErrWorkloadIdentityDenied
+	}
+
+	sd.Account = sa.ID.String()
+	sd.Username = sa.Username
+	sd.IsServiceAccount = true
+	ttl := time.Duration(policy.TTLSeconds) * time.Second
+	if ttl <= 0 {
+		ttl = defaultWorkloadIdentityTTL
+	}
+	CreateWorkloadIdentityExchangeAuditEvent(s.al, sd, policy.Name, subject, req.GetProject(), "")
+	return sd, ttl, nil
+}
+
+func (s *workloadIdentityService) ExchangeToken(ctx context.Context, req *userrpcv3.WorkloadIdentityTokenRequest, sd *commonv3.SessionData) (*userrpcv3.WorkloadIdentityCredentials, error) {
+	sd, ttl, err := s.Authenticate(ctx, req, sd)
+	if err != nil {
+		return nil, err
+	}
+	apikey := &models.ApiKey{
+		Name:           sd.Username,
+		Description:    fmt.Sprintf("workload identity policy %s", req.GetPolicy()),
+		CreatedAt:      time.Now(),
+		ModifiedAt:     time.Now(),
+		AccountID:      uuid.MustParse(sd.Account),
+		OrganizationID: uuid.MustParse(sd.Organization),
+		PartnerID:      uuid.MustParse(sd.Partner),
+		Key:            crypto.GenerateSha1Key(),
+		Secret:         crypto.GenerateSha256Secret(),
+		ExpiresAt:      time.Now().Add(ttl),
+	}
+	_, err = dao.Create(ctx, s.db, apikey)
+	if err != nil {
+		return nil, err
+	}
+	return &userrpcv3.WorkloadIdentityCredentials{
+		Key:       apikey.Key,
+		Secret:    apikey.Secret,
+		Username:  sd.Username,
+		ExpiresAt: timestamppb.New(apikey.ExpiresAt),
+	}, nil
+}
+
+func (s *workloadIdentityService) RemoveExpiredCredentials(ctx context.Context) error {
+	return dao.DeleteExpiredApiKeys(ctx, s.db, time.Now())
+}
@@ -0,0 +1,296 @@
+package service
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/google/uuid"
+	userrpcv3 "github.com/paralus/paralus/proto/rpc/user"
+	v3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	userv3 "github.com/paralus/paralus/proto/types/userpb/v3"
+)
+
+// newJWKSServer serves the public key as the JWKS of a local issuer
+func newJWKSServer(t *testing.T, kid string, key *ecdsa.PublicKey) *httptest.Server {
+	set := map[string]interface{}{
+		"keys": []map[string]string{{
+			"kty": "EC",
+			"kid": kid,
+			"use": "sig",
+			"crv": "P-256",
+			"x":   base64.RawURLEncoding.EncodeToString(key.X.FillBytes(make([]byte, 32))),
+			"y":   base64.RawURLEncoding.EncodeToString(key.Y.FillBytes(make([]byte, 32))),
+		}},
+	}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/.well-known/jwks" {
+			http.NotFound(w, r)
+			return
+		}
+		json.NewEncoder(w).Encode(set)
+	}))
+}
+
+func addWorkloadIdentityPolicyFetchExpectation(mock sqlmock.Sqlmock, issuer, sauuid string) string {
+	wuuid := uuid.New().String()
+	mock.ExpectQuery(`SELECT "workloadidentitypolicy"."id", .* FROM "authsrv_workloadidentitypolicy" AS "workloadidentitypolicy" WHERE \(name = 'policy-` + wuuid[:8] + `'\)`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id", "name", "issuer", "jwks_uri", "audience", "claims", "service_account_id", "ttl_seconds"}).
+		AddRow(wuuid, "policy-"+wuuid[:8], issuer, issuer+"/.well-known/jwks", "paralus", []byte(`{"repository":"org/app","ref":"refs/heads/main"}`), sauuid, 600))
+	return wuuid[:8]
+}
+
+func TestMatchClaim(t *testing.T) {
+	tt := []struct {
+		got   interface{}
+		want  string
+		match bool
+	}{
+		{"org/app", "org/app", true},
+		{"org/app-2", "org/app", false},
+		{"refs/heads/release-1", "refs/heads/release-*", true},
+		{"refs/heads/main", "refs/heads/release-*", false},
+		{true, "true", true},
+		{float64(42), "42", true},
+		{nil, "org/app", false},
+		{[]interface{}{"org/app"}, "org/app", false},
+	}
+	for _, tc := range tt {
+		if matchClaim(tc.got, tc.want) != tc.match {
+			t.Errorf("claim '%v' matching '%v'; expected %v", tc.got, tc.want, tc.match)
+		}
+	}
+}
+
+func TestWorkloadIdentityExchangeToken(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal("could not generate key:", err)
+	}
+	otherKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	srv := newJWKSServer(t, "ci-key", &key.PublicKey)
+	defer srv.Close()
+
+	tt := []struct {
+		name   string
+		key    *ecdsa.PrivateKey
+		claims jwt.MapClaims
+		valid  bool
+	}{
+		{"trusted token", key, jwt.MapClaims{}, true},
+		{"other repository", key, jwt.MapClaims{"repository": "org/other"}, false},
+		{"other branch", key, jwt.MapClaims{"ref": "refs/heads/feature"}, false},
+		{"other audience", key, jwt.MapClaims{"aud": "sts.amazonaws.com"}, false},
+		{"other issuer", key, jwt.MapClaims{"iss": "https://gitlab.com"}, false},
+		{"signed by other key", otherKey, jwt.MapClaims{}, false},
+		{"expired token", key, jwt.MapClaims{"exp": time.Now().Add(-time.Minute).Unix()}, false},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			db, mock := getDB(t)
+			defer db.Close()
+
+			ws := NewWorkloadIdentityService(db, getLogger())
+
+			sauuid := uuid.New().String()
+			puuid, ouuid := addParterOrgFetchExpectation(mock)
+			pruuid := addFetchExpectation(mock, "project")
+			policy := addWorkloadIdentityPolicyFetchExpectation(mock, srv.URL, sauuid)
+			if tc.valid {
+				mock.ExpectQuery(`SELECT "serviceaccount"."id", .* FROM "authsrv_serviceaccount" AS "serviceaccount" WHERE \(id = '` + sauuid + `'\)`).
+					WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id", "name", "username"}).AddRow(sauuid, "sa-"+sauuid, "sa-"+sauuid+"@org.serviceaccount.paralus.local"))
+				mock.ExpectQuery(`INSERT INTO "authsrv_apikey"`).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uuid.New().String()))
+			}
+
+			claims := jwt.MapClaims{
+				"iss":        srv.URL,
+				"aud":        "paralus",
+				"sub":        "repo:org/app:ref:refs/heads/main",
+				"repository": "org/app",
+				"ref":        "refs/heads/main",
+				"iat":        time.Now().Unix(),
+				"exp":        time.Now().Add(5 * time.Minute).Unix(),
+			}
+			for k, v := range tc.claims {
+				claims[k] = v
+			}
+			token := jwt.NewWithClaims(jwt.SigningMethodES256, claims)
+			token.Header["kid"] = "ci-key"
+			signed, err := token.SignedString(tc.key)
+			if err != nil {
+				t.Fatal("could not sign token:", err)
+			}
+
+			creds, err := ws.ExchangeToken(context.Background(), &userrpcv3.WorkloadIdentityTokenRequest{
+				Partner:      "partner-" + puuid,
+				Organization: "org-" + ouuid,
+				Project:      "project-" + pruuid,
+				Policy:       "policy-" + policy,
+				Token:        signed,
+			}, &v3.SessionData{})
+			if !tc.valid {
+				if err != ErrWorkloadIdentityDenied {
+					t.Fatal("expected token to be denied, got:", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal("could not exchange token:", err)
+			}
+			if creds.Key == "" || creds.Secret == "" {
+				t.Error("exchanged credentials should have key and secret")
+			}
+			if ttl := time.Until(creds.ExpiresAt.AsTime()); ttl > 10*time.Minute || ttl < 9*time.Minute {
+				t.Errorf("credentials should expire after the ttl of the policy; expires in %v", ttl)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestWorkloadIdentityPolicyCreateValidation(t *testing.T) {
+	tt := []struct {
+		name string
+		spec *userv3.WorkloadIdentityPolicySpec
+	}{
+		{"without claims", &userv3.WorkloadIdentityPolicySpec{Issuer: "https://token.actions.githubusercontent.com", Audience: "paralus", ServiceAccount: "deployer"}},
+		{"without audience", &userv3.WorkloadIdentityPolicySpec{Issuer: "https://token.actions.githubusercontent.com", Claims: map[string]string{"repository": "org/app"}, ServiceAccount: "deployer"}},
+		{"insecure issuer", &userv3.WorkloadIdentityPolicySpec{Issuer: "http://gitlab.example.com", Audience: "paralus", Claims: map[string]string{"project_path": "org/app"}, ServiceAccount: "deployer"}},
+		{"long ttl", &userv3.WorkloadIdentityPolicySpec{Issuer: "https://gitlab.com", Audience: "paralus", Claims: map[string]string{"project_path": "org/app"}, ServiceAccount: "deployer", TtlSeconds: 86400}},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			db, mock := getDB(t)
+			defer db.Close()
+
+			ws := NewWorkloadIdentityService(db, getLogger())
+
+			puuid, ouuid := addParterOrgFetchExpectation(mock)
+			pruuid := addFetchExpectation(mock, "project")
+			mock.ExpectQuery(`SELECT "workloadidentitypolicy"."id", .* FROM "authsrv_workloadidentitypolicy" AS "workloadidentitypolicy"`).
+				WillReturnError(fmt.Errorf("no data available"))
+
+			policy := &userv3.WorkloadIdentityPolicy{
+				Metadata: &v3.Metadata{Partner: "partner-" + puuid, Organization: "org-" + ouuid, Project: "project-" + pruuid, Name: "ci"},
+				Spec:     tc.spec,
+			}
+			_, err := ws.Create(context.Background(), policy)
+			if err == nil {
+				t.Fatal("created invalid workload identity policy")
+			}
+		})
+	}
+}
+
+func TestWorkloadIdentityPolicyCreateOrganizationServiceAccount(t *testing.T) {
+	db, mock := getDB(t)
+	defer db.Close()
+
+	ws := NewWorkloadIdentityService(db, getLogger())
+
+	puuid, ouuid := addParterOrgFetchExpectation(mock)
+	pruuid := addFetchExpectation(mock, "project")
+	mock.ExpectQuery(`SELECT "workloadidentitypolicy"."id", .* FROM "authsrv_workloadidentitypolicy" AS "workloadidentitypolicy"`).
+		WillReturnError(fmt.Errorf("no data available"))
+	sauuid := uuid.New().String()
+	mock.ExpectQuery(`SELECT "serviceaccount"."id", .* FROM "authsrv_serviceaccount" AS "serviceaccount" WHERE .*name = 'deployer'`).
+		WithArgs().WillReturnRows(sqlmock.NewRows([]string{"id", "name", "project_id"}).AddRow(sauuid, "deployer", nil))
+
+	policy := &userv3.WorkloadIdentityPolicy{
+		Metadata: &v3.Metadata{Partner: "partner-" + puuid, Organization: "org-" + ouuid, Project: "project-" + pruuid, Name: "ci"},
+		Spec: &userv3.WorkloadIdentityPolicySpec{
+			Issuer:         "https://token.actions.githubusercontent.com",
+			Audience:       "paralus",
+			Claims:         map[string]string{"repository": "org/app", "ref": "refs/heads/main"},
+			ServiceAccount: "deployer",
+		},
+	}
+	_, err := ws.Create(context.Background(), policy)
+	if err == nil {
+		t.Fatal("created workload identity policy for service account not scoped to the project")
+	}
+}
+
+func TestJWKSCacheConcurrentRequests(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal("could not generate key:", err)
+	}
+	jwksSrv := newJWKSServer(t, "ci-key", &key.PublicKey)
+	defer jwksSrv.Close()
+
+	var discoveries int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/.well-known/openid-configuration" {
+			http.NotFound(w, r)
+			return
+		}
+		atomic.AddInt32(&discoveries, 1)
+		json.NewEncoder(w).Encode(map[string]string{"jwks_uri": jwksSrv.URL + "/.well-known/jwks"})
+	}))
+	defer srv.Close()
+
+	c := newJWKSCache()
+	var wg sync.WaitGroup
+	errs := make(chan error, 20)
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			uri, err := c.discover(context.Background(), srv.URL)
+			if err != nil {
+				errs <- err
+				return
+			}
+			if _, err := c.key(context.Background(), uri, "ci-key"); err != nil {
+				errs <- err
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Error(err)
+	}
+	if discoveries != 1 {
+		t.Errorf("issuer should be discovered once; discovered %d times", discoveries)
+	}
+
+	// the keys are fetched from the discovered uri once and then cached
+	var keyFetches int32
+	keySrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&keyFetches, 1)
+		http.Redirect(w, r, jwksSrv.URL+r.URL.Path, http.StatusFound)
+	}))
+	defer keySrv.Close()
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := c.key(context.Background(), keySrv.URL+"/.well-known/jwks", "ci-key"); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+	if keyFetches != 1 {
+		t.Errorf("keys should be fetched once; fetched %d times", keyFetches)
+	}
+}
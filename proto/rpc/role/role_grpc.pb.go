@@ -20,11 +20,13 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	RoleService_CreateRole_FullMethodName = "/paralus.dev.rpc.role.v3.RoleService/CreateRole"
-	RoleService_GetRoles_FullMethodName   = "/paralus.dev.rpc.role.v3.RoleService/GetRoles"
-	RoleService_GetRole_FullMethodName    = "/paralus.dev.rpc.role.v3.RoleService/GetRole"
-	RoleService_UpdateRole_FullMethodName = "/paralus.dev.rpc.role.v3.RoleService/UpdateRole"
-	RoleService_DeleteRole_FullMethodName = "/paralus.dev.rpc.role.v3.RoleService/DeleteRole"
+	RoleService_CreateRole_FullMethodName                  = "/paralus.dev.rpc.role.v3.RoleService/CreateRole"
+	RoleService_GetRoles_FullMethodName                    = "/paralus.dev.rpc.role.v3.RoleService/GetRoles"
+	RoleService_GetRole_FullMethodName                     = "/paralus.dev.rpc.role.v3.RoleService/GetRole"
+	RoleService_GetRoleEffectivePermissions_FullMethodName = "/paralus.dev.rpc.role.v3.RoleService/GetRoleEffectivePermissions"
+	RoleService_UpdateRole_FullMethodName                  = "/paralus.dev.rpc.role.v3.RoleService/UpdateRole"
+	RoleService_DeleteRole_FullMethodName                  = "/paralus.dev.rpc.role.v3.RoleService/DeleteRole"
+	RoleService_ExplainAuthorization_FullMethodName        = "/paralus.dev.rpc.role.v3.RoleService/ExplainAuthorization"
 )
 
 // RoleServiceClient is the client API for RoleService service.
@@ -34,8 +36,10 @@ type RoleServiceClient interface {
 	CreateRole(ctx context.Context, in *v3.Role, opts ...grpc.CallOption) (*v3.Role, error)
 	GetRoles(ctx context.Context, in *v3.Role, opts ...grpc.CallOption) (*v3.RoleList, error)
 	GetRole(ctx context.Context, in *v3.Role, opts ...grpc.CallOption) (*v3.Role, error)
+	GetRoleEffectivePermissions(ctx context.Context, in *v3.Role, opts ...grpc.CallOption) (*v3.Role, error)
 	UpdateRole(ctx context.Context, in *v3.Role, opts ...grpc.CallOption) (*v3.Role, error)
 	DeleteRole(ctx context.Context, in *v3.Role, opts ...grpc.CallOption) (*v3.Role, error)
+	ExplainAuthorization(ctx context.Context, in *v3.AuthorizationExplainRequest, opts ...grpc.CallOption) (*v3.AuthorizationExplanation, error)
 }
 
 type roleServiceClient struct {
@@ -73,6 +77,15 @@ func (c *roleServiceClient) GetRole(ctx context.Context, in *v3.Role, opts ...gr
 	return out, nil
 }
 
+func (c *roleServiceClient) GetRoleEffectivePermissions(ctx context.Context, in *v3.Role, opts ...grpc.CallOption) (*v3.Role, error) {
+	out := new(v3.Role)
+	err := c.cc.Invoke(ctx, RoleService_GetRoleEffectivePermissions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *roleServiceClient) UpdateRole(ctx context.Context, in *v3.Role, opts ...grpc.CallOption) (*v3.Role, error) {
 	out := new(v3.Role)
 	err := c.cc.Invoke(ctx, RoleService_UpdateRole_FullMethodName, in, out, opts...)
@@ -91,6 +104,15 @@ func (c *roleServiceClient) DeleteRole(ctx context.Context, in *v3.Role, opts ..
 	return out, nil
 }
 
+func (c *roleServiceClient) ExplainAuthorization(ctx context.Context, in *v3.AuthorizationExplainRequest, opts ...grpc.CallOption) (*v3.AuthorizationExplanation, error) {
+	out := new(v3.AuthorizationExplanation)
+	err := c.cc.Invoke(ctx, RoleService_ExplainAuthorization_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RoleServiceServer is the server API for RoleService service.
 // All implementations should embed UnimplementedRoleServiceServer
 // for forward compatibility
@@ -98,8 +120,10 @@ type RoleServiceServer interface {
 	CreateRole(context.Context, *v3.Role) (*v3.Role, error)
 	GetRoles(context.Context, *v3.Role) (*v3.RoleList, error)
 	GetRole(context.Context, *v3.Role) (*v3.Role, error)
+	GetRoleEffectivePermissions(context.Context, *v3.Role) (*v3.Role, error)
 	UpdateRole(context.Context, *v3.Role) (*v3.Role, error)
 	DeleteRole(context.Context, *v3.Role) (*v3.Role, error)
+	ExplainAuthorization(context.Context, *v3.AuthorizationExplainRequest) (*v3.AuthorizationExplanation, error)
 }
 
 // UnimplementedRoleServiceServer should be embedded to have forward compatible implementations.
@@ -115,12 +139,18 @@ func (UnimplementedRoleServiceServer) GetRoles(context.Context, *v3.Role) (*v3.R
 func (UnimplementedRoleServiceServer) GetRole(context.Context, *v3.Role) (*v3.Role, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRole not implemented")
 }
+func (UnimplementedRoleServiceServer) GetRoleEffectivePermissions(context.Context, *v3.Role) (*v3.Role, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRoleEffectivePermissions not implemented")
+}
 func (UnimplementedRoleServiceServer) UpdateRole(context.Context, *v3.Role) (*v3.Role, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateRole not implemented")
 }
 func (UnimplementedRoleServiceServer) DeleteRole(context.Context, *v3.Role) (*v3.Role, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteRole not implemented")
 }
+func (UnimplementedRoleServiceServer) ExplainAuthorization(context.Context, *v3.AuthorizationExplainRequest) (*v3.AuthorizationExplanation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExplainAuthorization not implemented")
+}
 
 // UnsafeRoleServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to RoleServiceServer will
@@ -187,6 +217,24 @@ func _RoleService_GetRole_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RoleService_GetRoleEffectivePermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v3.Role)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleServiceServer).GetRoleEffectivePermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleService_GetRoleEffectivePermissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleServiceServer).GetRoleEffectivePermissions(ctx, req.(*v3.Role))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RoleService_UpdateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(v3.Role)
 	if err := dec(in); err != nil {
@@ -223,6 +271,24 @@ func _RoleService_DeleteRole_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RoleService_ExplainAuthorization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v3.AuthorizationExplainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RoleServiceServer).ExplainAuthorization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RoleService_ExplainAuthorization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RoleServiceServer).ExplainAuthorization(ctx, req.(*v3.AuthorizationExplainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RoleService_ServiceDesc is the grpc.ServiceDesc for RoleService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -242,6 +308,10 @@ var RoleService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetRole",
 			Handler:    _RoleService_GetRole_Handler,
 		},
+		{
+			MethodName: "GetRoleEffectivePermissions",
+			Handler:    _RoleService_GetRoleEffectivePermissions_Handler,
+		},
 		{
 			MethodName: "UpdateRole",
 			Handler:    _RoleService_UpdateRole_Handler,
@@ -250,6 +320,10 @@ var RoleService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteRole",
 			Handler:    _RoleService_DeleteRole_Handler,
 		},
+		{
+			MethodName: "ExplainAuthorization",
+			Handler:    _RoleService_ExplainAuthorization_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/rpc/role/role.proto",
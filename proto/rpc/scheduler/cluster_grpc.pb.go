@@ -21,14 +21,25 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	ClusterService_CreateCluster_FullMethodName       = "/paralus.dev.rpc.v3.ClusterService/CreateCluster"
-	ClusterService_GetClusters_FullMethodName         = "/paralus.dev.rpc.v3.ClusterService/GetClusters"
-	ClusterService_GetCluster_FullMethodName          = "/paralus.dev.rpc.v3.ClusterService/GetCluster"
-	ClusterService_UpdateCluster_FullMethodName       = "/paralus.dev.rpc.v3.ClusterService/UpdateCluster"
-	ClusterService_DeleteCluster_FullMethodName       = "/paralus.dev.rpc.v3.ClusterService/DeleteCluster"
-	ClusterService_DownloadCluster_FullMethodName     = "/paralus.dev.rpc.v3.ClusterService/DownloadCluster"
-	ClusterService_UpdateClusterStatus_FullMethodName = "/paralus.dev.rpc.v3.ClusterService/UpdateClusterStatus"
-	ClusterService_GetClusterStatus_FullMethodName    = "/paralus.dev.rpc.v3.ClusterService/GetClusterStatus"
+	ClusterService_CreateCluster_FullMethodName         = "/paralus.dev.rpc.v3.ClusterService/CreateCluster"
+	ClusterService_GetClusters_FullMethodName           = "/paralus.dev.rpc.v3.ClusterService/GetClusters"
+	ClusterService_GetCluster_FullMethodName            = "/paralus.dev.rpc.v3.ClusterService/GetCluster"
+	ClusterService_UpdateCluster_FullMethodName         = "/paralus.dev.rpc.v3.ClusterService/UpdateCluster"
+	ClusterService_DeleteCluster_FullMethodName         = "/paralus.dev.rpc.v3.ClusterService/DeleteCluster"
+	ClusterService_DownloadCluster_FullMethodName       = "/paralus.dev.rpc.v3.ClusterService/DownloadCluster"
+	ClusterService_DownloadClusterFormat_FullMethodName = "/paralus.dev.rpc.v3.ClusterService/DownloadClusterFormat"
+	ClusterService_UpdateClusterStatus_FullMethodName   = "/paralus.dev.rpc.v3.ClusterService/UpdateClusterStatus"
+	ClusterService_GetClusterStatus_FullMethodName      = "/paralus.dev.rpc.v3.ClusterService/GetClusterStatus"
+	ClusterService_GetAgentVersions_FullMethodName      = "/paralus.dev.rpc.v3.ClusterService/GetAgentVersions"
+	ClusterService_CreateAgentUpgrade_FullMethodName    = "/paralus.dev.rpc.v3.ClusterService/CreateAgentUpgrade"
+	ClusterService_GetAgentUpgrades_FullMethodName      = "/paralus.dev.rpc.v3.ClusterService/GetAgentUpgrades"
+	ClusterService_GetAgentUpgrade_FullMethodName       = "/paralus.dev.rpc.v3.ClusterService/GetAgentUpgrade"
+	ClusterService_ResumeAgentUpgrade_FullMethodName    = "/paralus.dev.rpc.v3.ClusterService/ResumeAgentUpgrade"
+	ClusterService_ShareCluster_FullMethodName          = "/paralus.dev.rpc.v3.ClusterService/ShareCluster"
+	ClusterService_UnshareCluster_FullMethodName        = "/paralus.dev.rpc.v3.ClusterService/UnshareCluster"
+	ClusterService_MoveCluster_FullMethodName           = "/paralus.dev.rpc.v3.ClusterService/MoveCluster"
+	ClusterService_RenameCluster_FullMethodName         = "/paralus.dev.rpc.v3.ClusterService/RenameCluster"
+	ClusterService_RegisterClusters_FullMethodName      = "/paralus.dev.rpc.v3.ClusterService/RegisterClusters"
 )
 
 // ClusterServiceClient is the client API for ClusterService service.
@@ -41,8 +52,19 @@ type ClusterServiceClient interface {
 	UpdateCluster(ctx context.Context, in *v3.Cluster, opts ...grpc.CallOption) (*v3.Cluster, error)
 	DeleteCluster(ctx context.Context, in *v3.Cluster, opts ...grpc.CallOption) (*DeleteClusterResponse, error)
 	DownloadCluster(ctx context.Context, in *v3.Cluster, opts ...grpc.CallOption) (*v31.HttpBody, error)
+	DownloadClusterFormat(ctx context.Context, in *DownloadClusterRequest, opts ...grpc.CallOption) (*v31.HttpBody, error)
 	UpdateClusterStatus(ctx context.Context, in *UpdateClusterStatusRequest, opts ...grpc.CallOption) (*UpdateClusterStatusResponse, error)
 	GetClusterStatus(ctx context.Context, in *GetClusterStatusRequest, opts ...grpc.CallOption) (*GetClusterStatusResponse, error)
+	GetAgentVersions(ctx context.Context, in *v31.QueryOptions, opts ...grpc.CallOption) (*v3.AgentVersionList, error)
+	CreateAgentUpgrade(ctx context.Context, in *v3.AgentUpgrade, opts ...grpc.CallOption) (*v3.AgentUpgrade, error)
+	GetAgentUpgrades(ctx context.Context, in *v31.QueryOptions, opts ...grpc.CallOption) (*v3.AgentUpgradeList, error)
+	GetAgentUpgrade(ctx context.Context, in *v3.AgentUpgrade, opts ...grpc.CallOption) (*v3.AgentUpgrade, error)
+	ResumeAgentUpgrade(ctx context.Context, in *v3.AgentUpgrade, opts ...grpc.CallOption) (*v3.AgentUpgrade, error)
+	ShareCluster(ctx context.Context, in *ShareClusterRequest, opts ...grpc.CallOption) (*v3.Cluster, error)
+	UnshareCluster(ctx context.Context, in *UnshareClusterRequest, opts ...grpc.CallOption) (*v3.Cluster, error)
+	MoveCluster(ctx context.Context, in *MoveClusterRequest, opts ...grpc.CallOption) (*v3.Cluster, error)
+	RenameCluster(ctx context.Context, in *RenameClusterRequest, opts ...grpc.CallOption) (*v3.Cluster, error)
+	RegisterClusters(ctx context.Context, in *RegisterClustersRequest, opts ...grpc.CallOption) (*v31.HttpBody, error)
 }
 
 type clusterServiceClient struct {
@@ -107,6 +129,15 @@ func (c *clusterServiceClient) DownloadCluster(ctx context.Context, in *v3.Clust
 	return out, nil
 }
 
+func (c *clusterServiceClient) DownloadClusterFormat(ctx context.Context, in *DownloadClusterRequest, opts ...grpc.CallOption) (*v31.HttpBody, error) {
+	out := new(v31.HttpBody)
+	err := c.cc.Invoke(ctx, ClusterService_DownloadClusterFormat_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clusterServiceClient) UpdateClusterStatus(ctx context.Context, in *UpdateClusterStatusRequest, opts ...grpc.CallOption) (*UpdateClusterStatusResponse, error) {
 	out := new(UpdateClusterStatusResponse)
 	err := c.cc.Invoke(ctx, ClusterService_UpdateClusterStatus_FullMethodName, in, out, opts...)
@@ -125,6 +156,96 @@ func (c *clusterServiceClient) GetClusterStatus(ctx context.Context, in *GetClus
 	return out, nil
 }
 
+func (c *clusterServiceClient) GetAgentVersions(ctx context.Context, in *v31.QueryOptions, opts ...grpc.CallOption) (*v3.AgentVersionList, error) {
+	out := new(v3.AgentVersionList)
+	err := c.cc.Invoke(ctx, ClusterService_GetAgentVersions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterServiceClient) CreateAgentUpgrade(ctx context.Context, in *v3.AgentUpgrade, opts ...grpc.CallOption) (*v3.AgentUpgrade, error) {
+	out := new(v3.AgentUpgrade)
+	err := c.cc.Invoke(ctx, ClusterService_CreateAgentUpgrade_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterServiceClient) GetAgentUpgrades(ctx context.Context, in *v31.QueryOptions, opts ...grpc.CallOption) (*v3.AgentUpgradeList, error) {
+	out := new(v3.AgentUpgradeList)
+	err := c.cc.Invoke(ctx, ClusterService_GetAgentUpgrades_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterServiceClient) GetAgentUpgrade(ctx context.Context, in *v3.AgentUpgrade, opts ...grpc.CallOption) (*v3.AgentUpgrade, error) {
+	out := new(v3.AgentUpgrade)
+	err := c.cc.Invoke(ctx, ClusterService_GetAgentUpgrade_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterServiceClient) ResumeAgentUpgrade(ctx context.Context, in *v3.AgentUpgrade, opts ...grpc.CallOption) (*v3.AgentUpgrade, error) {
+	out := new(v3.AgentUpgrade)
+	err := c.cc.Invoke(ctx, ClusterService_ResumeAgentUpgrade_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterServiceClient) ShareCluster(ctx context.Context, in *ShareClusterRequest, opts ...grpc.CallOption) (*v3.Cluster, error) {
+	out := new(v3.Cluster)
+	err := c.cc.Invoke(ctx, ClusterService_ShareCluster_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterServiceClient) UnshareCluster(ctx context.Context, in *UnshareClusterRequest, opts ...grpc.CallOption) (*v3.Cluster, error) {
+	out := new(v3.Cluster)
+	err := c.cc.Invoke(ctx, ClusterService_UnshareCluster_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterServiceClient) MoveCluster(ctx context.Context, in *MoveClusterRequest, opts ...grpc.CallOption) (*v3.Cluster, error) {
+	out := new(v3.Cluster)
+	err := c.cc.Invoke(ctx, ClusterService_MoveCluster_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterServiceClient) RenameCluster(ctx context.Context, in *RenameClusterRequest, opts ...grpc.CallOption) (*v3.Cluster, error) {
+	out := new(v3.Cluster)
+	err := c.cc.Invoke(ctx, ClusterService_RenameCluster_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterServiceClient) RegisterClusters(ctx context.Context, in *RegisterClustersRequest, opts ...grpc.CallOption) (*v31.HttpBody, error) {
+	out := new(v31.HttpBody)
+	err := c.cc.Invoke(ctx, ClusterService_RegisterClusters_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ClusterServiceServer is the server API for ClusterService service.
 // All implementations should embed UnimplementedClusterServiceServer
 // for forward compatibility
@@ -135,8 +256,19 @@ type ClusterServiceServer interface {
 	UpdateCluster(context.Context, *v3.Cluster) (*v3.Cluster, error)
 	DeleteCluster(context.Context, *v3.Cluster) (*DeleteClusterResponse, error)
 	DownloadCluster(context.Context, *v3.Cluster) (*v31.HttpBody, error)
+	DownloadClusterFormat(context.Context, *DownloadClusterRequest) (*v31.HttpBody, error)
 	UpdateClusterStatus(context.Context, *UpdateClusterStatusRequest) (*UpdateClusterStatusResponse, error)
 	GetClusterStatus(context.Context, *GetClusterStatusRequest) (*GetClusterStatusResponse, error)
+	GetAgentVersions(context.Context, *v31.QueryOptions) (*v3.AgentVersionList, error)
+	CreateAgentUpgrade(context.Context, *v3.AgentUpgrade) (*v3.AgentUpgrade, error)
+	GetAgentUpgrades(context.Context, *v31.QueryOptions) (*v3.AgentUpgradeList, error)
+	GetAgentUpgrade(context.Context, *v3.AgentUpgrade) (*v3.AgentUpgrade, error)
+	ResumeAgentUpgrade(context.Context, *v3.AgentUpgrade) (*v3.AgentUpgrade, error)
+	ShareCluster(context.Context, *ShareClusterRequest) (*v3.Cluster, error)
+	UnshareCluster(context.Context, *UnshareClusterRequest) (*v3.Cluster, error)
+	MoveCluster(context.Context, *MoveClusterRequest) (*v3.Cluster, error)
+	RenameCluster(context.Context, *RenameClusterRequest) (*v3.Cluster, error)
+	RegisterClusters(context.Context, *RegisterClustersRequest) (*v31.HttpBody, error)
 }
 
 // UnimplementedClusterServiceServer should be embedded to have forward compatible implementations.
@@ -161,12 +293,45 @@ func (UnimplementedClusterServiceServer) DeleteCluster(context.Context, *v3.Clus
 func (UnimplementedClusterServiceServer) DownloadCluster(context.Context, *v3.Cluster) (*v31.HttpBody, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DownloadCluster not implemented")
 }
+func (UnimplementedClusterServiceServer) DownloadClusterFormat(context.Context, *DownloadClusterRequest) (*v31.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DownloadClusterFormat not implemented")
+}
 func (UnimplementedClusterServiceServer) UpdateClusterStatus(context.Context, *UpdateClusterStatusRequest) (*UpdateClusterStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateClusterStatus not implemented")
 }
 func (UnimplementedClusterServiceServer) GetClusterStatus(context.Context, *GetClusterStatusRequest) (*GetClusterStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetClusterStatus not implemented")
 }
+func (UnimplementedClusterServiceServer) GetAgentVersions(context.Context, *v31.QueryOptions) (*v3.AgentVersionList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAgentVersions not implemented")
+}
+func (UnimplementedClusterServiceServer) CreateAgentUpgrade(context.Context, *v3.AgentUpgrade) (*v3.AgentUpgrade, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAgentUpgrade not implemented")
+}
+func (UnimplementedClusterServiceServer) GetAgentUpgrades(context.Context, *v31.QueryOptions) (*v3.AgentUpgradeList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAgentUpgrades not implemented")
+}
+func (UnimplementedClusterServiceServer) GetAgentUpgrade(context.Context, *v3.AgentUpgrade) (*v3.AgentUpgrade, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAgentUpgrade not implemented")
+}
+func (UnimplementedClusterServiceServer) ResumeAgentUpgrade(context.Context, *v3.AgentUpgrade) (*v3.AgentUpgrade, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeAgentUpgrade not implemented")
+}
+func (UnimplementedClusterServiceServer) ShareCluster(context.Context, *ShareClusterRequest) (*v3.Cluster, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShareCluster not implemented")
+}
+func (UnimplementedClusterServiceServer) UnshareCluster(context.Context, *UnshareClusterRequest) (*v3.Cluster, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnshareCluster not implemented")
+}
+func (UnimplementedClusterServiceServer) MoveCluster(context.Context, *MoveClusterRequest) (*v3.Cluster, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveCluster not implemented")
+}
+func (UnimplementedClusterServiceServer) RenameCluster(context.Context, *RenameClusterRequest) (*v3.Cluster, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameCluster not implemented")
+}
+func (UnimplementedClusterServiceServer) RegisterClusters(context.Context, *RegisterClustersRequest) (*v31.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterClusters not implemented")
+}
 
 // UnsafeClusterServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to ClusterServiceServer will
@@ -287,6 +452,24 @@ func _ClusterService_DownloadCluster_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ClusterService_DownloadClusterFormat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DownloadClusterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).DownloadClusterFormat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterService_DownloadClusterFormat_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).DownloadClusterFormat(ctx, req.(*DownloadClusterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ClusterService_UpdateClusterStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateClusterStatusRequest)
 	if err := dec(in); err != nil {
@@ -323,6 +506,186 @@ func _ClusterService_GetClusterStatus_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ClusterService_GetAgentVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v31.QueryOptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).GetAgentVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterService_GetAgentVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).GetAgentVersions(ctx, req.(*v31.QueryOptions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterService_CreateAgentUpgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v3.AgentUpgrade)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).CreateAgentUpgrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterService_CreateAgentUpgrade_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).CreateAgentUpgrade(ctx, req.(*v3.AgentUpgrade))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterService_GetAgentUpgrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v31.QueryOptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).GetAgentUpgrades(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterService_GetAgentUpgrades_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).GetAgentUpgrades(ctx, req.(*v31.QueryOptions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterService_GetAgentUpgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v3.AgentUpgrade)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).GetAgentUpgrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterService_GetAgentUpgrade_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).GetAgentUpgrade(ctx, req.(*v3.AgentUpgrade))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterService_ResumeAgentUpgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v3.AgentUpgrade)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).ResumeAgentUpgrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterService_ResumeAgentUpgrade_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).ResumeAgentUpgrade(ctx, req.(*v3.AgentUpgrade))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterService_ShareCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShareClusterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).ShareCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterService_ShareCluster_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).ShareCluster(ctx, req.(*ShareClusterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterService_UnshareCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnshareClusterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).UnshareCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterService_UnshareCluster_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).UnshareCluster(ctx, req.(*UnshareClusterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterService_MoveCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveClusterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).MoveCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterService_MoveCluster_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).MoveCluster(ctx, req.(*MoveClusterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterService_RenameCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameClusterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).RenameCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterService_RenameCluster_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).RenameCluster(ctx, req.(*RenameClusterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterService_RegisterClusters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterClustersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).RegisterClusters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterService_RegisterClusters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).RegisterClusters(ctx, req.(*RegisterClustersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ClusterService_ServiceDesc is the grpc.ServiceDesc for ClusterService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -354,6 +717,10 @@ var ClusterService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DownloadCluster",
 			Handler:    _ClusterService_DownloadCluster_Handler,
 		},
+		{
+			MethodName: "DownloadClusterFormat",
+			Handler:    _ClusterService_DownloadClusterFormat_Handler,
+		},
 		{
 			MethodName: "UpdateClusterStatus",
 			Handler:    _ClusterService_UpdateClusterStatus_Handler,
@@ -362,6 +729,46 @@ var ClusterService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetClusterStatus",
 			Handler:    _ClusterService_GetClusterStatus_Handler,
 		},
+		{
+			MethodName: "GetAgentVersions",
+			Handler:    _ClusterService_GetAgentVersions_Handler,
+		},
+		{
+			MethodName: "CreateAgentUpgrade",
+			Handler:    _ClusterService_CreateAgentUpgrade_Handler,
+		},
+		{
+			MethodName: "GetAgentUpgrades",
+			Handler:    _ClusterService_GetAgentUpgrades_Handler,
+		},
+		{
+			MethodName: "GetAgentUpgrade",
+			Handler:    _ClusterService_GetAgentUpgrade_Handler,
+		},
+		{
+			MethodName: "ResumeAgentUpgrade",
+			Handler:    _ClusterService_ResumeAgentUpgrade_Handler,
+		},
+		{
+			MethodName: "ShareCluster",
+			Handler:    _ClusterService_ShareCluster_Handler,
+		},
+		{
+			MethodName: "UnshareCluster",
+			Handler:    _ClusterService_UnshareCluster_Handler,
+		},
+		{
+			MethodName: "MoveCluster",
+			Handler:    _ClusterService_MoveCluster_Handler,
+		},
+		{
+			MethodName: "RenameCluster",
+			Handler:    _ClusterService_RenameCluster_Handler,
+		},
+		{
+			MethodName: "RegisterClusters",
+			Handler:    _ClusterService_RegisterClusters_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/rpc/scheduler/cluster.proto",
@@ -33,6 +33,10 @@ const (
 	BootstrapService_GetBootstrapAgents_FullMethodName          = "/paralus.dev.sentry.rpc.BootstrapService/GetBootstrapAgents"
 	BootstrapService_DeleteBootstrapAgent_FullMethodName        = "/paralus.dev.sentry.rpc.BootstrapService/DeleteBootstrapAgent"
 	BootstrapService_UpdateBootstrapAgent_FullMethodName        = "/paralus.dev.sentry.rpc.BootstrapService/UpdateBootstrapAgent"
+	BootstrapService_GetPendingBootstrapAgents_FullMethodName   = "/paralus.dev.sentry.rpc.BootstrapService/GetPendingBootstrapAgents"
+	BootstrapService_ApproveBootstrapAgent_FullMethodName       = "/paralus.dev.sentry.rpc.BootstrapService/ApproveBootstrapAgent"
+	BootstrapService_RejectBootstrapAgent_FullMethodName        = "/paralus.dev.sentry.rpc.BootstrapService/RejectBootstrapAgent"
+	BootstrapService_ReissueBootstrapAgentToken_FullMethodName  = "/paralus.dev.sentry.rpc.BootstrapService/ReissueBootstrapAgentToken"
 )
 
 // BootstrapServiceClient is the client API for BootstrapService service.
@@ -45,12 +49,16 @@ type BootstrapServiceClient interface {
 	GetBootstrapAgentTemplate(ctx context.Context, in *sentry.BootstrapAgentTemplate, opts ...grpc.CallOption) (*sentry.BootstrapAgentTemplate, error)
 	GetBootstrapAgentTemplates(ctx context.Context, in *v3.QueryOptions, opts ...grpc.CallOption) (*sentry.BootstrapAgentTemplateList, error)
 	RegisterBootstrapAgent(ctx context.Context, in *RegisterAgentRequest, opts ...grpc.CallOption) (*RegisterAgentResponse, error)
-	GetBootstrapAgentConfig(ctx context.Context, in *sentry.BootstrapAgent, opts ...grpc.CallOption) (*v3.HttpBody, error)
+	GetBootstrapAgentConfig(ctx context.Context, in *GetBootstrapAgentConfigRequest, opts ...grpc.CallOption) (*v3.HttpBody, error)
 	CreateBootstrapAgent(ctx context.Context, in *sentry.BootstrapAgent, opts ...grpc.CallOption) (*sentry.BootstrapAgent, error)
 	GetBootstrapAgent(ctx context.Context, in *sentry.BootstrapAgent, opts ...grpc.CallOption) (*sentry.BootstrapAgent, error)
 	GetBootstrapAgents(ctx context.Context, in *GetBootstrapAgentsRequest, opts ...grpc.CallOption) (*sentry.BootstrapAgentList, error)
 	DeleteBootstrapAgent(ctx context.Context, in *sentry.BootstrapAgent, opts ...grpc.CallOption) (*DeleteBootstrapAgentResponse, error)
 	UpdateBootstrapAgent(ctx context.Context, in *sentry.BootstrapAgent, opts ...grpc.CallOption) (*sentry.BootstrapAgent, error)
+	GetPendingBootstrapAgents(ctx context.Context, in *GetBootstrapAgentsRequest, opts ...grpc.CallOption) (*sentry.BootstrapAgentList, error)
+	ApproveBootstrapAgent(ctx context.Context, in *sentry.BootstrapAgent, opts ...grpc.CallOption) (*sentry.BootstrapAgent, error)
+	RejectBootstrapAgent(ctx context.Context, in *sentry.BootstrapAgent, opts ...grpc.CallOption) (*sentry.BootstrapAgent, error)
+	ReissueBootstrapAgentToken(ctx context.Context, in *sentry.BootstrapAgent, opts ...grpc.CallOption) (*sentry.BootstrapAgent, error)
 }
 
 type bootstrapServiceClient struct {
@@ -115,7 +123,7 @@ func (c *bootstrapServiceClient) RegisterBootstrapAgent(ctx context.Context, in
 	return out, nil
 }
 
-func (c *bootstrapServiceClient) GetBootstrapAgentConfig(ctx context.Context, in *sentry.BootstrapAgent, opts ...grpc.CallOption) (*v3.HttpBody, error) {
+func (c *bootstrapServiceClient) GetBootstrapAgentConfig(ctx context.Context, in *GetBootstrapAgentConfigRequest, opts ...grpc.CallOption) (*v3.HttpBody, error) {
 	out := new(v3.HttpBody)
 	err := c.cc.Invoke(ctx, BootstrapService_GetBootstrapAgentConfig_FullMethodName, in, out, opts...)
 	if err != nil {
@@ -169,6 +177,42 @@ func (c *bootstrapServiceClient) UpdateBootstrapAgent(ctx context.Context, in *s
 	return out, nil
 }
 
+func (c *bootstrapServiceClient) GetPendingBootstrapAgents(ctx context.Context, in *GetBootstrapAgentsRequest, opts ...grpc.CallOption) (*sentry.BootstrapAgentList, error) {
+	out := new(sentry.BootstrapAgentList)
+	err := c.cc.Invoke(ctx, BootstrapService_GetPendingBootstrapAgents_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bootstrapServiceClient) ApproveBootstrapAgent(ctx context.Context, in *sentry.BootstrapAgent, opts ...grpc.CallOption) (*sentry.BootstrapAgent, error) {
+	out := new(sentry.BootstrapAgent)
+	err := c.cc.Invoke(ctx, BootstrapService_ApproveBootstrapAgent_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bootstrapServiceClient) RejectBootstrapAgent(ctx context.Context, in *sentry.BootstrapAgent, opts ...grpc.CallOption) (*sentry.BootstrapAgent, error) {
+	out := new(sentry.BootstrapAgent)
+	err := c.cc.Invoke(ctx, BootstrapService_RejectBootstrapAgent_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bootstrapServiceClient) ReissueBootstrapAgentToken(ctx context.Context, in *sentry.BootstrapAgent, opts ...grpc.CallOption) (*sentry.BootstrapAgent, error) {
+	out := new(sentry.BootstrapAgent)
+	err := c.cc.Invoke(ctx, BootstrapService_ReissueBootstrapAgentToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BootstrapServiceServer is the server API for BootstrapService service.
 // All implementations should embed UnimplementedBootstrapServiceServer
 // for forward compatibility
@@ -179,12 +223,16 @@ type BootstrapServiceServer interface {
 	GetBootstrapAgentTemplate(context.Context, *sentry.BootstrapAgentTemplate) (*sentry.BootstrapAgentTemplate, error)
 	GetBootstrapAgentTemplates(context.Context, *v3.QueryOptions) (*sentry.BootstrapAgentTemplateList, error)
 	RegisterBootstrapAgent(context.Context, *RegisterAgentRequest) (*RegisterAgentResponse, error)
-	GetBootstrapAgentConfig(context.Context, *sentry.BootstrapAgent) (*v3.HttpBody, error)
+	GetBootstrapAgentConfig(context.Context, *GetBootstrapAgentConfigRequest) (*v3.HttpBody, error)
 	CreateBootstrapAgent(context.Context, *sentry.BootstrapAgent) (*sentry.BootstrapAgent, error)
 	GetBootstrapAgent(context.Context, *sentry.BootstrapAgent) (*sentry.BootstrapAgent, error)
 	GetBootstrapAgents(context.Context, *GetBootstrapAgentsRequest) (*sentry.BootstrapAgentList, error)
 	DeleteBootstrapAgent(context.Context, *sentry.BootstrapAgent) (*DeleteBootstrapAgentResponse, error)
 	UpdateBootstrapAgent(context.Context, *sentry.BootstrapAgent) (*sentry.BootstrapAgent, error)
+	GetPendingBootstrapAgents(context.Context, *GetBootstrapAgentsRequest) (*sentry.BootstrapAgentList, error)
+	ApproveBootstrapAgent(context.Context, *sentry.BootstrapAgent) (*sentry.BootstrapAgent, error)
+	RejectBootstrapAgent(context.Context, *sentry.BootstrapAgent) (*sentry.BootstrapAgent, error)
+	ReissueBootstrapAgentToken(context.Context, *sentry.BootstrapAgent) (*sentry.BootstrapAgent, error)
 }
 
 // UnimplementedBootstrapServiceServer should be embedded to have forward compatible implementations.
@@ -209,7 +257,7 @@ func (UnimplementedBootstrapServiceServer) GetBootstrapAgentTemplates(context.Co
 func (UnimplementedBootstrapServiceServer) RegisterBootstrapAgent(context.Context, *RegisterAgentRequest) (*RegisterAgentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterBootstrapAgent not implemented")
 }
-func (UnimplementedBootstrapServiceServer) GetBootstrapAgentConfig(context.Context, *sentry.BootstrapAgent) (*v3.HttpBody, error) {
+func (UnimplementedBootstrapServiceServer) GetBootstrapAgentConfig(context.Context, *GetBootstrapAgentConfigRequest) (*v3.HttpBody, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBootstrapAgentConfig not implemented")
 }
 func (UnimplementedBootstrapServiceServer) CreateBootstrapAgent(context.Context, *sentry.BootstrapAgent) (*sentry.BootstrapAgent, error) {
@@ -227,6 +275,18 @@ func (UnimplementedBootstrapServiceServer) DeleteBootstrapAgent(context.Context,
 func (UnimplementedBootstrapServiceServer) UpdateBootstrapAgent(context.Context, *sentry.BootstrapAgent) (*sentry.BootstrapAgent, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateBootstrapAgent not implemented")
 }
+func (UnimplementedBootstrapServiceServer) GetPendingBootstrapAgents(context.Context, *GetBootstrapAgentsRequest) (*sentry.BootstrapAgentList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPendingBootstrapAgents not implemented")
+}
+func (UnimplementedBootstrapServiceServer) ApproveBootstrapAgent(context.Context, *sentry.BootstrapAgent) (*sentry.BootstrapAgent, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveBootstrapAgent not implemented")
+}
+func (UnimplementedBootstrapServiceServer) RejectBootstrapAgent(context.Context, *sentry.BootstrapAgent) (*sentry.BootstrapAgent, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectBootstrapAgent not implemented")
+}
+func (UnimplementedBootstrapServiceServer) ReissueBootstrapAgentToken(context.Context, *sentry.BootstrapAgent) (*sentry.BootstrapAgent, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReissueBootstrapAgentToken not implemented")
+}
 
 // UnsafeBootstrapServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to BootstrapServiceServer will
@@ -348,7 +408,7 @@ func _BootstrapService_RegisterBootstrapAgent_Handler(srv interface{}, ctx conte
 }
 
 func _BootstrapService_GetBootstrapAgentConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(sentry.BootstrapAgent)
+	in := new(GetBootstrapAgentConfigRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -360,7 +420,7 @@ func _BootstrapService_GetBootstrapAgentConfig_Handler(srv interface{}, ctx cont
 		FullMethod: BootstrapService_GetBootstrapAgentConfig_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BootstrapServiceServer).GetBootstrapAgentConfig(ctx, req.(*sentry.BootstrapAgent))
+		return srv.(BootstrapServiceServer).GetBootstrapAgentConfig(ctx, req.(*GetBootstrapAgentConfigRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -455,6 +515,78 @@ func _BootstrapService_UpdateBootstrapAgent_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BootstrapService_GetPendingBootstrapAgents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBootstrapAgentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BootstrapServiceServer).GetPendingBootstrapAgents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BootstrapService_GetPendingBootstrapAgents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BootstrapServiceServer).GetPendingBootstrapAgents(ctx, req.(*GetBootstrapAgentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BootstrapService_ApproveBootstrapAgent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sentry.BootstrapAgent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BootstrapServiceServer).ApproveBootstrapAgent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BootstrapService_ApproveBootstrapAgent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BootstrapServiceServer).ApproveBootstrapAgent(ctx, req.(*sentry.BootstrapAgent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BootstrapService_RejectBootstrapAgent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sentry.BootstrapAgent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BootstrapServiceServer).RejectBootstrapAgent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BootstrapService_RejectBootstrapAgent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BootstrapServiceServer).RejectBootstrapAgent(ctx, req.(*sentry.BootstrapAgent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BootstrapService_ReissueBootstrapAgentToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(sentry.BootstrapAgent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BootstrapServiceServer).ReissueBootstrapAgentToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BootstrapService_ReissueBootstrapAgentToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BootstrapServiceServer).ReissueBootstrapAgentToken(ctx, req.(*sentry.BootstrapAgent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BootstrapService_ServiceDesc is the grpc.ServiceDesc for BootstrapService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -510,6 +642,22 @@ var BootstrapService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateBootstrapAgent",
 			Handler:    _BootstrapService_UpdateBootstrapAgent_Handler,
 		},
+		{
+			MethodName: "GetPendingBootstrapAgents",
+			Handler:    _BootstrapService_GetPendingBootstrapAgents_Handler,
+		},
+		{
+			MethodName: "ApproveBootstrapAgent",
+			Handler:    _BootstrapService_ApproveBootstrapAgent_Handler,
+		},
+		{
+			MethodName: "RejectBootstrapAgent",
+			Handler:    _BootstrapService_RejectBootstrapAgent_Handler,
+		},
+		{
+			MethodName: "ReissueBootstrapAgentToken",
+			Handler:    _BootstrapService_ReissueBootstrapAgentToken_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/rpc/sentry/bootstrap.proto",
@@ -0,0 +1,146 @@
+//protobuf for cluster agent service
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: proto/rpc/sentry/cluster_agent.proto
+
+package sentry
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	ClusterAgentService_GetAgentManifest_FullMethodName   = "/paralus.dev.sentry.rpc.ClusterAgentService/GetAgentManifest"
+	ClusterAgentService_ReportAgentUpgrade_FullMethodName = "/paralus.dev.sentry.rpc.ClusterAgentService/ReportAgentUpgrade"
+)
+
+// ClusterAgentServiceClient is the client API for ClusterAgentService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ClusterAgentServiceClient interface {
+	GetAgentManifest(ctx context.Context, in *GetAgentManifestRequest, opts ...grpc.CallOption) (*GetAgentManifestResponse, error)
+	ReportAgentUpgrade(ctx context.Context, in *ReportAgentUpgradeRequest, opts ...grpc.CallOption) (*ReportAgentUpgradeResponse, error)
+}
+
+type clusterAgentServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewClusterAgentServiceClient(cc grpc.ClientConnInterface) ClusterAgentServiceClient {
+	return &clusterAgentServiceClient{cc}
+}
+
+func (c *clusterAgentServiceClient) GetAgentManifest(ctx context.Context, in *GetAgentManifestRequest, opts ...grpc.CallOption) (*GetAgentManifestResponse, error) {
+	out := new(GetAgentManifestResponse)
+	err := c.cc.Invoke(ctx, ClusterAgentService_GetAgentManifest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterAgentServiceClient) ReportAgentUpgrade(ctx context.Context, in *ReportAgentUpgradeRequest, opts ...grpc.CallOption) (*ReportAgentUpgradeResponse, error) {
+	out := new(ReportAgentUpgradeResponse)
+	err := c.cc.Invoke(ctx, ClusterAgentService_ReportAgentUpgrade_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ClusterAgentServiceServer is the server API for ClusterAgentService service.
+// All implementations should embed UnimplementedClusterAgentServiceServer
+// for forward compatibility
+type ClusterAgentServiceServer interface {
+	GetAgentManifest(context.Context, *GetAgentManifestRequest) (*GetAgentManifestResponse, error)
+	ReportAgentUpgrade(context.Context, *ReportAgentUpgradeRequest) (*ReportAgentUpgradeResponse, error)
+}
+
+// UnimplementedClusterAgentServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedClusterAgentServiceServer struct {
+}
+
+func (UnimplementedClusterAgentServiceServer) GetAgentManifest(context.Context, *GetAgentManifestRequest) (*GetAgentManifestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAgentManifest not implemented")
+}
+func (UnimplementedClusterAgentServiceServer) ReportAgentUpgrade(context.Context, *ReportAgentUpgradeRequest) (*ReportAgentUpgradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportAgentUpgrade not implemented")
+}
+
+// UnsafeClusterAgentServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ClusterAgentServiceServer will
+// result in compilation errors.
+type UnsafeClusterAgentServiceServer interface {
+	mustEmbedUnimplementedClusterAgentServiceServer()
+}
+
+func RegisterClusterAgentServiceServer(s grpc.ServiceRegistrar, srv ClusterAgentServiceServer) {
+	s.RegisterService(&ClusterAgentService_ServiceDesc, srv)
+}
+
+func _ClusterAgentService_GetAgentManifest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAgentManifestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterAgentServiceServer).GetAgentManifest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterAgentService_GetAgentManifest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterAgentServiceServer).GetAgentManifest(ctx, req.(*GetAgentManifestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterAgentService_ReportAgentUpgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportAgentUpgradeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterAgentServiceServer).ReportAgentUpgrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterAgentService_ReportAgentUpgrade_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterAgentServiceServer).ReportAgentUpgrade(ctx, req.(*ReportAgentUpgradeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ClusterAgentService_ServiceDesc is the grpc.ServiceDesc for ClusterAgentService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ClusterAgentService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "paralus.dev.sentry.rpc.ClusterAgentService",
+	HandlerType: (*ClusterAgentServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAgentManifest",
+			Handler:    _ClusterAgentService_GetAgentManifest_Handler,
+		},
+		{
+			MethodName: "ReportAgentUpgrade",
+			Handler:    _ClusterAgentService_ReportAgentUpgrade_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/rpc/sentry/cluster_agent.proto",
+}
@@ -0,0 +1,109 @@
+//protobuf for cluster inventory service
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: proto/rpc/sentry/cluster_inventory.proto
+
+package sentry
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	ClusterInventoryService_UpdateClusterNodes_FullMethodName = "/paralus.dev.sentry.rpc.ClusterInventoryService/UpdateClusterNodes"
+)
+
+// ClusterInventoryServiceClient is the client API for ClusterInventoryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ClusterInventoryServiceClient interface {
+	UpdateClusterNodes(ctx context.Context, in *UpdateClusterNodesRequest, opts ...grpc.CallOption) (*UpdateClusterNodesResponse, error)
+}
+
+type clusterInventoryServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewClusterInventoryServiceClient(cc grpc.ClientConnInterface) ClusterInventoryServiceClient {
+	return &clusterInventoryServiceClient{cc}
+}
+
+func (c *clusterInventoryServiceClient) UpdateClusterNodes(ctx context.Context, in *UpdateClusterNodesRequest, opts ...grpc.CallOption) (*UpdateClusterNodesResponse, error) {
+	out := new(UpdateClusterNodesResponse)
+	err := c.cc.Invoke(ctx, ClusterInventoryService_UpdateClusterNodes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ClusterInventoryServiceServer is the server API for ClusterInventoryService service.
+// All implementations should embed UnimplementedClusterInventoryServiceServer
+// for forward compatibility
+type ClusterInventoryServiceServer interface {
+	UpdateClusterNodes(context.Context, *UpdateClusterNodesRequest) (*UpdateClusterNodesResponse, error)
+}
+
+// UnimplementedClusterInventoryServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedClusterInventoryServiceServer struct {
+}
+
+func (UnimplementedClusterInventoryServiceServer) UpdateClusterNodes(context.Context, *UpdateClusterNodesRequest) (*UpdateClusterNodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateClusterNodes not implemented")
+}
+
+// UnsafeClusterInventoryServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ClusterInventoryServiceServer will
+// result in compilation errors.
+type UnsafeClusterInventoryServiceServer interface {
+	mustEmbedUnimplementedClusterInventoryServiceServer()
+}
+
+func RegisterClusterInventoryServiceServer(s grpc.ServiceRegistrar, srv ClusterInventoryServiceServer) {
+	s.RegisterService(&ClusterInventoryService_ServiceDesc, srv)
+}
+
+func _ClusterInventoryService_UpdateClusterNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateClusterNodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterInventoryServiceServer).UpdateClusterNodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ClusterInventoryService_UpdateClusterNodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterInventoryServiceServer).UpdateClusterNodes(ctx, req.(*UpdateClusterNodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ClusterInventoryService_ServiceDesc is the grpc.ServiceDesc for ClusterInventoryService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ClusterInventoryService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "paralus.dev.sentry.rpc.ClusterInventoryService",
+	HandlerType: (*ClusterInventoryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateClusterNodes",
+			Handler:    _ClusterInventoryService_UpdateClusterNodes_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/rpc/sentry/cluster_inventory.proto",
+}
@@ -23,6 +23,7 @@ const (
 	KubeConfigService_GetForClusterWebSession_FullMethodName    = "/paralus.dev.sentry.rpc.KubeConfigService/GetForClusterWebSession"
 	KubeConfigService_GetForClusterSystemSession_FullMethodName = "/paralus.dev.sentry.rpc.KubeConfigService/GetForClusterSystemSession"
 	KubeConfigService_GetForUser_FullMethodName                 = "/paralus.dev.sentry.rpc.KubeConfigService/GetForUser"
+	KubeConfigService_GetForServiceAccount_FullMethodName       = "/paralus.dev.sentry.rpc.KubeConfigService/GetForServiceAccount"
 	KubeConfigService_RevokeKubeconfig_FullMethodName           = "/paralus.dev.sentry.rpc.KubeConfigService/RevokeKubeconfig"
 	KubeConfigService_GetOrganizationSetting_FullMethodName     = "/paralus.dev.sentry.rpc.KubeConfigService/GetOrganizationSetting"
 	KubeConfigService_GetUserSetting_FullMethodName             = "/paralus.dev.sentry.rpc.KubeConfigService/GetUserSetting"
@@ -39,6 +40,9 @@ type KubeConfigServiceClient interface {
 	GetForClusterWebSession(ctx context.Context, in *GetForClusterRequest, opts ...grpc.CallOption) (*v3.HttpBody, error)
 	GetForClusterSystemSession(ctx context.Context, in *GetForClusterRequest, opts ...grpc.CallOption) (*v3.HttpBody, error)
 	GetForUser(ctx context.Context, in *GetForUserRequest, opts ...grpc.CallOption) (*v3.HttpBody, error)
+	// GetForServiceAccount returns the kubeconfig of the service account
+	// making the request
+	GetForServiceAccount(ctx context.Context, in *GetForUserRequest, opts ...grpc.CallOption) (*v3.HttpBody, error)
 	RevokeKubeconfig(ctx context.Context, in *RevokeKubeconfigRequest, opts ...grpc.CallOption) (*RevokeKubeconfigResponse, error)
 	GetOrganizationSetting(ctx context.Context, in *GetKubeconfigSettingRequest, opts ...grpc.CallOption) (*GetKubeconfigSettingResponse, error)
 	GetUserSetting(ctx context.Context, in *GetKubeconfigSettingRequest, opts ...grpc.CallOption) (*GetKubeconfigSettingResponse, error)
@@ -83,6 +87,15 @@ func (c *kubeConfigServiceClient) GetForUser(ctx context.Context, in *GetForUser
 	return out, nil
 }
 
+func (c *kubeConfigServiceClient) GetForServiceAccount(ctx context.Context, in *GetForUserRequest, opts ...grpc.CallOption) (*v3.HttpBody, error) {
+	out := new(v3.HttpBody)
+	err := c.cc.Invoke(ctx, KubeConfigService_GetForServiceAccount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *kubeConfigServiceClient) RevokeKubeconfig(ctx context.Context, in *RevokeKubeconfigRequest, opts ...grpc.CallOption) (*RevokeKubeconfigResponse, error) {
 	out := new(RevokeKubeconfigResponse)
 	err := c.cc.Invoke(ctx, KubeConfigService_RevokeKubeconfig_FullMethodName, in, out, opts...)
@@ -153,6 +166,9 @@ type KubeConfigServiceServer interface {
 	GetForClusterWebSession(context.Context, *GetForClusterRequest) (*v3.HttpBody, error)
 	GetForClusterSystemSession(context.Context, *GetForClusterRequest) (*v3.HttpBody, error)
 	GetForUser(context.Context, *GetForUserRequest) (*v3.HttpBody, error)
+	// GetForServiceAccount returns the kubeconfig of the service account
+	// making the request
+	GetForServiceAccount(context.Context, *GetForUserRequest) (*v3.HttpBody, error)
 	RevokeKubeconfig(context.Context, *RevokeKubeconfigRequest) (*RevokeKubeconfigResponse, error)
 	GetOrganizationSetting(context.Context, *GetKubeconfigSettingRequest) (*GetKubeconfigSettingResponse, error)
 	GetUserSetting(context.Context, *GetKubeconfigSettingRequest) (*GetKubeconfigSettingResponse, error)
@@ -175,6 +191,9 @@ func (UnimplementedKubeConfigServiceServer) GetForClusterSystemSession(context.C
 func (UnimplementedKubeConfigServiceServer) GetForUser(context.Context, *GetForUserRequest) (*v3.HttpBody, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetForUser not implemented")
 }
+func (UnimplementedKubeConfigServiceServer) GetForServiceAccount(context.Context, *GetForUserRequest) (*v3.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetForServiceAccount not implemented")
+}
 func (UnimplementedKubeConfigServiceServer) RevokeKubeconfig(context.Context, *RevokeKubeconfigRequest) (*RevokeKubeconfigResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RevokeKubeconfig not implemented")
 }
@@ -262,6 +281,24 @@ func _KubeConfigService_GetForUser_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _KubeConfigService_GetForServiceAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetForUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KubeConfigServiceServer).GetForServiceAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KubeConfigService_GetForServiceAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KubeConfigServiceServer).GetForServiceAccount(ctx, req.(*GetForUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _KubeConfigService_RevokeKubeconfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RevokeKubeconfigRequest)
 	if err := dec(in); err != nil {
@@ -407,6 +444,10 @@ var KubeConfigService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetForUser",
 			Handler:    _KubeConfigService_GetForUser_Handler,
 		},
+		{
+			MethodName: "GetForServiceAccount",
+			Handler:    _KubeConfigService_GetForServiceAccount_Handler,
+		},
 		{
 			MethodName: "RevokeKubeconfig",
 			Handler:    _KubeConfigService_RevokeKubeconfig_Handler,
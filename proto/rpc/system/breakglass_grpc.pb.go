@@ -0,0 +1,298 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: proto/rpc/system/breakglass.proto
+
+package systemv3
+
+import (
+	context "context"
+	v31 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	v3 "github.com/paralus/paralus/proto/types/systempb/v3"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	BreakGlassService_CreateBreakGlassAccount_FullMethodName = "/paralus.dev.rpc.system.v3.BreakGlassService/CreateBreakGlassAccount"
+	BreakGlassService_GetBreakGlassAccounts_FullMethodName   = "/paralus.dev.rpc.system.v3.BreakGlassService/GetBreakGlassAccounts"
+	BreakGlassService_GetBreakGlassAccount_FullMethodName    = "/paralus.dev.rpc.system.v3.BreakGlassService/GetBreakGlassAccount"
+	BreakGlassService_DeleteBreakGlassAccount_FullMethodName = "/paralus.dev.rpc.system.v3.BreakGlassService/DeleteBreakGlassAccount"
+	BreakGlassService_RotateBreakGlassAccount_FullMethodName = "/paralus.dev.rpc.system.v3.BreakGlassService/RotateBreakGlassAccount"
+	BreakGlassService_BreakGlass_FullMethodName              = "/paralus.dev.rpc.system.v3.BreakGlassService/BreakGlass"
+)
+
+// BreakGlassServiceClient is the client API for BreakGlassService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type BreakGlassServiceClient interface {
+	CreateBreakGlassAccount(ctx context.Context, in *v3.BreakGlassAccount, opts ...grpc.CallOption) (*v3.BreakGlassAccount, error)
+	GetBreakGlassAccounts(ctx context.Context, in *v3.BreakGlassAccount, opts ...grpc.CallOption) (*v3.BreakGlassAccountList, error)
+	GetBreakGlassAccount(ctx context.Context, in *v3.BreakGlassAccount, opts ...grpc.CallOption) (*v3.BreakGlassAccount, error)
+	DeleteBreakGlassAccount(ctx context.Context, in *v3.BreakGlassAccount, opts ...grpc.CallOption) (*v31.Empty, error)
+	RotateBreakGlassAccount(ctx context.Context, in *v3.BreakGlassAccount, opts ...grpc.CallOption) (*v3.BreakGlassAccount, error)
+	// BreakGlass issues a short-lived kubeconfig of the break-glass
+	// account, it does not require a login
+	BreakGlass(ctx context.Context, in *BreakGlassRequest, opts ...grpc.CallOption) (*v31.HttpBody, error)
+}
+
+type breakGlassServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBreakGlassServiceClient(cc grpc.ClientConnInterface) BreakGlassServiceClient {
+	return &breakGlassServiceClient{cc}
+}
+
+func (c *breakGlassServiceClient) CreateBreakGlassAccount(ctx context.Context, in *v3.BreakGlassAccount, opts ...grpc.CallOption) (*v3.BreakGlassAccount, error) {
+	out := new(v3.BreakGlassAccount)
+	err := c.cc.Invoke(ctx, BreakGlassService_CreateBreakGlassAccount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *breakGlassServiceClient) GetBreakGlassAccounts(ctx context.Context, in *v3.BreakGlassAccount, opts ...grpc.CallOption) (*v3.BreakGlassAccountList, error) {
+	out := new(v3.BreakGlassAccountList)
+	err := c.cc.Invoke(ctx, BreakGlassService_GetBreakGlassAccounts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *breakGlassServiceClient) GetBreakGlassAccount(ctx context.Context, in *v3.BreakGlassAccount, opts ...grpc.CallOption) (*v3.BreakGlassAccount, error) {
+	out := new(v3.BreakGlassAccount)
+	err := c.cc.Invoke(ctx, BreakGlassService_GetBreakGlassAccount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *breakGlassServiceClient) DeleteBreakGlassAccount(ctx context.Context, in *v3.BreakGlassAccount, opts ...grpc.CallOption) (*v31.Empty, error) {
+	out := new(v31.Empty)
+	err := c.cc.Invoke(ctx, BreakGlassService_DeleteBreakGlassAccount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *breakGlassServiceClient) RotateBreakGlassAccount(ctx context.Context, in *v3.BreakGlassAccount, opts ...grpc.CallOption) (*v3.BreakGlassAccount, error) {
+	out := new(v3.BreakGlassAccount)
+	err := c.cc.Invoke(ctx, BreakGlassService_RotateBreakGlassAccount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *breakGlassServiceClient) BreakGlass(ctx context.Context, in *BreakGlassRequest, opts ...grpc.CallOption) (*v31.HttpBody, error) {
+	out := new(v31.HttpBody)
+	err := c.cc.Invoke(ctx, BreakGlassService_BreakGlass_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BreakGlassServiceServer is the server API for BreakGlassService service.
+// All implementations should embed UnimplementedBreakGlassServiceServer
+// for forward compatibility
+type BreakGlassServiceServer interface {
+	CreateBreakGlassAccount(context.Context, *v3.BreakGlassAccount) (*v3.BreakGlassAccount, error)
+	GetBreakGlassAccounts(context.Context, *v3.BreakGlassAccount) (*v3.BreakGlassAccountList, error)
+	GetBreakGlassAccount(context.Context, *v3.BreakGlassAccount) (*v3.BreakGlassAccount, error)
+	DeleteBreakGlassAccount(context.Context, *v3.BreakGlassAccount) (*v31.Empty, error)
+	RotateBreakGlassAccount(context.Context, *v3.BreakGlassAccount) (*v3.BreakGlassAccount, error)
+	// BreakGlass issues a short-lived kubeconfig of the break-glass
+	// account, it does not require a login
+	BreakGlass(context.Context, *BreakGlassRequest) (*v31.HttpBody, error)
+}
+
+// UnimplementedBreakGlassServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedBreakGlassServiceServer struct {
+}
+
+func (UnimplementedBreakGlassServiceServer) CreateBreakGlassAccount(context.Context, *v3.BreakGlassAccount) (*v3.BreakGlassAccount, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBreakGlassAccount not implemented")
+}
+func (UnimplementedBreakGlassServiceServer) GetBreakGlassAccounts(context.Context, *v3.BreakGlassAccount) (*v3.BreakGlassAccountList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBreakGlassAccounts not implemented")
+}
+func (UnimplementedBreakGlassServiceServer) GetBreakGlassAccount(context.Context, *v3.BreakGlassAccount) (*v3.BreakGlassAccount, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBreakGlassAccount not implemented")
+}
+func (UnimplementedBreakGlassServiceServer) DeleteBreakGlassAccount(context.Context, *v3.BreakGlassAccount) (*v31.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBreakGlassAccount not implemented")
+}
+func (UnimplementedBreakGlassServiceServer) RotateBreakGlassAccount(context.Context, *v3.BreakGlassAccount) (*v3.BreakGlassAccount, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateBreakGlassAccount not implemented")
+}
+func (UnimplementedBreakGlassServiceServer) BreakGlass(context.Context, *BreakGlassRequest) (*v31.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BreakGlass not implemented")
+}
+
+// UnsafeBreakGlassServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BreakGlassServiceServer will
+// result in compilation errors.
+type UnsafeBreakGlassServiceServer interface {
+	mustEmbedUnimplementedBreakGlassServiceServer()
+}
+
+func RegisterBreakGlassServiceServer(s grpc.ServiceRegistrar, srv BreakGlassServiceServer) {
+	s.RegisterService(&BreakGlassService_ServiceDesc, srv)
+}
+
+func _BreakGlassService_CreateBreakGlassAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v3.BreakGlassAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BreakGlassServiceServer).CreateBreakGlassAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BreakGlassService_CreateBreakGlassAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BreakGlassServiceServer).CreateBreakGlassAccount(ctx, req.(*v3.BreakGlassAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BreakGlassService_GetBreakGlassAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v3.BreakGlassAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BreakGlassServiceServer).GetBreakGlassAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BreakGlassService_GetBreakGlassAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BreakGlassServiceServer).GetBreakGlassAccounts(ctx, req.(*v3.BreakGlassAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BreakGlassService_GetBreakGlassAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v3.BreakGlassAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BreakGlassServiceServer).GetBreakGlassAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BreakGlassService_GetBreakGlassAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BreakGlassServiceServer).GetBreakGlassAccount(ctx, req.(*v3.BreakGlassAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BreakGlassService_DeleteBreakGlassAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v3.BreakGlassAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BreakGlassServiceServer).DeleteBreakGlassAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BreakGlassService_DeleteBreakGlassAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BreakGlassServiceServer).DeleteBreakGlassAccount(ctx, req.(*v3.BreakGlassAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BreakGlassService_RotateBreakGlassAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v3.BreakGlassAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BreakGlassServiceServer).RotateBreakGlassAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BreakGlassService_RotateBreakGlassAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BreakGlassServiceServer).RotateBreakGlassAccount(ctx, req.(*v3.BreakGlassAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BreakGlassService_BreakGlass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BreakGlassRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BreakGlassServiceServer).BreakGlass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BreakGlassService_BreakGlass_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BreakGlassServiceServer).BreakGlass(ctx, req.(*BreakGlassRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BreakGlassService_ServiceDesc is the grpc.ServiceDesc for BreakGlassService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BreakGlassService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "paralus.dev.rpc.system.v3.BreakGlassService",
+	HandlerType: (*BreakGlassServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateBreakGlassAccount",
+			Handler:    _BreakGlassService_CreateBreakGlassAccount_Handler,
+		},
+		{
+			MethodName: "GetBreakGlassAccounts",
+			Handler:    _BreakGlassService_GetBreakGlassAccounts_Handler,
+		},
+		{
+			MethodName: "GetBreakGlassAccount",
+			Handler:    _BreakGlassService_GetBreakGlassAccount_Handler,
+		},
+		{
+			MethodName: "DeleteBreakGlassAccount",
+			Handler:    _BreakGlassService_DeleteBreakGlassAccount_Handler,
+		},
+		{
+			MethodName: "RotateBreakGlassAccount",
+			Handler:    _BreakGlassService_RotateBreakGlassAccount_Handler,
+		},
+		{
+			MethodName: "BreakGlass",
+			Handler:    _BreakGlassService_BreakGlass_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/rpc/system/breakglass.proto",
+}
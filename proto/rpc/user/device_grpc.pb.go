@@ -0,0 +1,266 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: proto/rpc/user/device.proto
+
+package userv3
+
+import (
+	context "context"
+	v3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	DeviceAuthorizationService_AuthorizeDevice_FullMethodName    = "/paralus.dev.rpc.user.v3.DeviceAuthorizationService/AuthorizeDevice"
+	DeviceAuthorizationService_ApproveDevice_FullMethodName      = "/paralus.dev.rpc.user.v3.DeviceAuthorizationService/ApproveDevice"
+	DeviceAuthorizationService_RequestDeviceToken_FullMethodName = "/paralus.dev.rpc.user.v3.DeviceAuthorizationService/RequestDeviceToken"
+	DeviceAuthorizationService_GetDevices_FullMethodName         = "/paralus.dev.rpc.user.v3.DeviceAuthorizationService/GetDevices"
+	DeviceAuthorizationService_RevokeDevice_FullMethodName       = "/paralus.dev.rpc.user.v3.DeviceAuthorizationService/RevokeDevice"
+)
+
+// DeviceAuthorizationServiceClient is the client API for DeviceAuthorizationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DeviceAuthorizationServiceClient interface {
+	// AuthorizeDevice starts the authorization of a device, it is not
+	// authenticated
+	AuthorizeDevice(ctx context.Context, in *DeviceAuthorizationRequest, opts ...grpc.CallOption) (*DeviceAuthorizationResponse, error)
+	ApproveDevice(ctx context.Context, in *DeviceApprovalRequest, opts ...grpc.CallOption) (*v3.Empty, error)
+	// RequestDeviceToken issues the tokens of an approved device, it is
+	// authenticated by the device code or refresh token
+	RequestDeviceToken(ctx context.Context, in *DeviceTokenRequest, opts ...grpc.CallOption) (*DeviceTokenResponse, error)
+	// GetDevices lists the devices of the user
+	GetDevices(ctx context.Context, in *v3.Empty, opts ...grpc.CallOption) (*DeviceList, error)
+	RevokeDevice(ctx context.Context, in *Device, opts ...grpc.CallOption) (*v3.Empty, error)
+}
+
+type deviceAuthorizationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDeviceAuthorizationServiceClient(cc grpc.ClientConnInterface) DeviceAuthorizationServiceClient {
+	return &deviceAuthorizationServiceClient{cc}
+}
+
+func (c *deviceAuthorizationServiceClient) AuthorizeDevice(ctx context.Context, in *DeviceAuthorizationRequest, opts ...grpc.CallOption) (*DeviceAuthorizationResponse, error) {
+	out := new(DeviceAuthorizationResponse)
+	err := c.cc.Invoke(ctx, DeviceAuthorizationService_AuthorizeDevice_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceAuthorizationServiceClient) ApproveDevice(ctx context.Context, in *DeviceApprovalRequest, opts ...grpc.CallOption) (*v3.Empty, error) {
+	out := new(v3.Empty)
+	err := c.cc.Invoke(ctx, DeviceAuthorizationService_ApproveDevice_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceAuthorizationServiceClient) RequestDeviceToken(ctx context.Context, in *DeviceTokenRequest, opts ...grpc.CallOption) (*DeviceTokenResponse, error) {
+	out := new(DeviceTokenResponse)
+	err := c.cc.Invoke(ctx, DeviceAuthorizationService_RequestDeviceToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceAuthorizationServiceClient) GetDevices(ctx context.Context, in *v3.Empty, opts ...grpc.CallOption) (*DeviceList, error) {
+	out := new(DeviceList)
+	err := c.cc.Invoke(ctx, DeviceAuthorizationService_GetDevices_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deviceAuthorizationServiceClient) RevokeDevice(ctx context.Context, in *Device, opts ...grpc.CallOption) (*v3.Empty, error) {
+	out := new(v3.Empty)
+	err := c.cc.Invoke(ctx, DeviceAuthorizationService_RevokeDevice_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DeviceAuthorizationServiceServer is the server API for DeviceAuthorizationService service.
+// All implementations should embed UnimplementedDeviceAuthorizationServiceServer
+// for forward compatibility
+type DeviceAuthorizationServiceServer interface {
+	// AuthorizeDevice starts the authorization of a device, it is not
+	// authenticated
+	AuthorizeDevice(context.Context, *DeviceAuthorizationRequest) (*DeviceAuthorizationResponse, error)
+	ApproveDevice(context.Context, *DeviceApprovalRequest) (*v3.Empty, error)
+	// RequestDeviceToken issues the tokens of an approved device, it is
+	// authenticated by the device code or refresh token
+	RequestDeviceToken(context.Context, *DeviceTokenRequest) (*DeviceTokenResponse, error)
+	// GetDevices lists the devices of the user
+	GetDevices(context.Context, *v3.Empty) (*DeviceList, error)
+	RevokeDevice(context.Context, *Device) (*v3.Empty, error)
+}
+
+// UnimplementedDeviceAuthorizationServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedDeviceAuthorizationServiceServer struct {
+}
+
+func (UnimplementedDeviceAuthorizationServiceServer) AuthorizeDevice(context.Context, *DeviceAuthorizationRequest) (*DeviceAuthorizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthorizeDevice not implemented")
+}
+func (UnimplementedDeviceAuthorizationServiceServer) ApproveDevice(context.Context, *DeviceApprovalRequest) (*v3.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveDevice not implemented")
+}
+func (UnimplementedDeviceAuthorizationServiceServer) RequestDeviceToken(context.Context, *DeviceTokenRequest) (*DeviceTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestDeviceToken not implemented")
+}
+func (UnimplementedDeviceAuthorizationServiceServer) GetDevices(context.Context, *v3.Empty) (*DeviceList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDevices not implemented")
+}
+func (UnimplementedDeviceAuthorizationServiceServer) RevokeDevice(context.Context, *Device) (*v3.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeDevice not implemented")
+}
+
+// UnsafeDeviceAuthorizationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DeviceAuthorizationServiceServer will
+// result in compilation errors.
+type UnsafeDeviceAuthorizationServiceServer interface {
+	mustEmbedUnimplementedDeviceAuthorizationServiceServer()
+}
+
+func RegisterDeviceAuthorizationServiceServer(s grpc.ServiceRegistrar, srv DeviceAuthorizationServiceServer) {
+	s.RegisterService(&DeviceAuthorizationService_ServiceDesc, srv)
+}
+
+func _DeviceAuthorizationService_AuthorizeDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceAuthorizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceAuthorizationServiceServer).AuthorizeDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeviceAuthorizationService_AuthorizeDevice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceAuthorizationServiceServer).AuthorizeDevice(ctx, req.(*DeviceAuthorizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceAuthorizationService_ApproveDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceApprovalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceAuthorizationServiceServer).ApproveDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeviceAuthorizationService_ApproveDevice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceAuthorizationServiceServer).ApproveDevice(ctx, req.(*DeviceApprovalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceAuthorizationService_RequestDeviceToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceAuthorizationServiceServer).RequestDeviceToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeviceAuthorizationService_RequestDeviceToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceAuthorizationServiceServer).RequestDeviceToken(ctx, req.(*DeviceTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceAuthorizationService_GetDevices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v3.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceAuthorizationServiceServer).GetDevices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeviceAuthorizationService_GetDevices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceAuthorizationServiceServer).GetDevices(ctx, req.(*v3.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeviceAuthorizationService_RevokeDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Device)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceAuthorizationServiceServer).RevokeDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeviceAuthorizationService_RevokeDevice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceAuthorizationServiceServer).RevokeDevice(ctx, req.(*Device))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DeviceAuthorizationService_ServiceDesc is the grpc.ServiceDesc for DeviceAuthorizationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DeviceAuthorizationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "paralus.dev.rpc.user.v3.DeviceAuthorizationService",
+	HandlerType: (*DeviceAuthorizationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AuthorizeDevice",
+			Handler:    _DeviceAuthorizationService_AuthorizeDevice_Handler,
+		},
+		{
+			MethodName: "ApproveDevice",
+			Handler:    _DeviceAuthorizationService_ApproveDevice_Handler,
+		},
+		{
+			MethodName: "RequestDeviceToken",
+			Handler:    _DeviceAuthorizationService_RequestDeviceToken_Handler,
+		},
+		{
+			MethodName: "GetDevices",
+			Handler:    _DeviceAuthorizationService_GetDevices_Handler,
+		},
+		{
+			MethodName: "RevokeDevice",
+			Handler:    _DeviceAuthorizationService_RevokeDevice_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/rpc/user/device.proto",
+}
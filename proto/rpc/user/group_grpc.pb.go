@@ -21,11 +21,12 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	GroupService_CreateGroup_FullMethodName = "/paralus.dev.rpc.user.v3.GroupService/CreateGroup"
-	GroupService_GetGroups_FullMethodName   = "/paralus.dev.rpc.user.v3.GroupService/GetGroups"
-	GroupService_GetGroup_FullMethodName    = "/paralus.dev.rpc.user.v3.GroupService/GetGroup"
-	GroupService_UpdateGroup_FullMethodName = "/paralus.dev.rpc.user.v3.GroupService/UpdateGroup"
-	GroupService_DeleteGroup_FullMethodName = "/paralus.dev.rpc.user.v3.GroupService/DeleteGroup"
+	GroupService_CreateGroup_FullMethodName              = "/paralus.dev.rpc.user.v3.GroupService/CreateGroup"
+	GroupService_GetGroups_FullMethodName                = "/paralus.dev.rpc.user.v3.GroupService/GetGroups"
+	GroupService_GetGroup_FullMethodName                 = "/paralus.dev.rpc.user.v3.GroupService/GetGroup"
+	GroupService_GetGroupEffectiveMembers_FullMethodName = "/paralus.dev.rpc.user.v3.GroupService/GetGroupEffectiveMembers"
+	GroupService_UpdateGroup_FullMethodName              = "/paralus.dev.rpc.user.v3.GroupService/UpdateGroup"
+	GroupService_DeleteGroup_FullMethodName              = "/paralus.dev.rpc.user.v3.GroupService/DeleteGroup"
 )
 
 // GroupServiceClient is the client API for GroupService service.
@@ -35,6 +36,7 @@ type GroupServiceClient interface {
 	CreateGroup(ctx context.Context, in *v3.Group, opts ...grpc.CallOption) (*v3.Group, error)
 	GetGroups(ctx context.Context, in *v31.QueryOptions, opts ...grpc.CallOption) (*v3.GroupList, error)
 	GetGroup(ctx context.Context, in *v3.Group, opts ...grpc.CallOption) (*v3.Group, error)
+	GetGroupEffectiveMembers(ctx context.Context, in *v3.Group, opts ...grpc.CallOption) (*v3.Group, error)
 	UpdateGroup(ctx context.Context, in *v3.Group, opts ...grpc.CallOption) (*v3.Group, error)
 	DeleteGroup(ctx context.Context, in *v3.Group, opts ...grpc.CallOption) (*v3.Group, error)
 }
@@ -74,6 +76,15 @@ func (c *groupServiceClient) GetGroup(ctx context.Context, in *v3.Group, opts ..
 	return out, nil
 }
 
+func (c *groupServiceClient) GetGroupEffectiveMembers(ctx context.Context, in *v3.Group, opts ...grpc.CallOption) (*v3.Group, error) {
+	out := new(v3.Group)
+	err := c.cc.Invoke(ctx, GroupService_GetGroupEffectiveMembers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *groupServiceClient) UpdateGroup(ctx context.Context, in *v3.Group, opts ...grpc.CallOption) (*v3.Group, error) {
 	out := new(v3.Group)
 	err := c.cc.Invoke(ctx, GroupService_UpdateGroup_FullMethodName, in, out, opts...)
@@ -99,6 +110,7 @@ type GroupServiceServer interface {
 	CreateGroup(context.Context, *v3.Group) (*v3.Group, error)
 	GetGroups(context.Context, *v31.QueryOptions) (*v3.GroupList, error)
 	GetGroup(context.Context, *v3.Group) (*v3.Group, error)
+	GetGroupEffectiveMembers(context.Context, *v3.Group) (*v3.Group, error)
 	UpdateGroup(context.Context, *v3.Group) (*v3.Group, error)
 	DeleteGroup(context.Context, *v3.Group) (*v3.Group, error)
 }
@@ -116,6 +128,9 @@ func (UnimplementedGroupServiceServer) GetGroups(context.Context, *v31.QueryOpti
 func (UnimplementedGroupServiceServer) GetGroup(context.Context, *v3.Group) (*v3.Group, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetGroup not implemented")
 }
+func (UnimplementedGroupServiceServer) GetGroupEffectiveMembers(context.Context, *v3.Group) (*v3.Group, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupEffectiveMembers not implemented")
+}
 func (UnimplementedGroupServiceServer) UpdateGroup(context.Context, *v3.Group) (*v3.Group, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroup not implemented")
 }
@@ -188,6 +203,24 @@ func _GroupService_GetGroup_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GroupService_GetGroupEffectiveMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v3.Group)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GroupServiceServer).GetGroupEffectiveMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GroupService_GetGroupEffectiveMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GroupServiceServer).GetGroupEffectiveMembers(ctx, req.(*v3.Group))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GroupService_UpdateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(v3.Group)
 	if err := dec(in); err != nil {
@@ -243,6 +276,10 @@ var GroupService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetGroup",
 			Handler:    _GroupService_GetGroup_Handler,
 		},
+		{
+			MethodName: "GetGroupEffectiveMembers",
+			Handler:    _GroupService_GetGroupEffectiveMembers_Handler,
+		},
 		{
 			MethodName: "UpdateGroup",
 			Handler:    _GroupService_UpdateGroup_Handler,
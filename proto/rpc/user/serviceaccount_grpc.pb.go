@@ -0,0 +1,368 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: proto/rpc/user/serviceaccount.proto
+
+package userv3
+
+import (
+	context "context"
+	v31 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	v3 "github.com/paralus/paralus/proto/types/userpb/v3"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	ServiceAccountService_CreateServiceAccount_FullMethodName       = "/paralus.dev.rpc.user.v3.ServiceAccountService/CreateServiceAccount"
+	ServiceAccountService_GetServiceAccounts_FullMethodName         = "/paralus.dev.rpc.user.v3.ServiceAccountService/GetServiceAccounts"
+	ServiceAccountService_GetServiceAccount_FullMethodName          = "/paralus.dev.rpc.user.v3.ServiceAccountService/GetServiceAccount"
+	ServiceAccountService_UpdateServiceAccount_FullMethodName       = "/paralus.dev.rpc.user.v3.ServiceAccountService/UpdateServiceAccount"
+	ServiceAccountService_DeleteServiceAccount_FullMethodName       = "/paralus.dev.rpc.user.v3.ServiceAccountService/DeleteServiceAccount"
+	ServiceAccountService_CreateServiceAccountApiKey_FullMethodName = "/paralus.dev.rpc.user.v3.ServiceAccountService/CreateServiceAccountApiKey"
+	ServiceAccountService_GetServiceAccountApiKeys_FullMethodName   = "/paralus.dev.rpc.user.v3.ServiceAccountService/GetServiceAccountApiKeys"
+	ServiceAccountService_DeleteServiceAccountApiKey_FullMethodName = "/paralus.dev.rpc.user.v3.ServiceAccountService/DeleteServiceAccountApiKey"
+)
+
+// ServiceAccountServiceClient is the client API for ServiceAccountService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ServiceAccountServiceClient interface {
+	CreateServiceAccount(ctx context.Context, in *v3.ServiceAccount, opts ...grpc.CallOption) (*v3.ServiceAccount, error)
+	GetServiceAccounts(ctx context.Context, in *v31.QueryOptions, opts ...grpc.CallOption) (*v3.ServiceAccountList, error)
+	GetServiceAccount(ctx context.Context, in *v3.ServiceAccount, opts ...grpc.CallOption) (*v3.ServiceAccount, error)
+	UpdateServiceAccount(ctx context.Context, in *v3.ServiceAccount, opts ...grpc.CallOption) (*v3.ServiceAccount, error)
+	DeleteServiceAccount(ctx context.Context, in *v3.ServiceAccount, opts ...grpc.CallOption) (*v31.Empty, error)
+	CreateServiceAccountApiKey(ctx context.Context, in *ServiceAccountApiKeyRequest, opts ...grpc.CallOption) (*ServiceAccountApiKey, error)
+	GetServiceAccountApiKeys(ctx context.Context, in *ServiceAccountApiKeyRequest, opts ...grpc.CallOption) (*UserListApiKeysResponse, error)
+	DeleteServiceAccountApiKey(ctx context.Context, in *ServiceAccountApiKeyRequest, opts ...grpc.CallOption) (*v31.Empty, error)
+}
+
+type serviceAccountServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewServiceAccountServiceClient(cc grpc.ClientConnInterface) ServiceAccountServiceClient {
+	return &serviceAccountServiceClient{cc}
+}
+
+func (c *serviceAccountServiceClient) CreateServiceAccount(ctx context.Context, in *v3.ServiceAccount, opts ...grpc.CallOption) (*v3.ServiceAccount, error) {
+	out := new(v3.ServiceAccount)
+	err := c.cc.Invoke(ctx, ServiceAccountService_CreateServiceAccount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceAccountServiceClient) GetServiceAccounts(ctx context.Context, in *v31.QueryOptions, opts ...grpc.CallOption) (*v3.ServiceAccountList, error) {
+	out := new(v3.ServiceAccountList)
+	err := c.cc.Invoke(ctx, ServiceAccountService_GetServiceAccounts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceAccountServiceClient) GetServiceAccount(ctx context.Context, in *v3.ServiceAccount, opts ...grpc.CallOption) (*v3.ServiceAccount, error) {
+	out := new(v3.ServiceAccount)
+	err := c.cc.Invoke(ctx, ServiceAccountService_GetServiceAccount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceAccountServiceClient) UpdateServiceAccount(ctx context.Context, in *v3.ServiceAccount, opts ...grpc.CallOption) (*v3.ServiceAccount, error) {
+	out := new(v3.ServiceAccount)
+	err := c.cc.Invoke(ctx, ServiceAccountService_UpdateServiceAccount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceAccountServiceClient) DeleteServiceAccount(ctx context.Context, in *v3.ServiceAccount, opts ...grpc.CallOption) (*v31.Empty, error) {
+	out := new(v31.Empty)
+	err := c.cc.Invoke(ctx, ServiceAccountService_DeleteServiceAccount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceAccountServiceClient) CreateServiceAccountApiKey(ctx context.Context, in *ServiceAccountApiKeyRequest, opts ...grpc.CallOption) (*ServiceAccountApiKey, error) {
+	out := new(ServiceAccountApiKey)
+	err := c.cc.Invoke(ctx, ServiceAccountService_CreateServiceAccountApiKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceAccountServiceClient) GetServiceAccountApiKeys(ctx context.Context, in *ServiceAccountApiKeyRequest, opts ...grpc.CallOption) (*UserListApiKeysResponse, error) {
+	out := new(UserListApiKeysResponse)
+	err := c.cc.Invoke(ctx, ServiceAccountService_GetServiceAccountApiKeys_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *serviceAccountServiceClient) DeleteServiceAccountApiKey(ctx context.Context, in *ServiceAccountApiKeyRequest, opts ...grpc.CallOption) (*v31.Empty, error) {
+	out := new(v31.Empty)
+	err := c.cc.Invoke(ctx, ServiceAccountService_DeleteServiceAccountApiKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ServiceAccountServiceServer is the server API for ServiceAccountService service.
+// All implementations should embed UnimplementedServiceAccountServiceServer
+// for forward compatibility
+type ServiceAccountServiceServer interface {
+	CreateServiceAccount(context.Context, *v3.ServiceAccount) (*v3.ServiceAccount, error)
+	GetServiceAccounts(context.Context, *v31.QueryOptions) (*v3.ServiceAccountList, error)
+	GetServiceAccount(context.Context, *v3.ServiceAccount) (*v3.ServiceAccount, error)
+	UpdateServiceAccount(context.Context, *v3.ServiceAccount) (*v3.ServiceAccount, error)
+	DeleteServiceAccount(context.Context, *v3.ServiceAccount) (*v31.Empty, error)
+	CreateServiceAccountApiKey(context.Context, *ServiceAccountApiKeyRequest) (*ServiceAccountApiKey, error)
+	GetServiceAccountApiKeys(context.Context, *ServiceAccountApiKeyRequest) (*UserListApiKeysResponse, error)
+	DeleteServiceAccountApiKey(context.Context, *ServiceAccountApiKeyRequest) (*v31.Empty, error)
+}
+
+// UnimplementedServiceAccountServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedServiceAccountServiceServer struct {
+}
+
+func (UnimplementedServiceAccountServiceServer) CreateServiceAccount(context.Context, *v3.ServiceAccount) (*v3.ServiceAccount, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateServiceAccount not implemented")
+}
+func (UnimplementedServiceAccountServiceServer) GetServiceAccounts(context.Context, *v31.QueryOptions) (*v3.ServiceAccountList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServiceAccounts not implemented")
+}
+func (UnimplementedServiceAccountServiceServer) GetServiceAccount(context.Context, *v3.ServiceAccount) (*v3.ServiceAccount, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServiceAccount not implemented")
+}
+func (UnimplementedServiceAccountServiceServer) UpdateServiceAccount(context.Context, *v3.ServiceAccount) (*v3.ServiceAccount, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateServiceAccount not implemented")
+}
+func (UnimplementedServiceAccountServiceServer) DeleteServiceAccount(context.Context, *v3.ServiceAccount) (*v31.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteServiceAccount not implemented")
+}
+func (UnimplementedServiceAccountServiceServer) CreateServiceAccountApiKey(context.Context, *ServiceAccountApiKeyRequest) (*ServiceAccountApiKey, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateServiceAccountApiKey not implemented")
+}
+func (UnimplementedServiceAccountServiceServer) GetServiceAccountApiKeys(context.Context, *ServiceAccountApiKeyRequest) (*UserListApiKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServiceAccountApiKeys not implemented")
+}
+func (UnimplementedServiceAccountServiceServer) DeleteServiceAccountApiKey(context.Context, *ServiceAccountApiKeyRequest) (*v31.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteServiceAccountApiKey not implemented")
+}
+
+// UnsafeServiceAccountServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ServiceAccountServiceServer will
+// result in compilation errors.
+type UnsafeServiceAccountServiceServer interface {
+	mustEmbedUnimplementedServiceAccountServiceServer()
+}
+
+func RegisterServiceAccountServiceServer(s grpc.ServiceRegistrar, srv ServiceAccountServiceServer) {
+	s.RegisterService(&ServiceAccountService_ServiceDesc, srv)
+}
+
+func _ServiceAccountService_CreateServiceAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v3.ServiceAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceAccountServiceServer).CreateServiceAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceAccountService_CreateServiceAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceAccountServiceServer).CreateServiceAccount(ctx, req.(*v3.ServiceAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceAccountService_GetServiceAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v31.QueryOptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceAccountServiceServer).GetServiceAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceAccountService_GetServiceAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceAccountServiceServer).GetServiceAccounts(ctx, req.(*v31.QueryOptions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceAccountService_GetServiceAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v3.ServiceAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceAccountServiceServer).GetServiceAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceAccountService_GetServiceAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceAccountServiceServer).GetServiceAccount(ctx, req.(*v3.ServiceAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceAccountService_UpdateServiceAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v3.ServiceAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceAccountServiceServer).UpdateServiceAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceAccountService_UpdateServiceAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceAccountServiceServer).UpdateServiceAccount(ctx, req.(*v3.ServiceAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceAccountService_DeleteServiceAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v3.ServiceAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceAccountServiceServer).DeleteServiceAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceAccountService_DeleteServiceAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceAccountServiceServer).DeleteServiceAccount(ctx, req.(*v3.ServiceAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceAccountService_CreateServiceAccountApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServiceAccountApiKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceAccountServiceServer).CreateServiceAccountApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceAccountService_CreateServiceAccountApiKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceAccountServiceServer).CreateServiceAccountApiKey(ctx, req.(*ServiceAccountApiKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceAccountService_GetServiceAccountApiKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServiceAccountApiKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceAccountServiceServer).GetServiceAccountApiKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceAccountService_GetServiceAccountApiKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceAccountServiceServer).GetServiceAccountApiKeys(ctx, req.(*ServiceAccountApiKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ServiceAccountService_DeleteServiceAccountApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServiceAccountApiKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ServiceAccountServiceServer).DeleteServiceAccountApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ServiceAccountService_DeleteServiceAccountApiKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ServiceAccountServiceServer).DeleteServiceAccountApiKey(ctx, req.(*ServiceAccountApiKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ServiceAccountService_ServiceDesc is the grpc.ServiceDesc for ServiceAccountService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ServiceAccountService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "paralus.dev.rpc.user.v3.ServiceAccountService",
+	HandlerType: (*ServiceAccountServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateServiceAccount",
+			Handler:    _ServiceAccountService_CreateServiceAccount_Handler,
+		},
+		{
+			MethodName: "GetServiceAccounts",
+			Handler:    _ServiceAccountService_GetServiceAccounts_Handler,
+		},
+		{
+			MethodName: "GetServiceAccount",
+			Handler:    _ServiceAccountService_GetServiceAccount_Handler,
+		},
+		{
+			MethodName: "UpdateServiceAccount",
+			Handler:    _ServiceAccountService_UpdateServiceAccount_Handler,
+		},
+		{
+			MethodName: "DeleteServiceAccount",
+			Handler:    _ServiceAccountService_DeleteServiceAccount_Handler,
+		},
+		{
+			MethodName: "CreateServiceAccountApiKey",
+			Handler:    _ServiceAccountService_CreateServiceAccountApiKey_Handler,
+		},
+		{
+			MethodName: "GetServiceAccountApiKeys",
+			Handler:    _ServiceAccountService_GetServiceAccountApiKeys_Handler,
+		},
+		{
+			MethodName: "DeleteServiceAccountApiKey",
+			Handler:    _ServiceAccountService_DeleteServiceAccountApiKey_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/rpc/user/serviceaccount.proto",
+}
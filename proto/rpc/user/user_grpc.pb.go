@@ -22,17 +22,25 @@ const _ = grpc.SupportPackageIsVersion7
 
 const (
 	UserService_AuditLogWebhook_FullMethodName      = "/paralus.dev.rpc.user.v3.UserService/AuditLogWebhook"
+	UserService_LoginFailureWebhook_FullMethodName  = "/paralus.dev.rpc.user.v3.UserService/LoginFailureWebhook"
 	UserService_CreateUser_FullMethodName           = "/paralus.dev.rpc.user.v3.UserService/CreateUser"
 	UserService_GetUsers_FullMethodName             = "/paralus.dev.rpc.user.v3.UserService/GetUsers"
+	UserService_GetUsersWithoutMFA_FullMethodName   = "/paralus.dev.rpc.user.v3.UserService/GetUsersWithoutMFA"
 	UserService_GetUser_FullMethodName              = "/paralus.dev.rpc.user.v3.UserService/GetUser"
 	UserService_GetUserInfo_FullMethodName          = "/paralus.dev.rpc.user.v3.UserService/GetUserInfo"
 	UserService_UpdateUser_FullMethodName           = "/paralus.dev.rpc.user.v3.UserService/UpdateUser"
 	UserService_UpdateUserForceReset_FullMethodName = "/paralus.dev.rpc.user.v3.UserService/UpdateUserForceReset"
+	UserService_UnlockUser_FullMethodName           = "/paralus.dev.rpc.user.v3.UserService/UnlockUser"
+	UserService_SuspendUser_FullMethodName          = "/paralus.dev.rpc.user.v3.UserService/SuspendUser"
+	UserService_ReactivateUser_FullMethodName       = "/paralus.dev.rpc.user.v3.UserService/ReactivateUser"
 	UserService_DeleteUser_FullMethodName           = "/paralus.dev.rpc.user.v3.UserService/DeleteUser"
 	UserService_DownloadCliConfig_FullMethodName    = "/paralus.dev.rpc.user.v3.UserService/DownloadCliConfig"
 	UserService_UserListApiKeys_FullMethodName      = "/paralus.dev.rpc.user.v3.UserService/UserListApiKeys"
 	UserService_UserDeleteApiKeys_FullMethodName    = "/paralus.dev.rpc.user.v3.UserService/UserDeleteApiKeys"
 	UserService_UserForgotPassword_FullMethodName   = "/paralus.dev.rpc.user.v3.UserService/UserForgotPassword"
+	UserService_ListSessions_FullMethodName         = "/paralus.dev.rpc.user.v3.UserService/ListSessions"
+	UserService_RevokeSession_FullMethodName        = "/paralus.dev.rpc.user.v3.UserService/RevokeSession"
+	UserService_RevokeAllSessions_FullMethodName    = "/paralus.dev.rpc.user.v3.UserService/RevokeAllSessions"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -40,17 +48,31 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type UserServiceClient interface {
 	AuditLogWebhook(ctx context.Context, in *UserLoginAuditRequest, opts ...grpc.CallOption) (*UserLoginAuditResponse, error)
+	LoginFailureWebhook(ctx context.Context, in *UserLoginFailureRequest, opts ...grpc.CallOption) (*UserLoginFailureResponse, error)
 	CreateUser(ctx context.Context, in *v3.User, opts ...grpc.CallOption) (*v3.User, error)
 	GetUsers(ctx context.Context, in *v31.QueryOptions, opts ...grpc.CallOption) (*v3.UserList, error)
+	GetUsersWithoutMFA(ctx context.Context, in *v31.QueryOptions, opts ...grpc.CallOption) (*v3.UserList, error)
 	GetUser(ctx context.Context, in *v3.User, opts ...grpc.CallOption) (*v3.User, error)
 	GetUserInfo(ctx context.Context, in *v3.User, opts ...grpc.CallOption) (*v3.UserInfo, error)
 	UpdateUser(ctx context.Context, in *v3.User, opts ...grpc.CallOption) (*v3.User, error)
 	UpdateUserForceReset(ctx context.Context, in *UpdateForceResetRequest, opts ...grpc.CallOption) (*UpdateForceResetResponse, error)
+	UnlockUser(ctx context.Context, in *v3.User, opts ...grpc.CallOption) (*v3.User, error)
+	SuspendUser(ctx context.Context, in *v3.User, opts ...grpc.CallOption) (*v3.User, error)
+	ReactivateUser(ctx context.Context, in *v3.User, opts ...grpc.CallOption) (*v3.User, error)
 	DeleteUser(ctx context.Context, in *v3.User, opts ...grpc.CallOption) (*UserDeleteApiKeysResponse, error)
 	DownloadCliConfig(ctx context.Context, in *CliConfigRequest, opts ...grpc.CallOption) (*v31.HttpBody, error)
 	UserListApiKeys(ctx context.Context, in *ApiKeyRequest, opts ...grpc.CallOption) (*UserListApiKeysResponse, error)
 	UserDeleteApiKeys(ctx context.Context, in *ApiKeyRequest, opts ...grpc.CallOption) (*UserDeleteApiKeysResponse, error)
 	UserForgotPassword(ctx context.Context, in *UserForgotPasswordRequest, opts ...grpc.CallOption) (*UserForgotPasswordResponse, error)
+	// ListSessions lists the console sessions, api keys, CLI devices and
+	// kubeconfigs of the user, users can list their own sessions
+	ListSessions(ctx context.Context, in *UserSessionRequest, opts ...grpc.CallOption) (*UserSessionList, error)
+	// RevokeSession revokes a session of the user, revoking a kubeconfig
+	// revokes every kubeconfig issued to the user
+	RevokeSession(ctx context.Context, in *UserSessionRequest, opts ...grpc.CallOption) (*v31.Empty, error)
+	// RevokeAllSessions signs the user out everywhere and revokes their
+	// kubectl access
+	RevokeAllSessions(ctx context.Context, in *UserSessionRequest, opts ...grpc.CallOption) (*v31.Empty, error)
 }
 
 type userServiceClient struct {
@@ -70,6 +92,15 @@ func (c *userServiceClient) AuditLogWebhook(ctx context.Context, in *UserLoginAu
 	return out, nil
 }
 
+func (c *userServiceClient) LoginFailureWebhook(ctx context.Context, in *UserLoginFailureRequest, opts ...grpc.CallOption) (*UserLoginFailureResponse, error) {
+	out := new(UserLoginFailureResponse)
+	err := c.cc.Invoke(ctx, UserService_LoginFailureWebhook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) CreateUser(ctx context.Context, in *v3.User, opts ...grpc.CallOption) (*v3.User, error) {
 	out := new(v3.User)
 	err := c.cc.Invoke(ctx, UserService_CreateUser_FullMethodName, in, out, opts...)
@@ -88,6 +119,15 @@ func (c *userServiceClient) GetUsers(ctx context.Context, in *v31.QueryOptions,
 	return out, nil
 }
 
+func (c *userServiceClient) GetUsersWithoutMFA(ctx context.Context, in *v31.QueryOptions, opts ...grpc.CallOption) (*v3.UserList, error) {
+	out := new(v3.UserList)
+	err := c.cc.Invoke(ctx, UserService_GetUsersWithoutMFA_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) GetUser(ctx context.Context, in *v3.User, opts ...grpc.CallOption) (*v3.User, error) {
 	out := new(v3.User)
 	err := c.cc.Invoke(ctx, UserService_GetUser_FullMethodName, in, out, opts...)
@@ -124,6 +164,33 @@ func (c *userServiceClient) UpdateUserForceReset(ctx context.Context, in *Update
 	return out, nil
 }
 
+func (c *userServiceClient) UnlockUser(ctx context.Context, in *v3.User, opts ...grpc.CallOption) (*v3.User, error) {
+	out := new(v3.User)
+	err := c.cc.Invoke(ctx, UserService_UnlockUser_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SuspendUser(ctx context.Context, in *v3.User, opts ...grpc.CallOption) (*v3.User, error) {
+	out := new(v3.User)
+	err := c.cc.Invoke(ctx, UserService_SuspendUser_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ReactivateUser(ctx context.Context, in *v3.User, opts ...grpc.CallOption) (*v3.User, error) {
+	out := new(v3.User)
+	err := c.cc.Invoke(ctx, UserService_ReactivateUser_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) DeleteUser(ctx context.Context, in *v3.User, opts ...grpc.CallOption) (*UserDeleteApiKeysResponse, error) {
 	out := new(UserDeleteApiKeysResponse)
 	err := c.cc.Invoke(ctx, UserService_DeleteUser_FullMethodName, in, out, opts...)
@@ -169,22 +236,63 @@ func (c *userServiceClient) UserForgotPassword(ctx context.Context, in *UserForg
 	return out, nil
 }
 
+func (c *userServiceClient) ListSessions(ctx context.Context, in *UserSessionRequest, opts ...grpc.CallOption) (*UserSessionList, error) {
+	out := new(UserSessionList)
+	err := c.cc.Invoke(ctx, UserService_ListSessions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RevokeSession(ctx context.Context, in *UserSessionRequest, opts ...grpc.CallOption) (*v31.Empty, error) {
+	out := new(v31.Empty)
+	err := c.cc.Invoke(ctx, UserService_RevokeSession_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RevokeAllSessions(ctx context.Context, in *UserSessionRequest, opts ...grpc.CallOption) (*v31.Empty, error) {
+	out := new(v31.Empty)
+	err := c.cc.Invoke(ctx, UserService_RevokeAllSessions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations should embed UnimplementedUserServiceServer
 // for forward compatibility
 type UserServiceServer interface {
 	AuditLogWebhook(context.Context, *UserLoginAuditRequest) (*UserLoginAuditResponse, error)
+	LoginFailureWebhook(context.Context, *UserLoginFailureRequest) (*UserLoginFailureResponse, error)
 	CreateUser(context.Context, *v3.User) (*v3.User, error)
 	GetUsers(context.Context, *v31.QueryOptions) (*v3.UserList, error)
+	GetUsersWithoutMFA(context.Context, *v31.QueryOptions) (*v3.UserList, error)
 	GetUser(context.Context, *v3.User) (*v3.User, error)
 	GetUserInfo(context.Context, *v3.User) (*v3.UserInfo, error)
 	UpdateUser(context.Context, *v3.User) (*v3.User, error)
 	UpdateUserForceReset(context.Context, *UpdateForceResetRequest) (*UpdateForceResetResponse, error)
+	UnlockUser(context.Context, *v3.User) (*v3.User, error)
+	SuspendUser(context.Context, *v3.User) (*v3.User, error)
+	ReactivateUser(context.Context, *v3.User) (*v3.User, error)
 	DeleteUser(context.Context, *v3.User) (*UserDeleteApiKeysResponse, error)
 	DownloadCliConfig(context.Context, *CliConfigRequest) (*v31.HttpBody, error)
 	UserListApiKeys(context.Context, *ApiKeyRequest) (*UserListApiKeysResponse, error)
 	UserDeleteApiKeys(context.Context, *ApiKeyRequest) (*UserDeleteApiKeysResponse, error)
 	UserForgotPassword(context.Context, *UserForgotPasswordRequest) (*UserForgotPasswordResponse, error)
+	// ListSessions lists the console sessions, api keys, CLI devices and
+	// kubeconfigs of the user, users can list their own sessions
+	ListSessions(context.Context, *UserSessionRequest) (*UserSessionList, error)
+	// RevokeSession revokes a session of the user, revoking a kubeconfig
+	// revokes every kubeconfig issued to the user
+	RevokeSession(context.Context, *UserSessionRequest) (*v31.Empty, error)
+	// RevokeAllSessions signs the user out everywhere and revokes their
+	// kubectl access
+	RevokeAllSessions(context.Context, *UserSessionRequest) (*v31.Empty, error)
 }
 
 // UnimplementedUserServiceServer should be embedded to have forward compatible implementations.
@@ -194,12 +302,18 @@ type UnimplementedUserServiceServer struct {
 func (UnimplementedUserServiceServer) AuditLogWebhook(context.Context, *UserLoginAuditRequest) (*UserLoginAuditResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AuditLogWebhook not implemented")
 }
+func (UnimplementedUserServiceServer) LoginFailureWebhook(context.Context, *UserLoginFailureRequest) (*UserLoginFailureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoginFailureWebhook not implemented")
+}
 func (UnimplementedUserServiceServer) CreateUser(context.Context, *v3.User) (*v3.User, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
 }
 func (UnimplementedUserServiceServer) GetUsers(context.Context, *v31.QueryOptions) (*v3.UserList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUsers not implemented")
 }
+func (UnimplementedUserServiceServer) GetUsersWithoutMFA(context.Context, *v31.QueryOptions) (*v3.UserList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsersWithoutMFA not implemented")
+}
 func (UnimplementedUserServiceServer) GetUser(context.Context, *v3.User) (*v3.User, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
 }
@@ -212,6 +326,15 @@ func (UnimplementedUserServiceServer) UpdateUser(context.Context, *v3.User) (*v3
 func (UnimplementedUserServiceServer) UpdateUserForceReset(context.Context, *UpdateForceResetRequest) (*UpdateForceResetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserForceReset not implemented")
 }
+func (UnimplementedUserServiceServer) UnlockUser(context.Context, *v3.User) (*v3.User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockUser not implemented")
+}
+func (UnimplementedUserServiceServer) SuspendUser(context.Context, *v3.User) (*v3.User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendUser not implemented")
+}
+func (UnimplementedUserServiceServer) ReactivateUser(context.Context, *v3.User) (*v3.User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReactivateUser not implemented")
+}
 func (UnimplementedUserServiceServer) DeleteUser(context.Context, *v3.User) (*UserDeleteApiKeysResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
 }
@@ -227,6 +350,15 @@ func (UnimplementedUserServiceServer) UserDeleteApiKeys(context.Context, *ApiKey
 func (UnimplementedUserServiceServer) UserForgotPassword(context.Context, *UserForgotPasswordRequest) (*UserForgotPasswordResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UserForgotPassword not implemented")
 }
+func (UnimplementedUserServiceServer) ListSessions(context.Context, *UserSessionRequest) (*UserSessionList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSessions not implemented")
+}
+func (UnimplementedUserServiceServer) RevokeSession(context.Context, *UserSessionRequest) (*v31.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSession not implemented")
+}
+func (UnimplementedUserServiceServer) RevokeAllSessions(context.Context, *UserSessionRequest) (*v31.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAllSessions not implemented")
+}
 
 // UnsafeUserServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to UserServiceServer will
@@ -257,6 +389,24 @@ func _UserService_AuditLogWebhook_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_LoginFailureWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserLoginFailureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).LoginFailureWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_LoginFailureWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).LoginFailureWebhook(ctx, req.(*UserLoginFailureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(v3.User)
 	if err := dec(in); err != nil {
@@ -293,6 +443,24 @@ func _UserService_GetUsers_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_GetUsersWithoutMFA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v31.QueryOptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUsersWithoutMFA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetUsersWithoutMFA_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUsersWithoutMFA(ctx, req.(*v31.QueryOptions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(v3.User)
 	if err := dec(in); err != nil {
@@ -365,6 +533,60 @@ func _UserService_UpdateUserForceReset_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_UnlockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v3.User)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UnlockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UnlockUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UnlockUser(ctx, req.(*v3.User))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SuspendUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v3.User)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SuspendUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SuspendUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SuspendUser(ctx, req.(*v3.User))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ReactivateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v3.User)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ReactivateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ReactivateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ReactivateUser(ctx, req.(*v3.User))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(v3.User)
 	if err := dec(in); err != nil {
@@ -455,6 +677,60 @@ func _UserService_UserForgotPassword_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_ListSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListSessions(ctx, req.(*UserSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RevokeSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RevokeSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RevokeSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RevokeSession(ctx, req.(*UserSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RevokeAllSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RevokeAllSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RevokeAllSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RevokeAllSessions(ctx, req.(*UserSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -466,6 +742,10 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AuditLogWebhook",
 			Handler:    _UserService_AuditLogWebhook_Handler,
 		},
+		{
+			MethodName: "LoginFailureWebhook",
+			Handler:    _UserService_LoginFailureWebhook_Handler,
+		},
 		{
 			MethodName: "CreateUser",
 			Handler:    _UserService_CreateUser_Handler,
@@ -474,6 +754,10 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetUsers",
 			Handler:    _UserService_GetUsers_Handler,
 		},
+		{
+			MethodName: "GetUsersWithoutMFA",
+			Handler:    _UserService_GetUsersWithoutMFA_Handler,
+		},
 		{
 			MethodName: "GetUser",
 			Handler:    _UserService_GetUser_Handler,
@@ -490,6 +774,18 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateUserForceReset",
 			Handler:    _UserService_UpdateUserForceReset_Handler,
 		},
+		{
+			MethodName: "UnlockUser",
+			Handler:    _UserService_UnlockUser_Handler,
+		},
+		{
+			MethodName: "SuspendUser",
+			Handler:    _UserService_SuspendUser_Handler,
+		},
+		{
+			MethodName: "ReactivateUser",
+			Handler:    _UserService_ReactivateUser_Handler,
+		},
 		{
 			MethodName: "DeleteUser",
 			Handler:    _UserService_DeleteUser_Handler,
@@ -510,6 +806,18 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UserForgotPassword",
 			Handler:    _UserService_UserForgotPassword_Handler,
 		},
+		{
+			MethodName: "ListSessions",
+			Handler:    _UserService_ListSessions_Handler,
+		},
+		{
+			MethodName: "RevokeSession",
+			Handler:    _UserService_RevokeSession_Handler,
+		},
+		{
+			MethodName: "RevokeAllSessions",
+			Handler:    _UserService_RevokeAllSessions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/rpc/user/user.proto",
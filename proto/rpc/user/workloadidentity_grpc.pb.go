@@ -0,0 +1,339 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: proto/rpc/user/workloadidentity.proto
+
+package userv3
+
+import (
+	context "context"
+	v31 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	v3 "github.com/paralus/paralus/proto/types/userpb/v3"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	WorkloadIdentityService_CreateWorkloadIdentityPolicy_FullMethodName = "/paralus.dev.rpc.user.v3.WorkloadIdentityService/CreateWorkloadIdentityPolicy"
+	WorkloadIdentityService_GetWorkloadIdentityPolicies_FullMethodName  = "/paralus.dev.rpc.user.v3.WorkloadIdentityService/GetWorkloadIdentityPolicies"
+	WorkloadIdentityService_GetWorkloadIdentityPolicy_FullMethodName    = "/paralus.dev.rpc.user.v3.WorkloadIdentityService/GetWorkloadIdentityPolicy"
+	WorkloadIdentityService_UpdateWorkloadIdentityPolicy_FullMethodName = "/paralus.dev.rpc.user.v3.WorkloadIdentityService/UpdateWorkloadIdentityPolicy"
+	WorkloadIdentityService_DeleteWorkloadIdentityPolicy_FullMethodName = "/paralus.dev.rpc.user.v3.WorkloadIdentityService/DeleteWorkloadIdentityPolicy"
+	WorkloadIdentityService_ExchangeToken_FullMethodName                = "/paralus.dev.rpc.user.v3.WorkloadIdentityService/ExchangeToken"
+	WorkloadIdentityService_ExchangeTokenForKubeconfig_FullMethodName   = "/paralus.dev.rpc.user.v3.WorkloadIdentityService/ExchangeTokenForKubeconfig"
+)
+
+// WorkloadIdentityServiceClient is the client API for WorkloadIdentityService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type WorkloadIdentityServiceClient interface {
+	CreateWorkloadIdentityPolicy(ctx context.Context, in *v3.WorkloadIdentityPolicy, opts ...grpc.CallOption) (*v3.WorkloadIdentityPolicy, error)
+	GetWorkloadIdentityPolicies(ctx context.Context, in *v31.QueryOptions, opts ...grpc.CallOption) (*v3.WorkloadIdentityPolicyList, error)
+	GetWorkloadIdentityPolicy(ctx context.Context, in *v3.WorkloadIdentityPolicy, opts ...grpc.CallOption) (*v3.WorkloadIdentityPolicy, error)
+	UpdateWorkloadIdentityPolicy(ctx context.Context, in *v3.WorkloadIdentityPolicy, opts ...grpc.CallOption) (*v3.WorkloadIdentityPolicy, error)
+	DeleteWorkloadIdentityPolicy(ctx context.Context, in *v3.WorkloadIdentityPolicy, opts ...grpc.CallOption) (*v31.Empty, error)
+	// ExchangeToken exchanges an OIDC token for a short-lived api key, it
+	// is authenticated by the OIDC token
+	ExchangeToken(ctx context.Context, in *WorkloadIdentityTokenRequest, opts ...grpc.CallOption) (*WorkloadIdentityCredentials, error)
+	// ExchangeTokenForKubeconfig exchanges an OIDC token for a short-lived
+	// kubeconfig, it is authenticated by the OIDC token
+	ExchangeTokenForKubeconfig(ctx context.Context, in *WorkloadIdentityTokenRequest, opts ...grpc.CallOption) (*v31.HttpBody, error)
+}
+
+type workloadIdentityServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWorkloadIdentityServiceClient(cc grpc.ClientConnInterface) WorkloadIdentityServiceClient {
+	return &workloadIdentityServiceClient{cc}
+}
+
+func (c *workloadIdentityServiceClient) CreateWorkloadIdentityPolicy(ctx context.Context, in *v3.WorkloadIdentityPolicy, opts ...grpc.CallOption) (*v3.WorkloadIdentityPolicy, error) {
+	out := new(v3.WorkloadIdentityPolicy)
+	err := c.cc.Invoke(ctx, WorkloadIdentityService_CreateWorkloadIdentityPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workloadIdentityServiceClient) GetWorkloadIdentityPolicies(ctx context.Context, in *v31.QueryOptions, opts ...grpc.CallOption) (*v3.WorkloadIdentityPolicyList, error) {
+	out := new(v3.WorkloadIdentityPolicyList)
+	err := c.cc.Invoke(ctx, WorkloadIdentityService_GetWorkloadIdentityPolicies_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workloadIdentityServiceClient) GetWorkloadIdentityPolicy(ctx context.Context, in *v3.WorkloadIdentityPolicy, opts ...grpc.CallOption) (*v3.WorkloadIdentityPolicy, error) {
+	out := new(v3.WorkloadIdentityPolicy)
+	err := c.cc.Invoke(ctx, WorkloadIdentityService_GetWorkloadIdentityPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workloadIdentityServiceClient) UpdateWorkloadIdentityPolicy(ctx context.Context, in *v3.WorkloadIdentityPolicy, opts ...grpc.CallOption) (*v3.WorkloadIdentityPolicy, error) {
+	out := new(v3.WorkloadIdentityPolicy)
+	err := c.cc.Invoke(ctx, WorkloadIdentityService_UpdateWorkloadIdentityPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workloadIdentityServiceClient) DeleteWorkloadIdentityPolicy(ctx context.Context, in *v3.WorkloadIdentityPolicy, opts ...grpc.CallOption) (*v31.Empty, error) {
+	out := new(v31.Empty)
+	err := c.cc.Invoke(ctx, WorkloadIdentityService_DeleteWorkloadIdentityPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workloadIdentityServiceClient) ExchangeToken(ctx context.Context, in *WorkloadIdentityTokenRequest, opts ...grpc.CallOption) (*WorkloadIdentityCredentials, error) {
+	out := new(WorkloadIdentityCredentials)
+	err := c.cc.Invoke(ctx, WorkloadIdentityService_ExchangeToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workloadIdentityServiceClient) ExchangeTokenForKubeconfig(ctx context.Context, in *WorkloadIdentityTokenRequest, opts ...grpc.CallOption) (*v31.HttpBody, error) {
+	out := new(v31.HttpBody)
+	err := c.cc.Invoke(ctx, WorkloadIdentityService_ExchangeTokenForKubeconfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WorkloadIdentityServiceServer is the server API for WorkloadIdentityService service.
+// All implementations should embed UnimplementedWorkloadIdentityServiceServer
+// for forward compatibility
+type WorkloadIdentityServiceServer interface {
+	CreateWorkloadIdentityPolicy(context.Context, *v3.WorkloadIdentityPolicy) (*v3.WorkloadIdentityPolicy, error)
+	GetWorkloadIdentityPolicies(context.Context, *v31.QueryOptions) (*v3.WorkloadIdentityPolicyList, error)
+	GetWorkloadIdentityPolicy(context.Context, *v3.WorkloadIdentityPolicy) (*v3.WorkloadIdentityPolicy, error)
+	UpdateWorkloadIdentityPolicy(context.Context, *v3.WorkloadIdentityPolicy) (*v3.WorkloadIdentityPolicy, error)
+	DeleteWorkloadIdentityPolicy(context.Context, *v3.WorkloadIdentityPolicy) (*v31.Empty, error)
+	// ExchangeToken exchanges an OIDC token for a short-lived api key, it
+	// is authenticated by the OIDC token
+	ExchangeToken(context.Context, *WorkloadIdentityTokenRequest) (*WorkloadIdentityCredentials, error)
+	// ExchangeTokenForKubeconfig exchanges an OIDC token for a short-lived
+	// kubeconfig, it is authenticated by the OIDC token
+	ExchangeTokenForKubeconfig(context.Context, *WorkloadIdentityTokenRequest) (*v31.HttpBody, error)
+}
+
+// UnimplementedWorkloadIdentityServiceServer should be embedded to have forward compatible implementations.
+type UnimplementedWorkloadIdentityServiceServer struct {
+}
+
+func (UnimplementedWorkloadIdentityServiceServer) CreateWorkloadIdentityPolicy(context.Context, *v3.WorkloadIdentityPolicy) (*v3.WorkloadIdentityPolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWorkloadIdentityPolicy not implemented")
+}
+func (UnimplementedWorkloadIdentityServiceServer) GetWorkloadIdentityPolicies(context.Context, *v31.QueryOptions) (*v3.WorkloadIdentityPolicyList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkloadIdentityPolicies not implemented")
+}
+func (UnimplementedWorkloadIdentityServiceServer) GetWorkloadIdentityPolicy(context.Context, *v3.WorkloadIdentityPolicy) (*v3.WorkloadIdentityPolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkloadIdentityPolicy not implemented")
+}
+func (UnimplementedWorkloadIdentityServiceServer) UpdateWorkloadIdentityPolicy(context.Context, *v3.WorkloadIdentityPolicy) (*v3.WorkloadIdentityPolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWorkloadIdentityPolicy not implemented")
+}
+func (UnimplementedWorkloadIdentityServiceServer) DeleteWorkloadIdentityPolicy(context.Context, *v3.WorkloadIdentityPolicy) (*v31.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWorkloadIdentityPolicy not implemented")
+}
+func (UnimplementedWorkloadIdentityServiceServer) ExchangeToken(context.Context, *WorkloadIdentityTokenRequest) (*WorkloadIdentityCredentials, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExchangeToken not implemented")
+}
+func (UnimplementedWorkloadIdentityServiceServer) ExchangeTokenForKubeconfig(context.Context, *WorkloadIdentityTokenRequest) (*v31.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExchangeTokenForKubeconfig not implemented")
+}
+
+// UnsafeWorkloadIdentityServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WorkloadIdentityServiceServer will
+// result in compilation errors.
+type UnsafeWorkloadIdentityServiceServer interface {
+	mustEmbedUnimplementedWorkloadIdentityServiceServer()
+}
+
+func RegisterWorkloadIdentityServiceServer(s grpc.ServiceRegistrar, srv WorkloadIdentityServiceServer) {
+	s.RegisterService(&WorkloadIdentityService_ServiceDesc, srv)
+}
+
+func _WorkloadIdentityService_CreateWorkloadIdentityPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v3.WorkloadIdentityPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkloadIdentityServiceServer).CreateWorkloadIdentityPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkloadIdentityService_CreateWorkloadIdentityPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkloadIdentityServiceServer).CreateWorkloadIdentityPolicy(ctx, req.(*v3.WorkloadIdentityPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkloadIdentityService_GetWorkloadIdentityPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v31.QueryOptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkloadIdentityServiceServer).GetWorkloadIdentityPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkloadIdentityService_GetWorkloadIdentityPolicies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkloadIdentityServiceServer).GetWorkloadIdentityPolicies(ctx, req.(*v31.QueryOptions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkloadIdentityService_GetWorkloadIdentityPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v3.WorkloadIdentityPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkloadIdentityServiceServer).GetWorkloadIdentityPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkloadIdentityService_GetWorkloadIdentityPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkloadIdentityServiceServer).GetWorkloadIdentityPolicy(ctx, req.(*v3.WorkloadIdentityPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkloadIdentityService_UpdateWorkloadIdentityPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v3.WorkloadIdentityPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkloadIdentityServiceServer).UpdateWorkloadIdentityPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkloadIdentityService_UpdateWorkloadIdentityPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkloadIdentityServiceServer).UpdateWorkloadIdentityPolicy(ctx, req.(*v3.WorkloadIdentityPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkloadIdentityService_DeleteWorkloadIdentityPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(v3.WorkloadIdentityPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkloadIdentityServiceServer).DeleteWorkloadIdentityPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkloadIdentityService_DeleteWorkloadIdentityPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkloadIdentityServiceServer).DeleteWorkloadIdentityPolicy(ctx, req.(*v3.WorkloadIdentityPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkloadIdentityService_ExchangeToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkloadIdentityTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkloadIdentityServiceServer).ExchangeToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkloadIdentityService_ExchangeToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkloadIdentityServiceServer).ExchangeToken(ctx, req.(*WorkloadIdentityTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WorkloadIdentityService_ExchangeTokenForKubeconfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WorkloadIdentityTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkloadIdentityServiceServer).ExchangeTokenForKubeconfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WorkloadIdentityService_ExchangeTokenForKubeconfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkloadIdentityServiceServer).ExchangeTokenForKubeconfig(ctx, req.(*WorkloadIdentityTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WorkloadIdentityService_ServiceDesc is the grpc.ServiceDesc for WorkloadIdentityService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WorkloadIdentityService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "paralus.dev.rpc.user.v3.WorkloadIdentityService",
+	HandlerType: (*WorkloadIdentityServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateWorkloadIdentityPolicy",
+			Handler:    _WorkloadIdentityService_CreateWorkloadIdentityPolicy_Handler,
+		},
+		{
+			MethodName: "GetWorkloadIdentityPolicies",
+			Handler:    _WorkloadIdentityService_GetWorkloadIdentityPolicies_Handler,
+		},
+		{
+			MethodName: "GetWorkloadIdentityPolicy",
+			Handler:    _WorkloadIdentityService_GetWorkloadIdentityPolicy_Handler,
+		},
+		{
+			MethodName: "UpdateWorkloadIdentityPolicy",
+			Handler:    _WorkloadIdentityService_UpdateWorkloadIdentityPolicy_Handler,
+		},
+		{
+			MethodName: "DeleteWorkloadIdentityPolicy",
+			Handler:    _WorkloadIdentityService_DeleteWorkloadIdentityPolicy_Handler,
+		},
+		{
+			MethodName: "ExchangeToken",
+			Handler:    _WorkloadIdentityService_ExchangeToken_Handler,
+		},
+		{
+			MethodName: "ExchangeTokenForKubeconfig",
+			Handler:    _WorkloadIdentityService_ExchangeTokenForKubeconfig_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/rpc/user/workloadidentity.proto",
+}
@@ -0,0 +1,61 @@
+// Code generated by go generate; DO NOT EDIT.
+package infrav3
+
+import (
+	bytes "bytes"
+	driver "database/sql/driver"
+	"fmt"
+)
+
+// Scan converts database string to AgentUpgradeClusterState
+func (e *AgentUpgradeClusterState) Scan(value interface{}) error {
+	s := value.([]byte)
+	*e = AgentUpgradeClusterState(AgentUpgradeClusterState_value[string(s)])
+	return nil
+}
+
+// Value converts AgentUpgradeClusterState into database string
+func (e AgentUpgradeClusterState) Value() (driver.Value, error) {
+	return AgentUpgradeClusterState_name[int32(e)], nil
+}
+
+// MarshalJSON converts AgentUpgradeClusterState to JSON
+func (e AgentUpgradeClusterState) MarshalJSON() ([]byte, error) {
+	buffer := bytes.NewBufferString("\"")
+	buffer.WriteString(e.String())
+	buffer.WriteString("\"")
+	return buffer.Bytes(), nil
+}
+
+// UnmarshalJSON converts AgentUpgradeClusterState from JSON
+func (e *AgentUpgradeClusterState) UnmarshalJSON(b []byte) error {
+	if b != nil {
+		*e = AgentUpgradeClusterState(AgentUpgradeClusterState_value[string(b[1:len(b)-1])])
+	}
+	return nil
+}
+
+// MarshalYAML implements the yaml.Marshaler interface
+func (e AgentUpgradeClusterState) MarshalYAML() (interface{}, error) {
+	return AgentUpgradeClusterState_name[int32(e)], nil
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface
+func (e *AgentUpgradeClusterState) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var name string
+	if err := unmarshal(&name); err != nil {
+		return err
+	}
+
+	value, ok := AgentUpgradeClusterState_value[name]
+	if !ok {
+		return fmt.Errorf("invalid AgentUpgradeClusterState: %s", name)
+	}
+
+	*e = AgentUpgradeClusterState(value)
+	return nil
+}
+
+// implement proto enum interface
+func (e AgentUpgradeClusterState) IsEnum() {
+}
@@ -0,0 +1,61 @@
+// Code generated by go generate; DO NOT EDIT.
+package infrav3
+
+import (
+	bytes "bytes"
+	driver "database/sql/driver"
+	"fmt"
+)
+
+// Scan converts database string to AgentUpgradeState
+func (e *AgentUpgradeState) Scan(value interface{}) error {
+	s := value.([]byte)
+	*e = AgentUpgradeState(AgentUpgradeState_value[string(s)])
+	return nil
+}
+
+// Value converts AgentUpgradeState into database string
+func (e AgentUpgradeState) Value() (driver.Value, error) {
+	return AgentUpgradeState_name[int32(e)], nil
+}
+
+// MarshalJSON converts AgentUpgradeState to JSON
+func (e AgentUpgradeState) MarshalJSON() ([]byte, error) {
+	buffer := bytes.NewBufferString("\"")
+	buffer.WriteString(e.String())
+	buffer.WriteString("\"")
+	return buffer.Bytes(), nil
+}
+
+// UnmarshalJSON converts AgentUpgradeState from JSON
+func (e *AgentUpgradeState) UnmarshalJSON(b []byte) error {
+	if b != nil {
+		*e = AgentUpgradeState(AgentUpgradeState_value[string(b[1:len(b)-1])])
+	}
+	return nil
+}
+
+// MarshalYAML implements the yaml.Marshaler interface
+func (e AgentUpgradeState) MarshalYAML() (interface{}, error) {
+	return AgentUpgradeState_name[int32(e)], nil
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface
+func (e *AgentUpgradeState) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var name string
+	if err := unmarshal(&name); err != nil {
+		return err
+	}
+
+	value, ok := AgentUpgradeState_value[name]
+	if !ok {
+		return fmt.Errorf("invalid AgentUpgradeState: %s", name)
+	}
+
+	*e = AgentUpgradeState(value)
+	return nil
+}
+
+// implement proto enum interface
+func (e AgentUpgradeState) IsEnum() {
+}
@@ -5,6 +5,8 @@ import (
 	"database/sql"
 	"fmt"
 
+	"github.com/google/uuid"
+	clstrutil "github.com/paralus/paralus/internal/cluster"
 	"github.com/paralus/paralus/pkg/service"
 
 	"github.com/paralus/paralus/pkg/gateway"
@@ -78,6 +80,18 @@ func (s *bootstrapServer) CreateBootstrapAgent(ctx context.Context, in *sentry.B
 	return in, err
 }
 
+// withoutAgentTokens removes the tokens viewed with the configuration of
+// their agent from the agents returned to users, the token is only
+// handed out once after that. Agents whose configuration was not viewed
+// keep returning their token.
+func withoutAgentTokens(agents ...*sentry.BootstrapAgent) {
+	for _, ba := range agents {
+		if ba.GetSpec() != nil && ba.GetStatus().GetTokenViewedAt() != nil {
+			ba.Spec.Token = ""
+		}
+	}
+}
+
 func (s *bootstrapServer) GetBootstrapAgent(ctx context.Context, in *sentry.BootstrapAgent) (ret *sentry.BootstrapAgent, err error) {
 	templateRef, err := util.GetTemplateScope(in.Spec.TemplateRef)
 	if err != nil {
@@ -88,6 +102,7 @@ func (s *bootstrapServer) GetBootstrapAgent(ctx context.Context, in *sentry.Boot
 	if err == sql.ErrNoRows {
 		err = status.Error(codes.NotFound, err.Error())
 	}
+	withoutAgentTokens(ret)
 	return
 }
 
@@ -105,6 +120,7 @@ func (s *bootstrapServer) GetBootstrapAgents(ctx context.Context, in *sentryrpc.
 	if err != nil {
 		return nil, err
 	}
+	withoutAgentTokens(ret.Items...)
 
 	return
 }
@@ -239,6 +255,11 @@ func (s *bootstrapServer) RegisterBootstrapAgent(ctx context.Context, in *sentry
 	}
 
 	err = s.bs.RegisterBootstrapAgent(ctx, in.Token, in.IpAddress, in.Fingerprint)
+	if err == service.ErrBootstrapAgentPendingApproval {
+		_log.Infow("agent registration pending approval", "token", in.Token, "ip", in.IpAddress, "fingerprint", in.Fingerprint)
+		err = status.Error(codes.FailedPrecondition, err.Error())
+		return
+	}
 	if err != nil {
 		_log.Error(err.Error())
 		return
@@ -250,6 +271,11 @@ func (s *bootstrapServer) RegisterBootstrapAgent(ctx context.Context, in *sentry
 	if template.Metadata.Name == "paralus-core-relay-agent" {
 		_log.Info("updating cluster status for :: ", agent.Metadata.Name)
 		err = s.updateClusterStatus(ctx, agent.Metadata.Name, agent.Metadata.Project)
+		if in.Version != "" {
+			if verr := s.cs.RecordAgentVersion(ctx, agent.Metadata.Name, in.Version); verr != nil {
+				_log.Infow("unable to record agent version", "cluster", agent.Metadata.Name, "error", verr)
+			}
+		}
 	}
 
 	return
@@ -283,16 +309,147 @@ func (s *bootstrapServer) updateClusterStatus(ctx context.Context, clusterID, pr
 							LastUpdated: timestamppb.Now(),
 							Reason:      "Relay agent established connection.",
 						},
+						{
+							Type:        infrav3.ClusterConditionType_ClusterHealth,
+							Status:      commonv3.ParalusConditionStatus_Healthy,
+							LastUpdated: timestamppb.Now(),
+							Reason:      "Relay agent established connection.",
+						},
 					},
 				},
 			},
 		},
 	}
+	if err := s.cs.RecordClustersSeen(ctx, []string{clusterID}); err != nil {
+		_log.Infow("unable to record cluster seen", "cluster", clusterID, "error", err)
+	}
 	return s.cs.UpdateClusterConditionStatus(ctx, cluster)
 }
 
-func (s *bootstrapServer) GetBootstrapAgentConfig(ctx context.Context, in *sentry.BootstrapAgent) (*commonv3.HttpBody, error) {
-	return nil, nil
+func (s *bootstrapServer) GetPendingBootstrapAgents(ctx context.Context, in *sentryrpc.GetBootstrapAgentsRequest) (ret *sentry.BootstrapAgentList, err error) {
+	templateRef, err := util.GetTemplateScope(in.TemplateScope)
+	if err != nil {
+		return
+	}
+
+	ret, err = s.bs.SelectPendingBootstrapAgents(ctx, templateRef, query.WithOptions(in.Opts))
+	if err != nil {
+		return nil, err
+	}
+	withoutAgentTokens(ret.Items...)
+	return
+}
+
+func (s *bootstrapServer) ApproveBootstrapAgent(ctx context.Context, in *sentry.BootstrapAgent) (ret *sentry.BootstrapAgent, err error) {
+	templateRef, err := util.GetTemplateScope(in.Spec.TemplateRef)
+	if err != nil {
+		return
+	}
+
+	ret, err = s.bs.ApproveBootstrapAgent(ctx, templateRef, query.WithMeta(in.Metadata))
+	if err == sql.ErrNoRows {
+		err = status.Error(codes.NotFound, err.Error())
+	}
+	withoutAgentTokens(ret)
+	return
+}
+
+func (s *bootstrapServer) RejectBootstrapAgent(ctx context.Context, in *sentry.BootstrapAgent) (ret *sentry.BootstrapAgent, err error) {
+	templateRef, err := util.GetTemplateScope(in.Spec.TemplateRef)
+	if err != nil {
+		return
+	}
+
+	ret, err = s.bs.RejectBootstrapAgent(ctx, templateRef, query.WithMeta(in.Metadata))
+	if err == sql.ErrNoRows {
+		err = status.Error(codes.NotFound, err.Error())
+	}
+	withoutAgentTokens(ret)
+	return
+}
+
+func (s *bootstrapServer) ReissueBootstrapAgentToken(ctx context.Context, in *sentry.BootstrapAgent) (ret *sentry.BootstrapAgent, err error) {
+	templateRef, err := util.GetTemplateScope(in.Spec.TemplateRef)
+	if err != nil {
+		return
+	}
+
+	ret, err = s.bs.ReissueBootstrapAgentToken(ctx, templateRef, query.WithMeta(in.Metadata))
+	if err == sql.ErrNoRows {
+		err = status.Error(codes.NotFound, err.Error())
+	}
+	withoutAgentTokens(ret)
+	return
+}
+
+// GetBootstrapAgentConfig returns the configuration a bootstrap agent
+// running outside the cluster network registers and connects to the
+// relays with. The token of the agent is part of the configuration,
+// which can be downloaded only once until the token is reissued.
+func (s *bootstrapServer) GetBootstrapAgentConfig(ctx context.Context, in *sentryrpc.GetBootstrapAgentConfigRequest) (*commonv3.HttpBody, error) {
+	templateRef, err := util.GetTemplateScope(in.TemplateRef)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	switch in.Format {
+	case "", clstrutil.AgentConfigFormatYAML, clstrutil.AgentConfigFormatSecret:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown agent config format %q", in.Format)
+	}
+
+	template, err := s.bs.GetBootstrapAgentTemplate(ctx, templateRef)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			err = status.Error(codes.NotFound, err.Error())
+		}
+		return nil, err
+	}
+	infra, err := s.bs.GetBootstrapInfra(ctx, template.Spec.InfraRef)
+	if err != nil {
+		return nil, err
+	}
+
+	// the token is recorded as viewed only once the configuration
+	// holding it has been rendered
+	render := func(ba *sentry.BootstrapAgent) ([]byte, error) {
+		config := &clstrutil.BootstrapAgentConfig{
+			Name:          ba.Metadata.Name,
+			Project:       in.Metadata.GetProject(),
+			Mode:          ba.Spec.AgentMode.String(),
+			BootstrapAddr: service.GetRelayBootstrapAddr(),
+			TemplateToken: template.Spec.Token,
+			Token:         ba.Spec.Token,
+			CACert:        infra.Spec.CaCert,
+		}
+		for _, host := range template.Spec.Hosts {
+			if host.Type == sentry.BootstrapTemplateHostType_HostTypeExternal {
+				config.Relays = append(config.Relays, host.Host)
+			}
+		}
+		// the agents of clusters are named after the cluster and dial
+		// out through its proxy
+		if _, err := uuid.Parse(ba.Metadata.Name); err == nil {
+			c, err := s.cs.Select(ctx, &infrav3.Cluster{Metadata: &commonv3.Metadata{Id: ba.Metadata.Name, Project: in.Metadata.GetProject()}}, false)
+			if err != nil {
+				_log.Infow("unable to get cluster of bootstrap agent", "agent", ba.Metadata.Name, "error", err)
+			} else {
+				config.Proxy = clstrutil.NewBootstrapAgentProxy(c.GetSpec().GetProxyConfig())
+			}
+		}
+		return clstrutil.GetBootstrapAgentConfig(config, in.Format)
+	}
+
+	b, err := s.bs.ViewBootstrapAgentToken(ctx, templateRef, in.Metadata.GetProject(), render, query.WithMeta(in.Metadata))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			err = status.Error(codes.NotFound, err.Error())
+		}
+		return nil, err
+	}
+	return &commonv3.HttpBody{
+		ContentType: "application/x-yaml",
+		Data:        b,
+	}, nil
 }
 
 // NewBootstrapServer return new bootstrap server
@@ -0,0 +1,111 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/paralus/paralus/pkg/common"
+	"github.com/paralus/paralus/pkg/gateway"
+	"github.com/paralus/paralus/pkg/sentry/cryptoutil"
+	"github.com/paralus/paralus/pkg/sentry/kubeconfig"
+	"github.com/paralus/paralus/pkg/service"
+	sentryrpc "github.com/paralus/paralus/proto/rpc/sentry"
+	systemrpc "github.com/paralus/paralus/proto/rpc/system"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	systemv3 "github.com/paralus/paralus/proto/types/systempb/v3"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type breakGlassServer struct {
+	bgs service.BreakGlassService
+	bs  service.BootstrapService
+	aps service.AccountPermissionService
+	gps service.GroupPermissionService
+	cs  service.ClusterService
+	kss service.KubeconfigSettingService
+	pf  cryptoutil.PasswordFunc
+	ks  service.ApiKeyService
+	os  service.OrganizationService
+	ps  service.PartnerService
+	al  *zap.Logger
+}
+
+var _ systemrpc.BreakGlassServiceServer = (*breakGlassServer)(nil)
+
+// NewBreakGlassServer returns new break-glass server implementation
+func NewBreakGlassServer(bgs service.BreakGlassService, bs service.BootstrapService, aps service.AccountPermissionService, gps service.GroupPermissionService, cs service.ClusterService, kss service.KubeconfigSettingService,
+	pf cryptoutil.PasswordFunc, ks service.ApiKeyService, os service.OrganizationService, ps service.PartnerService, al *zap.Logger) systemrpc.BreakGlassServiceServer {
+	return &breakGlassServer{bgs, bs, aps, gps, cs, kss, pf, ks, os, ps, al}
+}
+
+func (s *breakGlassServer) CreateBreakGlassAccount(ctx context.Context, req *systemv3.BreakGlassAccount) (*systemv3.BreakGlassAccount, error) {
+	return s.bgs.Create(ctx, req)
+}
+
+func (s *breakGlassServer) GetBreakGlassAccounts(ctx context.Context, req *systemv3.BreakGlassAccount) (*systemv3.BreakGlassAccountList, error) {
+	return s.bgs.List(ctx, req)
+}
+
+func (s *breakGlassServer) GetBreakGlassAccount(ctx context.Context, req *systemv3.BreakGlassAccount) (*systemv3.BreakGlassAccount, error) {
+	return s.bgs.GetByName(ctx, req)
+}
+
+func (s *breakGlassServer) DeleteBreakGlassAccount(ctx context.Context, req *systemv3.BreakGlassAccount) (*commonv3.Empty, error) {
+	return &commonv3.Empty{}, s.bgs.Delete(ctx, req)
+}
+
+func (s *breakGlassServer) RotateBreakGlassAccount(ctx context.Context, req *systemv3.BreakGlassAccount) (*systemv3.BreakGlassAccount, error) {
+	return s.bgs.Rotate(ctx, req)
+}
+
+// clientSessionData returns the client details of a request which did
+// not go through the auth interceptor
+func clientSessionData(ctx context.Context) *commonv3.SessionData {
+	sd := &commonv3.SessionData{}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return sd
+	}
+	if v := md.Get(gateway.RemoteAddr); len(v) != 0 {
+		sd.ClientIp = v[0]
+	}
+	if v := md.Get(gateway.Host); len(v) != 0 {
+		sd.ClientHost = v[0]
+	}
+	if v := md.Get(gateway.UserAgent); len(v) != 0 {
+		sd.ClientUa = v[0]
+	}
+	return sd
+}
+
+func (s *breakGlassServer) BreakGlass(ctx context.Context, req *systemrpc.BreakGlassRequest) (*commonv3.HttpBody, error) {
+	sd, until, err := s.bgs.Unseal(ctx, req, clientSessionData(ctx))
+	if err == service.ErrBreakGlassDenied {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = context.WithValue(ctx, common.SessionDataKey, sd)
+	config, err := kubeconfig.GetBreakGlassConfigForUser(ctx, s.bs, s.aps, s.gps, s.cs, &sentryrpc.GetForUserRequest{
+		Opts: &commonv3.QueryOptions{
+			Account:      sd.Account,
+			Username:     sd.Username,
+			Organization: sd.Organization,
+			Partner:      sd.Partner,
+		},
+		Namespace: req.GetNamespace(),
+	}, s.pf, s.kss, s.ks, s.os, s.ps, s.al, time.Until(until))
+	if err != nil {
+		_log.Errorw("error generating break-glass kubeconfig", "error", err.Error())
+		return nil, err
+	}
+	return &commonv3.HttpBody{
+		ContentType: "application/yaml",
+		Data:        config,
+	}, nil
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/base64"
 
+	clstrutil "github.com/paralus/paralus/internal/cluster"
 	"github.com/paralus/paralus/internal/cluster/fixtures"
 	"github.com/paralus/paralus/pkg/common"
 	"github.com/paralus/paralus/pkg/query"
@@ -14,7 +15,10 @@ import (
 	v3 "github.com/paralus/paralus/proto/types/commonpb/v3"
 	infrapbv3 "github.com/paralus/paralus/proto/types/infrapb/v3"
 	infrav3 "github.com/paralus/paralus/proto/types/infrapb/v3"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"sigs.k8s.io/yaml"
 )
 
 type clusterServer struct {
@@ -70,11 +74,63 @@ func (s *clusterServer) UpdateCluster(ctx context.Context, req *infrapbv3.Cluste
 	return updateClusterStatus(req, resp, err), err
 }
 
-func (s *clusterServer) DownloadCluster(ctx context.Context, cluster *infrapbv3.Cluster) (*commonv3.HttpBody, error) {
-	c, err := s.Select(ctx, cluster, true)
+func (s *clusterServer) DownloadCluster(ctx context.Context, req *infrapbv3.Cluster) (*commonv3.HttpBody, error) {
+	return s.DownloadClusterFormat(ctx, &rpcv3.DownloadClusterRequest{Metadata: req.Metadata})
+}
+
+// DownloadClusterFormat returns the relay agent installation of the
+// cluster in the requested format
+func (s *clusterServer) DownloadClusterFormat(ctx context.Context, req *rpcv3.DownloadClusterRequest) (*commonv3.HttpBody, error) {
+	c, err := s.Select(ctx, &infrapbv3.Cluster{Metadata: req.Metadata}, true)
+	if err != nil {
+		return nil, err
+	}
+	data := s.downloadData
+	data.RelayAgentImage = clstrutil.RelayAgentImageForVersion(data.RelayAgentImage, c.GetSpec().GetDesiredAgentVersion())
+
+	switch req.Format {
+	case "", clstrutil.DownloadFormatManifest:
+	case clstrutil.DownloadFormatHelm, clstrutil.DownloadFormatKustomize:
+		relays, err := s.GetRelaysConfigForCluster(ctx, c)
+		if err != nil {
+			return nil, err
+		}
+		values := clstrutil.NewRelayAgentValues(&data, c, relays)
+		if req.Format == clstrutil.DownloadFormatHelm {
+			b, err := clstrutil.GetRelayAgentHelmValues(values)
+			if err != nil {
+				return nil, err
+			}
+			return &commonv3.HttpBody{
+				ContentType: "application/x-yaml",
+				Data:        b,
+			}, nil
+		}
+		b, err := clstrutil.GetRelayAgentKustomization(ctx, values)
+		if err != nil {
+			return nil, err
+		}
+		return &commonv3.HttpBody{
+			ContentType: "application/gzip",
+			Data:        b,
+		}, nil
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown download format %q", req.Format)
+	}
+
+	b, err := clusterManifest(c, data)
 	if err != nil {
 		return nil, err
 	}
+	return &commonv3.HttpBody{
+		ContentType: "application/x-paralus-yaml",
+		Data:        b,
+	}, nil
+}
+
+// clusterManifest renders the manifest installing the relay agent of
+// the cluster
+func clusterManifest(c *infrapbv3.Cluster, data common.DownloadData) ([]byte, error) {
 	bb := new(bytes.Buffer)
 
 	if c.Spec.ProxyConfig != nil {
@@ -83,21 +139,16 @@ func (s *clusterServer) DownloadCluster(ctx context.Context, cluster *infrapbv3.
 		}
 	}
 
-	err = fixtures.DownloadTemplate.Execute(bb, struct {
+	err := fixtures.DownloadTemplate.Execute(bb, struct {
 		DownloadData common.DownloadData
 		Cluster      *infrapbv3.Cluster
 	}{
-		s.downloadData, c,
+		data, c,
 	})
-
 	if err != nil {
 		return nil, err
 	}
-
-	return &commonv3.HttpBody{
-		ContentType: "application/x-paralus-yaml",
-		Data:        bb.Bytes(),
-	}, nil
+	return bb.Bytes(), nil
 }
 
 func (s *clusterServer) UpdateClusterStatus(ctx context.Context, request *rpcv3.UpdateClusterStatusRequest) (*rpcv3.UpdateClusterStatusResponse, error) {
@@ -125,3 +176,103 @@ func (s *clusterServer) GetClusterStatus(ctx context.Context, request *rpcv3.Get
 		ClusterStatus: cluster.GetSpec().GetClusterData().GetClusterStatus(),
 	}, nil
 }
+
+func (s *clusterServer) GetAgentVersions(ctx context.Context, req *commonv3.QueryOptions) (*infrapbv3.AgentVersionList, error) {
+	return s.ClusterService.GetAgentVersions(ctx, query.WithOptions(req))
+}
+
+func (s *clusterServer) CreateAgentUpgrade(ctx context.Context, req *infrapbv3.AgentUpgrade) (*infrapbv3.AgentUpgrade, error) {
+	return s.ClusterService.CreateAgentUpgrade(ctx, req)
+}
+
+func (s *clusterServer) GetAgentUpgrades(ctx context.Context, req *commonv3.QueryOptions) (*infrapbv3.AgentUpgradeList, error) {
+	return s.ListAgentUpgrades(ctx, query.WithOptions(req))
+}
+
+func (s *clusterServer) GetAgentUpgrade(ctx context.Context, req *infrapbv3.AgentUpgrade) (*infrapbv3.AgentUpgrade, error) {
+	return s.ClusterService.GetAgentUpgrade(ctx, req)
+}
+
+func (s *clusterServer) ResumeAgentUpgrade(ctx context.Context, req *infrapbv3.AgentUpgrade) (*infrapbv3.AgentUpgrade, error) {
+	return s.ClusterService.ResumeAgentUpgrade(ctx, req)
+}
+
+func (s *clusterServer) ShareCluster(ctx context.Context, req *rpcv3.ShareClusterRequest) (*infrapbv3.Cluster, error) {
+	return s.ClusterService.ShareCluster(ctx, req.Metadata, req.ShareMode, req.Projects)
+}
+
+func (s *clusterServer) UnshareCluster(ctx context.Context, req *rpcv3.UnshareClusterRequest) (*infrapbv3.Cluster, error) {
+	return s.ClusterService.UnshareCluster(ctx, req.Metadata, req.Projects)
+}
+
+func (s *clusterServer) MoveCluster(ctx context.Context, req *rpcv3.MoveClusterRequest) (*infrapbv3.Cluster, error) {
+	return s.ClusterService.MoveCluster(ctx, req.Metadata, req.Project)
+}
+
+func (s *clusterServer) RenameCluster(ctx context.Context, req *rpcv3.RenameClusterRequest) (*infrapbv3.Cluster, error) {
+	return s.ClusterService.RenameCluster(ctx, req.Metadata, req.Name)
+}
+
+// clusterRegistration is the result of the registration of a cluster
+// in the bundle of registered clusters
+type clusterRegistration struct {
+	Name      string `json:"name"`
+	Operation string `json:"operation"`
+	Status    string `json:"status"`
+	Reason    string `json:"reason,omitempty"`
+}
+
+func (s *clusterServer) RegisterClusters(ctx context.Context, req *rpcv3.RegisterClustersRequest) (*commonv3.HttpBody, error) {
+	clusters, err := s.ClusterService.RegisterClusters(ctx, req.GetMetadata().GetProject(), req.Clusters)
+	if err != nil {
+		return nil, err
+	}
+
+	manifests := make(map[string][]byte)
+	results := make([]clusterRegistration, 0, len(clusters))
+	for _, c := range clusters {
+		r := clusterRegistration{
+			Name:      c.GetMetadata().GetName(),
+			Operation: c.GetStatus().GetConditionType(),
+			Status:    c.GetStatus().GetConditionStatus().String(),
+			Reason:    c.GetStatus().GetReason(),
+		}
+		if c.GetStatus().GetConditionStatus() == v3.ConditionStatus_StatusOK {
+			b, err := s.registeredClusterManifest(ctx, c)
+			if err != nil {
+				r.Status = v3.ConditionStatus_StatusFailed.String()
+				r.Reason = "unable to download manifest: " + err.Error()
+			} else {
+				manifests[r.Name] = b
+			}
+		}
+		results = append(results, r)
+	}
+
+	rb, err := yaml.Marshal(results)
+	if err != nil {
+		return nil, err
+	}
+	b, err := clstrutil.GetClusterManifestBundle(manifests, rb)
+	if err != nil {
+		return nil, err
+	}
+	return &commonv3.HttpBody{
+		ContentType: "application/gzip",
+		Data:        b,
+	}, nil
+}
+
+// registeredClusterManifest renders the manifest of a registered cluster
+func (s *clusterServer) registeredClusterManifest(ctx context.Context, c *infrapbv3.Cluster) ([]byte, error) {
+	c, err := s.Select(ctx, &infrapbv3.Cluster{Metadata: &v3.Metadata{
+		Name:    c.Metadata.Name,
+		Project: c.Metadata.Project,
+	}}, true)
+	if err != nil {
+		return nil, err
+	}
+	data := s.downloadData
+	data.RelayAgentImage = clstrutil.RelayAgentImageForVersion(data.RelayAgentImage, c.GetSpec().GetDesiredAgentVersion())
+	return clusterManifest(c, data)
+}
@@ -17,11 +17,12 @@ type clusterAuthzServer struct {
 	kcs service.KubectlClusterSettingsService
 	kss service.KubeconfigSettingService
 	ns  service.NamespaceService
+	cs  service.ClusterService
 }
 
 // GetUserAuthorization return authorization profile of user for a given cluster
 func (s *clusterAuthzServer) GetUserAuthorization(ctx context.Context, req *sentryrpc.GetUserAuthorizationRequest) (*sentryrpc.GetUserAuthorizationResponse, error) {
-	resp, err := authz.GetAuthorization(ctx, req, s.bs, s.aps, s.gps, s.krs, s.kcs, s.kss, s.ns)
+	resp, err := authz.GetAuthorization(ctx, req, s.bs, s.aps, s.gps, s.krs, s.kcs, s.kss, s.ns, s.cs)
 	if err != nil {
 		_log.Errorw("error getting auth profile", "req", req, "error", err.Error())
 		return nil, err
@@ -30,7 +31,7 @@ func (s *clusterAuthzServer) GetUserAuthorization(ctx context.Context, req *sent
 }
 
 // NewClusterAuthzServer returns New ClusterAuthzServer
-func NewClusterAuthzServer(bs service.BootstrapService, aps service.AccountPermissionService, gps service.GroupPermissionService, krs service.KubeconfigRevocationService, kcs service.KubectlClusterSettingsService, kss service.KubeconfigSettingService, ns service.NamespaceService) sentryrpc.ClusterAuthorizationServiceServer {
+func NewClusterAuthzServer(bs service.BootstrapService, aps service.AccountPermissionService, gps service.GroupPermissionService, krs service.KubeconfigRevocationService, kcs service.KubectlClusterSettingsService, kss service.KubeconfigSettingService, ns service.NamespaceService, cs service.ClusterService) sentryrpc.ClusterAuthorizationServiceServer {
 	return &clusterAuthzServer{
 		bs:  bs,
 		aps: aps,
@@ -39,5 +40,6 @@ func NewClusterAuthzServer(bs service.BootstrapService, aps service.AccountPermi
 		kcs: kcs,
 		kss: kss,
 		ns:  ns,
+		cs:  cs,
 	}
 }
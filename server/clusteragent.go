@@ -0,0 +1,41 @@
+package server
+
+import (
+	"context"
+
+	"github.com/paralus/paralus/pkg/service"
+	sentryrpc "github.com/paralus/paralus/proto/rpc/sentry"
+)
+
+type clusterAgentServer struct {
+	bs service.BootstrapService
+	cs service.ClusterService
+}
+
+var _ sentryrpc.ClusterAgentServiceServer = (*clusterAgentServer)(nil)
+
+// NewClusterAgentServer returns new cluster agent server
+func NewClusterAgentServer(bs service.BootstrapService, cs service.ClusterService) sentryrpc.ClusterAgentServiceServer {
+	return &clusterAgentServer{bs: bs, cs: cs}
+}
+
+func (s *clusterAgentServer) GetAgentManifest(ctx context.Context, req *sentryrpc.GetAgentManifestRequest) (*sentryrpc.GetAgentManifestResponse, error) {
+	clusterID, err := clusterForClient(ctx, s.bs)
+	if err != nil {
+		_log.Infow("unable to authenticate agent manifest request", "error", err)
+		return nil, err
+	}
+	return s.cs.GetAgentManifest(ctx, clusterID, req.Version)
+}
+
+func (s *clusterAgentServer) ReportAgentUpgrade(ctx context.Context, req *sentryrpc.ReportAgentUpgradeRequest) (*sentryrpc.ReportAgentUpgradeResponse, error) {
+	clusterID, err := clusterForClient(ctx, s.bs)
+	if err != nil {
+		_log.Infow("unable to authenticate agent upgrade report", "error", err)
+		return nil, err
+	}
+	if err := s.cs.ReportAgentUpgrade(ctx, clusterID, req); err != nil {
+		return nil, err
+	}
+	return &sentryrpc.ReportAgentUpgradeResponse{}, nil
+}
@@ -0,0 +1,59 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/paralus/paralus/pkg/grpc"
+	"github.com/paralus/paralus/pkg/service"
+	sentryrpc "github.com/paralus/paralus/proto/rpc/sentry"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// relayAgentTemplate is the bootstrap agent template of the relay
+// agents running in clusters, the name of their agents is the ID of
+// the cluster
+const relayAgentTemplate = "paralus-core-relay-agent"
+
+type clusterInventoryServer struct {
+	bs service.BootstrapService
+	cs service.ClusterService
+}
+
+var _ sentryrpc.ClusterInventoryServiceServer = (*clusterInventoryServer)(nil)
+
+// NewClusterInventoryServer returns new cluster inventory server
+func NewClusterInventoryServer(bs service.BootstrapService, cs service.ClusterService) sentryrpc.ClusterInventoryServiceServer {
+	return &clusterInventoryServer{bs: bs, cs: cs}
+}
+
+// clusterForClient returns the ID of the cluster of the relay agent
+// the client cert was issued to, its common name is the token of the
+// bootstrap agent
+func clusterForClient(ctx context.Context, bs service.BootstrapService) (string, error) {
+	token, err := grpc.GetClientName(ctx)
+	if err != nil {
+		return "", status.Error(codes.Unauthenticated, "client certificate is required")
+	}
+	agent, err := bs.GetBootstrapAgentForToken(ctx, token)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return "", status.Error(codes.PermissionDenied, "client is not a relay agent")
+		}
+		return "", err
+	}
+	if agent.GetSpec().GetTemplateRef() != relayAgentTemplate {
+		return "", status.Error(codes.PermissionDenied, "client is not a relay agent")
+	}
+	return agent.GetMetadata().GetName(), nil
+}
+
+func (s *clusterInventoryServer) UpdateClusterNodes(ctx context.Context, req *sentryrpc.UpdateClusterNodesRequest) (*sentryrpc.UpdateClusterNodesResponse, error) {
+	clusterID, err := clusterForClient(ctx, s.bs)
+	if err != nil {
+		_log.Infow("unable to authenticate cluster inventory update", "error", err)
+		return nil, err
+	}
+	return s.cs.UpdateClusterNodes(ctx, clusterID, req)
+}
@@ -0,0 +1,49 @@
+package server
+
+import (
+	"context"
+
+	"github.com/paralus/paralus/pkg/service"
+	rpcv3 "github.com/paralus/paralus/proto/rpc/user"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type deviceServer struct {
+	service.DeviceService
+}
+
+var _ rpcv3.DeviceAuthorizationServiceServer = (*deviceServer)(nil)
+
+// NewDeviceServer returns new device authorization server implementation
+func NewDeviceServer(ds service.DeviceService) rpcv3.DeviceAuthorizationServiceServer {
+	return &deviceServer{ds}
+}
+
+func (s *deviceServer) AuthorizeDevice(ctx context.Context, req *rpcv3.DeviceAuthorizationRequest) (*rpcv3.DeviceAuthorizationResponse, error) {
+	return s.Authorize(ctx, req)
+}
+
+func (s *deviceServer) ApproveDevice(ctx context.Context, req *rpcv3.DeviceApprovalRequest) (*commonv3.Empty, error) {
+	return &commonv3.Empty{}, s.Approve(ctx, req)
+}
+
+func (s *deviceServer) RequestDeviceToken(ctx context.Context, req *rpcv3.DeviceTokenRequest) (*rpcv3.DeviceTokenResponse, error) {
+	resp, err := s.Token(ctx, req)
+	switch err {
+	case service.ErrAuthorizationPending, service.ErrSlowDown, service.ErrAccessDenied,
+		service.ErrExpiredToken, service.ErrInvalidGrant, service.ErrUnsupportedGrantType:
+		// the message is the error code the client expects
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return resp, err
+}
+
+func (s *deviceServer) GetDevices(ctx context.Context, _ *commonv3.Empty) (*rpcv3.DeviceList, error) {
+	return s.List(ctx)
+}
+
+func (s *deviceServer) RevokeDevice(ctx context.Context, req *rpcv3.Device) (*commonv3.Empty, error) {
+	return &commonv3.Empty{}, s.Revoke(ctx, req)
+}
@@ -57,3 +57,8 @@ func (s *groupServer) UpdateGroup(ctx context.Context, req *userpbv3.Group) (*us
 	resp, err := s.Update(ctx, req)
 	return updateGroupStatus(req, resp, err), err
 }
+
+func (s *groupServer) GetGroupEffectiveMembers(ctx context.Context, req *userpbv3.Group) (*userpbv3.Group, error) {
+	resp, err := s.GetEffectiveMembers(ctx, req)
+	return updateGroupStatus(req, resp, err), err
+}
@@ -6,15 +6,20 @@ import (
 	"time"
 
 	"github.com/paralus/paralus/internal/constants"
+	"github.com/paralus/paralus/pkg/gateway"
 	"github.com/paralus/paralus/pkg/query"
 	"github.com/paralus/paralus/pkg/sentry/cryptoutil"
 	"github.com/paralus/paralus/pkg/sentry/kubeconfig"
 	"github.com/paralus/paralus/pkg/sentry/util"
 	"github.com/paralus/paralus/pkg/service"
 	sentryrpc "github.com/paralus/paralus/proto/rpc/sentry"
+	rpcv3 "github.com/paralus/paralus/proto/rpc/user"
 	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
 	sentry "github.com/paralus/paralus/proto/types/sentry"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -22,8 +27,10 @@ type kubeConfigServer struct {
 	bs  service.BootstrapService
 	aps service.AccountPermissionService
 	gps service.GroupPermissionService
+	cs  service.ClusterService
 	kss service.KubeconfigSettingService
 	krs service.KubeconfigRevocationService
+	ses service.SessionService
 	pf  cryptoutil.PasswordFunc
 	ks  service.ApiKeyService
 	os  service.OrganizationService
@@ -56,11 +63,81 @@ func (s *kubeConfigServer) GetForClusterWebSession(ctx context.Context, in *sent
 }
 
 func (s *kubeConfigServer) GetForUser(ctx context.Context, in *sentryrpc.GetForUserRequest) (*commonv3.HttpBody, error) {
-	config, err := kubeconfig.GetConfigForUser(ctx, s.bs, s.aps, s.gps, in, s.pf, s.kss, s.ks, s.os, s.ps, s.al)
+	config, err := kubeconfig.GetConfigForUser(ctx, s.bs, s.aps, s.gps, s.cs, in, s.pf, s.kss, s.ks, s.os, s.ps, s.al)
 	if err != nil {
 		_log.Errorw("error generating kubeconfig", "error", err.Error())
 		return nil, err
 	}
+	// the kubeconfig is listed in the sessions of the user
+	validity, err := kubeconfig.GetCertValidity(ctx, in.Opts.Organization, in.Opts.Account, in.Opts.IsSSOUser, s.kss)
+	if err == nil {
+		err = s.ses.RecordKubeconfig(ctx, in.Opts.Account, validity)
+	}
+	if err != nil {
+		_log.Warnw("unable to record kubeconfig session", "account", in.Opts.Account, "error", err)
+	}
+	return &commonv3.HttpBody{
+		ContentType: "application/yaml",
+		Data:        config,
+	}, nil
+}
+
+// apiKeyValidity returns how long the api key the request is
+// authenticated with remains valid, zero when the request is not
+// authenticated with an api key or the key does not expire
+func (s *kubeConfigServer) apiKeyValidity(ctx context.Context) (time.Duration, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md.Get(gateway.APIKey)) == 0 || md.Get(gateway.APIKey)[0] == "" {
+		return 0, nil
+	}
+	key, err := s.ks.GetByKey(ctx, &rpcv3.ApiKeyRequest{Id: md.Get(gateway.APIKey)[0]})
+	if err != nil {
+		return 0, err
+	}
+	if key.ExpiresAt.IsZero() {
+		return 0, nil
+	}
+	validity := time.Until(key.ExpiresAt)
+	if validity <= 0 {
+		return 0, status.Error(codes.Unauthenticated, "api key has expired")
+	}
+	return validity, nil
+}
+
+func (s *kubeConfigServer) GetForServiceAccount(ctx context.Context, in *sentryrpc.GetForUserRequest) (*commonv3.HttpBody, error) {
+	sd, ok := service.GetSessionDataFromContext(ctx)
+	if !ok || !sd.GetIsServiceAccount() {
+		return nil, status.Error(codes.PermissionDenied, "kubeconfig is only issued to service accounts")
+	}
+	validity, err := s.apiKeyValidity(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// the kubeconfig is always issued for the authenticated service
+	// account, never for the account in the request
+	req := &sentryrpc.GetForUserRequest{
+		Opts: &commonv3.QueryOptions{
+			Account:      sd.Account,
+			Username:     sd.Username,
+			Organization: sd.Organization,
+			Partner:      sd.Partner,
+		},
+		Namespace: in.GetNamespace(),
+		Region:    in.GetRegion(),
+	}
+	var config []byte
+	if validity > 0 {
+		// kubeconfigs requested with short-lived api keys, such as
+		// the ones exchanged for workload identity tokens, do not
+		// outlive the key
+		config, err = kubeconfig.GetShortLivedConfigForUser(ctx, s.bs, s.aps, s.gps, s.cs, req, s.pf, s.kss, s.ks, s.os, s.ps, s.al, validity)
+	} else {
+		config, err = kubeconfig.GetConfigForUser(ctx, s.bs, s.aps, s.gps, s.cs, req, s.pf, s.kss, s.ks, s.os, s.ps, s.al)
+	}
+	if err != nil {
+		_log.Errorw("error generating service account kubeconfig", "error", err.Error())
+		return nil, err
+	}
 	return &commonv3.HttpBody{
 		ContentType: "application/yaml",
 		Data:        config,
@@ -205,9 +282,9 @@ func (s *kubeConfigServer) UpdateUserSetting(ctx context.Context, req *sentryrpc
 }
 
 // NewKubeConfigServer returns new kube config server
-func NewKubeConfigServer(bs service.BootstrapService, aps service.AccountPermissionService, gps service.GroupPermissionService, kss service.KubeconfigSettingService,
-	krs service.KubeconfigRevocationService, pf cryptoutil.PasswordFunc, ksvc service.ApiKeyService, os service.OrganizationService, ps service.PartnerService, al *zap.Logger) sentryrpc.KubeConfigServiceServer {
-	return &kubeConfigServer{bs, aps, gps, kss, krs, pf, ksvc, os, ps, al}
+func NewKubeConfigServer(bs service.BootstrapService, aps service.AccountPermissionService, gps service.GroupPermissionService, cs service.ClusterService, kss service.KubeconfigSettingService,
+	krs service.KubeconfigRevocationService, ses service.SessionService, pf cryptoutil.PasswordFunc, ksvc service.ApiKeyService, os service.OrganizationService, ps service.PartnerService, al *zap.Logger) sentryrpc.KubeConfigServiceServer {
+	return &kubeConfigServer{bs, aps, gps, cs, kss, krs, ses, pf, ksvc, os, ps, al}
 }
 
 func (s *kubeConfigServer) RevokeKubeconfigSSO(ctx context.Context, req *sentryrpc.RevokeKubeconfigRequest) (*sentryrpc.RevokeKubeconfigResponse, error) {
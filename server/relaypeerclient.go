@@ -61,12 +61,14 @@ func GetPeerCache(cache *ristretto.Cache, key interface{}) (string, bool) {
 	return "", found
 }
 
-// sends periodic heartbeats to core service
-func helloRPCSend(ctx context.Context, stream relayrpc.RelayPeerService_RelayPeerHelloRPCClient, interval time.Duration, relayUUID string, ip func() string) {
+// sends periodic heartbeats to core service along with the clusters
+// whose connectors are connected to the relay
+func helloRPCSend(ctx context.Context, stream relayrpc.RelayPeerService_RelayPeerHelloRPCClient, interval time.Duration, relayUUID string, ip func() string, clusters func() []string) {
 	_log.Infow("send first hello")
 	msg := &relayrpc.PeerHelloRequest{
-		Relayuuid: relayUUID,
-		Relayip:   ip(),
+		Relayuuid:   relayUUID,
+		Relayip:     ip(),
+		Clustersnis: clusters(),
 	}
 	// send first hello message
 	err := stream.Send(msg)
@@ -85,6 +87,7 @@ helloRPCSendLoop:
 			stream.CloseSend()
 			break helloRPCSendLoop
 		case <-tick.C:
+			msg.Clustersnis = clusters()
 			err := stream.Send(msg)
 			if err != nil {
 				_log.Errorw("failed to send hello message", err)
@@ -96,9 +99,11 @@ helloRPCSendLoop:
 }
 
 // ClientHelloRPC will handle periodic heartbeat messages between relay and the core service.
-func ClientHelloRPC(ctx context.Context, stream relayrpc.RelayPeerService_RelayPeerHelloRPCClient, interval time.Duration, relayUUID string, ip func() string) {
+// clusters returns the SNI of the clusters connected to the relay, which
+// the core service tracks the health of the clusters with.
+func ClientHelloRPC(ctx context.Context, stream relayrpc.RelayPeerService_RelayPeerHelloRPCClient, interval time.Duration, relayUUID string, ip func() string, clusters func() []string) {
 
-	go helloRPCSend(ctx, stream, interval, relayUUID, ip)
+	go helloRPCSend(ctx, stream, interval, relayUUID, ip, clusters)
 
 	for {
 		in, err := stream.Recv()
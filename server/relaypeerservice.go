@@ -4,12 +4,14 @@ import (
 	"context"
 	"net"
 	"os"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/dgraph-io/ristretto"
 	"github.com/google/uuid"
 	"github.com/paralus/paralus/pkg/grpc"
+	"github.com/paralus/paralus/pkg/service"
 	sentryrpc "github.com/paralus/paralus/proto/rpc/sentry"
 )
 
@@ -62,6 +64,9 @@ type relayPeerService struct {
 
 	//peerServiceCache stores peer dialin info
 	peerServiceCache *ristretto.Cache
+
+	//cs records the clusters seen connected to relays
+	cs service.ClusterService
 }
 
 var maxRelayIdle = 300 //5 min
@@ -102,7 +107,7 @@ func (s *relayPeerService) getPeerServiceCache(key interface{}) (string, bool) {
 }
 
 // NewRelayPeerService returns new placement server implementation
-func NewRelayPeerService() (sentryrpc.RelayPeerServiceServer, error) {
+func NewRelayPeerService(cs service.ClusterService) (sentryrpc.RelayPeerServiceServer, error) {
 	cache, err := initPeerServiceCache()
 	if err != nil {
 		_log.Errorw("failed to init cache", "error", err)
@@ -115,6 +120,7 @@ func NewRelayPeerService() (sentryrpc.RelayPeerServiceServer, error) {
 		surveyBroadCast:   make(chan surveyBroadCastRequest, 256),
 		surveyCacheExpiry: 60 * time.Second,
 		peerServiceCache:  cache,
+		cs:                cs,
 	}, nil
 }
 
@@ -375,6 +381,7 @@ func (s *relayPeerService) RelayPeerHelloRPC(stream sentryrpc.RelayPeerService_R
 
 		stream.Send(msg)
 		go s.handleHelloRequest(relayuuid, relayip, ou)
+		go s.recordClustersSeen(in.GetClustersnis()...)
 	}
 }
 
@@ -581,12 +588,30 @@ func (s *relayPeerService) RelayPeerSurveyRPC(stream sentryrpc.RelayPeerService_
 			if !s.insertPeerServiceCache(ckey, relayip) {
 				_log.Errorw("failed to insert into cache")
 			}
+			go s.recordClustersSeen(clustersni)
 		}
 
 	}
 
 }
 
+// recordClustersSeen records the clusters of the SNIs as connected, the
+// first label of the SNI of a cluster is its id
+func (s *relayPeerService) recordClustersSeen(clustersnis ...string) {
+	if s.cs == nil || len(clustersnis) == 0 {
+		return
+	}
+	ids := make([]string, 0, len(clustersnis))
+	for _, sni := range clustersnis {
+		ids = append(ids, strings.SplitN(sni, ".", 2)[0])
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := s.cs.RecordClustersSeen(ctx, ids); err != nil {
+		_log.Infow("unable to record clusters seen", "error", err)
+	}
+}
+
 func peerServiceCacheKey(clustersni, relayuuid, ou string) string {
 	return clustersni + relayuuid + ou
 }
@@ -55,3 +55,12 @@ func (s *roleServer) UpdateRole(ctx context.Context, req *rolepbv3.Role) (*rolep
 	resp, err := s.Update(ctx, req)
 	return updateRoleStatus(req, resp, err), err
 }
+
+func (s *roleServer) ExplainAuthorization(ctx context.Context, req *rolepbv3.AuthorizationExplainRequest) (*rolepbv3.AuthorizationExplanation, error) {
+	return s.RoleService.ExplainAuthorization(ctx, req)
+}
+
+func (s *roleServer) GetRoleEffectivePermissions(ctx context.Context, req *rolepbv3.Role) (*rolepbv3.Role, error) {
+	resp, err := s.GetEffectivePermissions(ctx, req)
+	return updateRoleStatus(req, resp, err), err
+}
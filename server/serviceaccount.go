@@ -0,0 +1,52 @@
+package server
+
+import (
+	"context"
+
+	"github.com/paralus/paralus/pkg/query"
+	"github.com/paralus/paralus/pkg/service"
+	rpcv3 "github.com/paralus/paralus/proto/rpc/user"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	userpbv3 "github.com/paralus/paralus/proto/types/userpb/v3"
+)
+
+type serviceAccountServer struct {
+	service.ServiceAccountService
+}
+
+// NewServiceAccountServer returns new service account server implementation
+func NewServiceAccountServer(ss service.ServiceAccountService) rpcv3.ServiceAccountServiceServer {
+	return &serviceAccountServer{ss}
+}
+
+func (s *serviceAccountServer) CreateServiceAccount(ctx context.Context, req *userpbv3.ServiceAccount) (*userpbv3.ServiceAccount, error) {
+	return s.Create(ctx, req)
+}
+
+func (s *serviceAccountServer) GetServiceAccounts(ctx context.Context, req *commonv3.QueryOptions) (*userpbv3.ServiceAccountList, error) {
+	return s.List(ctx, query.WithOptions(req))
+}
+
+func (s *serviceAccountServer) GetServiceAccount(ctx context.Context, req *userpbv3.ServiceAccount) (*userpbv3.ServiceAccount, error) {
+	return s.GetByName(ctx, req)
+}
+
+func (s *serviceAccountServer) UpdateServiceAccount(ctx context.Context, req *userpbv3.ServiceAccount) (*userpbv3.ServiceAccount, error) {
+	return s.Update(ctx, req)
+}
+
+func (s *serviceAccountServer) DeleteServiceAccount(ctx context.Context, req *userpbv3.ServiceAccount) (*commonv3.Empty, error) {
+	return &commonv3.Empty{}, s.Delete(ctx, req)
+}
+
+func (s *serviceAccountServer) CreateServiceAccountApiKey(ctx context.Context, req *rpcv3.ServiceAccountApiKeyRequest) (*rpcv3.ServiceAccountApiKey, error) {
+	return s.CreateApiKey(ctx, req)
+}
+
+func (s *serviceAccountServer) GetServiceAccountApiKeys(ctx context.Context, req *rpcv3.ServiceAccountApiKeyRequest) (*rpcv3.UserListApiKeysResponse, error) {
+	return s.ListApiKeys(ctx, req)
+}
+
+func (s *serviceAccountServer) DeleteServiceAccountApiKey(ctx context.Context, req *rpcv3.ServiceAccountApiKeyRequest) (*commonv3.Empty, error) {
+	return &commonv3.Empty{}, s.DeleteApiKey(ctx, req)
+}
@@ -10,17 +10,21 @@ import (
 	rpcv3 "github.com/paralus/paralus/proto/rpc/user"
 	v3 "github.com/paralus/paralus/proto/types/commonpb/v3"
 	userpbv3 "github.com/paralus/paralus/proto/types/userpb/v3"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type userServer struct {
 	us service.UserService
 	ks service.ApiKeyService
+	ls service.LockoutService
+	ss service.SessionService
 }
 
 // NewUserServer returns new user server implementation
-func NewUserServer(ps service.UserService, as service.ApiKeyService) rpcv3.UserServiceServer {
-	return &userServer{us: ps, ks: as}
+func NewUserServer(ps service.UserService, as service.ApiKeyService, ls service.LockoutService, ss service.SessionService) rpcv3.UserServiceServer {
+	return &userServer{us: ps, ks: as, ls: ls, ss: ss}
 }
 func updateUserStatus(req *userpbv3.User, resp *userpbv3.User, err error) *userpbv3.User {
 	if err != nil {
@@ -44,6 +48,10 @@ func (s *userServer) GetUsers(ctx context.Context, req *v3.QueryOptions) (*userp
 	return s.us.List(ctx, query.WithOptions(req))
 }
 
+func (s *userServer) GetUsersWithoutMFA(ctx context.Context, req *v3.QueryOptions) (*userpbv3.UserList, error) {
+	return s.us.ListWithoutMFA(ctx, query.WithOptions(req))
+}
+
 func (s *userServer) GetUser(ctx context.Context, req *userpbv3.User) (*userpbv3.User, error) {
 	resp, err := s.us.GetByName(ctx, req)
 	return updateUserStatus(req, resp, err), err
@@ -125,5 +133,60 @@ func (s *userServer) UserForgotPassword(ctx context.Context, req *rpcv3.UserForg
 }
 
 func (s *userServer) AuditLogWebhook(ctx context.Context, req *rpcv3.UserLoginAuditRequest) (*rpcv3.UserLoginAuditResponse, error) {
-	return s.us.CreateLoginAuditLog(ctx, req)
+	resp, err := s.us.CreateLoginAuditLog(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+	err = s.ls.RecordLoginSuccess(ctx, req.UserId)
+	return resp, err
+}
+
+func (s *userServer) LoginFailureWebhook(ctx context.Context, req *rpcv3.UserLoginFailureRequest) (*rpcv3.UserLoginFailureResponse, error) {
+	err := s.ls.RecordLoginFailure(ctx, req.Username)
+	return &rpcv3.UserLoginFailureResponse{}, err
+}
+
+func (s *userServer) UnlockUser(ctx context.Context, req *userpbv3.User) (*userpbv3.User, error) {
+	err := s.ls.Unlock(ctx, req.GetMetadata().GetName())
+	if err != nil {
+		return updateUserStatus(req, nil, err), err
+	}
+	return s.GetUser(ctx, req)
+}
+
+func (s *userServer) SuspendUser(ctx context.Context, req *userpbv3.User) (*userpbv3.User, error) {
+	err := s.us.Suspend(ctx, req)
+	if err != nil {
+		return updateUserStatus(req, nil, err), err
+	}
+	return s.GetUser(ctx, req)
+}
+
+func (s *userServer) ReactivateUser(ctx context.Context, req *userpbv3.User) (*userpbv3.User, error) {
+	err := s.us.Reactivate(ctx, req)
+	if err != nil {
+		return updateUserStatus(req, nil, err), err
+	}
+	return s.GetUser(ctx, req)
+}
+
+// sessionStatus maps the errors of managing sessions to their status
+func sessionStatus(err error) error {
+	if err == service.ErrSessionPermissionDenied {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	return err
+}
+
+func (s *userServer) ListSessions(ctx context.Context, req *rpcv3.UserSessionRequest) (*rpcv3.UserSessionList, error) {
+	resp, err := s.ss.List(ctx, req)
+	return resp, sessionStatus(err)
+}
+
+func (s *userServer) RevokeSession(ctx context.Context, req *rpcv3.UserSessionRequest) (*v3.Empty, error) {
+	return &v3.Empty{}, sessionStatus(s.ss.Revoke(ctx, req))
+}
+
+func (s *userServer) RevokeAllSessions(ctx context.Context, req *rpcv3.UserSessionRequest) (*v3.Empty, error) {
+	return &v3.Empty{}, sessionStatus(s.ss.RevokeAll(ctx, req))
 }
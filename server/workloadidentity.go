@@ -0,0 +1,97 @@
+package server
+
+import (
+	"context"
+
+	"github.com/paralus/paralus/pkg/common"
+	"github.com/paralus/paralus/pkg/query"
+	"github.com/paralus/paralus/pkg/sentry/cryptoutil"
+	"github.com/paralus/paralus/pkg/sentry/kubeconfig"
+	"github.com/paralus/paralus/pkg/service"
+	sentryrpc "github.com/paralus/paralus/proto/rpc/sentry"
+	rpcv3 "github.com/paralus/paralus/proto/rpc/user"
+	commonv3 "github.com/paralus/paralus/proto/types/commonpb/v3"
+	userpbv3 "github.com/paralus/paralus/proto/types/userpb/v3"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type workloadIdentityServer struct {
+	wis service.WorkloadIdentityService
+	bs  service.BootstrapService
+	aps service.AccountPermissionService
+	gps service.GroupPermissionService
+	cs  service.ClusterService
+	kss service.KubeconfigSettingService
+	pf  cryptoutil.PasswordFunc
+	ks  service.ApiKeyService
+	os  service.OrganizationService
+	ps  service.PartnerService
+	al  *zap.Logger
+}
+
+var _ rpcv3.WorkloadIdentityServiceServer = (*workloadIdentityServer)(nil)
+
+// NewWorkloadIdentityServer returns new workload identity server implementation
+func NewWorkloadIdentityServer(wis service.WorkloadIdentityService, bs service.BootstrapService, aps service.AccountPermissionService, gps service.GroupPermissionService, cs service.ClusterService, kss service.KubeconfigSettingService,
+	pf cryptoutil.PasswordFunc, ks service.ApiKeyService, os service.OrganizationService, ps service.PartnerService, al *zap.Logger) rpcv3.WorkloadIdentityServiceServer {
+	return &workloadIdentityServer{wis, bs, aps, gps, cs, kss, pf, ks, os, ps, al}
+}
+
+func (s *workloadIdentityServer) CreateWorkloadIdentityPolicy(ctx context.Context, req *userpbv3.WorkloadIdentityPolicy) (*userpbv3.WorkloadIdentityPolicy, error) {
+	return s.wis.Create(ctx, req)
+}
+
+func (s *workloadIdentityServer) GetWorkloadIdentityPolicies(ctx context.Context, req *commonv3.QueryOptions) (*userpbv3.WorkloadIdentityPolicyList, error) {
+	return s.wis.List(ctx, query.WithOptions(req))
+}
+
+func (s *workloadIdentityServer) GetWorkloadIdentityPolicy(ctx context.Context, req *userpbv3.WorkloadIdentityPolicy) (*userpbv3.WorkloadIdentityPolicy, error) {
+	return s.wis.GetByName(ctx, req)
+}
+
+func (s *workloadIdentityServer) UpdateWorkloadIdentityPolicy(ctx context.Context, req *userpbv3.WorkloadIdentityPolicy) (*userpbv3.WorkloadIdentityPolicy, error) {
+	return s.wis.Update(ctx, req)
+}
+
+func (s *workloadIdentityServer) DeleteWorkloadIdentityPolicy(ctx context.Context, req *userpbv3.WorkloadIdentityPolicy) (*commonv3.Empty, error) {
+	return &commonv3.Empty{}, s.wis.Delete(ctx, req)
+}
+
+func (s *workloadIdentityServer) ExchangeToken(ctx context.Context, req *rpcv3.WorkloadIdentityTokenRequest) (*rpcv3.WorkloadIdentityCredentials, error) {
+	creds, err := s.wis.ExchangeToken(ctx, req, clientSessionData(ctx))
+	if err == service.ErrWorkloadIdentityDenied {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+	return creds, err
+}
+
+func (s *workloadIdentityServer) ExchangeTokenForKubeconfig(ctx context.Context, req *rpcv3.WorkloadIdentityTokenRequest) (*commonv3.HttpBody, error) {
+	sd, ttl, err := s.wis.Authenticate(ctx, req, clientSessionData(ctx))
+	if err == service.ErrWorkloadIdentityDenied {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = context.WithValue(ctx, common.SessionDataKey, sd)
+	config, err := kubeconfig.GetShortLivedConfigForUser(ctx, s.bs, s.aps, s.gps, s.cs, &sentryrpc.GetForUserRequest{
+		Opts: &commonv3.QueryOptions{
+			Account:      sd.Account,
+			Username:     sd.Username,
+			Organization: sd.Organization,
+			Partner:      sd.Partner,
+		},
+		Namespace: req.GetNamespace(),
+	}, s.pf, s.kss, s.ks, s.os, s.ps, s.al, ttl)
+	if err != nil {
+		_log.Errorw("error generating workload identity kubeconfig", "error", err.Error())
+		return nil, err
+	}
+	return &commonv3.HttpBody{
+		ContentType: "application/yaml",
+		Data:        config,
+	}, nil
+}